@@ -25,6 +25,7 @@ type MethodInfo struct {
 	ProcessRaw     bool
 	SyncMessage    bool
 	FromArchiveArg bool
+	FilterArg      bool
 }
 
 func main() {
@@ -69,6 +70,7 @@ func main() {
 		}
 
 		info.FromArchiveArg = protobufName == "ChatMessage" || protobufName == "PinMessage"
+		info.FilterArg = protobufName == "UserStickerPack" || protobufName == "SafeTransactionProposal"
 
 		methodInfos = append(methodInfos, info)
 	}
@@ -101,6 +101,8 @@ type GethStatusBackend struct {
 	allowAllRPC              bool // used only for tests, disables api method restrictions
 	LocalPairingStateManager *statecontrol.ProcessStateManager
 	centralizedMetrics       *centralizedmetrics.MetricService
+	// profileKeyUID is set when the backend runs a profile alongside the main one, see Profiles
+	profileKeyUID string
 }
 
 // NewGethStatusBackend create a new GethStatusBackend instance
@@ -158,7 +160,7 @@ func (b *GethStatusBackend) StartNode(config *params.NodeConfig) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	if err := b.startNode(config); err != nil {
-		signal.SendNodeCrashed(err)
+		b.signals().SendNodeCrashed(err)
 		return err
 	}
 	return nil
@@ -363,7 +365,8 @@ func (b *GethStatusBackend) runDBFileMigrations(account multiaccounts.Account, p
 	}
 
 	if _, err = os.Stat(v3Path); err == nil {
-		if err := appdatabase.MigrateV3ToV4(v3Path, v4Path, password, account.KDFIterations, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished); err != nil {
+		signals := signal.NewNamespace(account.KeyUID)
+		if err := appdatabase.MigrateV3ToV4(v3Path, v4Path, password, account.KDFIterations, signals.SendReEncryptionStarted, signals.SendReEncryptionFinished); err != nil {
 			_ = os.Remove(v4Path)
 			_ = os.Remove(v4Path + "-shm")
 			_ = os.Remove(v4Path + "-wal")
@@ -406,8 +409,11 @@ func (b *GethStatusBackend) ensureAppDBOpened(account multiaccounts.Account, pas
 		return errors.New("Failed to migrate db file: " + err.Error())
 	}
 
+	// The key UID is read by the migrations, profiles can be opened concurrently
+	appDBInitMu.Lock()
 	appdatabase.CurrentAppDBKeyUID = account.KeyUID
 	b.appDB, err = appdatabase.InitializeDB(dbFilePath, password, account.KDFIterations)
+	appDBInitMu.Unlock()
 	if err != nil {
 		b.log.Error("failed to initialize db", "err", err.Error())
 		return err
@@ -464,6 +470,11 @@ func (b *GethStatusBackend) ensureWalletDBOpened(account multiaccounts.Account,
 }
 
 func (b *GethStatusBackend) setupLogSettings() error {
+	if b.profileKeyUID != "" {
+		// Logging is set up once for the process, by the main profile
+		return nil
+	}
+
 	logSettings := logutils.LogSettings{
 		Enabled:         b.config.LogEnabled,
 		MobileSystem:    b.config.LogMobileSystem,
@@ -897,7 +908,7 @@ func (b *GethStatusBackend) StartNodeWithAccount(acc multiaccounts.Account, pass
 
 func (b *GethStatusBackend) LoggedIn(keyUID string, err error) error {
 	if err != nil {
-		signal.SendLoggedIn(nil, nil, nil, err)
+		signal.NewNamespace(keyUID).SendLoggedIn(nil, nil, nil, err)
 		return err
 	}
 	settings, err := b.GetSettings()
@@ -920,7 +931,7 @@ func (b *GethStatusBackend) LoggedIn(keyUID string, err error) error {
 			return err
 		}
 	}
-	signal.SendLoggedIn(account, settings, ensUsernamesJSON, nil)
+	signal.NewNamespace(keyUID).SendLoggedIn(account, settings, ensUsernamesJSON, nil)
 	return nil
 }
 
@@ -959,7 +970,8 @@ func (b *GethStatusBackend) ImportUnencryptedDatabase(acc multiaccounts.Account,
 		return err
 	}
 
-	err = sqlite.EncryptDB(databasePath, path, password, acc.KDFIterations, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished)
+	signals := signal.NewNamespace(acc.KeyUID)
+	err = sqlite.EncryptDB(databasePath, path, password, acc.KDFIterations, signals.SendReEncryptionStarted, signals.SendReEncryptionFinished)
 	if err != nil {
 		b.log.Error("failed to initialize db", "err", err)
 		return err
@@ -1062,7 +1074,8 @@ func (b *GethStatusBackend) changeAppDBPassword(account *multiaccounts.Account,
 	}
 
 	// Exporting database to a temporary file with a new password
-	err = sqlite.ExportDB(dbPath, password, account.KDFIterations, tmpDbPath, newPassword, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished)
+	signals := signal.NewNamespace(account.KeyUID)
+	err = sqlite.ExportDB(dbPath, password, account.KDFIterations, tmpDbPath, newPassword, signals.SendReEncryptionStarted, signals.SendReEncryptionFinished)
 	if err != nil {
 		return err
 	}
@@ -1104,7 +1117,8 @@ func (b *GethStatusBackend) changeWalletDBPassword(account *multiaccounts.Accoun
 	}
 
 	// Exporting database to a temporary file with a new password
-	err = sqlite.ExportDB(dbPath, password, account.KDFIterations, tmpDbPath, newPassword, signal.SendReEncryptionStarted, signal.SendReEncryptionFinished)
+	signals := signal.NewNamespace(account.KeyUID)
+	err = sqlite.ExportDB(dbPath, password, account.KDFIterations, tmpDbPath, newPassword, signals.SendReEncryptionStarted, signals.SendReEncryptionFinished)
 	if err != nil {
 		return err
 	}
@@ -2042,6 +2056,10 @@ func (b *GethStatusBackend) loadNodeConfig(inputNodeCfg *params.NodeConfig) erro
 		}
 	}
 
+	if b.profileKeyUID != "" {
+		isolateProfileConfig(conf, b.profileKeyUID)
+	}
+
 	if len(conf.LogDir) == 0 {
 		conf.LogFile = filepath.Join(b.rootDataDir, conf.LogFile)
 	} else {
@@ -2105,6 +2123,9 @@ func (b *GethStatusBackend) startNode(config *params.NodeConfig) (err error) {
 		return errors.New("ethereum accounts.Manager is nil")
 	}
 
+	// The services of the node send their signals on behalf of the logged in profile
+	b.statusNode.SetSignalsNamespace(b.signals())
+
 	if err = b.statusNode.StartWithOptions(config, node.StartOptions{
 		// The peers discovery protocols are started manually after
 		// `node.ready` signal is sent.
@@ -2115,7 +2136,7 @@ func (b *GethStatusBackend) startNode(config *params.NodeConfig) (err error) {
 		return
 	}
 	b.accountManager.SetRPCClient(b.statusNode.RPCClient(), rpc.DefaultCallTimeout)
-	signal.SendNodeStarted()
+	b.signals().SendNodeStarted()
 
 	b.transactor.SetNetworkID(config.NetworkID)
 	b.transactor.SetRPC(b.statusNode.RPCClient(), rpc.DefaultCallTimeout)
@@ -2141,7 +2162,7 @@ func (b *GethStatusBackend) startNode(config *params.NodeConfig) (err error) {
 		b.statusNode.WalletService().KeycardPairings().SetKeycardPairingsFile(config.KeycardPairingDataFile)
 	}
 
-	signal.SendNodeReady()
+	b.signals().SendNodeReady()
 
 	if err := b.statusNode.StartDiscovery(); err != nil {
 		return err
@@ -2162,7 +2183,7 @@ func (b *GethStatusBackend) stopNode() error {
 		return nil
 	}
 	if !b.LocalPairingStateManager.IsPairing() {
-		defer signal.SendNodeStopped()
+		defer b.signals().SendNodeStopped()
 	}
 
 	return b.statusNode.Stop()
@@ -2197,7 +2218,7 @@ func (b *GethStatusBackend) ResetChainData() error {
 	if err := b.statusNode.ResetChainData(b.config); err != nil {
 		return err
 	}
-	signal.SendChainDataRemoved()
+	b.signals().SendChainDataRemoved()
 	return b.startNode(b.config)
 }
 
@@ -2509,6 +2530,7 @@ func (b *GethStatusBackend) Logout() error {
 	defer b.mu.Unlock()
 
 	b.log.Debug("logging out")
+	signals := b.signals()
 	err := b.cleanupServices()
 	if err != nil {
 		return err
@@ -2529,7 +2551,7 @@ func (b *GethStatusBackend) Logout() error {
 	}
 
 	if !b.LocalPairingStateManager.IsPairing() {
-		signal.SendNodeStopped()
+		signals.SendNodeStopped()
 	}
 
 	// re-initialize the node, at some point we should better manage the lifecycle
@@ -2608,6 +2630,14 @@ func (b *GethStatusBackend) SelectAccount(loginParams account.LoginParams) error
 	return nil
 }
 
+// signals returns the namespace of the signals sent for the logged in account
+func (b *GethStatusBackend) signals() signal.Namespace {
+	if b.account == nil {
+		return signal.Namespace("")
+	}
+	return signal.NewNamespace(b.account.KeyUID)
+}
+
 func (b *GethStatusBackend) GetActiveAccount() (*multiaccounts.Account, error) {
 	if b.account == nil {
 		return nil, errors.New("master key account is nil in the GethStatusBackend")
@@ -2691,6 +2721,10 @@ func (b *GethStatusBackend) KeyUID() string {
 }
 
 func (b *GethStatusBackend) injectAccountsIntoServices() error {
+	if b.statusNode.WalletService() != nil {
+		b.statusNode.WalletService().SetSignalsNamespace(b.signals())
+	}
+
 	if b.statusNode.WakuService() != nil {
 		return b.injectAccountsIntoWakuService(b.statusNode.WakuService(), func() *ext.Service {
 			if b.statusNode.WakuExtService() == nil {
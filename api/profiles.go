@@ -0,0 +1,213 @@
+package api
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// profilesDataDir is the directory, relative to the data directories of the node,
+// in which profiles running alongside the main one keep their data
+const profilesDataDir = "profiles"
+
+var (
+	// ErrProfileAlreadyLoggedIn is returned when logging in a profile that is already running
+	ErrProfileAlreadyLoggedIn = errors.New("profile is already logged in")
+	// ErrProfileNotLoggedIn is returned when a profile isn't running
+	ErrProfileNotLoggedIn = errors.New("profile is not logged in")
+)
+
+// appDBInitMu guards the initialization of app databases, as it relies on appdatabase.CurrentAppDBKeyUID
+var appDBInitMu sync.Mutex
+
+// Profiles runs profiles logged in alongside the one of the main backend.
+// Each profile runs in its own GethStatusBackend, with its own node, databases and Waku filters,
+// and its signals are tagged with its key UID.
+type Profiles struct {
+	mu       sync.Mutex
+	main     *GethStatusBackend
+	backends map[string]*GethStatusBackend
+}
+
+// NewProfiles returns the profiles running alongside the main backend
+func NewProfiles(main *GethStatusBackend) *Profiles {
+	return &Profiles{
+		main:     main,
+		backends: make(map[string]*GethStatusBackend),
+	}
+}
+
+func (p *Profiles) isMain(keyUID string) bool {
+	account, err := p.main.GetActiveAccount()
+	return err == nil && account.KeyUID == keyUID
+}
+
+// IsLoggedIn returns whether a profile is running, in the main backend or alongside it
+func (p *Profiles) IsLoggedIn(keyUID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.backends[keyUID]
+	return ok || p.isMain(keyUID)
+}
+
+// LoggedIn returns the key UIDs of the profiles running, the one of the main backend first
+func (p *Profiles) LoggedIn() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	keyUIDs := make([]string, 0, len(p.backends)+1)
+	for keyUID := range p.backends {
+		keyUIDs = append(keyUIDs, keyUID)
+	}
+	sort.Strings(keyUIDs)
+	if account, err := p.main.GetActiveAccount(); err == nil {
+		keyUIDs = append([]string{account.KeyUID}, keyUIDs...)
+	}
+	return keyUIDs
+}
+
+// Backend returns the backend running a profile
+func (p *Profiles) Backend(keyUID string) (*GethStatusBackend, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isMain(keyUID) {
+		return p.main, nil
+	}
+	b, ok := p.backends[keyUID]
+	if !ok {
+		return nil, ErrProfileNotLoggedIn
+	}
+	return b, nil
+}
+
+// Login logs a profile in alongside the running ones. The result is also sent
+// with the `node.login` signal tagged with the key UID of the profile.
+func (p *Profiles) Login(request *requests.Login) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	if _, ok := p.backends[request.KeyUID]; ok || p.isMain(request.KeyUID) {
+		p.mu.Unlock()
+		return ErrProfileAlreadyLoggedIn
+	}
+	multiaccountsDB := p.main.GetMultiaccountDB()
+	if multiaccountsDB == nil {
+		p.mu.Unlock()
+		return errors.New("accounts db wasn't initialized")
+	}
+
+	b := &GethStatusBackend{}
+	b.initialize()
+	b.rootDataDir = p.main.rootDataDir
+	b.multiaccountsDB = multiaccountsDB
+	b.statusNode.SetMultiaccountsDB(multiaccountsDB)
+	b.profileKeyUID = request.KeyUID
+	// The profile is reserved while logging in so that it can't be logged in twice
+	p.backends[request.KeyUID] = b
+	p.mu.Unlock()
+
+	err := b.LoginAccount(request)
+	if err != nil {
+		if closeErr := b.closeDBs(); closeErr != nil {
+			b.log.Error("failed to close profile databases", "err", closeErr)
+		}
+		p.mu.Lock()
+		delete(p.backends, request.KeyUID)
+		p.mu.Unlock()
+	}
+	return err
+}
+
+// Logout logs a profile out, the main backend is logged out when the profile runs in it
+func (p *Profiles) Logout(keyUID string) error {
+	p.mu.Lock()
+	if p.isMain(keyUID) {
+		p.mu.Unlock()
+		return p.main.Logout()
+	}
+	b, ok := p.backends[keyUID]
+	delete(p.backends, keyUID)
+	p.mu.Unlock()
+
+	if !ok {
+		return ErrProfileNotLoggedIn
+	}
+	return b.logoutProfile()
+}
+
+// LogoutAll logs out the profiles running alongside the main backend
+func (p *Profiles) LogoutAll() error {
+	p.mu.Lock()
+	backends := p.backends
+	p.backends = make(map[string]*GethStatusBackend)
+	p.mu.Unlock()
+
+	var lastErr error
+	for keyUID, b := range backends {
+		if err := b.logoutProfile(); err != nil {
+			b.log.Error("failed to log profile out", "keyUID", keyUID, "err", err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// logoutProfile stops the node of a profile running alongside the main one.
+// Unlike Logout, the backend isn't re-initialized as it isn't used anymore.
+func (b *GethStatusBackend) logoutProfile() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	signals := b.signals()
+	if err := b.cleanupServices(); err != nil {
+		return err
+	}
+	if err := b.closeDBs(); err != nil {
+		return err
+	}
+
+	b.AccountManager().Logout()
+	b.account = nil
+
+	if b.statusNode != nil {
+		if err := b.statusNode.Stop(); err != nil {
+			return err
+		}
+	}
+	signals.SendNodeStopped()
+	return nil
+}
+
+// isolateProfileConfig keeps the node of a profile running alongside the main one
+// from sharing data directories and listening ports with it
+func isolateProfileConfig(conf *params.NodeConfig, keyUID string) {
+	profileDir := func(dir string) string {
+		if dir == "" {
+			return dir
+		}
+		return filepath.Join(dir, profilesDataDir, keyUID)
+	}
+
+	conf.DataDir = profileDir(conf.DataDir)
+	conf.WakuConfig.DataDir = profileDir(conf.WakuConfig.DataDir)
+	conf.WakuV2Config.DataDir = profileDir(conf.WakuV2Config.DataDir)
+	conf.TorrentConfig.DataDir = profileDir(conf.TorrentConfig.DataDir)
+	conf.TorrentConfig.TorrentDir = profileDir(conf.TorrentConfig.TorrentDir)
+
+	conf.ListenAddr = ":0"
+	conf.WakuV2Config.Port = 0
+	conf.WakuV2Config.UDPPort = 0
+	conf.TorrentConfig.Port = 0
+	// The RPC servers are bound to the main profile, profiles are reached with their own RPC client
+	conf.HTTPEnabled = false
+	conf.WSEnabled = false
+	conf.IPCEnabled = false
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/requests"
+	localnotifications "github.com/status-im/status-go/services/local-notifications"
+	"github.com/status-im/status-go/signal"
+	"github.com/status-im/status-go/t/utils"
+)
+
+func createTestProfile(t *testing.T, b *GethStatusBackend, name string, password string, tmpdir string) string {
+	acc, err := b.CreateAccountAndLogin(&requests.CreateAccount{
+		DisplayName:        name,
+		CustomizationColor: "#ffffff",
+		Password:           password,
+		RootDataDir:        tmpdir,
+		LogFilePath:        tmpdir + "/log",
+	})
+	require.NoError(t, err)
+	require.NoError(t, b.Logout())
+	return acc.KeyUID
+}
+
+func TestProfilesLoginAlongside(t *testing.T) {
+	utils.Init()
+	password := "some-password"
+	tmpdir := t.TempDir()
+
+	var mu sync.Mutex
+	loggedIn := make(map[string]int)
+	signal.SetMobileSignalHandler(func(data []byte) {
+		var envelope struct {
+			Type   string `json:"type"`
+			KeyUID string `json:"keyUid"`
+		}
+		if strings.Contains(string(data), signal.EventLoggedIn) {
+			require.NoError(t, json.Unmarshal(data, &envelope))
+			mu.Lock()
+			loggedIn[envelope.KeyUID]++
+			mu.Unlock()
+		}
+	})
+	defer signal.SetMobileSignalHandler(nil)
+
+	b := NewGethStatusBackend()
+	personal := createTestProfile(t, b, "Personal profile", password, tmpdir)
+	team := createTestProfile(t, b, "Support team", password, tmpdir)
+	mu.Lock()
+	loggedIn = make(map[string]int)
+	mu.Unlock()
+
+	profiles := NewProfiles(b)
+	require.NoError(t, b.LoginAccount(&requests.Login{KeyUID: personal, Password: password}))
+	require.NoError(t, profiles.Login(&requests.Login{KeyUID: team, Password: password}))
+	defer func() {
+		require.NoError(t, profiles.LogoutAll())
+		require.NoError(t, b.Logout())
+	}()
+
+	require.ErrorIs(t, profiles.Login(&requests.Login{KeyUID: team, Password: password}), ErrProfileAlreadyLoggedIn)
+	require.ErrorIs(t, profiles.Login(&requests.Login{KeyUID: personal, Password: password}), ErrProfileAlreadyLoggedIn)
+	require.Equal(t, []string{personal, team}, profiles.LoggedIn())
+
+	teamBackend, err := profiles.Backend(team)
+	require.NoError(t, err)
+	require.NotSame(t, b, teamBackend)
+	require.True(t, b.IsNodeRunning())
+	require.True(t, teamBackend.IsNodeRunning())
+	require.Equal(t, personal, b.Messenger().KeyUID())
+	require.Equal(t, team, teamBackend.Messenger().KeyUID())
+	require.Equal(t, filepath.Join(b.config.DataDir, profilesDataDir, team), teamBackend.config.DataDir)
+
+	mu.Lock()
+	require.Equal(t, 1, loggedIn[personal])
+	require.Equal(t, 1, loggedIn[team])
+	mu.Unlock()
+
+	require.NoError(t, profiles.Logout(team))
+	require.False(t, teamBackend.IsNodeRunning())
+	require.True(t, b.IsNodeRunning())
+	require.Equal(t, []string{personal}, profiles.LoggedIn())
+	_, err = profiles.Backend(team)
+	require.ErrorIs(t, err, ErrProfileNotLoggedIn)
+	require.ErrorIs(t, profiles.Logout(team), ErrProfileNotLoggedIn)
+}
+
+func TestProfilesSignalsAreNamespaced(t *testing.T) {
+	utils.Init()
+	password := "some-password"
+	tmpdir := t.TempDir()
+
+	b := NewGethStatusBackend()
+	personal := createTestProfile(t, b, "Personal profile", password, tmpdir)
+	team := createTestProfile(t, b, "Support team", password, tmpdir)
+
+	var mu sync.Mutex
+	received := make(map[string]map[string]bool)
+	signal.SetMobileSignalHandler(func(data []byte) {
+		var envelope struct {
+			Type   string `json:"type"`
+			KeyUID string `json:"keyUid"`
+		}
+		require.NoError(t, json.Unmarshal(data, &envelope))
+		mu.Lock()
+		defer mu.Unlock()
+		if received[envelope.Type] == nil {
+			received[envelope.Type] = make(map[string]bool)
+		}
+		received[envelope.Type][envelope.KeyUID] = true
+	})
+	defer signal.SetMobileSignalHandler(nil)
+
+	profiles := NewProfiles(b)
+	require.NoError(t, b.LoginAccount(&requests.Login{KeyUID: personal, Password: password}))
+	require.NoError(t, profiles.Login(&requests.Login{KeyUID: team, Password: password}))
+	defer func() {
+		require.NoError(t, profiles.LogoutAll())
+		require.NoError(t, b.Logout())
+	}()
+
+	teamBackend, err := profiles.Backend(team)
+	require.NoError(t, err)
+
+	for keyUID, backend := range map[string]*GethStatusBackend{personal: b, team: teamBackend} {
+		require.Equal(t, signal.NewNamespace(keyUID), backend.statusNode.WalletService().SignalsNamespace())
+
+		response := &protocol.MessengerResponse{}
+		response.AddNotification(&localnotifications.Notification{ID: gethcommon.HexToHash(keyUID)})
+		backend.Messenger().PublishMessengerResponse(response)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, typ := range []string{signal.EventNodeStarted, signal.EventNodeReady, signal.EventLoggedIn, "local-notifications"} {
+		require.Equal(t, map[string]bool{personal: true, team: true}, received[typ], typ)
+	}
+}
@@ -120,6 +120,7 @@
 // 1721215212_create_keycard_and_accounts.up.sql (725B)
 // 1721832718_rename_shard_test.up.sql (3.186kB)
 // 1722415278_remove_incorrectly_added_keycards.up.sql (67B)
+// 1729300000_add_user_sticker_packs.up.sql (320B)
 // doc.go (94B)
 
 package migrations
@@ -2588,6 +2589,26 @@ func _1722415278_remove_incorrectly_added_keycardsUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1729300000_add_user_sticker_packsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\x3d\x6b\xc3\x30\x10\xc6\xf1\xdd\x9f\xe2\xd9\xd2\x42\x87\xee\x9d\x54\xf7\x0c\xa2\xaa\x1c\x94\x0b\x24\x93\x50\x64\x81\x84\xf3\x52\x24\x79\xe8\xb7\x2f\xa2\x5d\x02\xc1\xeb\xc3\xef\x7f\x5c\x6f\x48\x30\x81\xc5\xbb\x22\xc8\x01\x7a\x64\xd0\x41\xee\x78\x87\xa5\x84\x6c\x4b\x4d\x7e\x0e\xd9\x7e\x3b\x3f\x17\x3c\x75\x40\x9a\xc0\x74\x60\x6c\x8d\xfc\x12\xe6\x88\x4f\x3a\x62\xd4\xe8\x47\x3d\x28\xd9\x33\x0c\x6d\x95\xe8\xe9\xa5\x03\xae\xee\x12\xfe\x70\x3b\xab\xf7\x4a\xb5\xd5\x2d\x35\xde\xf2\xfd\x8e\x0f\x1a\xc4\x5e\x31\x36\x9b\x46\x6a\x5c\x2e\xa7\xab\x4b\xe7\x55\xf5\xff\x5b\xb9\x47\xad\x2f\xd1\xe5\x30\xd9\xd3\xcf\x6a\xef\xa3\xab\x36\x4d\xeb\xe6\x7c\xf3\x33\xa4\x7e\x00\x5e\xbb\xe7\xb7\xee\x77\x00\x7a\x9a\x19\x6c\x40\x01\x00\x00")
+
+func _1729300000_add_user_sticker_packsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729300000_add_user_sticker_packsUpSql,
+		"1729300000_add_user_sticker_packs.up.sql",
+	)
+}
+
+func _1729300000_add_user_sticker_packsUpSql() (*asset, error) {
+	bytes, err := _1729300000_add_user_sticker_packsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729300000_add_user_sticker_packs.up.sql", size: 320, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0x56, 0xf2, 0xaa, 0x27, 0x7e, 0xe2, 0xe0, 0xe6, 0x79, 0x13, 0xaa, 0x8d, 0x76, 0x1c, 0xef, 0x45, 0xf1, 0xf7, 0x4d, 0xa8, 0x97, 0xbe, 0x5f, 0x50, 0x55, 0x7, 0x2f, 0x4f, 0xe9, 0x29, 0xd1}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x41\x0e\x02\x31\x08\x05\xd0\x7d\x4f\xf1\x2f\x00\xe8\xca\xc4\xc4\xc3\xa0\x43\x08\x19\x5b\xc6\x96\xfb\xc7\x4d\xdf\xfe\x5d\xfa\x39\xd5\x0d\xeb\xf7\x6d\x4d\xc4\xf3\xe9\x36\x6c\x6a\x19\x3c\xe9\x1d\xe3\xd0\x52\x50\xcf\xa3\xa2\xdb\xeb\xfe\xb8\x6d\xa0\xeb\x74\xf4\xf0\xa9\x15\x39\x16\x28\xc1\x2c\x7b\xb0\x27\x58\xda\x3f\x00\x00\xff\xff\x57\xd4\xd5\x90\x5e\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -2819,6 +2840,7 @@ var _bindata = map[string]func() (*asset, error){
 	"1721215212_create_keycard_and_accounts.up.sql":                            _1721215212_create_keycard_and_accountsUpSql,
 	"1721832718_rename_shard_test.up.sql":                                      _1721832718_rename_shard_testUpSql,
 	"1722415278_remove_incorrectly_added_keycards.up.sql":                      _1722415278_remove_incorrectly_added_keycardsUpSql,
+	"1729300000_add_user_sticker_packs.up.sql":                                 _1729300000_add_user_sticker_packsUpSql,
 	"doc.go": docGo,
 }
 
@@ -2988,6 +3010,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1721215212_create_keycard_and_accounts.up.sql":                            {_1721215212_create_keycard_and_accountsUpSql, map[string]*bintree{}},
 	"1721832718_rename_shard_test.up.sql":                                      {_1721832718_rename_shard_testUpSql, map[string]*bintree{}},
 	"1722415278_remove_incorrectly_added_keycards.up.sql":                      {_1722415278_remove_incorrectly_added_keycardsUpSql, map[string]*bintree{}},
+	"1729300000_add_user_sticker_packs.up.sql":                                 {_1729300000_add_user_sticker_packsUpSql, map[string]*bintree{}},
 	"doc.go": {docGo, map[string]*bintree{}},
 }}
 
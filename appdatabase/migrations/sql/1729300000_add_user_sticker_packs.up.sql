@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS user_sticker_packs (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  name TEXT NOT NULL,
+  author TEXT NOT NULL DEFAULT '',
+  thumbnail TEXT NOT NULL DEFAULT '',
+  stickers TEXT NOT NULL,
+  shared_by TEXT NOT NULL DEFAULT '',
+  chat_id TEXT NOT NULL DEFAULT '',
+  clock INT NOT NULL DEFAULT 0
+);
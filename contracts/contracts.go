@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/status-go/contracts/balancechecker"
 	"github.com/status-im/status-go/contracts/directory"
+	"github.com/status-im/status-go/contracts/ethregistrar"
 	"github.com/status-im/status-go/contracts/ethscan"
 	"github.com/status-im/status-go/contracts/ierc20"
 	"github.com/status-im/status-go/contracts/registrar"
@@ -74,6 +75,40 @@ func (c *ContractMaker) NewUsernameRegistrar(chainID uint64, contractAddr common
 	)
 }
 
+func (c *ContractMaker) NewETHRegistrarController(chainID uint64) (*ethregistrar.IETHRegistrarController, error) {
+	contractAddr, err := ethregistrar.ControllerAddress(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := c.RPCClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethregistrar.NewIETHRegistrarController(
+		contractAddr,
+		backend,
+	)
+}
+
+func (c *ContractMaker) NewBaseRegistrar(chainID uint64) (*ethregistrar.IBaseRegistrar, error) {
+	contractAddr, err := ethregistrar.BaseRegistrarAddress(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := c.RPCClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethregistrar.NewIBaseRegistrar(
+		contractAddr,
+		backend,
+	)
+}
+
 func (c *ContractMaker) NewERC20(chainID uint64, contractAddr common.Address) (ierc20.IERC20Iface, error) {
 	backend, err := c.RPCClient.EthClient(chainID)
 	if err != nil {
@@ -0,0 +1,42 @@
+package erc4337
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	wallet_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+var ErrorNotAvailableOnChainID = errors.New("not available for chainID")
+
+// The EntryPoint v0.6 and its SimpleAccountFactory are deployed at the same address on every chain
+var (
+	entryPointAddress = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	factoryAddress    = common.HexToAddress("0x9406Cc6185a346906296840746125a0E44976454")
+)
+
+var supportedChainIDs = map[uint64]bool{
+	wallet_common.EthereumMainnet: true,
+	wallet_common.EthereumSepolia: true,
+	wallet_common.OptimismMainnet: true,
+	wallet_common.OptimismSepolia: true,
+	wallet_common.ArbitrumMainnet: true,
+	wallet_common.ArbitrumSepolia: true,
+}
+
+// EntryPointAddress returns the address of the EntryPoint UserOperations are sent to
+func EntryPointAddress(chainID uint64) (common.Address, error) {
+	if !supportedChainIDs[chainID] {
+		return common.Address{}, ErrorNotAvailableOnChainID
+	}
+	return entryPointAddress, nil
+}
+
+// FactoryAddress returns the address of the factory deploying smart accounts
+func FactoryAddress(chainID uint64) (common.Address, error) {
+	if !supportedChainIDs[chainID] {
+		return common.Address{}, ErrorNotAvailableOnChainID
+	}
+	return factoryAddress, nil
+}
@@ -0,0 +1,5 @@
+package erc4337
+
+//go:generate abigen -abi IEntryPoint.abi -pkg erc4337 -type IEntryPoint -out entrypoint.go
+//go:generate abigen -abi ISimpleAccountFactory.abi -pkg erc4337 -type ISimpleAccountFactory -out factory.go
+//go:generate abigen -abi ISimpleAccount.abi -pkg erc4337 -type ISimpleAccount -out account.go
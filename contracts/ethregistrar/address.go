@@ -0,0 +1,39 @@
+package ethregistrar
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	wallet_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+var ErrorNotAvailableOnChainID = errors.New("not available for chainID")
+
+var controllerAddressByChainID = map[uint64]common.Address{
+	wallet_common.EthereumMainnet: common.HexToAddress("0x253553366Da8546fC250F225fe3d25d0C782303b"),
+	wallet_common.EthereumSepolia: common.HexToAddress("0xFED6a969AaA60E4961FCD3EBF1A2e8913ac65B72"),
+}
+
+var baseRegistrarAddressByChainID = map[uint64]common.Address{
+	wallet_common.EthereumMainnet: common.HexToAddress("0x57f1887a8BF19b14fC0dF6Fd9B2acc9Af147eA85"),
+	wallet_common.EthereumSepolia: common.HexToAddress("0x57f1887a8BF19b14fC0dF6Fd9B2acc9Af147eA85"),
+}
+
+// ControllerAddress returns the address of the controller registering and renewing .eth names
+func ControllerAddress(chainID uint64) (common.Address, error) {
+	addr, exists := controllerAddressByChainID[chainID]
+	if !exists {
+		return common.Address{}, ErrorNotAvailableOnChainID
+	}
+	return addr, nil
+}
+
+// BaseRegistrarAddress returns the address of the registrar owning .eth and holding name expiries
+func BaseRegistrarAddress(chainID uint64) (common.Address, error) {
+	addr, exists := baseRegistrarAddressByChainID[chainID]
+	if !exists {
+		return common.Address{}, ErrorNotAvailableOnChainID
+	}
+	return addr, nil
+}
@@ -0,0 +1,4 @@
+package ethregistrar
+
+//go:generate abigen -abi IETHRegistrarController.abi -pkg ethregistrar -type IETHRegistrarController -out controller.go
+//go:generate abigen -abi IBaseRegistrar.abi -pkg ethregistrar -type IBaseRegistrar -out baseregistrar.go
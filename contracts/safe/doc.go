@@ -0,0 +1,3 @@
+package safe
+
+//go:generate abigen -abi ISafe.abi -pkg safe -type ISafe -out safe.go
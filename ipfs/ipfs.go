@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
 	"github.com/wealdtech/go-multicodec"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -149,6 +150,59 @@ func decodeStringHash(input string) (string, error) {
 	return cid, nil
 }
 
+// ContentHash returns the hex encoded contenthash of content stored as a raw IPFS block,
+// in the same format as the sticker hashes of the sticker market
+func ContentHash(content []byte) (string, error) {
+	hash, err := contentCID(content)
+	if err != nil {
+		return "", err
+	}
+
+	contentHash, err := multicodec.AddCodec("ipfs-ns", hash.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.Encode(contentHash)[2:], nil
+}
+
+func contentCID(content []byte) (cid.Cid, error) {
+	hash, err := multihash.Sum(content, multihash.SHA2_256, -1)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	return cid.NewCidV1(cid.Raw, hash), nil
+}
+
+// Add stores content in the cache, so that it's found by Get without being downloaded,
+// and returns its contenthash
+func (d *Downloader) Add(content []byte) (string, error) {
+	contentHash, err := ContentHash(content)
+	if err != nil {
+		return "", err
+	}
+
+	cid, err := decodeStringHash(contentHash)
+	if err != nil {
+		return "", err
+	}
+
+	exists, _, err := d.exists(cid)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		// #nosec G306
+		err = os.WriteFile(filepath.Join(d.ipfsDir, cid), content, 0700)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return contentHash, nil
+}
+
 // Get checks if an IPFS image exists and returns it from cache
 // otherwise downloads it from INFURA's ipfs gateway
 func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
@@ -0,0 +1,34 @@
+package ipfs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAndGet(t *testing.T) {
+	d := NewDownloader(t.TempDir())
+	defer d.Stop()
+
+	content := []byte("sticker image")
+	hash, err := d.Add(content)
+	require.NoError(t, err)
+
+	// ipfs-ns codec, CIDv1, raw codec
+	require.True(t, strings.HasPrefix(hash, "e3010155"))
+
+	expected, err := ContentHash(content)
+	require.NoError(t, err)
+	require.Equal(t, expected, hash)
+
+	// Found in the cache, nothing to download
+	stored, err := d.Get(hash, false)
+	require.NoError(t, err)
+	require.Equal(t, content, stored)
+
+	// Adding again is a no-op
+	again, err := d.Add(content)
+	require.NoError(t, err)
+	require.Equal(t, hash, again)
+}
@@ -0,0 +1,64 @@
+package mailserver
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// TopicVolume is the volume stored for a topic along with the policy applied to it
+type TopicVolume struct {
+	TopicStats
+	// RetentionSeconds is how long envelopes of the topic are kept, 0 means forever
+	RetentionSeconds int64      `json:"retentionSeconds"`
+	Quota            TopicQuota `json:"quota"`
+}
+
+// API is the admin API of the mail server
+type API struct {
+	ms *mailServer
+}
+
+// TopicsVolume returns the volume stored for each topic, largest first
+func (api *API) TopicsVolume() ([]TopicVolume, error) {
+	stats, err := api.ms.db.TopicsStats()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]TopicVolume, 0, len(stats))
+	for _, s := range stats {
+		volume := TopicVolume{TopicStats: s}
+		if api.ms.cleaner != nil {
+			policy := api.ms.cleaner.topicPolicy(s.Topic)
+			volume.RetentionSeconds = int64(policy.Retention.Seconds())
+			volume.Quota = policy.TopicQuota
+		}
+		result = append(result, volume)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Size > result[j].Size
+	})
+	return result, nil
+}
+
+// EvictOverQuota removes the oldest envelopes of the topics exceeding their quota
+// right away instead of waiting for the cleaner, and returns how many have been removed
+func (api *API) EvictOverQuota() (int, error) {
+	if api.ms.cleaner == nil {
+		return 0, nil
+	}
+	return api.ms.cleaner.EvictOverQuota()
+}
+
+// APIs returns the admin API of the mail server, it is not public
+func (s *WakuMailServer) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "mailserver",
+			Version:   "1.0",
+			Service:   &API{ms: s.ms},
+			Public:    false,
+		},
+	}
+}
@@ -1,10 +1,13 @@
 package mailserver
 
 import (
+	"math"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/eth-node/types"
 )
 
 const (
@@ -20,6 +23,10 @@ type dbCleaner struct {
 	batchSize int
 	retention time.Duration
 
+	// policies override the retention and quota of topics
+	policies     map[types.TopicType]TopicPolicy
+	defaultQuota TopicQuota
+
 	period time.Duration
 	cancel chan struct{}
 }
@@ -29,12 +36,67 @@ func newDBCleaner(db DB, retention time.Duration) *dbCleaner {
 	return &dbCleaner{
 		db:        db,
 		retention: retention,
+		policies:  make(map[types.TopicType]TopicPolicy),
 
 		batchSize: dbCleanerBatchSize,
 		period:    dbCleanerPeriod,
 	}
 }
 
+// setTopicPolicies sets the policies of specific topics and the quota of the others.
+func (c *dbCleaner) setTopicPolicies(policies []TopicPolicy, defaultQuota TopicQuota) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.policies = make(map[types.TopicType]TopicPolicy)
+	for _, p := range policies {
+		c.policies[p.Topic] = p
+	}
+	c.defaultQuota = defaultQuota
+}
+
+// topicPolicy returns the effective policy of a topic.
+func (c *dbCleaner) topicPolicy(topic types.TopicType) TopicPolicy {
+	c.RLock()
+	defer c.RUnlock()
+
+	policy, ok := c.policies[topic]
+	if !ok {
+		policy = TopicPolicy{Topic: topic, TopicQuota: c.defaultQuota}
+	}
+	if policy.Retention == 0 {
+		policy.Retention = c.retention
+	}
+	return policy
+}
+
+func (c *dbCleaner) hasTopicRetention() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	for _, p := range c.policies {
+		if p.Retention > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *dbCleaner) hasQuotas() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.defaultQuota.enabled() {
+		return true
+	}
+	for _, p := range c.policies {
+		if p.enabled() {
+			return true
+		}
+	}
+	return false
+}
+
 // Start starts a loop that cleans up old messages.
 func (c *dbCleaner) Start() {
 	log.Info("Starting cleaning envelopes", "period", c.period, "retention", c.retention)
@@ -67,7 +129,7 @@ func (c *dbCleaner) schedule(period time.Duration, cancel <-chan struct{}) {
 	for {
 		select {
 		case <-t.C:
-			count, err := c.PruneEntriesOlderThan(time.Now().Add(-c.retention))
+			count, err := c.Clean(time.Now())
 			if err != nil {
 				log.Error("failed to prune data", "err", err)
 			}
@@ -83,3 +145,75 @@ func (c *dbCleaner) schedule(period time.Duration, cancel <-chan struct{}) {
 func (c *dbCleaner) PruneEntriesOlderThan(t time.Time) (int, error) {
 	return c.db.Prune(t, c.batchSize)
 }
+
+// Clean applies the retention and the quotas of every topic
+// and returns how many messages have been removed.
+func (c *dbCleaner) Clean(now time.Time) (int, error) {
+	removed, err := c.pruneExpired(now)
+	if err != nil {
+		return removed, err
+	}
+
+	if !c.hasQuotas() {
+		return removed, nil
+	}
+	evicted, err := c.EvictOverQuota()
+	return removed + evicted, err
+}
+
+func (c *dbCleaner) pruneExpired(now time.Time) (int, error) {
+	if !c.hasTopicRetention() {
+		if c.retention == 0 {
+			return 0, nil
+		}
+		return c.PruneEntriesOlderThan(now.Add(-c.retention))
+	}
+
+	// Some topics have their own retention, prune them one by one
+	stats, err := c.db.TopicsStats()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, s := range stats {
+		policy := c.topicPolicy(s.Topic)
+		if policy.Retention == 0 {
+			continue
+		}
+		before := now.Add(-policy.Retention)
+		if !time.Unix(int64(s.Oldest), 0).Before(before) {
+			continue
+		}
+		count, err := c.db.PruneTopic(s.Topic, before, 0)
+		removed += count
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// EvictOverQuota removes the oldest messages of the topics exceeding their quota
+// and returns how many have been removed.
+func (c *dbCleaner) EvictOverQuota() (int, error) {
+	stats, err := c.db.TopicsStats()
+	if err != nil {
+		return 0, err
+	}
+
+	evicted := 0
+	for _, s := range stats {
+		excess := c.topicPolicy(s.Topic).excess(s)
+		if excess == 0 {
+			continue
+		}
+		count, err := c.db.PruneTopic(s.Topic, time.Unix(math.MaxUint32, 0), excess)
+		evicted += count
+		if err != nil {
+			return evicted, err
+		}
+		log.Info("Evicted messages over quota", "topic", s.Topic, "count", count)
+	}
+	return evicted, nil
+}
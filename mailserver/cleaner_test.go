@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/rlp"
 
+	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	waku "github.com/status-im/status-go/waku/common"
 )
@@ -158,3 +159,98 @@ func countMessages(t *testing.T, db DB) int {
 
 	return count
 }
+
+var otherTestTopic = waku.TopicType{0x01, 0x02, 0x03, 0x04}
+
+func archiveTopicEnvelope(t *testing.T, sentTime time.Time, topic waku.TopicType, server *WakuMailServer) {
+	h := crypto.Keccak256Hash([]byte("test sample data"))
+	params := &waku.MessageParams{
+		Topic:    topic,
+		Payload:  testPayload,
+		PoW:      powRequirement,
+		WorkTime: 2,
+		KeySym:   h[:],
+	}
+	msg, err := waku.NewSentMessage(params)
+	require.NoError(t, err)
+	env, err := msg.Wrap(params, sentTime)
+	require.NoError(t, err)
+	server.Archive(env)
+}
+
+func topicsStats(t *testing.T, db DB) map[types.TopicType]TopicStats {
+	stats, err := db.TopicsStats()
+	require.NoError(t, err)
+
+	result := make(map[types.TopicType]TopicStats)
+	for _, s := range stats {
+		result[s.Topic] = s
+	}
+	return result
+}
+
+func TestCleanerTopicRetention(t *testing.T) {
+	now := time.Now()
+	server := setupTestServer(t)
+	defer server.Close()
+
+	defaultTopic := types.TopicType{0x1F, 0x7E, 0xA1, 0x7F}
+	cleaner := newDBCleaner(server.ms.db, time.Hour)
+	cleaner.setTopicPolicies([]TopicPolicy{{
+		Topic:     types.TopicType(otherTestTopic),
+		Retention: 3 * time.Hour,
+	}}, TopicQuota{})
+
+	archiveEnvelope(t, now.Add(-2*time.Hour), server)
+	archiveEnvelope(t, now.Add(-time.Minute), server)
+	archiveTopicEnvelope(t, now.Add(-4*time.Hour), otherTestTopic, server)
+	archiveTopicEnvelope(t, now.Add(-2*time.Hour), otherTestTopic, server)
+
+	removed, err := cleaner.Clean(now)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	stats := topicsStats(t, server.ms.db)
+	require.Equal(t, 1, stats[defaultTopic].Envelopes)
+	require.Equal(t, 1, stats[types.TopicType(otherTestTopic)].Envelopes)
+	require.Equal(t, uint32(now.Add(-2*time.Hour).Unix()), stats[types.TopicType(otherTestTopic)].Oldest)
+}
+
+func TestCleanerTopicQuota(t *testing.T) {
+	now := time.Now()
+	server := setupTestServer(t)
+	defer server.Close()
+
+	defaultTopic := types.TopicType{0x1F, 0x7E, 0xA1, 0x7F}
+	cleaner := newDBCleaner(server.ms.db, 0)
+	cleaner.setTopicPolicies([]TopicPolicy{{
+		Topic:      types.TopicType(otherTestTopic),
+		TopicQuota: TopicQuota{MaxEnvelopes: 2},
+	}}, TopicQuota{MaxEnvelopes: 3})
+
+	for i := 5; i > 0; i-- {
+		archiveEnvelope(t, now.Add(-time.Duration(i)*time.Minute), server)
+		archiveTopicEnvelope(t, now.Add(-time.Duration(i)*time.Minute), otherTestTopic, server)
+	}
+
+	removed, err := cleaner.Clean(now)
+	require.NoError(t, err)
+	require.Equal(t, 5, removed)
+
+	stats := topicsStats(t, server.ms.db)
+	require.Equal(t, 3, stats[defaultTopic].Envelopes)
+	require.Equal(t, 2, stats[types.TopicType(otherTestTopic)].Envelopes)
+	// The oldest envelopes are evicted first
+	require.Equal(t, uint32(now.Add(-2*time.Minute).Unix()), stats[types.TopicType(otherTestTopic)].Oldest)
+
+	// Byte quotas rely on the average envelope size
+	size := stats[defaultTopic].Size / 3
+	cleaner.setTopicPolicies(nil, TopicQuota{MaxBytes: size})
+	removed, err = cleaner.EvictOverQuota()
+	require.NoError(t, err)
+	require.Equal(t, 3, removed)
+
+	stats = topicsStats(t, server.ms.db)
+	require.Equal(t, 1, stats[defaultTopic].Envelopes)
+	require.Equal(t, 1, stats[types.TopicType(otherTestTopic)].Envelopes)
+}
@@ -70,9 +70,13 @@ type Config struct {
 	// RateLimit is a maximum number of requests per second from a peer.
 	RateLimit int
 	// DataRetention specifies a number of days an envelope should be stored for.
-	DataRetention   int
-	PostgresEnabled bool
-	PostgresURI     string
+	DataRetention int
+	// TopicPolicies override the retention and the quota of specific topics.
+	TopicPolicies []TopicPolicy
+	// DefaultTopicQuota is the quota of the topics without a policy.
+	DefaultTopicQuota TopicQuota
+	PostgresEnabled   bool
+	PostgresURI       string
 }
 
 // --------------
@@ -92,16 +96,22 @@ func (s *WakuMailServer) Init(waku *waku.Waku, cfg *params.WakuConfig) error {
 	s.shh = waku
 	s.minRequestPoW = cfg.MinimumPoW
 
+	topicPolicies, defaultTopicQuota, err := topicPoliciesFromConfig(cfg.MailServerTopicPolicies)
+	if err != nil {
+		return err
+	}
+
 	config := Config{
-		DataDir:         cfg.DataDir,
-		Password:        cfg.MailServerPassword,
-		MinimumPoW:      cfg.MinimumPoW,
-		DataRetention:   cfg.MailServerDataRetention,
-		RateLimit:       cfg.MailServerRateLimit,
-		PostgresEnabled: cfg.DatabaseConfig.PGConfig.Enabled,
-		PostgresURI:     cfg.DatabaseConfig.PGConfig.URI,
-	}
-	var err error
+		DataDir:           cfg.DataDir,
+		Password:          cfg.MailServerPassword,
+		MinimumPoW:        cfg.MinimumPoW,
+		DataRetention:     cfg.MailServerDataRetention,
+		TopicPolicies:     topicPolicies,
+		DefaultTopicQuota: defaultTopicQuota,
+		RateLimit:         cfg.MailServerRateLimit,
+		PostgresEnabled:   cfg.DatabaseConfig.PGConfig.Enabled,
+		PostgresURI:       cfg.DatabaseConfig.PGConfig.URI,
+	}
 	s.ms, err = newMailServer(
 		config,
 		&wakuAdapter{},
@@ -415,9 +425,9 @@ func newMailServer(cfg Config, adapter adapter, service service) (*mailServer, e
 		s.db = database
 	}
 
-	if cfg.DataRetention > 0 {
+	if cfg.DataRetention > 0 || len(cfg.TopicPolicies) > 0 || cfg.DefaultTopicQuota.enabled() {
 		// MailServerDataRetention is a number of days.
-		s.setupCleaner(time.Duration(cfg.DataRetention) * time.Hour * 24)
+		s.setupCleaner(time.Duration(cfg.DataRetention)*time.Hour*24, cfg.TopicPolicies, cfg.DefaultTopicQuota)
 	}
 
 	return &s, nil
@@ -430,8 +440,9 @@ func (s *mailServer) setupRateLimiter(limit time.Duration) {
 	s.rateLimiter.Start()
 }
 
-func (s *mailServer) setupCleaner(retention time.Duration) {
+func (s *mailServer) setupCleaner(retention time.Duration, policies []TopicPolicy, defaultQuota TopicQuota) {
 	s.cleaner = newDBCleaner(s.db, retention)
+	s.cleaner.setTopicPolicies(policies, defaultQuota)
 	s.cleaner.Start()
 }
 
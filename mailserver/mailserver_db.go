@@ -19,10 +19,26 @@ type DB interface {
 	GetEnvelope(*DBKey) ([]byte, error)
 	// Prune removes envelopes older than time
 	Prune(time.Time, int) (int, error)
+	// PruneTopic removes at most limit envelopes of a topic older than time, oldest first,
+	// all of them if limit is 0
+	PruneTopic(topic types.TopicType, t time.Time, limit int) (int, error)
+	// TopicsStats returns the volume stored for each topic
+	TopicsStats() ([]TopicStats, error)
 	// BuildIterator returns an iterator over envelopes
 	BuildIterator(query CursorQuery) (Iterator, error)
 }
 
+// TopicStats is the volume of envelopes stored for a topic
+type TopicStats struct {
+	Topic     types.TopicType `json:"topic"`
+	Envelopes int             `json:"envelopes"`
+	// Size is the number of bytes used by the encoded envelopes
+	Size int64 `json:"size"`
+	// Oldest and Newest are the timestamps of the oldest and newest envelopes
+	Oldest uint32 `json:"oldest"`
+	Newest uint32 `json:"newest"`
+}
+
 type Iterator interface {
 	Next() bool
 	DBKey() (*DBKey, error)
@@ -1,7 +1,10 @@
 package mailserver
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/syndtr/goleveldb/leveldb"
@@ -16,6 +19,42 @@ import (
 	waku "github.com/status-im/status-go/waku/common"
 )
 
+// Envelope keys start with their timestamp, the topic index is kept after all of them, under a prefix
+// no envelope timestamp reaches. Index keys are the topic, timestamp and hash of an envelope, their
+// value its size, so envelopes of a topic are found and measured without reading the envelopes.
+var (
+	topicIndexKeyspace = []byte{0xff}
+	topicIndexBuiltKey = []byte{0xff, 0x00}
+	topicIndexPrefix   = []byte{0xff, 0x01}
+)
+
+// topicIndexBatchSize bounds the number of envelopes written or deleted at once when maintaining the index
+const topicIndexBatchSize = 1000
+
+func topicIndexKey(key *DBKey) []byte {
+	topic := key.Topic()
+	hash := key.EnvelopeHash()
+
+	indexKey := make([]byte, 0, len(topicIndexPrefix)+types.TopicLength+timestampLength+types.HashLength)
+	indexKey = append(indexKey, topicIndexPrefix...)
+	indexKey = append(indexKey, topic[:]...)
+	indexKey = binary.BigEndian.AppendUint32(indexKey, key.Timestamp())
+	return append(indexKey, hash[:]...)
+}
+
+// envelopeKeyFromIndex returns the envelope key of an index key
+func envelopeKeyFromIndex(indexKey []byte) *DBKey {
+	indexKey = indexKey[len(topicIndexPrefix):]
+	topic := types.BytesToTopic(indexKey[:types.TopicLength])
+	timestamp := binary.BigEndian.Uint32(indexKey[types.TopicLength:])
+	hash := types.BytesToHash(indexKey[types.TopicLength+timestampLength:])
+	return NewDBKey(timestamp, topic, hash)
+}
+
+func topicIndexValue(size int) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(size))
+}
+
 type LevelDB struct {
 	// We can't embed as there are some state problems with go-routines
 	ldb  *leveldb.DB
@@ -93,6 +132,14 @@ func NewLevelDB(dataDir string) (*LevelDB, error) {
 		done: make(chan struct{}),
 	}
 
+	if err != nil {
+		return &instance, err
+	}
+
+	if err := instance.buildTopicIndex(); err != nil {
+		return &instance, err
+	}
+
 	// initialize the metric value
 	instance.updateArchivedEnvelopesCount()
 	// checking count on every insert is inefficient
@@ -106,7 +153,40 @@ func NewLevelDB(dataDir string) (*LevelDB, error) {
 			}
 		}
 	}()
-	return &instance, err
+	return &instance, nil
+}
+
+// buildTopicIndex indexes the envelopes of databases created before the topic index
+func (db *LevelDB) buildTopicIndex() error {
+	built, err := db.ldb.Has(topicIndexBuiltKey, nil)
+	if err != nil || built {
+		return err
+	}
+
+	log.Info("building the topic index of the mail server database", "path", db.name)
+	i := db.ldb.NewIterator(&util.Range{Limit: topicIndexKeyspace}, nil)
+	defer i.Release()
+
+	batch := leveldb.Batch{}
+	for i.Next() {
+		if len(i.Key()) != DBKeyLength {
+			continue
+		}
+
+		batch.Put(topicIndexKey(&DBKey{raw: i.Key()}), topicIndexValue(len(i.Value())))
+		if batch.Len() == topicIndexBatchSize {
+			if err := db.ldb.Write(&batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := i.Error(); err != nil {
+		return err
+	}
+
+	batch.Put(topicIndexBuiltKey, nil)
+	return db.ldb.Write(&batch, nil)
 }
 
 // GetEnvelope get an envelope by its key
@@ -149,6 +229,9 @@ func (db *LevelDB) Prune(t time.Time, batchSize int) (int, error) {
 		start: kl.Bytes(),
 		end:   ku.Bytes(),
 	}
+	if bytes.Compare(query.end, topicIndexKeyspace) > 0 {
+		query.end = topicIndexKeyspace
+	}
 	i, err := db.BuildIterator(query)
 	if err != nil {
 		return 0, err
@@ -157,6 +240,7 @@ func (db *LevelDB) Prune(t time.Time, batchSize int) (int, error) {
 
 	batch := leveldb.Batch{}
 	removed := 0
+	pending := 0
 
 	for i.Next() {
 		dbKey, err := i.DBKey()
@@ -165,31 +249,111 @@ func (db *LevelDB) Prune(t time.Time, batchSize int) (int, error) {
 		}
 
 		batch.Delete(dbKey.Bytes())
+		if len(dbKey.Bytes()) == DBKeyLength {
+			batch.Delete(topicIndexKey(dbKey))
+		}
+		pending++
 
-		if batch.Len() == batchSize {
+		if pending == batchSize {
 			if err := db.ldb.Write(&batch, nil); err != nil {
 				return removed, err
 			}
 
-			removed = removed + batch.Len()
+			removed = removed + pending
+			pending = 0
 			batch.Reset()
 		}
 	}
 
-	if batch.Len() > 0 {
+	if pending > 0 {
 		if err := db.ldb.Write(&batch, nil); err != nil {
 			return removed, err
 		}
 
-		removed = removed + batch.Len()
+		removed = removed + pending
 	}
 
 	return removed, nil
 }
 
+// PruneTopic removes envelopes of a topic older than time, they're found through the topic index
+// and deleted in batches of topicIndexBatchSize
+func (db *LevelDB) PruneTopic(topic types.TopicType, t time.Time, limit int) (int, error) {
+	defer recoverLevelDBPanics("PruneTopic")
+
+	var zero types.Hash
+	start := topicIndexKey(NewDBKey(0, topic, zero))
+	end := topicIndexKey(NewDBKey(uint32(t.Unix()), topic, zero))
+	if t.Unix() >= math.MaxUint32 {
+		end = util.BytesPrefix(append(append([]byte{}, topicIndexPrefix...), topic[:]...)).Limit
+	}
+	i := db.ldb.NewIterator(&util.Range{Start: start, Limit: end}, nil)
+	defer i.Release()
+
+	batch := leveldb.Batch{}
+	removed := 0
+	pending := 0
+	for i.Next() {
+		batch.Delete(i.Key())
+		batch.Delete(envelopeKeyFromIndex(i.Key()).Bytes())
+		pending++
+
+		if pending == topicIndexBatchSize {
+			if err := db.ldb.Write(&batch, nil); err != nil {
+				return removed, err
+			}
+			removed += pending
+			pending = 0
+			batch.Reset()
+		}
+		if limit > 0 && removed+pending == limit {
+			break
+		}
+	}
+	if err := i.Error(); err != nil {
+		return removed, err
+	}
+
+	if pending > 0 {
+		if err := db.ldb.Write(&batch, nil); err != nil {
+			return removed, err
+		}
+		removed += pending
+	}
+	return removed, nil
+}
+
+// TopicsStats returns the volume stored for each topic, read from the topic index
+func (db *LevelDB) TopicsStats() ([]TopicStats, error) {
+	defer recoverLevelDBPanics("TopicsStats")
+
+	i := db.ldb.NewIterator(util.BytesPrefix(topicIndexPrefix), nil)
+	defer i.Release()
+
+	// Index keys are sorted by topic then timestamp
+	var result []TopicStats
+	for i.Next() {
+		dbKey := envelopeKeyFromIndex(i.Key())
+		topic := dbKey.Topic()
+		if len(result) == 0 || result[len(result)-1].Topic != topic {
+			result = append(result, TopicStats{Topic: topic, Oldest: dbKey.Timestamp()})
+		}
+
+		stats := &result[len(result)-1]
+		stats.Envelopes++
+		stats.Size += int64(binary.BigEndian.Uint64(i.Value()))
+		stats.Newest = dbKey.Timestamp()
+	}
+	if err := i.Error(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (db *LevelDB) envelopesCount() (int, error) {
 	defer recoverLevelDBPanics("envelopesCount")
-	iterator, err := db.BuildIterator(CursorQuery{})
+	iterator, err := db.BuildIterator(CursorQuery{end: topicIndexKeyspace})
 	if err != nil {
 		return 0, err
 	}
@@ -213,7 +377,10 @@ func (db *LevelDB) SaveEnvelope(env types.Envelope) error {
 		return err
 	}
 
-	if err = db.ldb.Put(key.Bytes(), rawEnvelope, nil); err != nil {
+	batch := leveldb.Batch{}
+	batch.Put(key.Bytes(), rawEnvelope)
+	batch.Put(topicIndexKey(key), topicIndexValue(len(rawEnvelope)))
+	if err = db.ldb.Write(&batch, nil); err != nil {
 		log.Error(fmt.Sprintf("Writing to DB failed: %s", err))
 		archivedErrorsCounter.WithLabelValues(db.name).Inc()
 	}
@@ -1,10 +1,13 @@
 package mailserver
 
 import (
+	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/status-im/status-go/eth-node/types"
@@ -45,3 +48,82 @@ func TestLevelDB_BuildIteratorWithTopic(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, iter.Error())
 }
+
+func TestLevelDB_TopicsStatsAndPruneTopic(t *testing.T) {
+	topic := []byte{0x11, 0x22, 0x33, 0x44}
+	otherTopic := []byte{0x55, 0x66, 0x77, 0x88}
+
+	db, err := NewLevelDB(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 3; i++ {
+		envelope, err := newTestEnvelope(topic)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveEnvelope(envelope))
+	}
+	envelope, err := newTestEnvelope(otherTopic)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveEnvelope(envelope))
+
+	stats, err := db.TopicsStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, types.BytesToTopic(topic), stats[0].Topic)
+	require.Equal(t, 3, stats[0].Envelopes)
+	require.Greater(t, stats[0].Size, int64(0))
+	require.Equal(t, 1, stats[1].Envelopes)
+
+	removed, err := db.PruneTopic(types.BytesToTopic(topic), time.Now().Add(time.Minute), 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	removed, err = db.PruneTopic(types.BytesToTopic(topic), time.Unix(math.MaxUint32, 0), 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	// The envelope of the other topic and its index entry are left
+	count, err := db.envelopesCount()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	removed, err = db.Prune(time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	stats, err = db.TopicsStats()
+	require.NoError(t, err)
+	require.Empty(t, stats)
+}
+
+func TestLevelDB_BuildTopicIndex(t *testing.T) {
+	topic := []byte{0x11, 0x22, 0x33, 0x44}
+	dataDir := t.TempDir()
+
+	db, err := NewLevelDB(dataDir)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		envelope, err := newTestEnvelope(topic)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveEnvelope(envelope))
+	}
+
+	// Drop the index, as in databases created before it
+	batch := leveldb.Batch{}
+	i := db.ldb.NewIterator(&util.Range{Start: topicIndexKeyspace}, nil)
+	for i.Next() {
+		batch.Delete(append([]byte{}, i.Key()...))
+	}
+	i.Release()
+	require.NoError(t, db.ldb.Write(&batch, nil))
+	require.NoError(t, db.Close())
+
+	db, err = NewLevelDB(dataDir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	stats, err := db.TopicsStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, 3, stats[0].Envelopes)
+}
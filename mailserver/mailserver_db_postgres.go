@@ -255,6 +255,57 @@ func (i *PostgresDB) Prune(t time.Time, batch int) (int, error) {
 	return int(rows), nil
 }
 
+func (i *PostgresDB) PruneTopic(topic types.TopicType, t time.Time, limit int) (int, error) {
+	var zero types.Hash
+	var emptyTopic types.TopicType
+	ku := NewDBKey(uint32(t.Unix()), emptyTopic, zero)
+	args := []interface{}{topicToByte(topic), ku.Bytes()}
+	statement := "DELETE FROM envelopes WHERE id IN (SELECT id FROM envelopes WHERE topic = $1 AND id < $2 ORDER BY id ASC"
+	if limit > 0 {
+		args = append(args, limit)
+		statement += " LIMIT $3"
+	}
+	statement += ")"
+
+	stmt, err := i.db.Prepare(statement)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.Exec(args...)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
+
+func (i *PostgresDB) TopicsStats() ([]TopicStats, error) {
+	rows, err := i.db.Query("SELECT topic, count(*), sum(octet_length(data)), min(id), max(id) FROM envelopes GROUP BY topic")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []TopicStats
+	for rows.Next() {
+		var topic, oldest, newest []byte
+		var stats TopicStats
+		if err := rows.Scan(&topic, &stats.Envelopes, &stats.Size, &oldest, &newest); err != nil {
+			return nil, err
+		}
+		stats.Topic = types.BytesToTopic(topic)
+		stats.Oldest = (&DBKey{raw: oldest}).Timestamp()
+		stats.Newest = (&DBKey{raw: newest}).Timestamp()
+		result = append(result, stats)
+	}
+	return result, rows.Err()
+}
+
 func (i *PostgresDB) SaveEnvelope(env types.Envelope) error {
 	topic := env.Topic()
 	key := NewDBKey(env.Expiry()-env.TTL(), topic, env.Hash())
@@ -103,6 +103,40 @@ func (s *MailServerPostgresDBSuite) TestPostgresDB_BuildIteratorWithTopic() {
 	s.NoError(iter.Error())
 }
 
+func (s *MailServerPostgresDBSuite) TestPostgresDB_TopicsStatsAndPruneTopic() {
+	topic := []byte{0x11, 0x22, 0x33, 0x44}
+
+	db, err := NewPostgresDB(postgres.DefaultTestURI)
+	s.NoError(err)
+	defer db.Close()
+
+	for i := 0; i < 3; i++ {
+		envelope, err := newTestEnvelope(topic)
+		s.NoError(err)
+		s.NoError(db.SaveEnvelope(envelope))
+	}
+
+	stats, err := db.TopicsStats()
+	s.NoError(err)
+	var topicStats *TopicStats
+	for i := range stats {
+		if stats[i].Topic == types.BytesToTopic(topic) {
+			topicStats = &stats[i]
+		}
+	}
+	s.Require().NotNil(topicStats)
+	s.Equal(3, topicStats.Envelopes)
+	s.Greater(topicStats.Size, int64(0))
+
+	removed, err := db.PruneTopic(types.BytesToTopic(topic), time.Now().Add(time.Minute), 2)
+	s.NoError(err)
+	s.Equal(2, removed)
+
+	removed, err = db.PruneTopic(types.BytesToTopic(topic), time.Now().Add(time.Minute), 0)
+	s.NoError(err)
+	s.Equal(1, removed)
+}
+
 func newTestEnvelope(topic []byte) (types.Envelope, error) {
 	privateKey, err := crypto.GenerateKey()
 	if err != nil {
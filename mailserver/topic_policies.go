@@ -0,0 +1,84 @@
+package mailserver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+)
+
+var errDefaultTopicPolicyRetention = errors.New("the retention of topics without a policy is set by MailServerDataRetention")
+
+// TopicQuota limits the storage used by a topic, the oldest envelopes are evicted first.
+// A zero value means no limit.
+type TopicQuota struct {
+	MaxEnvelopes int   `json:"maxEnvelopes"`
+	MaxBytes     int64 `json:"maxBytes"`
+}
+
+func (q TopicQuota) enabled() bool {
+	return q.MaxEnvelopes > 0 || q.MaxBytes > 0
+}
+
+// excess returns how many of the oldest envelopes need to be evicted for the topic to fit in the quota.
+// Sizes are only known per topic so the byte quota is applied using the average envelope size.
+func (q TopicQuota) excess(stats TopicStats) int {
+	if stats.Envelopes == 0 {
+		return 0
+	}
+
+	var n int
+	if q.MaxEnvelopes > 0 && stats.Envelopes > q.MaxEnvelopes {
+		n = stats.Envelopes - q.MaxEnvelopes
+	}
+	if q.MaxBytes > 0 && stats.Size > q.MaxBytes {
+		averageSize := float64(stats.Size) / float64(stats.Envelopes)
+		bytesExcess := int(math.Ceil(float64(stats.Size-q.MaxBytes) / averageSize))
+		if bytesExcess > n {
+			n = bytesExcess
+		}
+	}
+	if n > stats.Envelopes {
+		n = stats.Envelopes
+	}
+	return n
+}
+
+// TopicPolicy overrides the retention and the quota of a topic
+type TopicPolicy struct {
+	Topic types.TopicType `json:"topic"`
+	// Retention is how long envelopes of the topic are kept, the global retention applies when 0
+	Retention time.Duration `json:"retention"`
+	TopicQuota
+}
+
+// topicPoliciesFromConfig converts the topic policies of the Waku config,
+// a policy without a topic sets the quota of the topics without a policy of their own
+func topicPoliciesFromConfig(configPolicies []params.MailServerTopicPolicy) ([]TopicPolicy, TopicQuota, error) {
+	var policies []TopicPolicy
+	var defaultQuota TopicQuota
+	for _, p := range configPolicies {
+		quota := TopicQuota{MaxEnvelopes: p.MaxEnvelopes, MaxBytes: p.MaxBytes}
+		if p.Topic == "" {
+			if p.Retention != 0 {
+				return nil, TopicQuota{}, errDefaultTopicPolicyRetention
+			}
+			defaultQuota = quota
+			continue
+		}
+
+		topicBytes, err := types.DecodeHex(p.Topic)
+		if err != nil || len(topicBytes) != types.TopicLength {
+			return nil, TopicQuota{}, fmt.Errorf("invalid topic in mail server policy: %s", p.Topic)
+		}
+		policies = append(policies, TopicPolicy{
+			Topic:      types.BytesToTopic(topicBytes),
+			Retention:  time.Duration(p.Retention) * time.Hour * 24,
+			TopicQuota: quota,
+		})
+	}
+	return policies, defaultQuota, nil
+}
@@ -0,0 +1,41 @@
+package mailserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+)
+
+func TestTopicPoliciesFromConfig(t *testing.T) {
+	policies, defaultQuota, err := topicPoliciesFromConfig([]params.MailServerTopicPolicy{
+		{Topic: "0x01020304", Retention: 7, MaxBytes: 1024},
+		{MaxEnvelopes: 100},
+	})
+	require.NoError(t, err)
+	require.Equal(t, TopicQuota{MaxEnvelopes: 100}, defaultQuota)
+	require.Equal(t, []TopicPolicy{{
+		Topic:      types.TopicType{0x01, 0x02, 0x03, 0x04},
+		Retention:  7 * 24 * time.Hour,
+		TopicQuota: TopicQuota{MaxBytes: 1024},
+	}}, policies)
+
+	_, _, err = topicPoliciesFromConfig([]params.MailServerTopicPolicy{{Topic: "0x0102"}})
+	require.Error(t, err)
+
+	_, _, err = topicPoliciesFromConfig([]params.MailServerTopicPolicy{{Retention: 1}})
+	require.Equal(t, errDefaultTopicPolicyRetention, err)
+}
+
+func TestTopicQuotaExcess(t *testing.T) {
+	stats := TopicStats{Envelopes: 10, Size: 1000}
+
+	require.Equal(t, 0, TopicQuota{}.excess(stats))
+	require.Equal(t, 4, TopicQuota{MaxEnvelopes: 6}.excess(stats))
+	require.Equal(t, 3, TopicQuota{MaxBytes: 750}.excess(stats))
+	require.Equal(t, 4, TopicQuota{MaxEnvelopes: 6, MaxBytes: 750}.excess(stats))
+	require.Equal(t, 10, TopicQuota{MaxBytes: 1}.excess(stats))
+}
@@ -0,0 +1,66 @@
+package statusgo
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/api"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// profiles runs the profiles logged in alongside the one of statusBackend
+var profiles = api.NewProfiles(statusBackend)
+
+// LoginProfile logs a profile in alongside the ones already logged in, without logging them out.
+// The result is sent with the `node.login` signal, the signals of the profile carry its key UID.
+func LoginProfile(requestJSON string) string {
+	var request requests.Login
+	err := json.Unmarshal([]byte(requestJSON), &request)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	err = request.Validate()
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	api.RunAsync(func() error {
+		err := profiles.Login(&request)
+		if err != nil {
+			log.Error("loginProfile failed", "key-uid", request.KeyUID, "error", err)
+			return err
+		}
+		log.Debug("loginProfile started node", "key-uid", request.KeyUID)
+		return nil
+	})
+	return makeJSONResponse(nil)
+}
+
+// LogoutProfile logs a profile out, leaving the other profiles running.
+func LogoutProfile(keyUID string) string {
+	return makeJSONResponse(profiles.Logout(keyUID))
+}
+
+// GetLoggedInProfiles returns the key UIDs of the profiles logged in.
+func GetLoggedInProfiles() string {
+	data, err := json.Marshal(profiles.LoggedIn())
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return string(data)
+}
+
+// CallProfilePrivateRPC calls a private RPC method of a profile logged in.
+func CallProfilePrivateRPC(keyUID string, inputJSON string) string {
+	backend, err := profiles.Backend(keyUID)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	resp, err := backend.CallPrivateRPC(inputJSON)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return resp
+}
@@ -361,6 +361,10 @@ func LoginAccount(requestJSON string) string {
 		return makeJSONResponse(err)
 	}
 
+	if backend, err := profiles.Backend(request.KeyUID); err == nil && backend != statusBackend {
+		return makeJSONResponse(api.ErrProfileAlreadyLoggedIn)
+	}
+
 	api.RunAsync(func() error {
 		err := statusBackend.LoginAccount(&request)
 		if err != nil {
@@ -1262,6 +1266,44 @@ func InputConnectionStringForBootstrappingAnotherDevice(cs, configJSON string) s
 	return makeJSONResponse(err)
 }
 
+// InputConnectionStringForBootstrappingOverWaku receives the pairing data through Waku instead of
+// the local network, using the secret of the given connection string.
+// Used as a fallback when the devices can't reach each other, the Waku node must be running.
+func InputConnectionStringForBootstrappingOverWaku(cs, configJSON string) string {
+	var err error
+	if configJSON == "" {
+		return makeJSONResponse(fmt.Errorf("no config given, ReceiverClientConfig is expected"))
+	}
+
+	err = statusBackend.LocalPairingStateManager.StartPairing(cs)
+	defer func() { statusBackend.LocalPairingStateManager.StopPairing(cs, err) }()
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	err = pairing.StartUpWakuRelayReceiver(statusBackend, cs, configJSON)
+	return makeJSONResponse(err)
+}
+
+// InputConnectionStringForBootstrappingAnotherDeviceOverWaku sends the pairing data through Waku instead of
+// the local network, using the secret of the given connection string.
+// Used as a fallback when the devices can't reach each other.
+func InputConnectionStringForBootstrappingAnotherDeviceOverWaku(cs, configJSON string) string {
+	var err error
+	if configJSON == "" {
+		return makeJSONResponse(fmt.Errorf("no config given, SenderClientConfig is expected"))
+	}
+
+	err = statusBackend.LocalPairingStateManager.StartPairing(cs)
+	defer func() { statusBackend.LocalPairingStateManager.StopPairing(cs, err) }()
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	err = pairing.StartUpWakuRelaySender(statusBackend, cs, configJSON)
+	return makeJSONResponse(err)
+}
+
 // GetConnectionStringForExportingKeypairsKeystores starts a pairing.SenderServer
 // then generates a pairing.ConnectionParams. Used when the device is Logged in and therefore has Account keys
 // and the device might not have a camera, to transfer kestore files of provided key uids.
@@ -1292,6 +1334,14 @@ func InputConnectionStringForImportingKeypairsKeystores(cs, configJSON string) s
 	return makeJSONResponse(err)
 }
 
+// ConfirmLocalPairingSAS confirms or rejects the short authentication string shown during a local pairing
+// started with `verifySAS` enabled, the transfer only starts once both users confirmed it.
+// `id` is the one sent along with the SAS in the `sas-ready` local pairing event
+func ConfirmLocalPairingSAS(id string, confirmed bool) string {
+	err := pairing.ConfirmSAS(id, confirmed)
+	return makeJSONResponse(err)
+}
+
 func ValidateConnectionString(cs string) string {
 	err := pairing.ValidateConnectionString(cs)
 	if err == nil {
@@ -54,6 +54,7 @@ import (
 	"github.com/status-im/status-go/services/wakuv2ext"
 	"github.com/status-im/status-go/services/wallet"
 	"github.com/status-im/status-go/services/web3provider"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/timesource"
 	"github.com/status-im/status-go/transactions"
 	"github.com/status-im/status-go/waku"
@@ -93,6 +94,8 @@ type StatusNode struct {
 	db        *leveldb.DB // used as a cache for PeerPool
 
 	log log.Logger
+	// signals is the namespace of the signals sent by the node and its services
+	signals signal.Namespace
 
 	gethAccountManager *account.GethManager
 	accountsManager    *accounts.Manager
@@ -144,6 +147,14 @@ func New(transactor *transactions.Transactor) *StatusNode {
 	}
 }
 
+// SetSignalsNamespace sets the namespace of the signals sent by the node and its services,
+// it has to be called before the node is started
+func (n *StatusNode) SetSignalsNamespace(signals signal.Namespace) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.signals = signals
+}
+
 // Config exposes reference to running node's configuration
 func (n *StatusNode) Config() *params.NodeConfig {
 	n.mu.RLock()
@@ -403,6 +414,7 @@ func (n *StatusNode) startDiscovery() error {
 	// TODO(dshulyak) consider adding a flag to define this behaviour
 	options.AllowStop = len(n.config.RegisterTopics) == 0
 	options.TrustedMailServers = parseNodesToNodeID(n.config.ClusterConfig.TrustedMailServers)
+	options.Signals = n.signals
 
 	n.peerPool = peers.NewPeerPool(
 		n.discovery,
@@ -12,7 +12,6 @@ import (
 
 	"github.com/status-im/status-go/protocol/common/shard"
 	"github.com/status-im/status-go/server"
-	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/transactions"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -299,9 +298,11 @@ func (b *StatusNode) wakuService(wakuCfg *params.WakuConfig, clusterCfg *params.
 
 		// enable mail service
 		if wakuCfg.EnableMailServer {
-			if err := registerWakuMailServer(w, wakuCfg); err != nil {
+			mailServer, err := registerWakuMailServer(w, wakuCfg)
+			if err != nil {
 				return nil, fmt.Errorf("failed to register WakuMailServer: %v", err)
 			}
+			b.gethNode.RegisterAPIs(mailServer.APIs())
 		}
 
 		if wakuCfg.LightClient {
@@ -377,7 +378,7 @@ func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig) (*wakuv2.Waku,
 			}
 		}
 
-		w, err := wakuv2.New(nodeKey, nodeConfig.ClusterConfig.Fleet, cfg, logutils.ZapLogger(), b.appDB, b.timeSource(), signal.SendHistoricMessagesRequestFailed, signal.SendPeerStats)
+		w, err := wakuv2.New(nodeKey, nodeConfig.ClusterConfig.Fleet, cfg, logutils.ZapLogger(), b.appDB, b.timeSource(), b.signals.SendHistoricMessagesRequestFailed, b.signals.SendPeerStats)
 
 		if err != nil {
 			return nil, err
@@ -430,6 +431,7 @@ func wakuRateLimiter(wakuCfg *params.WakuConfig, clusterCfg *params.ClusterConfi
 func (b *StatusNode) connectorService() *connector.Service {
 	if b.connectorSrvc == nil {
 		b.connectorSrvc = connector.NewService(b.walletDB, b.rpcClient, b.rpcClient.NetworkManager)
+		b.connectorSrvc.SetSignalsNamespace(b.signals)
 	}
 	return b.connectorSrvc
 }
@@ -444,7 +446,7 @@ func (b *StatusNode) rpcFiltersService() *rpcfilters.Service {
 func (b *StatusNode) subscriptionService() *subscriptions.Service {
 	if b.subscriptionsSrvc == nil {
 
-		b.subscriptionsSrvc = subscriptions.New(func() *rpc.Client { return b.RPCClient() })
+		b.subscriptionsSrvc = subscriptions.New(func() *rpc.Client { return b.RPCClient() }, b.signals)
 	}
 	return b.subscriptionsSrvc
 }
@@ -498,7 +500,7 @@ func (b *StatusNode) pendingTrackerService(walletFeed *event.Feed) *transactions
 
 func (b *StatusNode) CommunityTokensService() *communitytokens.Service {
 	if b.communityTokensSrvc == nil {
-		b.communityTokensSrvc = communitytokens.NewService(b.rpcClient, b.gethAccountManager, b.pendingTracker, b.config, b.appDB, &b.walletFeed, b.transactor)
+		b.communityTokensSrvc = communitytokens.NewService(b.rpcClient, b.gethAccountManager, b.pendingTracker, b.config, b.appDB, &b.walletFeed, b.transactor, b.signals)
 	}
 	return b.communityTokensSrvc
 }
@@ -512,7 +514,7 @@ func (b *StatusNode) stickersService(accountDB *accounts.Database) *stickers.Ser
 
 func (b *StatusNode) updatesService() *updates.Service {
 	if b.updatesSrvc == nil {
-		b.updatesSrvc = updates.NewService(b.ensService(b.timeSourceNow()))
+		b.updatesSrvc = updates.NewService(b.ensService(b.timeSourceNow()), b.signals)
 	}
 
 	return b.updatesSrvc
@@ -583,6 +585,7 @@ func (b *StatusNode) walletService(accountsDB *accounts.Database, appDB *sql.DB,
 			b.httpServer,
 			statusProxyStageName,
 		)
+		b.walletSrvc.SetSignalsNamespace(b.signals)
 	}
 	return b.walletSrvc
 }
@@ -590,7 +593,7 @@ func (b *StatusNode) walletService(accountsDB *accounts.Database, appDB *sql.DB,
 func (b *StatusNode) localNotificationsService(network uint64) (*localnotifications.Service, error) {
 	var err error
 	if b.localNotificationsSrvc == nil {
-		b.localNotificationsSrvc, err = localnotifications.NewService(b.appDB, transfer.NewDB(b.walletDB), network)
+		b.localNotificationsSrvc, err = localnotifications.NewService(b.appDB, transfer.NewDB(b.walletDB), network, b.signals)
 		if err != nil {
 			return nil, err
 		}
@@ -605,11 +608,11 @@ func (b *StatusNode) peerService() *peer.Service {
 	return b.peerSrvc
 }
 
-func registerWakuMailServer(wakuService *waku.Waku, config *params.WakuConfig) (err error) {
+func registerWakuMailServer(wakuService *waku.Waku, config *params.WakuConfig) (*mailserver.WakuMailServer, error) {
 	var mailServer mailserver.WakuMailServer
 	wakuService.RegisterMailServer(&mailServer)
 
-	return mailServer.Init(wakuService, config)
+	return &mailServer, mailServer.Init(wakuService, config)
 }
 
 func appendIf(condition bool, services []common.StatusService, service common.StatusService) []common.StatusService {
@@ -101,6 +101,10 @@ type WakuConfig struct {
 	// MailServerDataRetention is a number of days data should be stored by MailServer.
 	MailServerDataRetention int
 
+	// MailServerTopicPolicies override the retention and limit the storage of specific topics.
+	// A policy without a topic sets the quota of the topics without a policy of their own.
+	MailServerTopicPolicies []MailServerTopicPolicy
+
 	// TTL time to live for messages, in seconds
 	TTL int
 
@@ -146,6 +150,21 @@ type WakuConfig struct {
 	EnableConfirmations bool
 }
 
+// MailServerTopicPolicy is the retention and the quota of a topic stored by MailServer.
+type MailServerTopicPolicy struct {
+	// Topic is the hex encoded topic the policy applies to.
+	Topic string
+
+	// Retention is a number of days data of the topic should be stored, MailServerDataRetention applies when 0.
+	Retention int
+
+	// MaxEnvelopes is the maximum number of envelopes stored for the topic, 0 means no limit.
+	MaxEnvelopes int
+
+	// MaxBytes is the maximum size of the envelopes stored for the topic, 0 means no limit.
+	MaxBytes int64
+}
+
 // ----------
 // WakuV2Config
 // ----------
@@ -575,19 +594,27 @@ type WalletConfig struct {
 	StatusProxyEnabled            bool              `json:"StatusProxyEnabled"`
 	StatusProxyStageName          string            `json:"StatusProxyStageName"`
 	EnableCelerBridge             bool              `json:"EnableCelerBridge"`
+	// OnChainCollectiblesOnly disables the collectible indexers, ownership and metadata are read from the chain
+	OnChainCollectiblesOnly bool `json:"OnChainCollectiblesOnly"`
+	// BundlerURLs are the ERC-4337 bundlers the UserOperations of smart accounts are sent to, per chain
+	BundlerURLs map[uint64]string `json:"BundlerURLs"`
+	// PaymasterURLs are the paymasters sponsoring the gas of smart accounts, per chain
+	PaymasterURLs map[uint64]string `json:"PaymasterURLs"`
 }
 
 // MarshalJSON custom marshalling to avoid exposing sensitive data in log,
 // there's a function called `startNode` will log NodeConfig which include WalletConfig
 func (wc WalletConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Enabled            bool `json:"Enabled"`
-		StatusProxyEnabled bool `json:"StatusProxyEnabled"`
-		EnableCelerBridge  bool `json:"EnableCelerBridge"`
+		Enabled                 bool `json:"Enabled"`
+		StatusProxyEnabled      bool `json:"StatusProxyEnabled"`
+		EnableCelerBridge       bool `json:"EnableCelerBridge"`
+		OnChainCollectiblesOnly bool `json:"OnChainCollectiblesOnly"`
 	}{
-		Enabled:            wc.Enabled,
-		StatusProxyEnabled: wc.StatusProxyEnabled,
-		EnableCelerBridge:  wc.EnableCelerBridge,
+		Enabled:                 wc.Enabled,
+		StatusProxyEnabled:      wc.StatusProxyEnabled,
+		EnableCelerBridge:       wc.EnableCelerBridge,
+		OnChainCollectiblesOnly: wc.OnChainCollectiblesOnly,
 	})
 }
 
@@ -701,6 +728,9 @@ type ShhextConfig struct {
 	// No default is provided and if not set ENS resolution is disabled
 	VerifyENSContractAddress string
 
+	// ENSExpiryReminderDays are how many days before our ENS names expire we get reminded of it
+	ENSExpiryReminderDays []int
+
 	VerifyTransactionChainID int64
 
 	// DefaultPushNotificationsServers is the default-status run push notification servers
@@ -729,6 +759,9 @@ type TorrentConfig struct {
 	DataDir string
 	// TorrentDir is the file system folder Status should use for storing torrent metadata files.
 	TorrentDir string
+	// MirrorURL is the base URL of a web server serving a copy of DataDir. When set, control nodes
+	// advertise it so members can download the history archives without BitTorrent.
+	MirrorURL string
 }
 
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
@@ -26,13 +26,15 @@ var MailServerDiscoveryLimits = params.Limits{Min: 3, Max: 3}
 type cacheOnlyTopicPool struct {
 	*TopicPool
 	verifier Verifier
+	signals  signal.Namespace
 }
 
 // newCacheOnlyTopicPool returns instance of CacheOnlyTopicPool.
-func newCacheOnlyTopicPool(t *TopicPool, verifier Verifier) *cacheOnlyTopicPool {
+func newCacheOnlyTopicPool(t *TopicPool, verifier Verifier, signals signal.Namespace) *cacheOnlyTopicPool {
 	return &cacheOnlyTopicPool{
 		TopicPool: t,
 		verifier:  verifier,
+		signals:   signals,
 	}
 }
 
@@ -50,7 +52,7 @@ func (t *cacheOnlyTopicPool) MaxReached() bool {
 	return len(peers) >= t.limits.Max
 }
 
-var sendEnodeDiscovered = signal.SendEnodeDiscovered
+var sendEnodeDiscovered = signal.Namespace.SendEnodeDiscovered
 
 // ConfirmAdded calls base TopicPool ConfirmAdded method and sends a signal
 // confirming the enode has been discovered.
@@ -59,7 +61,7 @@ func (t *cacheOnlyTopicPool) ConfirmAdded(server *p2p.Server, nodeID enode.ID) {
 	if trusted {
 		// add to cache only if trusted
 		t.TopicPool.ConfirmAdded(server, nodeID)
-		sendEnodeDiscovered(nodeID.String(), string(t.topic))
+		sendEnodeDiscovered(t.signals, nodeID.String(), string(t.topic))
 		t.subtractToLimits()
 	}
 
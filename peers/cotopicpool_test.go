@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/signal"
 )
 
 type CacheOnlyTopicPoolSuite struct {
@@ -45,7 +46,7 @@ func (s *CacheOnlyTopicPoolSuite) SetupTest() {
 	cache, err := newInMemoryCache()
 	s.Require().NoError(err)
 	t := newTopicPool(nil, MailServerDiscoveryTopic, limits, 100*time.Millisecond, 200*time.Millisecond, cache)
-	s.topicPool = newCacheOnlyTopicPool(t, &testTrueVerifier{})
+	s.topicPool = newCacheOnlyTopicPool(t, &testTrueVerifier{}, signal.Namespace(""))
 	s.topicPool.running = 1
 	// This is a buffered channel to simplify testing.
 	// If your test generates more than 10 mode changes,
@@ -91,7 +92,7 @@ func (s *CacheOnlyTopicPoolSuite) TestReplacementPeerIsCounted() {
 func (s *CacheOnlyTopicPoolSuite) TestConfirmAddedSignals() {
 	sentNodeID := ""
 	sentTopic := ""
-	sendEnodeDiscovered = func(enode, topic string) {
+	sendEnodeDiscovered = func(_ signal.Namespace, enode, topic string) {
 		sentNodeID = enode
 		sentTopic = topic
 	}
@@ -107,7 +108,7 @@ func (s *CacheOnlyTopicPoolSuite) TestConfirmAddedSignals() {
 
 func (s *CacheOnlyTopicPoolSuite) TestNotTrustedPeer() {
 	var signalCalled bool
-	sendEnodeDiscovered = func(_, _ string) { signalCalled = true }
+	sendEnodeDiscovered = func(_ signal.Namespace, _, _ string) { signalCalled = true }
 
 	s.topicPool.limits = params.NewLimits(1, 1)
 	s.topicPool.maxCachedPeers = 1
@@ -57,6 +57,8 @@ type Options struct {
 	TopicStopSearchDelay time.Duration
 	// TrustedMailServers is a list of trusted nodes.
 	TrustedMailServers []enode.ID
+	// Signals is the namespace of the discovery signals, that is the profile running the node.
+	Signals signal.Namespace
 }
 
 // NewDefaultOptions returns a struct with default Options.
@@ -159,7 +161,7 @@ func (p *PeerPool) Start(server *p2p.Server) error {
 			if err != nil {
 				return err
 			}
-			topicPool = newCacheOnlyTopicPool(t, v)
+			topicPool = newCacheOnlyTopicPool(t, v, p.opts.Signals)
 		} else {
 			topicPool = t
 		}
@@ -170,7 +172,7 @@ func (p *PeerPool) Start(server *p2p.Server) error {
 	}
 
 	// discovery must be already started when pool is started
-	signal.SendDiscoveryStarted()
+	p.opts.Signals.SendDiscoveryStarted()
 
 	return nil
 }
@@ -192,7 +194,7 @@ func (p *PeerPool) startDiscovery() error {
 	p.setDiscoveryTimeout()
 	p.mu.Unlock()
 
-	signal.SendDiscoveryStarted()
+	p.opts.Signals.SendDiscoveryStarted()
 
 	return nil
 }
@@ -213,7 +215,7 @@ func (p *PeerPool) stopDiscovery(server *p2p.Server) {
 	p.timeout = nil
 	p.timeoutMu.Unlock()
 
-	signal.SendDiscoveryStopped()
+	p.opts.Signals.SendDiscoveryStopped()
 }
 
 // restartDiscovery and search for topics that have peer count below min
@@ -328,7 +330,7 @@ func (p *PeerPool) handlePeerEventType(server *p2p.Server, event *p2p.PeerEvent,
 	}
 
 	// First we send the discovery summary
-	SendDiscoverySummary(server.PeersInfo())
+	SendDiscoverySummary(p.opts.Signals, server.PeersInfo())
 
 	// then we send the stop event
 	if shouldRetry {
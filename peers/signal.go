@@ -7,6 +7,6 @@ import (
 )
 
 // SendDiscoverySummary sends discovery.summary signal.
-func SendDiscoverySummary(peers []*p2p.PeerInfo) {
-	signal.SendDiscoverySummary(peers)
+func SendDiscoverySummary(signals signal.Namespace, peers []*p2p.PeerInfo) {
+	signals.SendDiscoverySummary(peers)
 }
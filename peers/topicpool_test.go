@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/signal"
 )
 
 type TopicPoolSuite struct {
@@ -425,7 +426,7 @@ func (s *TopicPoolSuite) TestNewTopicPoolInterface() {
 	s.IsType(&TopicPool{}, t)
 
 	tp := newTopicPool(nil, MailServerDiscoveryTopic, limits, 100*time.Millisecond, 200*time.Millisecond, cache)
-	cacheTP := newCacheOnlyTopicPool(tp, &testTrueVerifier{})
+	cacheTP := newCacheOnlyTopicPool(tp, &testTrueVerifier{}, signal.Namespace(""))
 	s.IsType(&cacheOnlyTopicPool{}, cacheTP)
 }
 
@@ -40,6 +40,8 @@ const (
 	ActivityCenterNotificationTypeFirstCommunityTokenReceived
 	ActivityCenterNotificationTypeCommunityBanned
 	ActivityCenterNotificationTypeCommunityUnbanned
+	ActivityCenterNotificationTypeCommunityScheduledEventReminder
+	ActivityCenterNotificationTypeENSNameExpiring
 )
 
 type ActivityCenterMembershipStatus int
@@ -78,6 +80,8 @@ type ActivityTokenData struct {
 	Symbol    string `json:"symbol,omitempty"`
 	ImageURL  string `json:"imageUrl,omitempty"`
 	TokenType int    `json:"tokenType,omitempty"`
+	// ENS name data
+	ExpiresAt uint64 `json:"expiresAt,omitempty"`
 }
 
 type ActivityCenterNotification struct {
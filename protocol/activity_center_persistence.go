@@ -610,6 +610,10 @@ func (db sqlitePersistence) GetToProcessActivityCenterNotificationIds() ([][]byt
 		`)
 }
 
+func (db sqlitePersistence) GetActivityCenterNotificationIds() ([][]byte, error) {
+	return db.runActivityCenterIDQuery("SELECT a.id FROM activity_center_notifications a WHERE NOT a.deleted")
+}
+
 func (db sqlitePersistence) HasPendingNotificationsForChat(chatID string) (bool, error) {
 	rows, err := db.db.Query(`
 		SELECT 1 FROM activity_center_notifications a
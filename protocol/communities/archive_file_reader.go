@@ -0,0 +1,163 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"os"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/encryption"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ArchiveFileReader reads the history archives stored in the archive data folder.
+// It doesn't depend on BitTorrent, so that archives downloaded from a mirror can
+// also be imported by builds without torrent support.
+type ArchiveFileReader struct {
+	torrentConfig *params.TorrentConfig
+
+	logger      *zap.Logger
+	persistence *Persistence
+	identity    *ecdsa.PrivateKey
+	encryptor   *encryption.Protocol
+
+	publisher Publisher
+}
+
+func NewArchiveFileReader(amc *ArchiveManagerConfig) *ArchiveFileReader {
+	return &ArchiveFileReader{
+		torrentConfig: amc.TorrentConfig,
+		logger:        amc.Logger,
+		persistence:   amc.Persistence,
+		identity:      amc.Identity,
+		encryptor:     amc.Encryptor,
+		publisher:     amc.Publisher,
+	}
+}
+
+func (m *ArchiveFileReader) archiveIndexFile(communityID string) string {
+	return path.Join(m.torrentConfig.DataDir, communityID, "index")
+}
+
+func (m *ArchiveFileReader) GetMessageArchiveIDsToImport(communityID types.HexBytes) ([]string, error) {
+	return m.persistence.GetMessageArchiveIDsToImport(communityID)
+}
+
+func (m *ArchiveFileReader) SaveMessageArchiveID(communityID types.HexBytes, hash string) error {
+	return m.persistence.SaveMessageArchiveID(communityID, hash)
+}
+
+func (m *ArchiveFileReader) SetMessageArchiveIDImported(communityID types.HexBytes, hash string, imported bool) error {
+	return m.persistence.SetMessageArchiveIDImported(communityID, hash, imported)
+}
+
+func (m *ArchiveFileReader) archiveDataFile(communityID string) string {
+	return path.Join(m.torrentConfig.DataDir, communityID, "data")
+}
+
+func (m *ArchiveFileReader) ExtractMessagesFromHistoryArchive(communityID types.HexBytes, archiveID string) ([]*protobuf.WakuMessage, error) {
+	id := communityID.String()
+
+	index, err := m.LoadHistoryArchiveIndexFromFile(m.identity, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataFile, err := os.Open(m.archiveDataFile(id))
+	if err != nil {
+		return nil, err
+	}
+	defer dataFile.Close()
+
+	m.logger.Debug("extracting messages from history archive",
+		zap.String("communityID", communityID.String()),
+		zap.String("archiveID", archiveID))
+	metadata := index.Archives[archiveID]
+
+	_, err = dataFile.Seek(int64(metadata.Offset), 0)
+	if err != nil {
+		m.logger.Error("failed to seek archive data file", zap.Error(err))
+		return nil, err
+	}
+
+	data := make([]byte, metadata.Size-metadata.Padding)
+	m.logger.Debug("loading history archive data into memory", zap.Float64("data_size_MB", float64(metadata.Size-metadata.Padding)/1024.0/1024.0))
+	_, err = dataFile.Read(data)
+	if err != nil {
+		m.logger.Error("failed failed to read archive data", zap.Error(err))
+		return nil, err
+	}
+
+	archive := &protobuf.WakuMessageArchive{}
+
+	err = proto.Unmarshal(data, archive)
+	if err != nil {
+		// The archive data might eb encrypted so we try to decrypt instead first
+		var protocolMessage encryption.ProtocolMessage
+		err := proto.Unmarshal(data, &protocolMessage)
+		if err != nil {
+			m.logger.Error("failed to unmarshal protocol message", zap.Error(err))
+			return nil, err
+		}
+
+		pk, err := crypto.DecompressPubkey(communityID)
+		if err != nil {
+			m.logger.Error("failed to decompress community pubkey", zap.Error(err))
+			return nil, err
+		}
+		decryptedBytes, err := m.encryptor.HandleMessage(m.identity, pk, &protocolMessage, make([]byte, 0))
+		if err != nil {
+			m.logger.Error("failed to decrypt message archive", zap.Error(err))
+			return nil, err
+		}
+		err = proto.Unmarshal(decryptedBytes.DecryptedMessage, archive)
+		if err != nil {
+			m.logger.Error("failed to unmarshal message archive", zap.Error(err))
+			return nil, err
+		}
+	}
+	return archive.Messages, nil
+}
+
+func (m *ArchiveFileReader) LoadHistoryArchiveIndexFromFile(myKey *ecdsa.PrivateKey, communityID types.HexBytes) (*protobuf.WakuMessageArchiveIndex, error) {
+	wakuMessageArchiveIndexProto := &protobuf.WakuMessageArchiveIndex{}
+
+	indexPath := m.archiveIndexFile(communityID.String())
+	indexData, err := os.ReadFile(indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = proto.Unmarshal(indexData, wakuMessageArchiveIndexProto)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wakuMessageArchiveIndexProto.Archives) == 0 && len(indexData) > 0 {
+		// This means we're dealing with an encrypted index file, so we have to decrypt it first
+		var protocolMessage encryption.ProtocolMessage
+		err := proto.Unmarshal(indexData, &protocolMessage)
+		if err != nil {
+			return nil, err
+		}
+		pk, err := crypto.DecompressPubkey(communityID)
+		if err != nil {
+			return nil, err
+		}
+		decryptedBytes, err := m.encryptor.HandleMessage(myKey, pk, &protocolMessage, make([]byte, 0))
+		if err != nil {
+			return nil, err
+		}
+		err = proto.Unmarshal(decryptedBytes.DecryptedMessage, wakuMessageArchiveIndexProto)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return wakuMessageArchiveIndexProto, nil
+}
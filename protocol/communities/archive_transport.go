@@ -0,0 +1,134 @@
+package communities
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ArchiveAdvertisement describes where the control node makes the history archives
+// of a community available
+type ArchiveAdvertisement struct {
+	// MagnetURI is the magnet link of the archives torrent
+	MagnetURI string
+	// MirrorURL is the URL of a web server serving the community `index` and `data` files
+	MirrorURL string
+	// IndexHash is the Keccak256 hash of the archive index file
+	IndexHash []byte
+}
+
+func ArchiveAdvertisementFromMagnetlinkMessage(message *protobuf.CommunityMessageArchiveMagnetlink) *ArchiveAdvertisement {
+	return &ArchiveAdvertisement{
+		MagnetURI: message.MagnetUri,
+		MirrorURL: message.MirrorUrl,
+		IndexHash: message.IndexHash,
+	}
+}
+
+func ArchiveAdvertisementFromRequestToJoinResponse(response *protobuf.CommunityRequestToJoinResponse) *ArchiveAdvertisement {
+	return &ArchiveAdvertisement{
+		MagnetURI: response.MagnetUri,
+		MirrorURL: response.ArchiveMirrorUrl,
+		IndexHash: response.ArchiveIndexHash,
+	}
+}
+
+// Empty tells whether the advertisement doesn't point to any archives
+func (a *ArchiveAdvertisement) Empty() bool {
+	return a == nil || (a.MagnetURI == "" && a.MirrorURL == "")
+}
+
+// ArchiveTransport downloads the history archives advertised by a community control node.
+// Downloaded archives are stored in the archive data folder and recorded as downloaded,
+// so that they can be imported regardless of the transport they came from.
+type ArchiveTransport interface {
+	// Name identifies the transport in logs
+	Name() string
+	// CanDownload tells whether the transport can fetch the archives of the advertisement
+	CanDownload(advertisement *ArchiveAdvertisement) bool
+	// DownloadHistoryArchives downloads the archive index and the archives we don't have yet
+	DownloadHistoryArchives(communityID types.HexBytes, advertisement *ArchiveAdvertisement, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error)
+}
+
+type archiveMDSlice []*archiveMetadata
+
+type archiveMetadata struct {
+	hash string
+	from uint64
+}
+
+func (md archiveMDSlice) Len() int {
+	return len(md)
+}
+
+func (md archiveMDSlice) Swap(i, j int) {
+	md[i], md[j] = md[j], md[i]
+}
+
+func (md archiveMDSlice) Less(i, j int) bool {
+	return md[i].from > md[j].from
+}
+
+// archiveDownloader keeps track of the registered archive transports and of the running
+// download tasks. It's shared by the torrent and the nop archive managers, so that
+// archives can be downloaded from a mirror even when BitTorrent isn't available.
+type archiveDownloader struct {
+	logger                      *zap.Logger
+	archiveTransports           []ArchiveTransport
+	historyArchiveDownloadTasks map[string]*HistoryArchiveDownloadTask
+}
+
+func newArchiveDownloader(logger *zap.Logger) *archiveDownloader {
+	return &archiveDownloader{
+		logger:                      logger,
+		historyArchiveDownloadTasks: make(map[string]*HistoryArchiveDownloadTask),
+	}
+}
+
+func (d *archiveDownloader) GetHistoryArchiveDownloadTask(communityID string) *HistoryArchiveDownloadTask {
+	return d.historyArchiveDownloadTasks[communityID]
+}
+
+func (d *archiveDownloader) AddHistoryArchiveDownloadTask(communityID string, task *HistoryArchiveDownloadTask) {
+	d.historyArchiveDownloadTasks[communityID] = task
+}
+
+// RegisterArchiveTransport adds a transport to try, after the existing ones, when downloading history archives
+func (d *archiveDownloader) RegisterArchiveTransport(transport ArchiveTransport) {
+	d.archiveTransports = append(d.archiveTransports, transport)
+}
+
+// CanDownloadHistoryArchives tells whether any of the registered transports can fetch the advertised archives
+func (d *archiveDownloader) CanDownloadHistoryArchives(advertisement *ArchiveAdvertisement) bool {
+	if advertisement.Empty() {
+		return false
+	}
+
+	for _, transport := range d.archiveTransports {
+		if transport.CanDownload(advertisement) {
+			return true
+		}
+	}
+	return false
+}
+
+// DownloadHistoryArchives downloads the advertised history archives with the first transport that succeeds
+func (d *archiveDownloader) DownloadHistoryArchives(communityID types.HexBytes, advertisement *ArchiveAdvertisement, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	err := ErrNoArchiveTransport
+	for _, transport := range d.archiveTransports {
+		if !transport.CanDownload(advertisement) {
+			continue
+		}
+
+		var downloadTaskInfo *HistoryArchiveDownloadTaskInfo
+		downloadTaskInfo, err = transport.DownloadHistoryArchives(communityID, advertisement, cancelTask)
+		if err == nil {
+			return downloadTaskInfo, nil
+		}
+
+		d.logger.Warn("failed to download history archives", zap.String("transport", transport.Name()), zap.Error(err))
+	}
+
+	return nil, err
+}
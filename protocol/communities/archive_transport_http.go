@@ -0,0 +1,233 @@
+package communities
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/signal"
+)
+
+const httpArchiveRequestTimeout = 5 * time.Minute
+
+// httpArchiveTransport downloads history archives from a plain web server mirroring
+// the archive data folder of the control node. As the mirror isn't trusted, the index
+// is verified against the hash advertised by the control node and every archive against
+// the hash recorded in the index.
+type httpArchiveTransport struct {
+	m      *ArchiveFileReader
+	client *http.Client
+}
+
+func newHTTPArchiveTransport(m *ArchiveFileReader) *httpArchiveTransport {
+	return &httpArchiveTransport{
+		m:      m,
+		client: &http.Client{Timeout: httpArchiveRequestTimeout},
+	}
+}
+
+func (t *httpArchiveTransport) Name() string {
+	return "http"
+}
+
+func (t *httpArchiveTransport) CanDownload(advertisement *ArchiveAdvertisement) bool {
+	return advertisement.MirrorURL != "" && len(advertisement.IndexHash) > 0 &&
+		t.m.torrentConfig != nil && t.m.torrentConfig.DataDir != ""
+}
+
+func (t *httpArchiveTransport) DownloadHistoryArchives(communityID types.HexBytes, advertisement *ArchiveAdvertisement, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	id := communityID.String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-cancelTask:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	downloadTaskInfo := &HistoryArchiveDownloadTaskInfo{
+		TotalDownloadedArchivesCount: 0,
+		TotalArchivesCount:           0,
+		Cancelled:                    false,
+	}
+
+	indexURL, err := url.JoinPath(advertisement.MirrorURL, "index")
+	if err != nil {
+		return nil, err
+	}
+	dataURL, err := url.JoinPath(advertisement.MirrorURL, "data")
+	if err != nil {
+		return nil, err
+	}
+
+	t.m.logger.Debug("downloading history archive index from mirror", zap.String("id", id), zap.String("url", indexURL))
+	indexData, err := t.fetch(ctx, indexURL, 0, 0)
+	if ctx.Err() != nil {
+		t.m.logger.Debug("cancelled downloading archive index")
+		downloadTaskInfo.Cancelled = true
+		return downloadTaskInfo, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(crypto.Keccak256(indexData), advertisement.IndexHash) {
+		return nil, ErrArchiveIndexHashMismatch
+	}
+
+	err = os.MkdirAll(path.Join(t.m.torrentConfig.DataDir, id), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(t.m.archiveIndexFile(id), indexData, 0644) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := t.m.LoadHistoryArchiveIndexFromFile(t.m.identity, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingArchiveIDs, err := t.m.persistence.GetDownloadedMessageArchiveIDs(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existingArchiveIDs) == len(index.Archives) {
+		t.m.logger.Debug("download cancelled, no new archives")
+		return downloadTaskInfo, nil
+	}
+
+	downloadTaskInfo.TotalDownloadedArchivesCount = len(existingArchiveIDs)
+	downloadTaskInfo.TotalArchivesCount = len(index.Archives)
+
+	existing := make(map[string]bool, len(existingArchiveIDs))
+	for _, hash := range existingArchiveIDs {
+		existing[hash] = true
+	}
+
+	archiveHashes := make(archiveMDSlice, 0, downloadTaskInfo.TotalArchivesCount)
+	for hash, metadata := range index.Archives {
+		if !existing[hash] {
+			archiveHashes = append(archiveHashes, &archiveMetadata{hash: hash, from: metadata.Metadata.From})
+		}
+	}
+
+	sort.Sort(sort.Reverse(archiveHashes))
+
+	dataFile, err := os.OpenFile(t.m.archiveDataFile(id), os.O_RDWR|os.O_CREATE, 0644) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	defer dataFile.Close()
+
+	t.m.publisher.publish(&Subscription{
+		DownloadingHistoryArchivesStartedSignal: &signal.DownloadingHistoryArchivesStartedSignal{
+			CommunityID: id,
+		},
+	})
+
+	for _, hd := range archiveHashes {
+		hash := hd.hash
+		metadata := index.Archives[hash]
+
+		if len(metadata.Hash) == 0 {
+			// Archives created before the hash was recorded can't be verified
+			t.m.logger.Warn("skipping unverifiable message archive", zap.String("hash", hash))
+			continue
+		}
+
+		t.m.logger.Debug(fmt.Sprintf("downloading data for message archive (%d/%d)", downloadTaskInfo.TotalDownloadedArchivesCount+1, downloadTaskInfo.TotalArchivesCount), zap.String("hash", hash))
+		data, err := t.fetch(ctx, dataURL, metadata.Offset, metadata.Size-metadata.Padding)
+		if ctx.Err() != nil {
+			t.m.logger.Debug("downloading archive data interrupted")
+			downloadTaskInfo.Cancelled = true
+			return downloadTaskInfo, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(crypto.Keccak256(data), metadata.Hash) {
+			return nil, ErrArchiveHashMismatch
+		}
+
+		_, err = dataFile.WriteAt(data, int64(metadata.Offset))
+		if err != nil {
+			return nil, err
+		}
+
+		downloadTaskInfo.TotalDownloadedArchivesCount++
+		err = t.m.persistence.SaveMessageArchiveID(communityID, hash)
+		if err != nil {
+			t.m.logger.Error("couldn't save message archive ID", zap.Error(err))
+			continue
+		}
+		t.m.publisher.publish(&Subscription{
+			HistoryArchiveDownloadedSignal: &signal.HistoryArchiveDownloadedSignal{
+				CommunityID: id,
+				From:        int(metadata.Metadata.From),
+				To:          int(metadata.Metadata.To),
+			},
+		})
+	}
+
+	t.m.logger.Debug("finished downloading archives from mirror")
+	return downloadTaskInfo, nil
+}
+
+// fetch downloads `length` bytes starting at `offset`, or the whole file when `length` is 0
+func (t *httpArchiveTransport) fetch(ctx context.Context, rawURL string, offset uint64, length uint64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if length > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	}
+
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		// The server doesn't support ranges, skip to the requested bytes
+		_, err = io.CopyN(io.Discard, res.Body, int64(offset))
+		if err != nil {
+			return nil, err
+		}
+	case http.StatusPartialContent:
+	default:
+		return nil, fmt.Errorf("unexpected status code from archive mirror: %d", res.StatusCode)
+	}
+
+	if length == 0 {
+		return io.ReadAll(res.Body)
+	}
+
+	data := make([]byte, length)
+	_, err = io.ReadFull(res.Body, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
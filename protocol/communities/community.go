@@ -288,6 +288,7 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		Tags                        []CommunityTag                       `json:"tags"`
 		Chats                       map[string]CommunityChat             `json:"chats"`
 		Categories                  map[string]CommunityCategory         `json:"categories"`
+		ScheduledEvents             []*protobuf.CommunityScheduledEvent  `json:"scheduledEvents"`
 		Images                      map[string]Image                     `json:"images"`
 		Permissions                 *protobuf.CommunityPermissions       `json:"permissions"`
 		Members                     map[string]*protobuf.CommunityMember `json:"members"`
@@ -384,6 +385,7 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 			communityItem.Chats[id] = chat
 		}
 		communityItem.TokenPermissions = o.tokenPermissions()
+		communityItem.ScheduledEvents = o.scheduledEvents()
 		communityItem.PendingAndBannedMembers = o.PendingAndBannedMembers()
 		communityItem.Members = o.config.CommunityDescription.Members
 		communityItem.Permissions = o.config.CommunityDescription.Permissions
@@ -859,7 +861,7 @@ func (o *Community) RemoveUserFromOrg(pk *ecdsa.PublicKey) (*protobuf.CommunityD
 		_ = o.RemoveMembersFromOrg([]string{pkStr})
 		o.increaseClock()
 	} else {
-		err := o.addNewCommunityEvent(o.ToKickCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
+		err := o.addNewCommunityEvent(o.ToKickCommunityMemberCommunityEvent(common.PubkeyToHex(pk), ""))
 		if err != nil {
 			return nil, err
 		}
@@ -977,7 +979,7 @@ func (o *Community) UpsertCommunityTokensMetadata(token *protobuf.CommunityToken
 	return true, err
 }
 
-func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.CommunityDescription, error) {
+func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey, reason string) (*protobuf.CommunityDescription, error) {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
@@ -989,7 +991,7 @@ func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Commu
 		o.unbanUserFromCommunity(pk)
 		o.increaseClock()
 	} else {
-		err := o.addNewCommunityEvent(o.ToUnbanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
+		err := o.addNewCommunityEvent(o.ToUnbanCommunityMemberCommunityEvent(common.PubkeyToHex(pk), reason))
 		if err != nil {
 			return nil, err
 		}
@@ -998,7 +1000,7 @@ func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Commu
 	return o.config.CommunityDescription, nil
 }
 
-func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *protobuf.CommunityBanInfo) (*protobuf.CommunityDescription, error) {
+func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *protobuf.CommunityBanInfo, reason string) (*protobuf.CommunityDescription, error) {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
@@ -1015,7 +1017,7 @@ func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *
 		o.increaseClock()
 	} else {
 		pkStr := common.PubkeyToHex(pk)
-		err := o.addNewCommunityEvent(o.ToBanCommunityMemberCommunityEvent(pkStr))
+		err := o.addNewCommunityEvent(o.ToBanCommunityMemberCommunityEvent(pkStr, reason))
 		if err != nil {
 			return nil, err
 		}
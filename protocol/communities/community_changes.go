@@ -3,6 +3,7 @@ package communities
 import (
 	"crypto/ecdsa"
 
+	"github.com/golang/protobuf/proto"
 	slices "golang.org/x/exp/slices"
 
 	"github.com/status-im/status-go/protocol/protobuf"
@@ -39,6 +40,10 @@ type CommunityChanges struct {
 	CategoriesAdded    map[string]*protobuf.CommunityCategory `json:"categoriesAdded"`
 	CategoriesModified map[string]*protobuf.CommunityCategory `json:"categoriesModified"`
 
+	ScheduledEventsRemoved  []string                                     `json:"scheduledEventsRemoved"`
+	ScheduledEventsAdded    map[string]*protobuf.CommunityScheduledEvent `json:"scheduledEventsAdded"`
+	ScheduledEventsModified map[string]*protobuf.CommunityScheduledEvent `json:"scheduledEventsModified"`
+
 	MemberWalletsRemoved []string                               `json:"memberWalletsRemoved"`
 	MemberWalletsAdded   map[string][]*protobuf.RevealedAccount `json:"memberWalletsAdded"`
 
@@ -74,6 +79,10 @@ func EmptyCommunityChanges() *CommunityChanges {
 		CategoriesAdded:    make(map[string]*protobuf.CommunityCategory),
 		CategoriesModified: make(map[string]*protobuf.CommunityCategory),
 
+		ScheduledEventsRemoved:  []string{},
+		ScheduledEventsAdded:    make(map[string]*protobuf.CommunityScheduledEvent),
+		ScheduledEventsModified: make(map[string]*protobuf.CommunityScheduledEvent),
+
 		MemberWalletsRemoved: []string{},
 		MemberWalletsAdded:   make(map[string][]*protobuf.RevealedAccount),
 	}
@@ -120,6 +129,15 @@ func (c *CommunityChanges) Merge(other *CommunityChanges) {
 		c.CategoriesModified[categoryID] = category
 	}
 
+	c.ScheduledEventsRemoved = append(c.ScheduledEventsRemoved, other.ScheduledEventsRemoved...)
+
+	for eventID, event := range other.ScheduledEventsAdded {
+		c.ScheduledEventsAdded[eventID] = event
+	}
+	for eventID, event := range other.ScheduledEventsModified {
+		c.ScheduledEventsModified[eventID] = event
+	}
+
 	c.MemberWalletsRemoved = append(c.MemberWalletsRemoved, other.MemberWalletsRemoved...)
 
 	for walletID, wallets := range other.MemberWalletsAdded {
@@ -332,6 +350,22 @@ func evaluateCommunityChangesByDescription(origin, modified *protobuf.CommunityD
 		}
 	}
 
+	// Check for scheduled events that were removed
+	for eventID := range origin.ScheduledEvents {
+		if _, ok := modified.ScheduledEvents[eventID]; !ok {
+			changes.ScheduledEventsRemoved = append(changes.ScheduledEventsRemoved, eventID)
+		}
+	}
+
+	// Check for scheduled events that were added or modified
+	for eventID, event := range modified.ScheduledEvents {
+		if originEvent, ok := origin.ScheduledEvents[eventID]; !ok {
+			changes.ScheduledEventsAdded[eventID] = event
+		} else if !proto.Equal(originEvent, event) {
+			changes.ScheduledEventsModified[eventID] = event
+		}
+	}
+
 	return changes
 }
 
@@ -44,6 +44,7 @@ func encryptDescription(encryptor DescriptionEncryptor, community *Community, de
 			ActiveMembersCount: description.ActiveMembersCount,
 			Chats:              description.Chats,
 			Categories:         description.Categories,
+			ScheduledEvents:    description.ScheduledEvents,
 		}
 
 		keyIDSeqNo, encryptedDescription, err := encryptor.encryptCommunityDescription(community, descriptionToEncrypt)
@@ -51,12 +52,13 @@ func encryptDescription(encryptor DescriptionEncryptor, community *Community, de
 			return err
 		}
 
-		// Set private data and cleanup unencrypted members, chats and categories
+		// Set private data and cleanup unencrypted members, chats, categories and scheduled events
 		description.PrivateData[keyIDSeqNo] = encryptedDescription
 		description.Members = make(map[string]*protobuf.CommunityMember)
 		description.ActiveMembersCount = 0
 		description.Chats = make(map[string]*protobuf.CommunityChat)
 		description.Categories = make(map[string]*protobuf.CommunityCategory)
+		description.ScheduledEvents = make(map[string]*protobuf.CommunityScheduledEvent)
 	}
 
 	return nil
@@ -112,6 +114,10 @@ func decryptDescription(id types.HexBytes, encryptor DescriptionEncryptor, descr
 		if len(decryptedDescription.Categories) > 0 {
 			description.Categories = decryptedDescription.Categories
 		}
+
+		if len(decryptedDescription.ScheduledEvents) > 0 {
+			description.ScheduledEvents = decryptedDescription.ScheduledEvents
+		}
 	}
 
 	return failedToDecrypt, nil
@@ -22,6 +22,8 @@ type CommunityEvent struct {
 	MemberToAction      string                             `json:"memberToAction,omitempty"`
 	RequestToJoin       *protobuf.CommunityRequestToJoin   `json:"requestToJoin,omitempty"`
 	TokenMetadata       *protobuf.CommunityTokenMetadata   `json:"tokenMetadata,omitempty"`
+	Reason              string                             `json:"reason,omitempty"`
+	ScheduledEvent      *protobuf.CommunityScheduledEvent  `json:"scheduledEvent,omitempty"`
 	Payload             []byte                             `json:"payload"`
 	Signature           []byte                             `json:"signature"`
 }
@@ -50,6 +52,8 @@ func (e *CommunityEvent) ToProtobuf() *protobuf.CommunityEvent {
 		RejectedRequestsToJoin: rejectedRequestsToJoin,
 		AcceptedRequestsToJoin: acceptedRequestsToJoin,
 		TokenMetadata:          e.TokenMetadata,
+		Reason:                 e.Reason,
+		ScheduledEvent:         e.ScheduledEvent,
 	}
 }
 
@@ -88,6 +92,8 @@ func communityEventFromProtobuf(msg *protobuf.SignedCommunityEvent) (*CommunityE
 		MemberToAction:      memberToAction,
 		RequestToJoin:       requestToJoin,
 		TokenMetadata:       decodedEvent.TokenMetadata,
+		Reason:              decodedEvent.Reason,
+		ScheduledEvent:      decodedEvent.ScheduledEvent,
 		Payload:             msg.Payload,
 		Signature:           msg.Signature,
 	}, nil
@@ -207,6 +213,21 @@ func (e *CommunityEvent) Validate() error {
 		if len(e.MemberToAction) == 0 {
 			return errors.New("invalid delete all community member messages event")
 		}
+
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE:
+		if e.ScheduledEvent == nil || len(e.ScheduledEvent.Id) == 0 {
+			return errors.New("invalid community scheduled event create event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT:
+		if e.ScheduledEvent == nil || len(e.ScheduledEvent.Id) == 0 {
+			return errors.New("invalid community scheduled event edit event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE:
+		if e.ScheduledEvent == nil || len(e.ScheduledEvent.Id) == 0 {
+			return errors.New("invalid community scheduled event delete event")
+		}
 	}
 	return nil
 }
@@ -243,6 +264,11 @@ func (e *CommunityEvent) EventTypeID() string {
 
 	case protobuf.CommunityEvent_COMMUNITY_TOKEN_ADD:
 		return fmt.Sprintf("%d-%s", e.Type, e.TokenMetadata.Name)
+
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE,
+		protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT,
+		protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE:
+		return fmt.Sprintf("%d-%s", e.Type, e.ScheduledEvent.Id)
 	}
 
 	return ""
@@ -91,11 +91,38 @@ func (o *Community) ToReorderCategoryCommunityEvent(categoryID string, position
 	}
 }
 
-func (o *Community) ToBanCommunityMemberCommunityEvent(pubkey string) *CommunityEvent {
+func (o *Community) ToCreateScheduledEventCommunityEvent(event *protobuf.CommunityScheduledEvent) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE,
+		ScheduledEvent:      event,
+	}
+}
+
+func (o *Community) ToEditScheduledEventCommunityEvent(event *protobuf.CommunityScheduledEvent) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT,
+		ScheduledEvent:      event,
+	}
+}
+
+func (o *Community) ToDeleteScheduledEventCommunityEvent(eventID string) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE,
+		ScheduledEvent: &protobuf.CommunityScheduledEvent{
+			Id: eventID,
+		},
+	}
+}
+
+func (o *Community) ToBanCommunityMemberCommunityEvent(pubkey string, reason string) *CommunityEvent {
 	return &CommunityEvent{
 		CommunityEventClock: o.nextEventClock(),
 		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN,
 		MemberToAction:      pubkey,
+		Reason:              reason,
 	}
 }
 
@@ -107,19 +134,21 @@ func (o *Community) ToDeleteAllMemberMessagesEvent(pubkey string) *CommunityEven
 	}
 }
 
-func (o *Community) ToUnbanCommunityMemberCommunityEvent(pubkey string) *CommunityEvent {
+func (o *Community) ToUnbanCommunityMemberCommunityEvent(pubkey string, reason string) *CommunityEvent {
 	return &CommunityEvent{
 		CommunityEventClock: o.nextEventClock(),
 		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN,
 		MemberToAction:      pubkey,
+		Reason:              reason,
 	}
 }
 
-func (o *Community) ToKickCommunityMemberCommunityEvent(pubkey string) *CommunityEvent {
+func (o *Community) ToKickCommunityMemberCommunityEvent(pubkey string, reason string) *CommunityEvent {
 	return &CommunityEvent{
 		CommunityEventClock: o.nextEventClock(),
 		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK,
 		MemberToAction:      pubkey,
+		Reason:              reason,
 	}
 }
 
@@ -271,6 +271,21 @@ func (o *Community) applyEvent(communityEvent CommunityEvent) error {
 				return err
 			}
 		}
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE:
+		err := o.createScheduledEvent(communityEvent.ScheduledEvent)
+		if err != nil {
+			return err
+		}
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT:
+		err := o.editScheduledEvent(communityEvent.ScheduledEvent)
+		if err != nil {
+			return err
+		}
+	case protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE:
+		err := o.deleteScheduledEvent(communityEvent.ScheduledEvent.Id)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
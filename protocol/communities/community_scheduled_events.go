@@ -0,0 +1,193 @@
+package communities
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (o *Community) ScheduledEvents() []*protobuf.CommunityScheduledEvent {
+	if o == nil {
+		return nil
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.scheduledEvents()
+}
+
+// scheduledEvents returns the events sorted by start time
+func (o *Community) scheduledEvents() []*protobuf.CommunityScheduledEvent {
+	var response []*protobuf.CommunityScheduledEvent
+
+	if o.config == nil || o.config.CommunityDescription == nil {
+		return response
+	}
+
+	for _, event := range o.config.CommunityDescription.ScheduledEvents {
+		response = append(response, event)
+	}
+
+	sort.Slice(response, func(i, j int) bool {
+		return response[i].StartTime < response[j].StartTime
+	})
+
+	return response
+}
+
+func (o *Community) ScheduledEvent(eventID string) *protobuf.CommunityScheduledEvent {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config == nil || o.config.CommunityDescription == nil {
+		return nil
+	}
+
+	return o.config.CommunityDescription.ScheduledEvents[eventID]
+}
+
+func (o *Community) CreateScheduledEvent(event *protobuf.CommunityScheduledEvent) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE)) {
+		return nil, ErrNotAuthorized
+	}
+
+	err := o.createScheduledEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := o.emptyCommunityChanges()
+	changes.ScheduledEventsAdded[event.Id] = event
+
+	if o.IsControlNode() {
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToCreateScheduledEventCommunityEvent(event))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func (o *Community) EditScheduledEvent(event *protobuf.CommunityScheduledEvent) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT)) {
+		return nil, ErrNotAuthorized
+	}
+
+	err := o.editScheduledEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := o.emptyCommunityChanges()
+	changes.ScheduledEventsModified[event.Id] = event
+
+	if o.IsControlNode() {
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToEditScheduledEventCommunityEvent(event))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func (o *Community) DeleteScheduledEvent(eventID string) (*CommunityChanges, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE)) {
+		return nil, ErrNotAuthorized
+	}
+
+	err := o.deleteScheduledEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := o.emptyCommunityChanges()
+	changes.ScheduledEventsRemoved = append(changes.ScheduledEventsRemoved, eventID)
+
+	if o.IsControlNode() {
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToDeleteScheduledEventCommunityEvent(eventID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func (o *Community) validateScheduledEvent(event *protobuf.CommunityScheduledEvent) error {
+	if event == nil || len(event.Id) == 0 || len(event.Title) == 0 || event.StartTime == 0 {
+		return ErrInvalidScheduledEvent
+	}
+
+	if event.EndTime != 0 && event.EndTime < event.StartTime {
+		return ErrInvalidScheduledEvent
+	}
+
+	if len(event.ChatId) != 0 {
+		if _, ok := o.config.CommunityDescription.Chats[event.ChatId]; !ok {
+			return ErrChatNotFound
+		}
+	}
+
+	return nil
+}
+
+func (o *Community) createScheduledEvent(event *protobuf.CommunityScheduledEvent) error {
+	if err := o.validateScheduledEvent(event); err != nil {
+		return err
+	}
+
+	if o.config.CommunityDescription.ScheduledEvents == nil {
+		o.config.CommunityDescription.ScheduledEvents = make(map[string]*protobuf.CommunityScheduledEvent)
+	}
+	if _, ok := o.config.CommunityDescription.ScheduledEvents[event.Id]; ok {
+		return ErrScheduledEventAlreadyExists
+	}
+
+	o.config.CommunityDescription.ScheduledEvents[event.Id] = proto.Clone(event).(*protobuf.CommunityScheduledEvent)
+
+	return nil
+}
+
+func (o *Community) editScheduledEvent(event *protobuf.CommunityScheduledEvent) error {
+	if err := o.validateScheduledEvent(event); err != nil {
+		return err
+	}
+
+	if _, ok := o.config.CommunityDescription.ScheduledEvents[event.Id]; !ok {
+		return ErrScheduledEventNotFound
+	}
+
+	o.config.CommunityDescription.ScheduledEvents[event.Id] = proto.Clone(event).(*protobuf.CommunityScheduledEvent)
+
+	return nil
+}
+
+func (o *Community) deleteScheduledEvent(eventID string) error {
+	if _, ok := o.config.CommunityDescription.ScheduledEvents[eventID]; !ok {
+		return ErrScheduledEventNotFound
+	}
+
+	delete(o.config.CommunityDescription.ScheduledEvents, eventID)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func (s *CommunitySuite) TestScheduledEvents() {
+	event := &protobuf.CommunityScheduledEvent{
+		Id:        "event-id",
+		Title:     "AMA",
+		StartTime: 2000,
+		EndTime:   3000,
+		Location:  "https://status.app",
+	}
+
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = nil
+	org.config.ID = nil
+
+	_, err := org.CreateScheduledEvent(event)
+	s.Require().Equal(ErrNotAuthorized, err)
+
+	org.config.PrivateKey = s.identity
+	org.config.ID = &s.identity.PublicKey
+
+	changes, err := org.CreateScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "invalid", Title: "AMA"})
+	s.Require().Equal(ErrInvalidScheduledEvent, err)
+	s.Require().Nil(changes)
+
+	_, err = org.CreateScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "invalid", Title: "AMA", StartTime: 1, ChatId: "unknown"})
+	s.Require().Equal(ErrChatNotFound, err)
+
+	clock := org.Clock()
+	changes, err = org.CreateScheduledEvent(event)
+	s.Require().NoError(err)
+	s.Require().Greater(org.Clock(), clock)
+	s.Require().NotNil(changes.ScheduledEventsAdded[event.Id])
+	s.Require().Equal(event.Title, org.ScheduledEvent(event.Id).Title)
+
+	_, err = org.CreateScheduledEvent(event)
+	s.Require().Equal(ErrScheduledEventAlreadyExists, err)
+
+	_, err = org.CreateScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "earlier", Title: "Meetup", StartTime: 1000})
+	s.Require().NoError(err)
+
+	events := org.ScheduledEvents()
+	s.Require().Len(events, 2)
+	s.Require().Equal("earlier", events[0].Id)
+
+	edited := &protobuf.CommunityScheduledEvent{
+		Id:        event.Id,
+		Title:     "Rescheduled AMA",
+		StartTime: 4000,
+	}
+	changes, err = org.EditScheduledEvent(edited)
+	s.Require().NoError(err)
+	s.Require().NotNil(changes.ScheduledEventsModified[event.Id])
+	s.Require().Equal(edited.Title, org.ScheduledEvent(event.Id).Title)
+	s.Require().Equal(edited.StartTime, org.ScheduledEvent(event.Id).StartTime)
+
+	_, err = org.EditScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "unknown", Title: "AMA", StartTime: 1})
+	s.Require().Equal(ErrScheduledEventNotFound, err)
+
+	changes, err = org.DeleteScheduledEvent(event.Id)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{event.Id}, changes.ScheduledEventsRemoved)
+	s.Require().Nil(org.ScheduledEvent(event.Id))
+
+	_, err = org.DeleteScheduledEvent(event.Id)
+	s.Require().Equal(ErrScheduledEventNotFound, err)
+}
+
+func (s *CommunitySuite) TestScheduledEventsChanges() {
+	origin := s.buildCommunity(&s.identity.PublicKey)
+	modified := origin.CreateDeepCopy()
+
+	_, err := modified.CreateScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "added", Title: "AMA", StartTime: 1000})
+	s.Require().NoError(err)
+
+	changes := EvaluateCommunityChanges(origin, modified)
+	s.Require().NotNil(changes.ScheduledEventsAdded["added"])
+	s.Require().Empty(changes.ScheduledEventsModified)
+	s.Require().Empty(changes.ScheduledEventsRemoved)
+
+	origin = modified.CreateDeepCopy()
+	_, err = modified.EditScheduledEvent(&protobuf.CommunityScheduledEvent{Id: "added", Title: "AMA", StartTime: 2000})
+	s.Require().NoError(err)
+
+	changes = EvaluateCommunityChanges(origin, modified)
+	s.Require().Empty(changes.ScheduledEventsAdded)
+	s.Require().NotNil(changes.ScheduledEventsModified["added"])
+
+	origin = modified.CreateDeepCopy()
+	_, err = modified.DeleteScheduledEvent("added")
+	s.Require().NoError(err)
+
+	changes = EvaluateCommunityChanges(origin, modified)
+	s.Require().Equal([]string{"added"}, changes.ScheduledEventsRemoved)
+}
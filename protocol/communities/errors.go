@@ -51,3 +51,6 @@ var ErrPermissionToJoinNotSatisfied = errors.New("permission to join not satisfi
 var ErrBannedMemberNotFound = errors.New("banned member not found")
 var ErrGrantMemberPublicKeyIsDifferent = errors.New("grant member public key is different")
 var ErrEditSharedAddressesRequestOutdated = errors.New("outdated edit shares addresses request")
+var ErrScheduledEventNotFound = errors.New("scheduled event not found")
+var ErrScheduledEventAlreadyExists = errors.New("scheduled event already exists")
+var ErrInvalidScheduledEvent = errors.New("invalid scheduled event")
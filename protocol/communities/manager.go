@@ -79,6 +79,9 @@ func SetMaxNbPendingRequestedMembers(maxNb int) {
 // errors
 var (
 	ErrTorrentTimedout                 = errors.New("torrent has timed out")
+	ErrNoArchiveTransport              = errors.New("no transport can download the history archives")
+	ErrArchiveIndexHashMismatch        = errors.New("history archive index doesn't match its advertised hash")
+	ErrArchiveHashMismatch             = errors.New("history archive doesn't match its index hash")
 	ErrCommunityRequestAlreadyRejected = errors.New("that user was already rejected from the community")
 	ErrInvalidClock                    = errors.New("invalid clock to cancel request to join")
 )
@@ -109,6 +112,7 @@ type Manager struct {
 	keyDistributor           KeyDistributor
 	communityLock            *CommunityLock
 	mediaServer              server.MediaServerInterface
+	signals                  signal.Namespace
 }
 
 type CommunityLock struct {
@@ -175,6 +179,7 @@ type ArchiveFileService interface {
 	SetMessageArchiveIDImported(communityID types.HexBytes, hash string, imported bool) error
 	ExtractMessagesFromHistoryArchive(communityID types.HexBytes, archiveID string) ([]*protobuf.WakuMessage, error)
 	GetHistoryArchiveMagnetlink(communityID types.HexBytes) (string, error)
+	GetHistoryArchiveAdvertisement(communityID types.HexBytes) (*ArchiveAdvertisement, error)
 	LoadHistoryArchiveIndexFromFile(myKey *ecdsa.PrivateKey, communityID types.HexBytes) (*protobuf.WakuMessageArchiveIndex, error)
 }
 
@@ -198,6 +203,9 @@ type ArchiveService interface {
 	GetHistoryArchiveDownloadTask(communityID string) *HistoryArchiveDownloadTask
 	AddHistoryArchiveDownloadTask(communityID string, task *HistoryArchiveDownloadTask)
 	DownloadHistoryArchivesByMagnetlink(communityID types.HexBytes, magnetlink string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error)
+	CanDownloadHistoryArchives(advertisement *ArchiveAdvertisement) bool
+	DownloadHistoryArchives(communityID types.HexBytes, advertisement *ArchiveAdvertisement, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error)
+	RegisterArchiveTransport(transport ArchiveTransport)
 	TorrentFileExists(communityID string) bool
 }
 
@@ -238,6 +246,7 @@ type managerOptions struct {
 	walletConfig           *params.WalletConfig
 	communityTokensService CommunityTokensServiceInterface
 	permissionChecker      PermissionChecker
+	signals                signal.Namespace
 
 	// allowForcingCommunityMembersReevaluation indicates whether we should allow forcing community members reevaluation.
 	// This will allow using `force` argument in ScheduleMembersReevaluation.
@@ -372,6 +381,13 @@ func WithCommunityTokensService(communityTokensService CommunityTokensServiceInt
 	}
 }
 
+// WithSignalsNamespace sets the namespace of the signals sent by the manager, that is the profile it runs for
+func WithSignalsNamespace(signals signal.Namespace) ManagerOption {
+	return func(opts *managerOptions) {
+		opts.signals = signals
+	}
+}
+
 func WithAllowForcingCommunityMembersReevaluation(enabled bool) ManagerOption {
 	return func(opts *managerOptions) {
 		opts.allowForcingCommunityMembersReevaluation = enabled
@@ -428,6 +444,7 @@ func NewManager(
 		keyDistributor: keyDistributor,
 		communityLock:  NewCommunityLock(logger),
 		mediaServer:    mediaServer,
+		signals:        managerConfig.signals,
 	}
 
 	manager.persistence = &Persistence{
@@ -948,6 +965,8 @@ func (m *Manager) CreateCommunityTokenPermission(request *requests.CreateCommuni
 		return nil, nil, err
 	}
 
+	m.recordTokenPermissionChanges(community, changes)
+
 	return community, changes, nil
 }
 
@@ -972,6 +991,8 @@ func (m *Manager) EditCommunityTokenPermission(request *requests.EditCommunityTo
 		return nil, nil, err
 	}
 
+	m.recordTokenPermissionChanges(community, changes)
+
 	return community, changes, nil
 }
 
@@ -1515,23 +1536,30 @@ func (m *Manager) DeleteCommunityTokenPermission(request *requests.DeleteCommuni
 		return nil, nil, err
 	}
 
+	m.recordTokenPermissionChanges(community, changes)
+
 	return community, changes, nil
 }
 
 func (m *Manager) reevaluateCommunityMembersPermissions(communityID types.HexBytes) error {
 	// Publish when the reevluation started since it can take a while
-	signal.SendCommunityMemberReevaluationStarted(types.EncodeHex(communityID))
+	m.signals.SendCommunityMemberReevaluationStarted(types.EncodeHex(communityID))
 
 	community, newPrivilegedMembers, err := m.reevaluateMembers(communityID)
 
 	// Publish the reevaluation ending, even if it errored
 	// A possible improvement would be to pass the error here
-	signal.SendCommunityMemberReevaluationEnded(types.EncodeHex(communityID))
+	m.signals.SendCommunityMemberReevaluationEnded(types.EncodeHex(communityID))
 
 	if err != nil {
 		return err
 	}
 
+	err = m.ShareModerationLogWithPrivilegedMembers(community, newPrivilegedMembers)
+	if err != nil {
+		return err
+	}
+
 	return m.ShareRequestsToJoinWithPrivilegedMembers(community, newPrivilegedMembers)
 }
 
@@ -2405,6 +2433,10 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 		return nil, err
 	}
 
+	// Events are cleared once applied, so the log entries are collected upfront
+	// and only stored once the community has been saved successfully
+	moderationLogEntries := community.moderationLogEntriesFromEvents()
+
 	// Control node applies events and publish updated CommunityDescription
 	if community.IsControlNode() {
 		appliedEvents := map[string]uint64{}
@@ -2434,6 +2466,8 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 			return nil, err
 		}
 
+		m.saveModerationLogEntries(community, moderationLogEntries)
+
 		m.publish(&Subscription{Community: community})
 	} else {
 		err = m.persistence.SaveCommunity(community)
@@ -2444,6 +2478,8 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 		if err != nil {
 			return nil, err
 		}
+
+		m.saveModerationLogEntries(community, moderationLogEntries)
 	}
 
 	return &CommunityResponse{
@@ -2881,6 +2917,9 @@ func (m *Manager) AcceptRequestToJoin(dbRequest *RequestToJoin) (*Community, err
 			if err = m.ShareRequestsToJoinWithPrivilegedMembers(community, newPrivilegedMember); err != nil {
 				return nil, err
 			}
+			if err = m.ShareModerationLogWithPrivilegedMembers(community, newPrivilegedMember); err != nil {
+				return nil, err
+			}
 		}
 	} else if community.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT) {
 		err := community.addNewCommunityEvent(community.ToCommunityRequestToJoinAcceptCommunityEvent(dbRequest.PublicKey, dbRequest.ToCommunityRequestToJoinProtobuf()))
@@ -2901,6 +2940,8 @@ func (m *Manager) AcceptRequestToJoin(dbRequest *RequestToJoin) (*Community, err
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_REQUEST_TO_JOIN_ACCEPT, dbRequest.PublicKey, protobuf.CommunityMember_ROLE_NONE, "")
+
 	return community, nil
 }
 
@@ -2938,6 +2979,8 @@ func (m *Manager) DeclineRequestToJoin(dbRequest *RequestToJoin) (*Community, er
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_REQUEST_TO_JOIN_DECLINE, dbRequest.PublicKey, protobuf.CommunityMember_ROLE_NONE, "")
+
 	return community, nil
 }
 
@@ -3795,7 +3838,7 @@ func (m *Manager) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunit
 		return nil, err
 	}
 
-	_, err = community.UnbanUserFromCommunity(publicKey)
+	_, err = community.UnbanUserFromCommunity(publicKey, request.Reason)
 	if err != nil {
 		return nil, err
 	}
@@ -3805,6 +3848,8 @@ func (m *Manager) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunit
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_UNBAN, common.PubkeyToHex(publicKey), protobuf.CommunityMember_ROLE_NONE, request.Reason)
+
 	return community, nil
 }
 
@@ -3837,6 +3882,8 @@ func (m *Manager) AddRoleToMember(request *requests.AddRoleToMember) (*Community
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_ROLE_ADD, common.PubkeyToHex(publicKey), request.Role, "")
+
 	m.publish(&Subscription{Community: community})
 
 	return community, nil
@@ -3871,6 +3918,8 @@ func (m *Manager) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_ROLE_REMOVE, common.PubkeyToHex(publicKey), request.Role, "")
+
 	m.publish(&Subscription{Community: community})
 
 	return community, nil
@@ -3892,7 +3941,7 @@ func (m *Manager) BanUserFromCommunity(request *requests.BanUserFromCommunity) (
 		return nil, err
 	}
 
-	_, err = community.BanUserFromCommunity(publicKey, &protobuf.CommunityBanInfo{DeleteAllMessages: request.DeleteAllMessages})
+	_, err = community.BanUserFromCommunity(publicKey, &protobuf.CommunityBanInfo{DeleteAllMessages: request.DeleteAllMessages}, request.Reason)
 	if err != nil {
 		return nil, err
 	}
@@ -3902,6 +3951,8 @@ func (m *Manager) BanUserFromCommunity(request *requests.BanUserFromCommunity) (
 		return nil, err
 	}
 
+	m.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_BAN, common.PubkeyToHex(publicKey), protobuf.CommunityMember_ROLE_NONE, request.Reason)
+
 	return community, nil
 }
 
@@ -4067,6 +4118,16 @@ func (m *Manager) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*Reque
 	return m.persistence.PendingRequestsToJoinForCommunity(id)
 }
 
+// JoinedMembersByPeriod returns the members accepted into the community, the period is in unix seconds
+func (m *Manager) JoinedMembersByPeriod(id types.HexBytes, start uint64, end uint64) ([]string, error) {
+	return m.persistence.AcceptedRequestsToJoinPublicKeysByPeriod(id, start, end)
+}
+
+// LeftMembersByPeriod returns the members who left the community, the period is in unix seconds
+func (m *Manager) LeftMembersByPeriod(id types.HexBytes, start uint64, end uint64) ([]string, error) {
+	return m.persistence.RequestsToLeavePublicKeysByPeriod(id, start, end)
+}
+
 func (m *Manager) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*RequestToJoin, error) {
 	m.logger.Info("fetching declined invitations", zap.String("community-id", id.String()))
 	return m.persistence.DeclinedRequestsToJoinForCommunity(id)
@@ -4483,6 +4544,8 @@ func (m *Manager) saveAndPublish(community *Community) error {
 			return err
 		}
 
+		m.recordModerationEvents(community)
+
 		m.publish(&Subscription{CommunityEventsMessage: community.toCommunityEventsMessage()})
 		return nil
 	}
@@ -4651,6 +4714,10 @@ func (m *Manager) ValidateCommunityPrivilegedUserSyncMessage(message *protobuf.C
 			len(message.SyncEditSharedAddresses.PublicKey) == 0 || message.SyncEditSharedAddresses.EditSharedAddress == nil {
 			return errors.New("invalid edit shared adresses in CommunityPrivilegedUserSyncMessage message")
 		}
+	case protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_MODERATION_LOG:
+		if len(message.ModerationLogEntries) == 0 {
+			return errors.New("invalid moderation log entries in CommunityPrivilegedUserSyncMessage message")
+		}
 	}
 
 	return nil
@@ -31,25 +31,6 @@ import (
 	"go.uber.org/zap"
 )
 
-type archiveMDSlice []*archiveMetadata
-
-type archiveMetadata struct {
-	hash string
-	from uint64
-}
-
-func (md archiveMDSlice) Len() int {
-	return len(md)
-}
-
-func (md archiveMDSlice) Swap(i, j int) {
-	md[i], md[j] = md[j], md[i]
-}
-
-func (md archiveMDSlice) Less(i, j int) bool {
-	return md[i].from > md[j].from
-}
-
 type EncodedArchiveData struct {
 	padding int
 	bytes   []byte
@@ -59,7 +40,6 @@ type ArchiveManager struct {
 	torrentConfig                *params.TorrentConfig
 	torrentClient                *torrent.Client
 	torrentTasks                 map[string]metainfo.Hash
-	historyArchiveDownloadTasks  map[string]*HistoryArchiveDownloadTask
 	historyArchiveTasksWaitGroup sync.WaitGroup
 	historyArchiveTasks          sync.Map // stores `chan struct{}`
 
@@ -70,6 +50,7 @@ type ArchiveManager struct {
 	encryptor   *encryption.Protocol
 
 	*ArchiveFileManager
+	*archiveDownloader
 	publisher Publisher
 }
 
@@ -78,10 +59,9 @@ type ArchiveManager struct {
 // build command will import and build the torrent deps for the Desktop OSes.
 // NOTE: It is intentional that this file contains the identical function name as in "manager_archive_nop.go"
 func NewArchiveManager(amc *ArchiveManagerConfig) *ArchiveManager {
-	return &ArchiveManager{
-		torrentConfig:               amc.TorrentConfig,
-		torrentTasks:                make(map[string]metainfo.Hash),
-		historyArchiveDownloadTasks: make(map[string]*HistoryArchiveDownloadTask),
+	m := &ArchiveManager{
+		torrentConfig: amc.TorrentConfig,
+		torrentTasks:  make(map[string]metainfo.Hash),
 
 		logger:      amc.Logger,
 		persistence: amc.Persistence,
@@ -91,7 +71,14 @@ func NewArchiveManager(amc *ArchiveManagerConfig) *ArchiveManager {
 
 		publisher:          amc.Publisher,
 		ArchiveFileManager: NewArchiveFileManager(amc),
+		archiveDownloader:  newArchiveDownloader(amc.Logger),
 	}
+
+	// BitTorrent is preferred, the mirror is used when the torrent can't be fetched
+	m.RegisterArchiveTransport(&torrentArchiveTransport{m: m})
+	m.RegisterArchiveTransport(newHTTPArchiveTransport(m.ArchiveFileReader))
+
+	return m
 }
 
 func (m *ArchiveManager) SetOnline(online bool) {
@@ -460,14 +447,6 @@ func (m *ArchiveManager) IsSeedingHistoryArchiveTorrent(communityID types.HexByt
 	return ok && torrent.Seeding()
 }
 
-func (m *ArchiveManager) GetHistoryArchiveDownloadTask(communityID string) *HistoryArchiveDownloadTask {
-	return m.historyArchiveDownloadTasks[communityID]
-}
-
-func (m *ArchiveManager) AddHistoryArchiveDownloadTask(communityID string, task *HistoryArchiveDownloadTask) {
-	m.historyArchiveDownloadTasks[communityID] = task
-}
-
 func (m *ArchiveManager) DownloadHistoryArchivesByMagnetlink(communityID types.HexBytes, magnetlink string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
 
 	id := communityID.String()
@@ -644,6 +623,22 @@ func (m *ArchiveManager) TorrentFileExists(communityID string) bool {
 	return err == nil
 }
 
+type torrentArchiveTransport struct {
+	m *ArchiveManager
+}
+
+func (t *torrentArchiveTransport) Name() string {
+	return "torrent"
+}
+
+func (t *torrentArchiveTransport) CanDownload(advertisement *ArchiveAdvertisement) bool {
+	return advertisement.MagnetURI != "" && t.m.torrentClientStarted()
+}
+
+func (t *torrentArchiveTransport) DownloadHistoryArchives(communityID types.HexBytes, advertisement *ArchiveAdvertisement, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	return t.m.DownloadHistoryArchivesByMagnetlink(communityID, advertisement.MagnetURI, cancelTask)
+}
+
 func topicsAsByteArrays(topics []types.TopicType) [][]byte {
 	var topicsAsByteArrays [][]byte
 	for _, t := range topics {
@@ -10,16 +10,13 @@
 package communities
 
 import (
-	"crypto/ecdsa"
+	"net/url"
 	"os"
-	"path"
 	"time"
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
-	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/protocol/common"
-	"github.com/status-im/status-go/protocol/encryption"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/signal"
 
@@ -30,24 +27,12 @@ import (
 )
 
 type ArchiveFileManager struct {
-	torrentConfig *params.TorrentConfig
-
-	logger      *zap.Logger
-	persistence *Persistence
-	identity    *ecdsa.PrivateKey
-	encryptor   *encryption.Protocol
-
-	publisher Publisher
+	*ArchiveFileReader
 }
 
 func NewArchiveFileManager(amc *ArchiveManagerConfig) *ArchiveFileManager {
 	return &ArchiveFileManager{
-		torrentConfig: amc.TorrentConfig,
-		logger:        amc.Logger,
-		persistence:   amc.Persistence,
-		identity:      amc.Identity,
-		encryptor:     amc.Encryptor,
-		publisher:     amc.Publisher,
+		ArchiveFileReader: NewArchiveFileReader(amc),
 	}
 }
 
@@ -206,6 +191,7 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 				Offset:   offset,
 				Size:     uint64(size),
 				Padding:  uint64(padding),
+				Hash:     crypto.Keccak256(encodedArchive),
 			}
 
 			wakuMessageArchiveIndexMetadataBytes, err := proto.Marshal(wakuMessageArchiveIndexMetadata)
@@ -335,10 +321,6 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 	return archiveIDs, nil
 }
 
-func (m *ArchiveFileManager) archiveIndexFile(communityID string) string {
-	return path.Join(m.torrentConfig.DataDir, communityID, "index")
-}
-
 func (m *ArchiveFileManager) createWakuMessageArchive(from time.Time, to time.Time, messages []types.Message, topics [][]byte) *protobuf.WakuMessageArchive {
 	var wakuMessages []*protobuf.WakuMessage
 
@@ -377,18 +359,6 @@ func (m *ArchiveFileManager) CreateHistoryArchiveTorrentFromDB(communityID types
 	return m.createHistoryArchiveTorrent(communityID, make([]*types.Message, 0), topics, startDate, endDate, partition, encrypt)
 }
 
-func (m *ArchiveFileManager) GetMessageArchiveIDsToImport(communityID types.HexBytes) ([]string, error) {
-	return m.persistence.GetMessageArchiveIDsToImport(communityID)
-}
-
-func (m *ArchiveFileManager) SaveMessageArchiveID(communityID types.HexBytes, hash string) error {
-	return m.persistence.SaveMessageArchiveID(communityID, hash)
-}
-
-func (m *ArchiveFileManager) SetMessageArchiveIDImported(communityID types.HexBytes, hash string, imported bool) error {
-	return m.persistence.SetMessageArchiveIDImported(communityID, hash, imported)
-}
-
 func (m *ArchiveFileManager) GetHistoryArchiveMagnetlink(communityID types.HexBytes) (string, error) {
 	id := communityID.String()
 	torrentFile := torrentFile(m.torrentConfig.TorrentDir, id)
@@ -406,108 +376,31 @@ func (m *ArchiveFileManager) GetHistoryArchiveMagnetlink(communityID types.HexBy
 	return metaInfo.Magnet(nil, &info).String(), nil
 }
 
-func (m *ArchiveFileManager) archiveDataFile(communityID string) string {
-	return path.Join(m.torrentConfig.DataDir, communityID, "data")
-}
-
-func (m *ArchiveFileManager) ExtractMessagesFromHistoryArchive(communityID types.HexBytes, archiveID string) ([]*protobuf.WakuMessage, error) {
-	id := communityID.String()
-
-	index, err := m.LoadHistoryArchiveIndexFromFile(m.identity, communityID)
+// GetHistoryArchiveAdvertisement returns the locations members can download our history archives from
+func (m *ArchiveFileManager) GetHistoryArchiveAdvertisement(communityID types.HexBytes) (*ArchiveAdvertisement, error) {
+	magnetlink, err := m.GetHistoryArchiveMagnetlink(communityID)
 	if err != nil {
 		return nil, err
 	}
 
-	dataFile, err := os.Open(m.archiveDataFile(id))
-	if err != nil {
-		return nil, err
+	advertisement := &ArchiveAdvertisement{
+		MagnetURI: magnetlink,
 	}
-	defer dataFile.Close()
 
-	m.logger.Debug("extracting messages from history archive",
-		zap.String("communityID", communityID.String()),
-		zap.String("archiveID", archiveID))
-	metadata := index.Archives[archiveID]
-
-	_, err = dataFile.Seek(int64(metadata.Offset), 0)
-	if err != nil {
-		m.logger.Error("failed to seek archive data file", zap.Error(err))
-		return nil, err
+	if m.torrentConfig.MirrorURL == "" {
+		return advertisement, nil
 	}
 
-	data := make([]byte, metadata.Size-metadata.Padding)
-	m.logger.Debug("loading history archive data into memory", zap.Float64("data_size_MB", float64(metadata.Size-metadata.Padding)/1024.0/1024.0))
-	_, err = dataFile.Read(data)
+	indexData, err := os.ReadFile(m.archiveIndexFile(communityID.String()))
 	if err != nil {
-		m.logger.Error("failed failed to read archive data", zap.Error(err))
 		return nil, err
 	}
 
-	archive := &protobuf.WakuMessageArchive{}
-
-	err = proto.Unmarshal(data, archive)
-	if err != nil {
-		// The archive data might eb encrypted so we try to decrypt instead first
-		var protocolMessage encryption.ProtocolMessage
-		err := proto.Unmarshal(data, &protocolMessage)
-		if err != nil {
-			m.logger.Error("failed to unmarshal protocol message", zap.Error(err))
-			return nil, err
-		}
-
-		pk, err := crypto.DecompressPubkey(communityID)
-		if err != nil {
-			m.logger.Error("failed to decompress community pubkey", zap.Error(err))
-			return nil, err
-		}
-		decryptedBytes, err := m.encryptor.HandleMessage(m.identity, pk, &protocolMessage, make([]byte, 0))
-		if err != nil {
-			m.logger.Error("failed to decrypt message archive", zap.Error(err))
-			return nil, err
-		}
-		err = proto.Unmarshal(decryptedBytes.DecryptedMessage, archive)
-		if err != nil {
-			m.logger.Error("failed to unmarshal message archive", zap.Error(err))
-			return nil, err
-		}
-	}
-	return archive.Messages, nil
-}
-
-func (m *ArchiveFileManager) LoadHistoryArchiveIndexFromFile(myKey *ecdsa.PrivateKey, communityID types.HexBytes) (*protobuf.WakuMessageArchiveIndex, error) {
-	wakuMessageArchiveIndexProto := &protobuf.WakuMessageArchiveIndex{}
-
-	indexPath := m.archiveIndexFile(communityID.String())
-	indexData, err := os.ReadFile(indexPath)
-	if err != nil {
-		return nil, err
-	}
-
-	err = proto.Unmarshal(indexData, wakuMessageArchiveIndexProto)
+	advertisement.MirrorURL, err = url.JoinPath(m.torrentConfig.MirrorURL, communityID.String())
 	if err != nil {
 		return nil, err
 	}
+	advertisement.IndexHash = crypto.Keccak256(indexData)
 
-	if len(wakuMessageArchiveIndexProto.Archives) == 0 && len(indexData) > 0 {
-		// This means we're dealing with an encrypted index file, so we have to decrypt it first
-		var protocolMessage encryption.ProtocolMessage
-		err := proto.Unmarshal(indexData, &protocolMessage)
-		if err != nil {
-			return nil, err
-		}
-		pk, err := crypto.DecompressPubkey(communityID)
-		if err != nil {
-			return nil, err
-		}
-		decryptedBytes, err := m.encryptor.HandleMessage(myKey, pk, &protocolMessage, make([]byte, 0))
-		if err != nil {
-			return nil, err
-		}
-		err = proto.Unmarshal(decryptedBytes.DecryptedMessage, wakuMessageArchiveIndexProto)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return wakuMessageArchiveIndexProto, nil
+	return advertisement, nil
 }
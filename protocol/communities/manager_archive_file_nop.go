@@ -4,14 +4,15 @@
 package communities
 
 import (
-	"crypto/ecdsa"
 	"time"
 
 	"github.com/status-im/status-go/eth-node/types"
-	"github.com/status-im/status-go/protocol/protobuf"
 )
 
-type ArchiveFileManagerNop struct{}
+// ArchiveFileManagerNop can't create archives, but still reads the ones downloaded from a mirror
+type ArchiveFileManagerNop struct {
+	*ArchiveFileReader
+}
 
 func (amm *ArchiveFileManagerNop) CreateHistoryArchiveTorrentFromMessages(communityID types.HexBytes, messages []*types.Message, topics []types.TopicType, startDate time.Time, endDate time.Time, partition time.Duration, encrypt bool) ([]string, error) {
 	return nil, nil
@@ -21,26 +22,10 @@ func (amm *ArchiveFileManagerNop) CreateHistoryArchiveTorrentFromDB(communityID
 	return nil, nil
 }
 
-func (amm *ArchiveFileManagerNop) SaveMessageArchiveID(communityID types.HexBytes, hash string) error {
-	return nil
-}
-
-func (amm *ArchiveFileManagerNop) GetMessageArchiveIDsToImport(communityID types.HexBytes) ([]string, error) {
-	return nil, nil
-}
-
-func (amm *ArchiveFileManagerNop) SetMessageArchiveIDImported(communityID types.HexBytes, hash string, imported bool) error {
-	return nil
-}
-
-func (amm *ArchiveFileManagerNop) ExtractMessagesFromHistoryArchive(communityID types.HexBytes, archiveID string) ([]*protobuf.WakuMessage, error) {
-	return nil, nil
-}
-
 func (amm *ArchiveFileManagerNop) GetHistoryArchiveMagnetlink(communityID types.HexBytes) (string, error) {
 	return "", nil
 }
 
-func (amm *ArchiveFileManagerNop) LoadHistoryArchiveIndexFromFile(myKey *ecdsa.PrivateKey, communityID types.HexBytes) (*protobuf.WakuMessageArchiveIndex, error) {
-	return nil, nil
+func (amm *ArchiveFileManagerNop) GetHistoryArchiveAdvertisement(communityID types.HexBytes) (*ArchiveAdvertisement, error) {
+	return &ArchiveAdvertisement{}, nil
 }
@@ -13,6 +13,7 @@ import (
 
 type ArchiveManagerNop struct {
 	*ArchiveFileManagerNop
+	*archiveDownloader
 }
 
 // NewArchiveManager this function is only built and called when the "disable_torrent" build tag is set
@@ -20,14 +21,22 @@ type ArchiveManagerNop struct {
 // build command will not import or build the torrent deps for the mobile OS.
 // NOTE: It is intentional that this file contains the identical function name as in "manager_archive.go"
 func NewArchiveManager(amc *ArchiveManagerConfig) *ArchiveManagerNop {
-	return &ArchiveManagerNop{
-		&ArchiveFileManagerNop{},
+	m := &ArchiveManagerNop{
+		ArchiveFileManagerNop: &ArchiveFileManagerNop{NewArchiveFileReader(amc)},
+		archiveDownloader:     newArchiveDownloader(amc.Logger),
 	}
+
+	// Archives can still be downloaded from a mirror, as it doesn't need BitTorrent
+	m.RegisterArchiveTransport(newHTTPArchiveTransport(m.ArchiveFileReader))
+
+	return m
 }
 
 func (tmm *ArchiveManagerNop) SetOnline(online bool) {}
 
-func (tmm *ArchiveManagerNop) SetTorrentConfig(*params.TorrentConfig) {}
+func (tmm *ArchiveManagerNop) SetTorrentConfig(config *params.TorrentConfig) {
+	tmm.torrentConfig = config
+}
 
 func (tmm *ArchiveManagerNop) StartTorrentClient() error {
 	return nil
@@ -72,13 +81,6 @@ func (tmm *ArchiveManagerNop) IsSeedingHistoryArchiveTorrent(communityID types.H
 	return false
 }
 
-func (tmm *ArchiveManagerNop) GetHistoryArchiveDownloadTask(communityID string) *HistoryArchiveDownloadTask {
-	return nil
-}
-
-func (tmm *ArchiveManagerNop) AddHistoryArchiveDownloadTask(communityID string, task *HistoryArchiveDownloadTask) {
-}
-
 func (tmm *ArchiveManagerNop) DownloadHistoryArchivesByMagnetlink(communityID types.HexBytes, magnetlink string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
 	return nil, nil
 }
@@ -0,0 +1,161 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrInvalidModerationLogLimit = errors.New("moderation log limit must be greater than zero")
+
+// GetModerationLog returns a page of the community moderation log, most recent entries first.
+// Only privileged members keep a moderation log.
+func (m *Manager) GetModerationLog(communityID types.HexBytes, cursor string, limit int) ([]*ModerationLogEntry, string, error) {
+	if limit <= 0 {
+		return nil, "", ErrInvalidModerationLogLimit
+	}
+
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !community.IsControlNode() && !community.IsPrivilegedMember(&m.identity.PublicKey) {
+		return nil, "", ErrNotEnoughPermissions
+	}
+
+	return m.persistence.GetModerationLogEntries(communityID, cursor, limit)
+}
+
+// SaveModerationLogEntries stores moderation log entries if we are a privileged member of the community.
+// The control node additionally shares the new entries with the other privileged members.
+func (m *Manager) SaveModerationLogEntries(community *Community, entries []*ModerationLogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if !community.IsControlNode() && !community.IsPrivilegedMember(&m.identity.PublicKey) {
+		return nil
+	}
+
+	inserted, err := m.persistence.SaveModerationLogEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	if community.IsControlNode() && len(inserted) > 0 {
+		skipMembers := map[string]struct{}{common.PubkeyToHex(&m.identity.PublicKey): {}}
+		m.shareModerationLogEntries(community, community.GetFilteredPrivilegedMembers(skipMembers), inserted)
+	}
+
+	return nil
+}
+
+// ShareModerationLogWithPrivilegedMembers sends the whole moderation log to newly promoted privileged members
+func (m *Manager) ShareModerationLogWithPrivilegedMembers(community *Community, privilegedMembers map[protobuf.CommunityMember_Roles][]*ecdsa.PublicKey) error {
+	if len(privilegedMembers) == 0 || !community.IsControlNode() {
+		return nil
+	}
+
+	entries, _, err := m.persistence.GetModerationLogEntries(community.ID(), "", -1)
+	if err != nil {
+		return err
+	}
+
+	m.shareModerationLogEntries(community, privilegedMembers, entries)
+	return nil
+}
+
+func (m *Manager) shareModerationLogEntries(community *Community, privilegedMembers map[protobuf.CommunityMember_Roles][]*ecdsa.PublicKey, entries []*ModerationLogEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	protoEntries := make([]*protobuf.CommunityModerationLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, entry.ToProtobuf())
+	}
+
+	syncMsg := &protobuf.CommunityPrivilegedUserSyncMessage{
+		Type:                 protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_MODERATION_LOG,
+		CommunityId:          community.ID(),
+		ModerationLogEntries: protoEntries,
+	}
+
+	for _, members := range privilegedMembers {
+		if len(members) == 0 {
+			continue
+		}
+
+		m.publish(&Subscription{CommunityPrivilegedMemberSyncMessage: &CommunityPrivilegedMemberSyncMessage{
+			Receivers:                          members,
+			CommunityPrivilegedUserSyncMessage: syncMsg,
+		}})
+	}
+}
+
+func (m *Manager) HandleModerationLogPrivilegedUserSyncMessage(message *protobuf.CommunityPrivilegedUserSyncMessage, community *Community) error {
+	if !community.IsPrivilegedMember(&m.identity.PublicKey) {
+		return ErrNotEnoughPermissions
+	}
+
+	entries := make([]*ModerationLogEntry, 0, len(message.ModerationLogEntries))
+	for _, entry := range message.ModerationLogEntries {
+		entries = append(entries, ModerationLogEntryFromProtobuf(community.ID(), entry))
+	}
+
+	_, err := m.persistence.SaveModerationLogEntries(entries)
+	return err
+}
+
+// RecordModerationAction stores an action performed directly by the control node.
+// Actions of other privileged members are recorded from their community events.
+func (m *Manager) RecordModerationAction(community *Community, action protobuf.CommunityModerationLogEntry_Action, target string, role protobuf.CommunityMember_Roles, reason string) {
+	if !community.IsControlNode() {
+		return
+	}
+
+	entry := NewModerationLogEntry(community.ID(), common.PubkeyToHex(&m.identity.PublicKey), action, target, community.Clock())
+	entry.Role = role
+	entry.Reason = reason
+	entry.CalculateID()
+
+	m.saveModerationLogEntries(community, []*ModerationLogEntry{entry})
+}
+
+func (m *Manager) recordTokenPermissionChanges(community *Community, changes *CommunityChanges) {
+	if !community.IsControlNode() || changes == nil {
+		return
+	}
+
+	var entries []*ModerationLogEntry
+	actor := common.PubkeyToHex(&m.identity.PublicKey)
+	for id := range changes.TokenPermissionsAdded {
+		entries = append(entries, NewModerationLogEntry(community.ID(), actor, protobuf.CommunityModerationLogEntry_TOKEN_PERMISSION_CHANGE, id, community.Clock()))
+	}
+	for id := range changes.TokenPermissionsModified {
+		entries = append(entries, NewModerationLogEntry(community.ID(), actor, protobuf.CommunityModerationLogEntry_TOKEN_PERMISSION_CHANGE, id, community.Clock()))
+	}
+	for id := range changes.TokenPermissionsRemoved {
+		entries = append(entries, NewModerationLogEntry(community.ID(), actor, protobuf.CommunityModerationLogEntry_TOKEN_PERMISSION_DELETE, id, community.Clock()))
+	}
+
+	m.saveModerationLogEntries(community, entries)
+}
+
+func (m *Manager) recordModerationEvents(community *Community) {
+	m.saveModerationLogEntries(community, community.moderationLogEntriesFromEvents())
+}
+
+// saveModerationLogEntries never fails the moderation action itself, the log is best effort
+func (m *Manager) saveModerationLogEntries(community *Community, entries []*ModerationLogEntry) {
+	err := m.SaveModerationLogEntries(community, entries)
+	if err != nil {
+		m.logger.Warn("failed to save moderation log entries", zap.String("communityID", community.IDString()), zap.Error(err))
+	}
+}
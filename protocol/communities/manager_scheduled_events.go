@@ -0,0 +1,115 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func (m *Manager) CreateScheduledEvent(request *requests.CreateCommunityScheduledEvent) (*Community, *CommunityChanges, error) {
+	m.communityLock.Lock(request.CommunityID)
+	defer m.communityLock.Unlock(request.CommunityID)
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, err := community.CreateScheduledEvent(request.ToScheduledEvent(uuid.New().String()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, changes, nil
+}
+
+func (m *Manager) EditScheduledEvent(request *requests.EditCommunityScheduledEvent) (*Community, *CommunityChanges, error) {
+	m.communityLock.Lock(request.CommunityID)
+	defer m.communityLock.Unlock(request.CommunityID)
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, err := community.EditScheduledEvent(request.ToScheduledEvent())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, changes, nil
+}
+
+func (m *Manager) DeleteScheduledEvent(request *requests.DeleteCommunityScheduledEvent) (*Community, *CommunityChanges, error) {
+	m.communityLock.Lock(request.CommunityID)
+	defer m.communityLock.Unlock(request.CommunityID)
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, err := community.DeleteScheduledEvent(request.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.DeleteScheduledEventRsvps(changes.ScheduledEventsRemoved)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, changes, nil
+}
+
+// SaveScheduledEventRsvp stores the RSVP of a community member.
+// It returns false when the RSVP is outdated, the event unknown or the signer not a member.
+func (m *Manager) SaveScheduledEventRsvp(signer *ecdsa.PublicKey, rsvp *ScheduledEventRsvp) (bool, error) {
+	community, err := m.GetByID(rsvp.CommunityID)
+	if err != nil {
+		return false, err
+	}
+
+	if community.ScheduledEvent(rsvp.EventID) == nil {
+		return false, ErrScheduledEventNotFound
+	}
+
+	if !community.HasMember(signer) {
+		return false, ErrNotAuthorized
+	}
+
+	rsvp.Member = common.PubkeyToHex(signer)
+
+	return m.persistence.SaveScheduledEventRsvp(rsvp)
+}
+
+func (m *Manager) GetScheduledEventRsvps(eventID string) ([]*ScheduledEventRsvp, error) {
+	return m.persistence.GetScheduledEventRsvps(eventID)
+}
+
+func (m *Manager) GetMemberScheduledEventRsvps(communityID types.HexBytes, member *ecdsa.PublicKey) ([]*ScheduledEventRsvp, error) {
+	return m.persistence.GetMemberScheduledEventRsvps(communityID, common.PubkeyToHex(member))
+}
+
+func (m *Manager) DeleteScheduledEventRsvps(eventIDs []string) error {
+	return m.persistence.DeleteScheduledEventRsvps(eventIDs)
+}
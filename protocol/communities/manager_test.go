@@ -8,6 +8,8 @@ import (
 	"image/png"
 	"math"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -1610,6 +1612,120 @@ func (s *ManagerSuite) TestCheckAllChannelsPermissions() {
 	s.Require().Len(response.Channels[chatID2].ViewOnlyPermissions.Permissions, 0)
 }
 
+func (s *ManagerSuite) buildMirroredHistoryArchive() (*Community, *ArchiveAdvertisement, *httptest.Server) {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	topic := types.BytesToTopic(transport.ToTopic(chatID))
+	topics := []types.TopicType{topic}
+
+	// Time range of 3 weeks with a partition of 7 days, this should create 3 archives
+	startDate := time.Date(2020, 1, 1, 00, 00, 00, 0, time.UTC)
+	endDate := time.Date(2020, 1, 21, 00, 00, 00, 0, time.UTC)
+	partition := 7 * 24 * time.Hour
+
+	message1 := buildMessage(startDate.Add(1*time.Hour), topic, []byte{1})
+	message2 := buildMessage(startDate.Add(8*24*time.Hour), topic, []byte{2})
+	message3 := buildMessage(startDate.Add(15*24*time.Hour), topic, []byte{3})
+
+	_, err = s.archiveManager.CreateHistoryArchiveTorrentFromMessages(community.ID(), []*types.Message{&message1, &message2, &message3}, topics, startDate, endDate, partition, false)
+	s.Require().NoError(err)
+
+	server := httptest.NewServer(http.FileServer(http.Dir(s.archiveManager.torrentConfig.DataDir)))
+
+	torrentConfig := *s.archiveManager.torrentConfig
+	torrentConfig.MirrorURL = server.URL
+	s.archiveManager.SetTorrentConfig(&torrentConfig)
+
+	advertisement, err := s.archiveManager.GetHistoryArchiveAdvertisement(community.ID())
+	s.Require().NoError(err)
+	s.Require().NotEmpty(advertisement.MagnetURI)
+	s.Require().Equal(server.URL+"/"+community.IDString(), advertisement.MirrorURL)
+	s.Require().NotEmpty(advertisement.IndexHash)
+
+	return community, advertisement, server
+}
+
+func (s *ManagerSuite) buildMemberArchiveManager() (*Manager, *ArchiveManager) {
+	manager, archiveManager := s.buildManagers(nil)
+
+	// The member doesn't use BitTorrent at all, archives can only come from the mirror
+	torrentConfig := buildTorrentConfig()
+	torrentConfig.Enabled = false
+	torrentConfig.DataDir = s.T().TempDir()
+	archiveManager.SetTorrentConfig(torrentConfig)
+
+	return manager, archiveManager
+}
+
+func (s *ManagerSuite) TestDownloadHistoryArchives_FromMirror() {
+	community, advertisement, server := s.buildMirroredHistoryArchive()
+	defer server.Close()
+
+	memberManager, memberArchiveManager := s.buildMemberArchiveManager()
+
+	// The torrent client isn't running, so the mirror is used
+	downloadTaskInfo, err := memberArchiveManager.DownloadHistoryArchives(community.ID(), advertisement, make(chan struct{}))
+	s.Require().NoError(err)
+	s.Require().False(downloadTaskInfo.Cancelled)
+	s.Require().Equal(3, downloadTaskInfo.TotalArchivesCount)
+	s.Require().Equal(3, downloadTaskInfo.TotalDownloadedArchivesCount)
+
+	archiveIDs, err := memberManager.GetPersistence().GetDownloadedMessageArchiveIDs(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(archiveIDs, 3)
+
+	for _, archiveID := range archiveIDs {
+		messages, err := memberArchiveManager.ExtractMessagesFromHistoryArchive(community.ID(), archiveID)
+		s.Require().NoError(err)
+		s.Require().Len(messages, 1)
+	}
+
+	// Downloading again doesn't fetch anything new
+	downloadTaskInfo, err = memberArchiveManager.DownloadHistoryArchives(community.ID(), advertisement, make(chan struct{}))
+	s.Require().NoError(err)
+	s.Require().Equal(0, downloadTaskInfo.TotalArchivesCount)
+}
+
+func (s *ManagerSuite) TestDownloadHistoryArchives_FromMirrorRejectsTamperedData() {
+	community, advertisement, server := s.buildMirroredHistoryArchive()
+	defer server.Close()
+
+	_, memberArchiveManager := s.buildMemberArchiveManager()
+
+	tamperedAdvertisement := *advertisement
+	tamperedAdvertisement.IndexHash = crypto.Keccak256([]byte("tampered"))
+	_, err := memberArchiveManager.DownloadHistoryArchives(community.ID(), &tamperedAdvertisement, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrArchiveIndexHashMismatch)
+
+	dataFile := s.archiveManager.archiveDataFile(community.IDString())
+	data, err := os.ReadFile(dataFile)
+	s.Require().NoError(err)
+	data[0] ^= 0xff
+	err = os.WriteFile(dataFile, data, 0644) // nolint: gosec
+	s.Require().NoError(err)
+
+	_, err = memberArchiveManager.DownloadHistoryArchives(community.ID(), advertisement, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrArchiveHashMismatch)
+}
+
+func (s *ManagerSuite) TestCanDownloadHistoryArchives() {
+	_, memberArchiveManager := s.buildMemberArchiveManager()
+	s.Require().False(memberArchiveManager.IsReady())
+
+	s.Require().False(memberArchiveManager.CanDownloadHistoryArchives(&ArchiveAdvertisement{}))
+	s.Require().False(memberArchiveManager.CanDownloadHistoryArchives(&ArchiveAdvertisement{MagnetURI: "magnet:?xt=urn:btih:1"}))
+	s.Require().True(memberArchiveManager.CanDownloadHistoryArchives(&ArchiveAdvertisement{MirrorURL: "http://localhost", IndexHash: []byte{1}}))
+}
+
+func (s *ManagerSuite) TestDownloadHistoryArchives_NoTransport() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	_, err = s.archiveManager.DownloadHistoryArchives(community.ID(), &ArchiveAdvertisement{MirrorURL: "http://localhost"}, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrNoArchiveTransport)
+}
+
 func buildTorrentConfig() *params.TorrentConfig {
 	return &params.TorrentConfig{
 		Enabled:    true,
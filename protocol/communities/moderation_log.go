@@ -0,0 +1,123 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ModerationLogEntry records a single moderation action taken by a privileged member
+type ModerationLogEntry struct {
+	ID          string                                      `json:"id"`
+	CommunityID types.HexBytes                              `json:"communityId"`
+	Actor       string                                      `json:"actor"`
+	Target      string                                      `json:"target"`
+	Action      protobuf.CommunityModerationLogEntry_Action `json:"action"`
+	Role        protobuf.CommunityMember_Roles              `json:"role,omitempty"`
+	Reason      string                                      `json:"reason,omitempty"`
+	Clock       uint64                                      `json:"clock"`
+}
+
+func NewModerationLogEntry(communityID types.HexBytes, actor string, action protobuf.CommunityModerationLogEntry_Action, target string, clock uint64) *ModerationLogEntry {
+	entry := &ModerationLogEntry{
+		CommunityID: communityID,
+		Actor:       actor,
+		Target:      target,
+		Action:      action,
+		Clock:       clock,
+	}
+	entry.CalculateID()
+	return entry
+}
+
+// CalculateID derives a deterministic ID, so that the same action recorded
+// independently by several privileged members is stored only once
+func (e *ModerationLogEntry) CalculateID() {
+	idString := fmt.Sprintf("%s-%s-%d-%s-%d-%d", e.CommunityID.String(), e.Actor, e.Action, e.Target, e.Role, e.Clock)
+	e.ID = types.EncodeHex(crypto.Keccak256([]byte(idString)))
+}
+
+func (e *ModerationLogEntry) ToProtobuf() *protobuf.CommunityModerationLogEntry {
+	return &protobuf.CommunityModerationLogEntry{
+		Id:     e.ID,
+		Actor:  e.Actor,
+		Target: e.Target,
+		Action: e.Action,
+		Role:   e.Role,
+		Reason: e.Reason,
+		Clock:  e.Clock,
+	}
+}
+
+func ModerationLogEntryFromProtobuf(communityID types.HexBytes, p *protobuf.CommunityModerationLogEntry) *ModerationLogEntry {
+	entry := &ModerationLogEntry{
+		CommunityID: communityID,
+		Actor:       p.Actor,
+		Target:      p.Target,
+		Action:      p.Action,
+		Role:        p.Role,
+		Reason:      p.Reason,
+		Clock:       p.Clock,
+	}
+	// Never trust the received ID, it must match the content
+	entry.CalculateID()
+	return entry
+}
+
+var eventTypeToModerationAction = map[protobuf.CommunityEvent_EventType]protobuf.CommunityModerationLogEntry_Action{
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK:                    protobuf.CommunityModerationLogEntry_MEMBER_KICK,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN:                     protobuf.CommunityModerationLogEntry_MEMBER_BAN,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN:                   protobuf.CommunityModerationLogEntry_MEMBER_UNBAN,
+	protobuf.CommunityEvent_COMMUNITY_DELETE_BANNED_MEMBER_MESSAGES:  protobuf.CommunityModerationLogEntry_MEMBER_MESSAGES_DELETE,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_CHANGE: protobuf.CommunityModerationLogEntry_TOKEN_PERMISSION_CHANGE,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_DELETE: protobuf.CommunityModerationLogEntry_TOKEN_PERMISSION_DELETE,
+	protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT:         protobuf.CommunityModerationLogEntry_REQUEST_TO_JOIN_ACCEPT,
+	protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_REJECT:         protobuf.CommunityModerationLogEntry_REQUEST_TO_JOIN_DECLINE,
+}
+
+// moderationLogEntryFromEvent returns nil for events that are not moderation actions
+func moderationLogEntryFromEvent(communityID types.HexBytes, event *CommunityEvent, actor *ecdsa.PublicKey) *ModerationLogEntry {
+	action, ok := eventTypeToModerationAction[event.Type]
+	if !ok {
+		return nil
+	}
+
+	target := event.MemberToAction
+	if event.TokenPermission != nil {
+		target = event.TokenPermission.Id
+	}
+
+	entry := &ModerationLogEntry{
+		CommunityID: communityID,
+		Actor:       common.PubkeyToHex(actor),
+		Target:      target,
+		Action:      action,
+		Reason:      event.Reason,
+		Clock:       event.CommunityEventClock,
+	}
+	entry.CalculateID()
+	return entry
+}
+
+func (o *Community) moderationLogEntriesFromEvents() []*ModerationLogEntry {
+	if o.config.EventsData == nil {
+		return nil
+	}
+
+	var entries []*ModerationLogEntry
+	for i := range o.config.EventsData.Events {
+		event := &o.config.EventsData.Events[i]
+		signer, err := event.RecoverSigner()
+		if err != nil {
+			continue
+		}
+		if entry := moderationLogEntryFromEvent(o.ID(), event, signer); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -226,7 +226,9 @@ func (p *Persistence) SaveCommunityEvents(community *Community) error {
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec(`DELETE FROM communities_communities WHERE id = ?;
 						 DELETE FROM communities_events WHERE id = ?;
-						 DELETE FROM communities_shards WHERE community_id = ?`, id, id, id)
+						 DELETE FROM communities_shards WHERE community_id = ?;
+						 DELETE FROM community_moderation_log WHERE community_id = ?;
+						 DELETE FROM community_scheduled_event_rsvps WHERE community_id = ?`, id, id, id, id, id)
 	return err
 }
 
@@ -2192,3 +2194,208 @@ func (p *Persistence) UpdateAndPruneEncryptionKeyRequests(communityID types.HexB
 
 	return nil
 }
+
+// SaveModerationLogEntries stores the given entries, ignoring the ones already known.
+// It returns the entries that were not stored before.
+func (p *Persistence) SaveModerationLogEntries(entries []*ModerationLogEntry) (inserted []*ModerationLogEntry, err error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	stmt, err := tx.Prepare(`
+		INSERT OR IGNORE INTO community_moderation_log (id, community_id, actor, target, action, role, reason, clock)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	for _, entry := range entries {
+		result, err := stmt.Exec(entry.ID, entry.CommunityID, entry.Actor, entry.Target, entry.Action, entry.Role, entry.Reason, entry.Clock)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+
+		if rowsAffected > 0 {
+			inserted = append(inserted, entry)
+		}
+	}
+
+	return inserted, nil
+}
+
+// GetModerationLogEntries returns the community moderation log starting from the most recent entries.
+// A negative limit returns all entries. The returned cursor is empty when there are no more entries to fetch.
+func (p *Persistence) GetModerationLogEntries(communityID types.HexBytes, cursor string, limit int) ([]*ModerationLogEntry, string, error) {
+	cursorWhere := ""
+	args := []interface{}{communityID}
+	if cursor != "" {
+		cursorWhere = "AND cursor <= ?" //nolint: goconst
+		args = append(args, cursor)
+	}
+	if limit > -1 {
+		// take one more to figure our whether a cursor should be returned
+		args = append(args, limit+1)
+	} else {
+		args = append(args, -1)
+	}
+
+	// The cursor is a fixed-sized clock value concatenated with the entry ID,
+	// so that entries with the same clock are still strictly ordered
+	rows, err := p.db.Query(fmt.Sprintf(`
+		SELECT id, community_id, actor, target, action, role, reason, clock,
+			substr('0000000000000000000000000000000000000000000000000000000000000000' || clock, -64, 64) || id as cursor
+		FROM community_moderation_log
+		WHERE community_id = ? %s
+		ORDER BY cursor DESC
+		LIMIT ?`, cursorWhere), args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var entries []*ModerationLogEntry
+	var cursors []string
+	for rows.Next() {
+		entry := &ModerationLogEntry{}
+		var entryCursor string
+		err := rows.Scan(&entry.ID, &entry.CommunityID, &entry.Actor, &entry.Target, &entry.Action, &entry.Role, &entry.Reason, &entry.Clock, &entryCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, entry)
+		cursors = append(cursors, entryCursor)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var newCursor string
+	if limit > -1 && len(entries) > limit {
+		newCursor = cursors[limit]
+		entries = entries[:limit]
+	}
+
+	return entries, newCursor, nil
+}
+
+// SaveScheduledEventRsvp stores the RSVP unless a more recent one from the same member is already known.
+// It returns whether the RSVP was stored.
+func (p *Persistence) SaveScheduledEventRsvp(rsvp *ScheduledEventRsvp) (bool, error) {
+	result, err := p.db.Exec(`
+		INSERT INTO community_scheduled_event_rsvps (community_id, event_id, member, response, clock)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(event_id, member)
+		DO UPDATE SET response = excluded.response, clock = excluded.clock
+		WHERE excluded.clock > community_scheduled_event_rsvps.clock`,
+		rsvp.CommunityID, rsvp.EventID, rsvp.Member, rsvp.Response, rsvp.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (p *Persistence) GetScheduledEventRsvps(eventID string) ([]*ScheduledEventRsvp, error) {
+	return p.queryScheduledEventRsvps(`WHERE event_id = ?`, eventID)
+}
+
+// GetMemberScheduledEventRsvps returns the RSVPs of the member across the given community
+func (p *Persistence) GetMemberScheduledEventRsvps(communityID types.HexBytes, member string) ([]*ScheduledEventRsvp, error) {
+	return p.queryScheduledEventRsvps(`WHERE community_id = ? AND member = ?`, communityID, member)
+}
+
+func (p *Persistence) queryScheduledEventRsvps(where string, args ...interface{}) ([]*ScheduledEventRsvp, error) {
+	rows, err := p.db.Query(`SELECT community_id, event_id, member, response, clock FROM community_scheduled_event_rsvps `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rsvps []*ScheduledEventRsvp
+	for rows.Next() {
+		rsvp := &ScheduledEventRsvp{}
+		err := rows.Scan(&rsvp.CommunityID, &rsvp.EventID, &rsvp.Member, &rsvp.Response, &rsvp.Clock)
+		if err != nil {
+			return nil, err
+		}
+		rsvps = append(rsvps, rsvp)
+	}
+
+	return rsvps, rows.Err()
+}
+
+func (p *Persistence) DeleteScheduledEventRsvps(eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	inVector := strings.Repeat("?, ", len(eventIDs)-1) + "?"
+	args := make([]interface{}, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		args = append(args, eventID)
+	}
+
+	_, err := p.db.Exec(`DELETE FROM community_scheduled_event_rsvps WHERE event_id IN (`+inVector+`)`, args...) // nolint: gosec
+	return err
+}
+
+// AcceptedRequestsToJoinPublicKeysByPeriod returns the members whose request to join,
+// sent within the given unix seconds period, was accepted
+func (p *Persistence) AcceptedRequestsToJoinPublicKeysByPeriod(communityID types.HexBytes, startClock uint64, endClock uint64) ([]string, error) {
+	return p.queryPublicKeys(`SELECT DISTINCT public_key FROM communities_requests_to_join
+		WHERE community_id = ? AND state = ? AND clock >= ? AND clock <= ? ORDER BY public_key`,
+		communityID, RequestToJoinStateAccepted, startClock, endClock)
+}
+
+// RequestsToLeavePublicKeysByPeriod returns the members who left within the given unix seconds period
+func (p *Persistence) RequestsToLeavePublicKeysByPeriod(communityID types.HexBytes, startClock uint64, endClock uint64) ([]string, error) {
+	return p.queryPublicKeys(`SELECT DISTINCT public_key FROM communities_requests_to_leave
+		WHERE community_id = ? AND clock >= ? AND clock <= ? ORDER BY public_key`,
+		communityID, startClock, endClock)
+}
+
+func (p *Persistence) queryPublicKeys(query string, args ...interface{}) ([]string, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publicKeys []string
+	for rows.Next() {
+		var publicKey string
+		err := rows.Scan(&publicKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, rows.Err()
+}
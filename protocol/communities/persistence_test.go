@@ -3,6 +3,7 @@ package communities
 import (
 	"crypto/ecdsa"
 	"database/sql"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -1004,3 +1005,136 @@ func (s *PersistenceSuite) TestGetCommunityRequestsToJoinRevealedAddresses() {
 	s.Require().True(exists)
 	s.Require().Len(memberAccounts, 1)
 }
+
+func (s *PersistenceSuite) TestModerationLogEntries() {
+	communityID := types.HexBytes{1, 2, 3}
+	actor := common.PubkeyToHex(&s.identity.PublicKey)
+
+	entries := []*ModerationLogEntry{
+		NewModerationLogEntry(communityID, actor, protobuf.CommunityModerationLogEntry_MEMBER_KICK, "0x01", 1),
+		NewModerationLogEntry(communityID, actor, protobuf.CommunityModerationLogEntry_MEMBER_BAN, "0x02", 2),
+		NewModerationLogEntry(communityID, actor, protobuf.CommunityModerationLogEntry_MEMBER_UNBAN, "0x02", 3),
+	}
+
+	inserted, err := s.db.SaveModerationLogEntries(entries)
+	s.Require().NoError(err)
+	s.Require().Len(inserted, 3)
+
+	// Saving the same entries again is a no-op
+	inserted, err = s.db.SaveModerationLogEntries(entries[:1])
+	s.Require().NoError(err)
+	s.Require().Len(inserted, 0)
+
+	page, cursor, err := s.db.GetModerationLogEntries(communityID, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(page, 2)
+	s.Require().NotEmpty(cursor)
+	s.Require().Equal(entries[2].ID, page[0].ID)
+	s.Require().Equal(entries[1].ID, page[1].ID)
+
+	page, cursor, err = s.db.GetModerationLogEntries(communityID, cursor, 2)
+	s.Require().NoError(err)
+	s.Require().Len(page, 1)
+	s.Require().Empty(cursor)
+	s.Require().Equal(entries[0].ID, page[0].ID)
+	s.Require().Equal(protobuf.CommunityModerationLogEntry_MEMBER_KICK, page[0].Action)
+	s.Require().Equal("0x01", page[0].Target)
+
+	page, _, err = s.db.GetModerationLogEntries(types.HexBytes{4, 5, 6}, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(page, 0)
+}
+
+func (s *PersistenceSuite) TestScheduledEventRsvps() {
+	communityID := types.HexBytes{1, 2, 3}
+	member := common.PubkeyToHex(&s.identity.PublicKey)
+
+	rsvp := &ScheduledEventRsvp{
+		CommunityID: communityID,
+		EventID:     "event-id",
+		Member:      member,
+		Response:    protobuf.CommunityScheduledEventRsvp_GOING,
+		Clock:       2,
+	}
+
+	saved, err := s.db.SaveScheduledEventRsvp(rsvp)
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	// Outdated RSVPs are ignored
+	saved, err = s.db.SaveScheduledEventRsvp(&ScheduledEventRsvp{
+		CommunityID: communityID,
+		EventID:     rsvp.EventID,
+		Member:      member,
+		Response:    protobuf.CommunityScheduledEventRsvp_NOT_GOING,
+		Clock:       1,
+	})
+	s.Require().NoError(err)
+	s.Require().False(saved)
+
+	saved, err = s.db.SaveScheduledEventRsvp(&ScheduledEventRsvp{
+		CommunityID: communityID,
+		EventID:     rsvp.EventID,
+		Member:      member,
+		Response:    protobuf.CommunityScheduledEventRsvp_MAYBE,
+		Clock:       3,
+	})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	rsvps, err := s.db.GetScheduledEventRsvps(rsvp.EventID)
+	s.Require().NoError(err)
+	s.Require().Len(rsvps, 1)
+	s.Require().Equal(protobuf.CommunityScheduledEventRsvp_MAYBE, rsvps[0].Response)
+	s.Require().Equal(uint64(3), rsvps[0].Clock)
+
+	rsvps, err = s.db.GetMemberScheduledEventRsvps(communityID, member)
+	s.Require().NoError(err)
+	s.Require().Len(rsvps, 1)
+
+	err = s.db.DeleteScheduledEventRsvps([]string{rsvp.EventID})
+	s.Require().NoError(err)
+
+	rsvps, err = s.db.GetScheduledEventRsvps(rsvp.EventID)
+	s.Require().NoError(err)
+	s.Require().Len(rsvps, 0)
+}
+
+func (s *PersistenceSuite) TestMembersChangesByPeriod() {
+	communityID := types.HexBytes{1, 2, 3}
+
+	for i, state := range []RequestToJoinState{RequestToJoinStateAccepted, RequestToJoinStatePending, RequestToJoinStateAccepted} {
+		err := s.db.SaveRequestToJoin(&RequestToJoin{
+			ID:          types.HexBytes{byte(i)},
+			PublicKey:   fmt.Sprintf("0x0%d", i),
+			Clock:       uint64(100 + i*100),
+			CommunityID: communityID,
+			State:       state,
+		})
+		s.Require().NoError(err)
+	}
+
+	joined, err := s.db.AcceptedRequestsToJoinPublicKeysByPeriod(communityID, 100, 250)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"0x00"}, joined)
+
+	joined, err = s.db.AcceptedRequestsToJoinPublicKeysByPeriod(communityID, 100, 300)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"0x00", "0x02"}, joined)
+
+	err = s.db.SaveRequestToLeave(&RequestToLeave{
+		ID:          types.HexBytes{4},
+		PublicKey:   "0x00",
+		Clock:       400,
+		CommunityID: communityID,
+	})
+	s.Require().NoError(err)
+
+	left, err := s.db.RequestsToLeavePublicKeysByPeriod(communityID, 300, 500)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"0x00"}, left)
+
+	left, err = s.db.RequestsToLeavePublicKeysByPeriod(communityID, 100, 300)
+	s.Require().NoError(err)
+	s.Require().Len(left, 0)
+}
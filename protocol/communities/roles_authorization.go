@@ -24,6 +24,9 @@ var adminAuthorizedEventTypes = []protobuf.CommunityEvent_EventType{
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN,
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN,
 	protobuf.CommunityEvent_COMMUNITY_DELETE_BANNED_MEMBER_MESSAGES,
+	protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_CREATE,
+	protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_EDIT,
+	protobuf.CommunityEvent_COMMUNITY_SCHEDULED_EVENT_DELETE,
 }
 
 var tokenMasterAuthorizedEventTypes = append(adminAuthorizedEventTypes, []protobuf.CommunityEvent_EventType{
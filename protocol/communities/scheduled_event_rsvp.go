@@ -0,0 +1,39 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ScheduledEventRsvp is the answer of a member to a community scheduled event
+type ScheduledEventRsvp struct {
+	CommunityID types.HexBytes                                `json:"communityId"`
+	EventID     string                                        `json:"eventId"`
+	Member      string                                        `json:"member"`
+	Response    protobuf.CommunityScheduledEventRsvp_Response `json:"response"`
+	Clock       uint64                                        `json:"clock"`
+}
+
+func (r *ScheduledEventRsvp) ToProtobuf() *protobuf.CommunityScheduledEventRsvp {
+	return &protobuf.CommunityScheduledEventRsvp{
+		Clock:       r.Clock,
+		CommunityId: r.CommunityID,
+		EventId:     r.EventID,
+		Response:    r.Response,
+	}
+}
+
+func ScheduledEventRsvpFromProtobuf(member string, p *protobuf.CommunityScheduledEventRsvp) *ScheduledEventRsvp {
+	return &ScheduledEventRsvp{
+		CommunityID: p.CommunityId,
+		EventID:     p.EventId,
+		Member:      member,
+		Response:    p.Response,
+		Clock:       p.Clock,
+	}
+}
+
+// Attending tells whether the member plans to attend the event and should be reminded of it
+func (r *ScheduledEventRsvp) Attending() bool {
+	return r.Response == protobuf.CommunityScheduledEventRsvp_GOING || r.Response == protobuf.CommunityScheduledEventRsvp_MAYBE
+}
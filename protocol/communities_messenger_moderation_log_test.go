@@ -0,0 +1,71 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func findModerationLogEntry(messenger *Messenger, communityID types.HexBytes, action protobuf.CommunityModerationLogEntry_Action, target string) (*communities.ModerationLogEntry, error) {
+	entries, _, err := messenger.CommunityModerationLog(communityID, "", 100)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Action == action && entry.Target == target {
+			return entry, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *AdminCommunityEventsSuite) TestAdminBanIsRecordedInModerationLog() {
+	community := setUpCommunityAndRoles(s, protobuf.CommunityMember_ROLE_ADMIN)
+
+	alicePk := common.PubkeyToHex(&s.alice.identity.PublicKey)
+	eventSenderPk := common.PubkeyToHex(&s.eventSender.identity.PublicKey)
+
+	banMember(s, &requests.BanUserFromCommunity{
+		CommunityID: community.ID(),
+		User:        common.PubkeyToHexBytes(&s.alice.identity.PublicKey),
+		Reason:      "spam",
+	})
+
+	// both the event sender and the control node, which applied the event, log the ban
+	for _, messenger := range []*Messenger{s.eventSender, s.owner} {
+		entry, err := findModerationLogEntry(messenger, community.ID(), protobuf.CommunityModerationLogEntry_MEMBER_BAN, alicePk)
+		s.Require().NoError(err)
+		s.Require().NotNil(entry)
+		s.Require().Equal(eventSenderPk, entry.Actor)
+		s.Require().Equal("spam", entry.Reason)
+	}
+
+	// regular members don't keep a moderation log
+	_, _, err := s.alice.CommunityModerationLog(community.ID(), "", 100)
+	s.Require().ErrorIs(err, communities.ErrNotEnoughPermissions)
+}
+
+func (s *AdminCommunityEventsSuite) TestControlNodeModerationActionIsSyncedToAdmins() {
+	community := setUpCommunityAndRoles(s, protobuf.CommunityMember_ROLE_ADMIN)
+
+	alicePk := common.PubkeyToHex(&s.alice.identity.PublicKey)
+	ownerPk := common.PubkeyToHex(&s.owner.identity.PublicKey)
+
+	_, err := s.owner.RemoveUserFromCommunity(community.ID(), alicePk)
+	s.Require().NoError(err)
+
+	entry, err := findModerationLogEntry(s.owner, community.ID(), protobuf.CommunityModerationLogEntry_MEMBER_KICK, alicePk)
+	s.Require().NoError(err)
+	s.Require().NotNil(entry)
+	s.Require().Equal(ownerPk, entry.Actor)
+
+	// the admin receives the entry through the CONTROL_NODE_MODERATION_LOG privileged sync message
+	_, err = WaitOnMessengerResponse(s.eventSender, func(*MessengerResponse) bool {
+		entry, err := findModerationLogEntry(s.eventSender, community.ID(), protobuf.CommunityModerationLogEntry_MEMBER_KICK, alicePk)
+		return err == nil && entry != nil && entry.Actor == ownerPk
+	}, "admin did not receive the control node moderation log entry")
+	s.Require().NoError(err)
+}
@@ -4807,3 +4807,24 @@ func (s *MessengerCommunitiesSuite) mockPermissionCheckerForAllMessenger() {
 	s.alice.communitiesManager.PermissionChecker = &testPermissionChecker{}
 	s.bob.communitiesManager.PermissionChecker = &testPermissionChecker{}
 }
+
+func (s *MessengerCommunitiesSuite) TestIgnoreRsvpForUnknownScheduledEvent() {
+	community, _ := createCommunity(&s.Suite, s.owner)
+
+	state := &ReceivedMessageState{
+		Response: &MessengerResponse{},
+		CurrentMessageState: &CurrentMessageState{
+			PublicKey: &s.owner.identity.PublicKey,
+		},
+	}
+	rsvp := &protobuf.CommunityScheduledEventRsvp{
+		Clock:       1,
+		CommunityId: community.ID(),
+		EventId:     "unknown-event",
+		Response:    protobuf.CommunityScheduledEventRsvp_GOING,
+	}
+
+	err := s.owner.HandleCommunityScheduledEventRsvp(state, rsvp, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(state.Response.ScheduledEventRsvps())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/verification"
+	ensservice "github.com/status-im/status-go/services/ens"
 )
 
 type ContactRequestState int
@@ -90,6 +91,8 @@ type Contact struct {
 	EnsName string `json:"name,omitempty"`
 	// EnsVerified whether we verified the name of the contact
 	ENSVerified bool `json:"ensVerified"`
+	// ENSProfile text records of the verified ENS name, loaded by FetchContactENSProfile and not persisted
+	ENSProfile *ensservice.Profile `json:"ensProfile,omitempty"`
 	// Generated username name of the contact
 	Alias string `json:"alias,omitempty"`
 	// Identicon generated from public key
@@ -3,6 +3,8 @@ package discord
 import (
 	"io/ioutil"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -19,6 +21,11 @@ func DownloadAvatarAsset(url string) ([]byte, error) {
 }
 
 func DownloadAsset(url string) ([]byte, string, error) {
+	// Some exports (e.g. Telegram) ship attachments as local files
+	if strings.HasPrefix(url, "file://") {
+		return readLocalAsset(strings.TrimPrefix(url, "file://"))
+	}
+
 	client := http.Client{Timeout: time.Minute}
 	res, err := client.Get(url)
 	if err != nil {
@@ -34,3 +41,11 @@ func DownloadAsset(url string) ([]byte, string, error) {
 	bodyBytes, err := ioutil.ReadAll(res.Body)
 	return bodyBytes, contentType, err
 }
+
+func readLocalAsset(path string) ([]byte, string, error) {
+	bodyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return bodyBytes, http.DetectContentType(bodyBytes), nil
+}
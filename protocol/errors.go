@@ -11,4 +11,9 @@ var (
 	ErrContactNotFound  = errors.New("contact not found")
 	ErrCommunityIDEmpty = errors.New("community ID is empty")
 	ErrUserNotMember    = errors.New("user not a member")
+	ErrENSAPINotEnabled = errors.New("ens api not enabled")
+	ErrENSNotVerified   = errors.New("contact ENS name not verified")
+	ErrNoMediaServer    = errors.New("media server not available")
+	ErrStickerPackChat  = errors.New("sticker packs can only be shared in one to one and community chats")
+	ErrSafeProposalChat = errors.New("safe proposals can only be shared in one to one and group chats")
 )
@@ -3,11 +3,14 @@ package protocol
 import (
 	"crypto/ecdsa"
 	"encoding/json"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	ensservice "github.com/status-im/status-go/services/ens"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 )
 
@@ -16,6 +19,9 @@ type NotificationBody struct {
 	Contact   *Contact               `json:"contact"`
 	Chat      *Chat                  `json:"chat"`
 	Community *communities.Community `json:"community"`
+
+	ScheduledEvent *protobuf.CommunityScheduledEvent `json:"scheduledEvent,omitempty"`
+	ENSName        *ensservice.ExpiringName          `json:"ensName,omitempty"`
 }
 
 func showMessageNotification(publicKey ecdsa.PublicKey, message *common.Message, chat *Chat, responseTo *common.Message) bool {
@@ -75,6 +81,23 @@ func NewCommunityRequestToJoinNotification(id string, community *communities.Com
 	return body.toCommunityRequestToJoinNotification(id)
 }
 
+func NewCommunityScheduledEventReminderNotification(id string, community *communities.Community, event *protobuf.CommunityScheduledEvent) *localnotifications.Notification {
+	body := &NotificationBody{
+		Community:      community,
+		ScheduledEvent: event,
+	}
+
+	return body.toCommunityScheduledEventReminderNotification(id)
+}
+
+func NewENSExpiryNotification(id string, name *ensservice.ExpiringName) *localnotifications.Notification {
+	body := &NotificationBody{
+		ENSName: name,
+	}
+
+	return body.toENSExpiryNotification(id)
+}
+
 func NewPrivateGroupInviteNotification(id string, chat *Chat, contact *Contact, profilePicturesVisibility int) *localnotifications.Notification {
 	body := &NotificationBody{
 		Chat:    chat,
@@ -160,3 +183,37 @@ func (n NotificationBody) toCommunityRequestToJoinNotification(id string) *local
 		Image:    "",
 	}
 }
+
+func (n NotificationBody) toCommunityScheduledEventReminderNotification(id string) *localnotifications.Notification {
+	startTime := time.UnixMilli(int64(n.ScheduledEvent.StartTime))
+
+	return &localnotifications.Notification{
+		ID:        gethcommon.HexToHash(id),
+		Body:      n,
+		Title:     n.ScheduledEvent.Title,
+		Message:   n.Community.Name() + " event starts at " + startTime.Format(time.Kitchen),
+		BodyType:  localnotifications.TypeMessage,
+		Category:  localnotifications.CategoryCommunityScheduledEvent,
+		Deeplink:  "status-app://cr/" + n.Community.IDString(),
+		Timestamp: n.ScheduledEvent.StartTime,
+		Image:     "",
+	}
+}
+
+func (n NotificationBody) toENSExpiryNotification(id string) *localnotifications.Notification {
+	message := n.ENSName.Username + " expires on " + n.ENSName.ExpiresAt.Format(time.DateOnly)
+	if !n.ENSName.ExpiresAt.After(time.Now()) {
+		message = n.ENSName.Username + " expired on " + n.ENSName.ExpiresAt.Format(time.DateOnly)
+	}
+
+	return &localnotifications.Notification{
+		ID:        gethcommon.HexToHash(id),
+		Body:      n,
+		Title:     "Your ENS name is expiring",
+		Message:   message,
+		BodyType:  localnotifications.TypeMessage,
+		Category:  localnotifications.CategoryENSExpiry,
+		Timestamp: uint64(n.ENSName.ExpiresAt.UnixMilli()),
+		Image:     "",
+	}
+}
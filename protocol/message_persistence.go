@@ -736,6 +736,27 @@ func (db sqlitePersistence) MessagesExist(ids []string) (map[string]bool, error)
 	return result, nil
 }
 
+// MessageIDs returns the IDs of all the visible messages, oldest first
+func (db sqlitePersistence) MessageIDs() ([]string, error) {
+	rows, err := db.db.Query("SELECT id FROM user_messages WHERE NOT(hide) ORDER BY clock_value")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var id string
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+
+	return result, rows.Err()
+}
+
 func (db sqlitePersistence) MessagesByIDs(ids []string) ([]*common.Message, error) {
 	if len(ids) == 0 {
 		return nil, nil
@@ -888,6 +909,159 @@ func (db sqlitePersistence) latestIncomingMessageClock(chatID string) (uint64, e
 	return clock, nil
 }
 
+// timelineClocksBatchSize keeps the host parameters of the clock queries below SQLITE_MAX_VARIABLE_NUMBER,
+// which defaults to 999
+const timelineClocksBatchSize = 300
+
+// timelineClocks returns which of the given clocks are used by a message, an emoji reaction or a pin message
+// of a chat, gap messages are ignored
+func (db sqlitePersistence) timelineClocks(chatID string, clocks []uint64) (map[uint64]bool, error) {
+	result := make(map[uint64]bool)
+	for i := 0; i < len(clocks); i += timelineClocksBatchSize {
+		j := i + timelineClocksBatchSize
+		if j > len(clocks) {
+			j = len(clocks)
+		}
+		batch := clocks[i:j]
+
+		inVector := strings.Repeat("?, ", len(batch)-1) + "?"
+		args := make([]interface{}, 0, 3*len(batch)+4)
+		args = append(args, chatID, protobuf.ChatMessage_SYSTEM_MESSAGE_GAP)
+		for _, clock := range batch {
+			args = append(args, clock)
+		}
+		args = append(args, chatID)
+		for _, clock := range batch {
+			args = append(args, clock)
+		}
+		args = append(args, chatID)
+		for _, clock := range batch {
+			args = append(args, clock)
+		}
+
+		// nolint: gosec
+		query := `
+			SELECT clock_value FROM user_messages WHERE local_chat_id = ? AND content_type != ? AND clock_value IN (` + inVector + `)
+			UNION
+			SELECT clock_value FROM emoji_reactions WHERE local_chat_id = ? AND clock_value IN (` + inVector + `)
+			UNION
+			SELECT clock_value FROM pin_messages WHERE local_chat_id = ? AND clock_value IN (` + inVector + `)`
+		rows, err := db.db.Query(query, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var clock uint64
+			if err := rows.Scan(&clock); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			result[clock] = true
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// messageIDsByClock returns the IDs of the messages of a chat with the given clock, gap messages are ignored
+func (db sqlitePersistence) messageIDsByClock(chatID string, clock uint64) ([]string, error) {
+	rows, err := db.db.Query(`
+		SELECT
+			id
+		FROM
+			user_messages
+		WHERE
+			local_chat_id = ? AND clock_value = ? AND content_type != ?`,
+		chatID, clock, protobuf.ChatMessage_SYSTEM_MESSAGE_GAP)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// previousMessagesWhisperTimestamps returns, for each of the given clocks, the whisper timestamp of the latest
+// message of a chat with a lower clock. Clocks without a previous message are left out, gap messages are ignored.
+func (db sqlitePersistence) previousMessagesWhisperTimestamps(chatID string, clocks []uint64) (map[uint64]uint64, error) {
+	result := make(map[uint64]uint64)
+	for i := 0; i < len(clocks); i += timelineClocksBatchSize {
+		j := i + timelineClocksBatchSize
+		if j > len(clocks) {
+			j = len(clocks)
+		}
+		batch := clocks[i:j]
+
+		values := strings.Repeat("(?), ", len(batch)-1) + "(?)"
+		args := make([]interface{}, 0, len(batch)+2)
+		for _, clock := range batch {
+			args = append(args, clock)
+		}
+		args = append(args, chatID, protobuf.ChatMessage_SYSTEM_MESSAGE_GAP)
+
+		// nolint: gosec
+		query := `
+			WITH clocks(clock) AS (VALUES ` + values + `)
+			SELECT
+				clocks.clock,
+				(SELECT
+					whisper_timestamp
+				FROM
+					user_messages
+				WHERE
+					local_chat_id = ? AND clock_value < clocks.clock AND content_type != ?
+				ORDER BY clock_value DESC
+				LIMIT 1)
+			FROM
+				clocks`
+		rows, err := db.db.Query(query, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var clock uint64
+			var timestamp sql.NullInt64
+			if err := rows.Scan(&clock, &timestamp); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if timestamp.Valid {
+				result[clock] = uint64(timestamp.Int64)
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// MessagesByChatIDAndWhisperTimestamp returns the messages of a chat received between from and to, in milliseconds
+func (db sqlitePersistence) MessagesByChatIDAndWhisperTimestamp(chatID string, from, to uint64) ([]*common.Message, error) {
+	where := "WHERE NOT(m1.hide) AND m1.local_chat_id = ? AND m1.whisper_timestamp BETWEEN ? AND ?"
+	query := db.buildMessagesQuery(where)
+	rows, err := db.db.Query(query, chatID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return getMessagesFromScanRows(db, rows, false)
+}
+
 func (db sqlitePersistence) PendingContactRequests(currCursor string, limit int) ([]*common.Message, string, error) {
 	cursorWhere := ""
 	if currCursor != "" {
@@ -1327,6 +1501,37 @@ func (db sqlitePersistence) OldestMessageWhisperTimestampByChatID(chatID string)
 	return whisperTimestamp, true, nil
 }
 
+// AllPinMessages returns the pin and unpin messages of all chats
+func (db sqlitePersistence) AllPinMessages() ([]*common.PinMessage, error) {
+	rows, err := db.db.Query(`SELECT id, message_id, whisper_timestamp, chat_id, local_chat_id, clock_value, pinned, pinned_by FROM pin_messages`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*common.PinMessage
+	for rows.Next() {
+		message := common.NewPinMessage()
+		var pinnedBy sql.NullString
+		err := rows.Scan(&message.ID,
+			&message.MessageId,
+			&message.WhisperTimestamp,
+			&message.ChatId,
+			&message.LocalChatID,
+			&message.Clock,
+			&message.Pinned,
+			&pinnedBy)
+		if err != nil {
+			return nil, err
+		}
+		message.From = pinnedBy.String
+
+		result = append(result, message)
+	}
+
+	return result, rows.Err()
+}
+
 // EmojiReactionsByChatID returns the emoji reactions for the queried messages, up to a maximum of 100, as it's a potentially unbound number.
 // NOTE: This is not completely accurate, as the messages in the database might have change since the last call to `MessageByChatID`.
 func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor string, limit int) ([]*EmojiReaction, error) {
@@ -2415,6 +2620,45 @@ func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err
 	return
 }
 
+// AllEmojiReactions returns the emoji reactions of all chats, including retracted ones
+func (db sqlitePersistence) AllEmojiReactions() ([]*EmojiReaction, error) {
+	rows, err := db.db.Query(
+		`SELECT
+			    clock_value,
+			    source,
+			    emoji_id,
+			    message_id,
+			    chat_id,
+			    local_chat_id,
+			    retracted
+			FROM
+				emoji_reactions
+		`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EmojiReaction
+	for rows.Next() {
+		emojiReaction := NewEmojiReaction()
+		err := rows.Scan(&emojiReaction.Clock,
+			&emojiReaction.From,
+			&emojiReaction.Type,
+			&emojiReaction.MessageId,
+			&emojiReaction.ChatId,
+			&emojiReaction.LocalChatID,
+			&emojiReaction.Retracted)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, emojiReaction)
+	}
+
+	return result, rows.Err()
+}
+
 func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error) {
 	row := db.db.QueryRow(
 		`SELECT
@@ -70,6 +70,7 @@ import (
 	mailserversDB "github.com/status-im/status-go/services/mailservers"
 	"github.com/status-im/status-go/services/wallet"
 	"github.com/status-im/status-go/services/wallet/community"
+	"github.com/status-im/status-go/services/wallet/safe"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/telemetry"
@@ -141,6 +142,7 @@ type Messenger struct {
 	installationID             string
 	mailserverCycle            mailserverCycle
 	communityStorenodes        *storenodes.CommunityStorenodes
+	storenodeScores            *storenodes.Scores
 	database                   *sql.DB
 	multiAccounts              *multiaccounts.Database
 	settings                   *accounts.Database
@@ -154,6 +156,8 @@ type Messenger struct {
 	ctx               context.Context
 	cancel            context.CancelFunc
 	shutdownWaitGroup sync.WaitGroup
+	// historyGapsInFlight holds the IDs of the detected history gaps being backfilled, which are only saved afterwards
+	historyGapsInFlight sync.Map
 
 	importingCommunities map[string]bool
 	importingChannels    map[string]bool
@@ -168,7 +172,9 @@ type Messenger struct {
 	contractMaker         *contracts.ContractMaker
 	verificationDatabase  *verification.Persistence
 	savedAddressesManager *wallet.SavedAddressesManager
+	safeDB                *safe.Database
 	walletAPI             *wallet.API
+	ensAPI                *ensservice.API
 
 	// TODO(samyoul) Determine if/how the remaining usage of this mutex can be removed
 	mutex                     sync.Mutex
@@ -472,6 +478,10 @@ func NewMessenger(
 		communities.WithAccountManager(c.accountsManager),
 	}
 
+	if c.account != nil {
+		managerOptions = append(managerOptions, communities.WithSignalsNamespace(signal.NewNamespace(c.account.KeyUID)))
+	}
+
 	var walletAPI *wallet.API
 	if c.walletService != nil {
 		walletAPI = wallet.NewAPI(c.walletService)
@@ -544,6 +554,7 @@ func NewMessenger(
 	}
 
 	savedAddressesManager := wallet.NewSavedAddressesManager(c.walletDb)
+	safeDB := safe.NewDB(c.walletDb)
 
 	selfContact, err := buildSelfContact(identity, settings, c.multiAccount, c.account)
 	if err != nil {
@@ -607,6 +618,7 @@ func NewMessenger(
 		},
 		mailserversDatabase:  c.mailserversDatabase,
 		communityStorenodes:  storenodes.NewCommunityStorenodes(storenodes.NewDB(database), logger),
+		storenodeScores:      storenodes.NewScores(logger),
 		account:              c.account,
 		quit:                 make(chan struct{}),
 		ctx:                  ctx,
@@ -646,6 +658,7 @@ func NewMessenger(
 		},
 		logger:                           logger,
 		savedAddressesManager:            savedAddressesManager,
+		safeDB:                           safeDB,
 		retrievedMessagesIteratorFactory: NewDefaultMessagesIterator,
 	}
 
@@ -662,6 +675,9 @@ func NewMessenger(
 
 	if c.walletService != nil {
 		messenger.walletAPI = walletAPI
+		if ensService := c.walletService.GetEnsService(); ensService != nil {
+			messenger.ensAPI = ensService.API()
+		}
 	}
 
 	if c.outputMessagesCSV {
@@ -686,6 +702,12 @@ func NewMessenger(
 	if anonMetricsServer != nil {
 		messenger.shutdownTasks = append(messenger.shutdownTasks, anonMetricsServer.Stop)
 	}
+	messenger.shutdownTasks = append(messenger.shutdownTasks, func() error {
+		if messenger.pushNotificationServer != nil {
+			return messenger.pushNotificationServer.Stop()
+		}
+		return nil
+	})
 
 	if c.envelopesMonitorConfig != nil {
 		interceptor := EnvelopeEventsInterceptor{c.envelopesMonitorConfig.EnvelopeEventsHandler, messenger}
@@ -829,6 +851,8 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.watchConnectionChange()
 	m.watchChatsAndCommunitiesToUnmute()
 	m.watchCommunitiesToUnmute()
+	m.watchCommunityScheduledEventReminders()
+	m.watchENSExpiry()
 	m.watchExpiredMessages()
 	m.watchIdentityImageChanges()
 	m.watchWalletBalances()
@@ -1473,6 +1497,9 @@ func (m *Messenger) handleENSVerified(records []*ens.VerificationRecord) {
 			continue
 		}
 
+		if !record.Verified || contact.EnsName != record.Name {
+			contact.ENSProfile = nil
+		}
 		contact.ENSVerified = record.Verified
 		contact.EnsName = record.Name
 		contacts = append(contacts, contact)
@@ -1603,7 +1630,7 @@ func (m *Messenger) watchChatsAndCommunitiesToUnmute() {
 				})
 
 				if !response.IsEmpty() {
-					signal.SendNewMessages(response)
+					m.signals().SendNewMessages(response)
 				}
 			case <-m.quit:
 				return
@@ -1625,7 +1652,7 @@ func (m *Messenger) watchCommunitiesToUnmute() {
 				}
 
 				if !response.IsEmpty() {
-					signal.SendNewMessages(response)
+					m.signals().SendNewMessages(response)
 				}
 			case <-m.quit:
 				return
@@ -2560,6 +2587,14 @@ func (m *Messenger) KeyUID() string {
 	return m.account.KeyUID
 }
 
+// signals returns the namespace of the signals sent for the account of the messenger
+func (m *Messenger) signals() signal.Namespace {
+	if m.account == nil {
+		return signal.Namespace("")
+	}
+	return signal.NewNamespace(m.account.KeyUID)
+}
+
 // syncChat sync a chat with paired devices
 func (m *Messenger) syncChat(ctx context.Context, chatToSync *Chat, rawMessageHandler RawMessageHandler) error {
 	var err error
@@ -3062,8 +3097,8 @@ func (m *Messenger) PublishMessengerResponse(response *MessengerResponse) {
 	notifications := response.Notifications()
 	// Clear notifications as not used for now
 	response.ClearNotifications()
-	signal.SendNewMessages(response)
-	localnotifications.PushMessages(notifications)
+	m.signals().SendNewMessages(response)
+	localnotifications.PushMessages(m.signals(), notifications)
 }
 
 func (m *Messenger) GetStats() types.StatsSummary {
@@ -3686,6 +3721,8 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		messageState.Response.AddEmojiReaction(emojiReaction)
 	}
 
+	m.detectHistoryGaps(messagesToSave, messageState.Response)
+
 	for _, groupChatInvitation := range messageState.GroupChatInvitations {
 		messageState.Response.Invitations = append(messageState.Response.Invitations, groupChatInvitation)
 	}
@@ -5420,8 +5457,12 @@ func (m *Messenger) StartPushNotificationsServer() error {
 
 // StopPushNotificationServer stops the push notification server if running
 func (m *Messenger) StopPushNotificationsServer() error {
+	if m.pushNotificationServer == nil {
+		return nil
+	}
+	err := m.pushNotificationServer.Stop()
 	m.pushNotificationServer = nil
-	return nil
+	return err
 }
 
 func generateAliasAndIdenticon(pk string) (string, string, error) {
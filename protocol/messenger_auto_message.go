@@ -9,7 +9,6 @@ import (
 
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
-	"github.com/status-im/status-go/signal"
 )
 
 // autoMessageInterval is how often we should send a message
@@ -52,7 +51,7 @@ func (m *Messenger) startAutoMessageLoop() error {
 					m.logger.Error("[auto message] failed to send message", zap.Error(err))
 					continue
 				}
-				signal.SendNewMessages(resp)
+				m.signals().SendNewMessages(resp)
 
 				err = m.UpdateMessageOutgoingStatus(msg.ID, common.OutgoingStatusDelivered)
 				if err != nil {
@@ -88,33 +88,63 @@ func (m *Messenger) startBackupLoop() {
 
 func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 	clock, chat := m.getLastClockWithRelatedChat()
-	contactsToBackup := m.backupContacts(ctx)
-	communitiesToBackup, err := m.backupCommunities(ctx, clock)
+	backupMessages, err := m.prepareBackupMessages(ctx, clock)
 	if err != nil {
 		return 0, err
 	}
-	chatsToBackup := m.backupChats(ctx, clock)
+
+	for _, pb := range backupMessages {
+		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
 	if err != nil {
 		return 0, err
 	}
-	profileToBackup, err := m.backupProfile(ctx, clock)
+
+	clockInSeconds := clock / 1000
+	err = m.settings.SetLastBackup(clockInSeconds)
 	if err != nil {
 		return 0, err
 	}
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.BackupPerformed(clockInSeconds)
+	}
+
+	return clockInSeconds, nil
+}
+
+// prepareBackupMessages returns the backup messages to be sent, each one carrying
+// a single part of the data along with the details of the whole backup
+func (m *Messenger) prepareBackupMessages(ctx context.Context, clock uint64) ([]*protobuf.Backup, error) {
+	contactsToBackup := m.backupContacts(ctx)
+	communitiesToBackup, err := m.backupCommunities(ctx, clock)
+	if err != nil {
+		return nil, err
+	}
+	chatsToBackup := m.backupChats(ctx, clock)
+	profileToBackup, err := m.backupProfile(ctx, clock)
+	if err != nil {
+		return nil, err
+	}
 	_, settings, errors := m.prepareSyncSettingsMessages(clock, true)
 	if len(errors) != 0 {
 		// return just the first error, the others have been logged
-		return 0, errors[0]
+		return nil, errors[0]
 	}
 
 	keypairsToBackup, err := m.backupKeypairs()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	woAccountsToBackup, err := m.backupWatchOnlyAccounts()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	backupDetailsOnly := func() *protobuf.Backup {
@@ -151,99 +181,65 @@ func (m *Messenger) BackupData(ctx context.Context) (uint64, error) {
 		}
 	}
 
-	// Update contacts messages encode and dispatch
+	var backupMessages []*protobuf.Backup
+
+	// Update contacts messages
 	for i, d := range contactsToBackup {
 		pb := backupDetailsOnly()
 		pb.ContactsDetails.DataNumber = uint32(i + 1)
 		pb.Contacts = d.Contacts
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update communities messages encode and dispatch
+	// Update communities messages
 	for i, d := range communitiesToBackup {
 		pb := backupDetailsOnly()
 		pb.CommunitiesDetails.DataNumber = uint32(i + 1)
 		pb.Communities = d.Communities
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update profile messages encode and dispatch
+	// Update profile messages
 	for i, d := range profileToBackup {
 		pb := backupDetailsOnly()
 		pb.ProfileDetails.DataNumber = uint32(i + 1)
 		pb.Profile = d.Profile
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update chats encode and dispatch
+	// Update chats
 	for i, d := range chatsToBackup {
 		pb := backupDetailsOnly()
 		pb.ChatsDetails.DataNumber = uint32(i + 1)
 		pb.Chats = d.Chats
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update settings messages encode and dispatch
+	// Update settings messages
 	for i, d := range settings {
 		pb := backupDetailsOnly()
 		pb.SettingsDetails.DataNumber = uint32(i + 1)
 		pb.Setting = d
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update keypairs messages encode and dispatch
+	// Update keypairs messages
 	for i, d := range keypairsToBackup {
 		pb := backupDetailsOnly()
 		pb.KeypairDetails.DataNumber = uint32(i + 1)
 		pb.Keypair = d.Keypair
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	// Update watch only messages encode and dispatch
+	// Update watch only messages
 	for i, d := range woAccountsToBackup {
 		pb := backupDetailsOnly()
 		pb.WatchOnlyAccountDetails.DataNumber = uint32(i + 1)
 		pb.WatchOnlyAccount = d.WatchOnlyAccount
-		err = m.encodeAndDispatchBackupMessage(ctx, pb, chat.ID)
-		if err != nil {
-			return 0, err
-		}
+		backupMessages = append(backupMessages, pb)
 	}
 
-	chat.LastClockValue = clock
-	err = m.saveChat(chat)
-	if err != nil {
-		return 0, err
-	}
-
-	clockInSeconds := clock / 1000
-	err = m.settings.SetLastBackup(clockInSeconds)
-	if err != nil {
-		return 0, err
-	}
-	if m.config.messengerSignalsHandler != nil {
-		m.config.messengerSignalsHandler.BackupPerformed(clockInSeconds)
-	}
-
-	return clockInSeconds, nil
+	return backupMessages, nil
 }
 
 func (m *Messenger) encodeAndDispatchBackupMessage(ctx context.Context, message *protobuf.Backup, chatID string) error {
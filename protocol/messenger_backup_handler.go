@@ -44,6 +44,31 @@ func (m *Messenger) HandleBackup(state *ReceivedMessageState, message *protobuf.
 }
 
 func (m *Messenger) handleBackup(state *ReceivedMessageState, message *protobuf.Backup) []error {
+	errors := m.applyBackup(state, message)
+
+	// Send signal about applied backup progress
+	if m.config.messengerSignalsHandler != nil {
+		response := wakusync.WakuBackedUpDataResponse{
+			Clock: message.Clock,
+		}
+
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyProfile, message.ProfileDetails)
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyContacts, message.ContactsDetails)
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyCommunities, message.CommunitiesDetails)
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeySettings, message.SettingsDetails)
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyKeypairs, message.KeypairDetails)
+		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyWatchOnlyAccounts, message.WatchOnlyAccountDetails)
+
+		m.config.messengerSignalsHandler.SendWakuFetchingBackupProgress(&response)
+	}
+
+	state.Response.BackupHandled = true
+
+	return errors
+}
+
+// applyBackup saves the backed up data
+func (m *Messenger) applyBackup(state *ReceivedMessageState, message *protobuf.Backup) []error {
 	var errors []error
 
 	err := m.handleBackedUpProfile(message.Profile, message.Clock)
@@ -83,24 +108,6 @@ func (m *Messenger) handleBackup(state *ReceivedMessageState, message *protobuf.
 		errors = append(errors, err)
 	}
 
-	// Send signal about applied backup progress
-	if m.config.messengerSignalsHandler != nil {
-		response := wakusync.WakuBackedUpDataResponse{
-			Clock: message.Clock,
-		}
-
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyProfile, message.ProfileDetails)
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyContacts, message.ContactsDetails)
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyCommunities, message.CommunitiesDetails)
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeySettings, message.SettingsDetails)
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyKeypairs, message.KeypairDetails)
-		response.AddFetchingBackedUpDataDetails(SyncWakuSectionKeyWatchOnlyAccounts, message.WatchOnlyAccountDetails)
-
-		m.config.messengerSignalsHandler.SendWakuFetchingBackupProgress(&response)
-	}
-
-	state.Response.BackupHandled = true
-
 	return errors
 }
 
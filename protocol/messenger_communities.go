@@ -43,7 +43,6 @@ import (
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/services/wallet/bigint"
-	"github.com/status-im/status-go/signal"
 )
 
 // 7 days interval
@@ -693,6 +692,9 @@ func (m *Messenger) handleCommunitySharedAddressesRequest(state *ReceivedMessage
 		if err = m.communitiesManager.ShareRequestsToJoinWithPrivilegedMembers(community, newPrivilegedMember); err != nil {
 			return err
 		}
+		if err = m.communitiesManager.ShareModerationLogWithPrivilegedMembers(community, newPrivilegedMember); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1992,13 +1994,16 @@ func (m *Messenger) acceptRequestToJoinCommunity(requestToJoin *communities.Requ
 		}
 
 		// The purpose of this torrent code is to get the 'magnetlink' to populate 'requestToJoinResponseProto.MagnetUri'
+		// along with the archive mirror, if any
 		if m.archiveManager.IsReady() && m.archiveManager.TorrentFileExists(community.IDString()) {
-			magnetlink, err := m.archiveManager.GetHistoryArchiveMagnetlink(community.ID())
+			advertisement, err := m.archiveManager.GetHistoryArchiveAdvertisement(community.ID())
 			if err != nil {
 				m.logger.Warn("couldn't get magnet link for community", zap.Error(err))
 				return nil, err
 			}
-			requestToJoinResponseProto.MagnetUri = magnetlink
+			requestToJoinResponseProto.MagnetUri = advertisement.MagnetURI
+			requestToJoinResponseProto.ArchiveMirrorUrl = advertisement.MirrorURL
+			requestToJoinResponseProto.ArchiveIndexHash = advertisement.IndexHash
 		}
 
 		payload, err := proto.Marshal(requestToJoinResponseProto)
@@ -2350,7 +2355,7 @@ func (m *Messenger) CheckAndDeletePendingRequestToJoinCommunity(ctx context.Cont
 	}
 
 	if sendSignal && !sendResponse {
-		signal.SendNewMessages(response)
+		m.signals().SendNewMessages(response)
 	}
 
 	if sendResponse {
@@ -3098,6 +3103,8 @@ func (m *Messenger) RemoveUserFromCommunity(id types.HexBytes, pkString string)
 		return nil, err
 	}
 
+	m.communitiesManager.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_KICK, pkString, protobuf.CommunityMember_ROLE_NONE, "")
+
 	response := &MessengerResponse{}
 	response.AddCommunity(community)
 	return response, nil
@@ -3173,6 +3180,8 @@ func (m *Messenger) BanUserFromCommunity(ctx context.Context, request *requests.
 			return nil, err
 		}
 
+		m.communitiesManager.RecordModerationAction(community, protobuf.CommunityModerationLogEntry_MEMBER_MESSAGES_DELETE, request.User.String(), protobuf.CommunityMember_ROLE_NONE, "")
+
 		err = response.Merge(deleteMessagesResponse)
 		if err != nil {
 			return nil, err
@@ -3334,6 +3343,11 @@ func (m *Messenger) handleCommunityResponse(state *ReceivedMessageState, communi
 		}
 	}
 
+	err := m.communitiesManager.DeleteScheduledEventRsvps(communityResponse.Changes.ScheduledEventsRemoved)
+	if err != nil {
+		m.logger.Warn("failed to delete scheduled event rsvps", zap.Error(err))
+	}
+
 	state.Response.AddCommunity(community)
 	state.Response.CommunityChanges = append(state.Response.CommunityChanges, communityResponse.Changes)
 	state.Response.AddRequestsToJoinCommunity(communityResponse.RequestsToJoin)
@@ -3628,6 +3642,11 @@ func (m *Messenger) handleCommunityPrivilegedUserSyncMessage(state *ReceivedMess
 		if err != nil {
 			return err
 		}
+	case protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_MODERATION_LOG:
+		err = m.communitiesManager.HandleModerationLogPrivilegedUserSyncMessage(message, community)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -4167,8 +4186,8 @@ importMessageArchivesLoop:
 				if !response.IsEmpty() {
 					notifications := response.Notifications()
 					response.ClearNotifications()
-					signal.SendNewMessages(response)
-					localnotifications.PushMessages(notifications)
+					m.signals().SendNewMessages(response)
+					localnotifications.PushMessages(m.signals(), notifications)
 				}
 			}
 
@@ -4189,14 +4208,16 @@ func (m *Messenger) dispatchMagnetlinkMessage(communityID string) error {
 		return err
 	}
 
-	magnetlink, err := m.archiveManager.GetHistoryArchiveMagnetlink(community.ID())
+	advertisement, err := m.archiveManager.GetHistoryArchiveAdvertisement(community.ID())
 	if err != nil {
 		return err
 	}
 
 	magnetLinkMessage := &protobuf.CommunityMessageArchiveMagnetlink{
 		Clock:     m.getTimesource().GetCurrentTime(),
-		MagnetUri: magnetlink,
+		MagnetUri: advertisement.MagnetURI,
+		MirrorUrl: advertisement.MirrorURL,
+		IndexHash: advertisement.IndexHash,
 	}
 
 	encodedMessage, err := proto.Marshal(magnetLinkMessage)
@@ -4993,6 +5014,8 @@ func (m *Messenger) DeleteCommunityMemberMessages(request *requests.DeleteCommun
 		Messages:    request.Messages,
 	}
 
+	m.recordCommunityModerationAction(community.IDString(), common.PubkeyToHex(m.IdentityPublicKey()), protobuf.CommunityModerationLogEntry_MEMBER_MESSAGES_DELETE, request.MemberPubKey, deletedMessages.Clock)
+
 	payload, err := proto.Marshal(deletedMessages)
 	if err != nil {
 		return nil, err
@@ -5030,6 +5053,8 @@ func (m *Messenger) HandleDeleteCommunityMemberMessages(state *ReceivedMessageSt
 		return err
 	}
 
+	m.recordCommunityModerationAction(community.IDString(), common.PubkeyToHex(state.CurrentMessageState.PublicKey), protobuf.CommunityModerationLogEntry_MEMBER_MESSAGES_DELETE, request.MemberId, request.Clock)
+
 	return state.Response.Merge(deleteMessagesResponse)
 }
 
@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/protocol/discord"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/slack"
+	"github.com/status-im/status-go/protocol/telegram"
+)
+
+// readExportFunc converts an export file into Discord export data, one item per channel
+type readExportFunc func(filePath string) ([]*discord.ExportedData, error)
+
+func (m *Messenger) ExtractTelegramDataFromImportFiles(filesToImport []string) (*discord.ExtractedData, map[string]*discord.ImportError) {
+	return m.extractDataFromExportFiles(filesToImport, telegram.ReadExport)
+}
+
+func (m *Messenger) ExtractSlackDataFromImportFiles(filesToImport []string) (*discord.ExtractedData, map[string]*discord.ImportError) {
+	return m.extractDataFromExportFiles(filesToImport, slack.ReadExport)
+}
+
+func (m *Messenger) RequestImportTelegramCommunity(request *requests.ImportTelegramCommunity) {
+	m.requestImportCommunity(request.ToCreateCommunityRequest(), request.FilesToImport, request.From, m.ExtractTelegramDataFromImportFiles)
+}
+
+func (m *Messenger) RequestImportSlackCommunity(request *requests.ImportSlackCommunity) {
+	m.requestImportCommunity(request.ToCreateCommunityRequest(), request.FilesToImport, request.From, m.ExtractSlackDataFromImportFiles)
+}
+
+func (m *Messenger) extractDataFromExportFiles(filesToImport []string, readExport readExportFunc) (*discord.ExtractedData, map[string]*discord.ImportError) {
+	extractedData := &discord.ExtractedData{
+		Categories:             map[string]*discord.Category{},
+		ExportedData:           make([]*discord.ExportedData, 0),
+		OldestMessageTimestamp: 0,
+		MessageCount:           0,
+	}
+
+	errors := map[string]*discord.ImportError{}
+
+	for _, fileToImport := range filesToImport {
+		filePath := strings.Replace(fileToImport, "file://", "", -1)
+
+		fileInfo, err := os.Stat(filePath)
+		if err != nil {
+			errors[fileToImport] = discord.Error(err.Error())
+			continue
+		}
+
+		if fileInfo.Size() > discord.MaxImportFileSizeBytes {
+			errors[fileToImport] = discord.Error(discord.ErrImportFileTooBig.Error())
+			continue
+		}
+
+		exportedData, err := readExport(filePath)
+		if err != nil {
+			errors[fileToImport] = discord.Error(err.Error())
+			continue
+		}
+
+		for _, data := range exportedData {
+			data.Channel.FilePath = filePath
+
+			extractedData.MessageCount = extractedData.MessageCount + data.MessageCount
+			extractedData.ExportedData = append(extractedData.ExportedData, data)
+
+			if len(data.Messages) == 0 {
+				continue
+			}
+
+			// Converted messages are sorted, starting with the oldest
+			msgTime, err := time.Parse(discordTimestampLayout, data.Messages[0].Timestamp)
+			if err != nil {
+				continue
+			}
+
+			if extractedData.OldestMessageTimestamp == 0 || int(msgTime.Unix()) <= extractedData.OldestMessageTimestamp {
+				extractedData.OldestMessageTimestamp = int(msgTime.Unix())
+			}
+		}
+	}
+
+	return extractedData, errors
+}
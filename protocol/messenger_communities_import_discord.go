@@ -1017,9 +1017,16 @@ func (m *Messenger) RequestImportDiscordChannel(request *requests.ImportDiscordC
 }
 
 func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscordCommunity) {
+	m.requestImportCommunity(request.ToCreateCommunityRequest(), request.FilesToImport, request.From, m.ExtractDiscordDataFromImportFiles)
+}
+
+// requestImportCommunity creates a community out of exported chat data.
+// `extractData` converts a single import file into Discord export data,
+// which allows to import other export formats the same way as Discord ones.
+func (m *Messenger) requestImportCommunity(createCommunityRequest *requests.CreateCommunity, filesToImport []string, from int64, extractData func([]string) (*discord.ExtractedData, map[string]*discord.ImportError)) {
 	go func() {
 
-		totalImportChunkCount := len(request.FilesToImport)
+		totalImportChunkCount := len(filesToImport)
 
 		progressUpdates := make(chan *discord.ImportProgress)
 		done := make(chan struct{})
@@ -1034,13 +1041,11 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			discord.DownloadAssetsTask,
 			discord.InitCommunityTask,
 		})
-		importProgress.CommunityName = request.Name
+		importProgress.CommunityName = createCommunityRequest.Name
 
 		// initial progress immediately
 		m.publishImportProgress(importProgress)
 
-		createCommunityRequest := request.ToCreateCommunityRequest()
-
 		// We're calling `CreateCommunity` on `communitiesManager` directly, instead of
 		// using the `Messenger` API, so we get more control over when we set up filters,
 		// the community is published and data is being synced (we don't want the community
@@ -1096,92 +1101,14 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 		// The map with counts of duplicated channel names
 		uniqueChatNames := make(map[string]int, 0)
 
-		for i, importFile := range request.FilesToImport {
-
-			exportData, errs := m.ExtractDiscordDataFromImportFiles([]string{importFile})
-			if len(errs) > 0 {
-				for _, err := range errs {
-					importProgress.AddTaskError(discord.CommunityCreationTask, err)
-				}
-				progressUpdates <- importProgress
-				return
-			}
-			totalChannelsCount := len(exportData.ExportedData)
-			totalMessageCount := exportData.MessageCount
-
-			if totalChannelsCount == 0 || totalMessageCount == 0 {
-				importError := discord.Error(fmt.Errorf("No channel to import messages from in file: %s", importFile).Error())
-				if totalMessageCount == 0 {
-					importError.Message = fmt.Errorf("No messages to import in file: %s", importFile).Error()
-				}
-				importProgress.AddTaskError(discord.ChannelsCreationTask, importError)
-				progressUpdates <- importProgress
-				continue
-			}
-
-			importProgress.CurrentChunk = i + 1
-
-			// We actually only ever receive a single category
-			// from `exportData` but since it's a map, we still have to
-			// iterate over it to access its values
-			for _, category := range exportData.Categories {
-
-				categories := discordCommunity.Categories()
-				exists := false
-				for catID := range categories {
-					if strings.HasSuffix(catID, category.ID) {
-						exists = true
-						break
-					}
-				}
-
-				if !exists {
-					createCommunityCategoryRequest := &requests.CreateCommunityCategory{
-						CommunityID:  discordCommunity.ID(),
-						CategoryName: category.Name,
-						ThirdPartyID: category.ID,
-						ChatIDs:      make([]string, 0),
-					}
-					// We call `CreateCategory` on `communitiesManager` directly so we can control
-					// whether or not the community update should be published (it should not until the
-					// import has finished)
-					communityWithCategories, changes, err := m.communitiesManager.CreateCategory(createCommunityCategoryRequest, false)
-					if err != nil {
-						m.cleanUpImport(communityID)
-						importProgress.AddTaskError(discord.CommunityCreationTask, discord.Error(err.Error()))
-						importProgress.StopTask(discord.CommunityCreationTask)
-						progressUpdates <- importProgress
-						return
-					}
-					discordCommunity = communityWithCategories
-					// This looks like we keep overriding the same field but there's
-					// only one `CategoriesAdded` change at this point.
-					for _, addedCategory := range changes.CategoriesAdded {
-						processedCategoriesIds[category.ID] = addedCategory.CategoryId
-					}
-				}
-			}
-
-			progressValue := calculateProgress(i+1, totalImportChunkCount, (float32(1) / 2))
-			importProgress.UpdateTaskProgress(discord.ChannelsCreationTask, progressValue)
-
-			progressUpdates <- importProgress
-
-			if m.DiscordImportMarkedAsCancelled(communityID) {
-				importProgress.StopTask(discord.CommunityCreationTask)
-				progressUpdates <- importProgress
-				cancel <- communityID
-				return
-			}
-
+		// importChannel imports the messages and assets of a single exported channel,
+		// it returns false when the whole import has been stopped
+		importChannel := func(i int, exportData *discord.ExtractedData, channel *discord.ExportedData) bool {
 			messagesToSave := make(map[string]*common.Message, 0)
 			pinMessagesToSave := make([]*common.PinMessage, 0)
 			authorProfilesToSave := make(map[string]*protobuf.DiscordMessageAuthor, 0)
 			messageAttachmentsToDownload := make([]*protobuf.DiscordMessageAttachment, 0)
 
-			// Save to access the first item here as we process
-			// exported data by files which only holds a single channel
-			channel := exportData.ExportedData[0]
 			chatIDs := discordCommunity.ChatIDs()
 
 			exists := false
@@ -1227,7 +1154,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					importProgress.AddTaskError(discord.ChannelsCreationTask, discord.Error(errmsg))
 					importProgress.StopTask(discord.ChannelsCreationTask)
 					progressUpdates <- importProgress
-					return
+					return false
 				}
 				discordCommunity = changes.Community
 
@@ -1242,7 +1169,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				}
 			}
 
-			progressValue = calculateProgress(i+1, totalImportChunkCount, 1)
+			progressValue := calculateProgress(i+1, totalImportChunkCount, 1)
 			importProgress.UpdateTaskProgress(discord.ChannelsCreationTask, progressValue)
 			progressUpdates <- importProgress
 
@@ -1256,7 +1183,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					continue
 				}
 
-				if timestamp.Unix() < request.From {
+				if timestamp.Unix() < from {
 					progressUpdates <- importProgress
 					continue
 				}
@@ -1444,7 +1371,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				importProgress.StopTask(discord.ImportMessagesTask)
 				progressUpdates <- importProgress
 				cancel <- communityID
-				return
+				return false
 			}
 
 			var discordMessages []*protobuf.DiscordMessage
@@ -1467,14 +1394,14 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					importProgress.AddTaskError(discord.ImportMessagesTask, discord.Error(err.Error()))
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
-					return
+					return false
 				}
 
 				if m.DiscordImportMarkedAsCancelled(communityID) {
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
 					cancel <- communityID
-					return
+					return false
 				}
 
 				// We're multiplying `chunksCount` by `0.25` so we leave 25% for additional save operations
@@ -1510,14 +1437,14 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					importProgress.AddTaskError(discord.ImportMessagesTask, discord.Error(err.Error()))
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
-					return
+					return false
 				}
 
 				if m.DiscordImportMarkedAsCancelled(communityID) {
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
 					cancel <- communityID
-					return
+					return false
 				}
 
 				// 0.75 are the previous 75% of progress, hence we multiply our chunk progress
@@ -1542,14 +1469,14 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					importProgress.AddTaskError(discord.ImportMessagesTask, discord.Error(err.Error()))
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
-					return
+					return false
 				}
 
 				if m.DiscordImportMarkedAsCancelled(communityID) {
 					importProgress.StopTask(discord.ImportMessagesTask)
 					progressUpdates <- importProgress
 					cancel <- communityID
-					return
+					return false
 				}
 			}
 
@@ -1605,7 +1532,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				importProgress.StopTask(discord.DownloadAssetsTask)
 				progressUpdates <- importProgress
 				cancel <- communityID
-				return
+				return false
 			}
 
 			for idxRange := range gopart.Partition(len(messageAttachmentsToDownload), 100) {
@@ -1652,7 +1579,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				importProgress.StopTask(discord.DownloadAssetsTask)
 				progressUpdates <- importProgress
 				cancel <- communityID
-				return
+				return false
 			}
 
 			attachmentChunks := chunkAttachmentsByByteSize(messageAttachmentsToDownload, maxChunkSizeBytes)
@@ -1666,14 +1593,14 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					importProgress.AddTaskError(discord.DownloadAssetsTask, discord.Error(err.Error()))
 					importProgress.Stop()
 					progressUpdates <- importProgress
-					return
+					return false
 				}
 
 				if m.DiscordImportMarkedAsCancelled(communityID) {
 					importProgress.StopTask(discord.DownloadAssetsTask)
 					progressUpdates <- importProgress
 					cancel <- communityID
-					return
+					return false
 				}
 
 				// 0.5 are the previous 50% of progress, hence we multiply our chunk progress
@@ -1697,19 +1624,19 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			_, err := m.transport.JoinPublic(processedChannelIds[channel.Channel.ID])
 			if err != nil {
 				m.logger.Error("failed to load filter for chat", zap.Error(err))
-				continue
+				return true
 			}
 
 			wakuChatMessages, err := m.chatMessagesToWakuMessages(messages, discordCommunity)
 			if err != nil {
 				m.logger.Error("failed to convert chat messages into waku messages", zap.Error(err))
-				continue
+				return true
 			}
 
 			wakuPinMessages, err := m.pinMessagesToWakuMessages(pinMessagesToSave, discordCommunity)
 			if err != nil {
 				m.logger.Error("failed to convert pin messages into waku messages", zap.Error(err))
-				continue
+				return true
 			}
 
 			wakuMessages := append(wakuChatMessages, wakuPinMessages...)
@@ -1717,7 +1644,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			topics, err := m.archiveManager.GetCommunityChatsTopics(discordCommunity.ID())
 			if err != nil {
 				m.logger.Error("failed to get community chat topics", zap.Error(err))
-				continue
+				return true
 			}
 
 			startDate := time.Unix(int64(exportData.OldestMessageTimestamp), 0)
@@ -1734,7 +1661,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 			)
 			if err != nil {
 				m.logger.Error("failed to create history archive torrent", zap.Error(err))
-				continue
+				return true
 			}
 
 			if m.archiveManager.IsReady() && communitySettings.HistoryArchiveSupportEnabled {
@@ -1745,6 +1672,94 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 				}
 				go m.archiveManager.StartHistoryArchiveTasksInterval(discordCommunity, messageArchiveInterval)
 			}
+			return true
+		}
+
+		for i, importFile := range filesToImport {
+
+			exportData, errs := extractData([]string{importFile})
+			if len(errs) > 0 {
+				for _, err := range errs {
+					importProgress.AddTaskError(discord.CommunityCreationTask, err)
+				}
+				progressUpdates <- importProgress
+				return
+			}
+			totalChannelsCount := len(exportData.ExportedData)
+			totalMessageCount := exportData.MessageCount
+
+			if totalChannelsCount == 0 || totalMessageCount == 0 {
+				importError := discord.Error(fmt.Errorf("No channel to import messages from in file: %s", importFile).Error())
+				if totalMessageCount == 0 {
+					importError.Message = fmt.Errorf("No messages to import in file: %s", importFile).Error()
+				}
+				importProgress.AddTaskError(discord.ChannelsCreationTask, importError)
+				progressUpdates <- importProgress
+				continue
+			}
+
+			importProgress.CurrentChunk = i + 1
+
+			// We actually only ever receive a single category
+			// from `exportData` but since it's a map, we still have to
+			// iterate over it to access its values
+			for _, category := range exportData.Categories {
+
+				categories := discordCommunity.Categories()
+				exists := false
+				for catID := range categories {
+					if strings.HasSuffix(catID, category.ID) {
+						exists = true
+						break
+					}
+				}
+
+				if !exists {
+					createCommunityCategoryRequest := &requests.CreateCommunityCategory{
+						CommunityID:  discordCommunity.ID(),
+						CategoryName: category.Name,
+						ThirdPartyID: category.ID,
+						ChatIDs:      make([]string, 0),
+					}
+					// We call `CreateCategory` on `communitiesManager` directly so we can control
+					// whether or not the community update should be published (it should not until the
+					// import has finished)
+					communityWithCategories, changes, err := m.communitiesManager.CreateCategory(createCommunityCategoryRequest, false)
+					if err != nil {
+						m.cleanUpImport(communityID)
+						importProgress.AddTaskError(discord.CommunityCreationTask, discord.Error(err.Error()))
+						importProgress.StopTask(discord.CommunityCreationTask)
+						progressUpdates <- importProgress
+						return
+					}
+					discordCommunity = communityWithCategories
+					// This looks like we keep overriding the same field but there's
+					// only one `CategoriesAdded` change at this point.
+					for _, addedCategory := range changes.CategoriesAdded {
+						processedCategoriesIds[category.ID] = addedCategory.CategoryId
+					}
+				}
+			}
+
+			progressValue := calculateProgress(i+1, totalImportChunkCount, (float32(1) / 2))
+			importProgress.UpdateTaskProgress(discord.ChannelsCreationTask, progressValue)
+
+			progressUpdates <- importProgress
+
+			if m.DiscordImportMarkedAsCancelled(communityID) {
+				importProgress.StopTask(discord.CommunityCreationTask)
+				progressUpdates <- importProgress
+				cancel <- communityID
+				return
+			}
+
+			// Discord exports hold a single channel per file, while other
+			// exports (e.g. Slack workspaces) may hold several of them
+			for _, channel := range exportData.ExportedData {
+				if !importChannel(i, exportData, channel) {
+					return
+				}
+			}
 		}
 
 		err = m.publishOrg(discordCommunity, false)
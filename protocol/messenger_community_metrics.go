@@ -13,6 +13,16 @@ type MetricsIntervalResponse struct {
 	EndTimestamp   uint64   `json:"endTimestamp"`
 	Timestamps     []uint64 `json:"timestamps"`
 	Count          int      `json:"count"`
+	// Members are the public keys counted in the interval, e.g. active posters or new members
+	Members []string `json:"members,omitempty"`
+	// Channels holds the messages count per community chat ID
+	Channels        map[string]int       `json:"channels,omitempty"`
+	TopContributors []MetricsContributor `json:"topContributors,omitempty"`
+}
+
+type MetricsContributor struct {
+	PublicKey string `json:"publicKey"`
+	Count     int    `json:"count"`
 }
 
 type CommunityMetricsResponse struct {
@@ -92,6 +102,141 @@ func (m *Messenger) collectCommunityMessagesCount(request *requests.CommunityMet
 	return response, nil
 }
 
+// collectCommunityIntervals builds the response by collecting each requested interval with `collect`
+func (m *Messenger) collectCommunityIntervals(request *requests.CommunityMetricsRequest, collect func(chatIDs []string, interval *MetricsIntervalResponse) error) (*CommunityMetricsResponse, error) {
+	chatIDs, err := m.getChatIdsForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]MetricsIntervalResponse, len(request.Intervals))
+	for i, sourceInterval := range request.Intervals {
+		intervals[i] = MetricsIntervalResponse{
+			StartTimestamp: sourceInterval.StartTimestamp,
+			EndTimestamp:   sourceInterval.EndTimestamp,
+		}
+
+		err := collect(chatIDs, &intervals[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &CommunityMetricsResponse{
+		Type:        request.Type,
+		CommunityID: request.CommunityID,
+		Intervals:   intervals,
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) collectCommunityActivePosters(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	return m.collectCommunityIntervals(request, func(chatIDs []string, interval *MetricsIntervalResponse) error {
+		authors, err := m.persistence.SelectMessagesAuthorsForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return err
+		}
+
+		interval.Members = authors
+		interval.Count = len(authors)
+		return nil
+	})
+}
+
+// collectCommunityRetainedPosters returns the members who posted both in the interval
+// and in the period of the same length right before it
+func (m *Messenger) collectCommunityRetainedPosters(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	return m.collectCommunityIntervals(request, func(chatIDs []string, interval *MetricsIntervalResponse) error {
+		authors, err := m.persistence.SelectMessagesAuthorsForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return err
+		}
+
+		length := interval.EndTimestamp - interval.StartTimestamp
+		var previousAuthors []string
+		if interval.StartTimestamp > length {
+			previousAuthors, err = m.persistence.SelectMessagesAuthorsForChatsByPeriod(chatIDs, interval.StartTimestamp-length-1, interval.StartTimestamp-1)
+			if err != nil {
+				return err
+			}
+		}
+
+		previous := make(map[string]bool, len(previousAuthors))
+		for _, author := range previousAuthors {
+			previous[author] = true
+		}
+
+		for _, author := range authors {
+			if previous[author] {
+				interval.Members = append(interval.Members, author)
+			}
+		}
+		interval.Count = len(interval.Members)
+		return nil
+	})
+}
+
+// collectCommunityMembersChanges collects joins or leaves, which are only fully known by the control node.
+// Requests to join and leave are clocked in unix seconds, unlike messages.
+func (m *Messenger) collectCommunityMembersChanges(request *requests.CommunityMetricsRequest, membersByPeriod func(types.HexBytes, uint64, uint64) ([]string, error)) (*CommunityMetricsResponse, error) {
+	return m.collectCommunityIntervals(request, func(_ []string, interval *MetricsIntervalResponse) error {
+		members, err := membersByPeriod(request.CommunityID, interval.StartTimestamp/1000, interval.EndTimestamp/1000)
+		if err != nil {
+			return err
+		}
+
+		interval.Members = members
+		interval.Count = len(members)
+		return nil
+	})
+}
+
+func (m *Messenger) collectCommunityChannelMessages(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	return m.collectCommunityIntervals(request, func(chatIDs []string, interval *MetricsIntervalResponse) error {
+		channels, err := m.persistence.SelectMessagesCountPerChatForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return err
+		}
+
+		interval.Channels = channels
+		for _, count := range channels {
+			interval.Count += count
+		}
+		return nil
+	})
+}
+
+func (m *Messenger) collectCommunityReactionsCount(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	return m.collectCommunityIntervals(request, func(chatIDs []string, interval *MetricsIntervalResponse) error {
+		count, err := m.persistence.SelectReactionsCountForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return err
+		}
+
+		interval.Count = count
+		return nil
+	})
+}
+
+func (m *Messenger) collectCommunityTopContributors(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	limit := request.Limit
+	if limit == 0 {
+		limit = requests.DefaultCommunityMetricsTopContributorsLimit
+	}
+
+	return m.collectCommunityIntervals(request, func(chatIDs []string, interval *MetricsIntervalResponse) error {
+		contributors, err := m.persistence.SelectTopMessagesAuthorsForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp, limit)
+		if err != nil {
+			return err
+		}
+
+		interval.TopContributors = contributors
+		interval.Count = len(contributors)
+		return nil
+	})
+}
+
 func (m *Messenger) CollectCommunityMetrics(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -102,6 +247,20 @@ func (m *Messenger) CollectCommunityMetrics(request *requests.CommunityMetricsRe
 		return m.collectCommunityMessagesTimestamps(request)
 	case requests.CommunityMetricsRequestMessagesCount:
 		return m.collectCommunityMessagesCount(request)
+	case requests.CommunityMetricsRequestActivePosters:
+		return m.collectCommunityActivePosters(request)
+	case requests.CommunityMetricsRequestRetainedPosters:
+		return m.collectCommunityRetainedPosters(request)
+	case requests.CommunityMetricsRequestMemberJoins:
+		return m.collectCommunityMembersChanges(request, m.communitiesManager.JoinedMembersByPeriod)
+	case requests.CommunityMetricsRequestMemberLeaves:
+		return m.collectCommunityMembersChanges(request, m.communitiesManager.LeftMembersByPeriod)
+	case requests.CommunityMetricsRequestChannelMessages:
+		return m.collectCommunityChannelMessages(request)
+	case requests.CommunityMetricsRequestReactionsCount:
+		return m.collectCommunityReactionsCount(request)
+	case requests.CommunityMetricsRequestTopContributors:
+		return m.collectCommunityTopContributors(request)
 	default:
 		return nil, fmt.Errorf("metrics for %d is not implemented yet", request.Type)
 	}
@@ -90,6 +90,10 @@ func (s *MessengerCommunityMetricsSuite) prepareCommunityChatMessages(communityI
 }
 
 func (s *MessengerCommunityMetricsSuite) generateMessages(chatID string, communityID string, timestamps []uint64) {
+	s.generateMessagesFrom(chatID, communityID, common.PubkeyToHex(&s.m.identity.PublicKey), timestamps)
+}
+
+func (s *MessengerCommunityMetricsSuite) generateMessagesFrom(chatID string, communityID string, from string, timestamps []uint64) {
 	var messages []*common.Message
 	for i, timestamp := range timestamps {
 		message := &common.Message{
@@ -102,10 +106,10 @@ func (s *MessengerCommunityMetricsSuite) generateMessages(chatID string, communi
 				Timestamp: timestamp,
 			},
 			WhisperTimestamp: timestamp,
-			From:             common.PubkeyToHex(&s.m.identity.PublicKey),
+			From:             from,
 			LocalChatID:      chatID,
 			CommunityID:      communityID,
-			ID:               types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d", chatID, communityID, timestamp)))),
+			ID:               types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%s%d", chatID, communityID, from, timestamp)))),
 		}
 
 		err := message.PrepareContent(common.PubkeyToHex(&s.m.identity.PublicKey))
@@ -233,3 +237,101 @@ func (s *MessengerCommunityMetricsSuite) TestCollectCommunityMessagesCount() {
 	s.Require().Equal(resp.Intervals[1].Count, 2)
 	s.Require().Equal(resp.Intervals[2].Count, 1)
 }
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityEngagementMetrics() {
+	community, chatIDs := s.prepareCommunityAndChatIDs()
+
+	s.prepareCommunityChatMessages(string(community.ID()), chatIDs)
+
+	otherMember := "0x04other"
+	s.generateMessagesFrom(chatIDs[1], string(community.ID()), otherMember, []uint64{1690371900, 1690372250})
+
+	intervals := []requests.MetricsIntervalRequest{
+		requests.MetricsIntervalRequest{
+			StartTimestamp: 1690372000,
+			EndTimestamp:   1690372300,
+		},
+		requests.MetricsIntervalRequest{
+			StartTimestamp: 1690372900,
+			EndTimestamp:   1690373000,
+		},
+	}
+
+	self := common.PubkeyToHex(&s.m.identity.PublicKey)
+
+	// Active posters
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestActivePosters,
+		Intervals:   intervals,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals, 2)
+	s.Require().Equal(2, resp.Intervals[0].Count)
+	s.Require().ElementsMatch([]string{self, otherMember}, resp.Intervals[0].Members)
+	s.Require().Equal([]string{self}, resp.Intervals[1].Members)
+
+	// Retained posters, the other member also posted right before the first interval
+	resp, err = s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestRetainedPosters,
+		Intervals:   intervals,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{otherMember}, resp.Intervals[0].Members)
+
+	// Per channel messages
+	resp, err = s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestChannelMessages,
+		Intervals:   intervals,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(4, resp.Intervals[0].Count)
+	s.Require().Equal(1, resp.Intervals[0].Channels[chatIDs[0]])
+	s.Require().Equal(3, resp.Intervals[0].Channels[chatIDs[1]])
+
+	// Top contributors
+	resp, err = s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestTopContributors,
+		Intervals:   intervals,
+		Limit:       1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals[0].TopContributors, 1)
+	s.Require().Equal(MetricsContributor{PublicKey: self, Count: 3}, resp.Intervals[0].TopContributors[0])
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityReactionsCount() {
+	community, chatIDs := s.prepareCommunityAndChatIDs()
+
+	for i, clock := range []uint64{1690372000, 1690372100, 1690373000} {
+		reaction := &EmojiReaction{
+			EmojiReaction: &protobuf.EmojiReaction{
+				Clock:     clock,
+				MessageId: fmt.Sprintf("message-%d", i),
+				ChatId:    chatIDs[0],
+				Type:      protobuf.EmojiReaction_LOVE,
+				Retracted: i == 1,
+			},
+			From:        common.PubkeyToHex(&s.m.identity.PublicKey),
+			LocalChatID: chatIDs[0],
+		}
+		err := s.m.persistence.SaveEmojiReaction(reaction)
+		s.Require().NoError(err)
+	}
+
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestReactionsCount,
+		Intervals: []requests.MetricsIntervalRequest{
+			requests.MetricsIntervalRequest{
+				StartTimestamp: 1690372000,
+				EndTimestamp:   1690373000,
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, resp.Intervals[0].Count)
+}
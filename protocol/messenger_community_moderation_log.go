@@ -0,0 +1,29 @@
+package protocol
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// CommunityModerationLog returns a page of the moderation log of the given community,
+// most recent entries first. It's only available to privileged members.
+func (m *Messenger) CommunityModerationLog(communityID types.HexBytes, cursor string, limit int) ([]*communities.ModerationLogEntry, string, error) {
+	return m.communitiesManager.GetModerationLog(communityID, cursor, limit)
+}
+
+func (m *Messenger) recordCommunityModerationAction(communityID string, actor string, action protobuf.CommunityModerationLogEntry_Action, target string, clock uint64) {
+	community, err := m.communitiesManager.GetByIDString(communityID)
+	if err != nil {
+		m.logger.Warn("failed to get community for moderation log", zap.String("communityID", communityID), zap.Error(err))
+		return
+	}
+
+	entry := communities.NewModerationLogEntry(community.ID(), actor, action, target, clock)
+	err = m.communitiesManager.SaveModerationLogEntries(community, []*communities.ModerationLogEntry{entry})
+	if err != nil {
+		m.logger.Warn("failed to save moderation log entry", zap.String("communityID", communityID), zap.Error(err))
+	}
+}
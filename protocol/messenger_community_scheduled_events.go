@@ -0,0 +1,238 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// scheduledEventReminderWindow is how long before a scheduled event starts
+// members who are going or might go get reminded of it
+const scheduledEventReminderWindow = 15 * time.Minute
+
+func (m *Messenger) CreateCommunityScheduledEvent(request *requests.CreateCommunityScheduledEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, changes, err := m.communitiesManager.CreateScheduledEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return response, nil
+}
+
+func (m *Messenger) EditCommunityScheduledEvent(request *requests.EditCommunityScheduledEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, changes, err := m.communitiesManager.EditScheduledEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return response, nil
+}
+
+func (m *Messenger) DeleteCommunityScheduledEvent(request *requests.DeleteCommunityScheduledEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, changes, err := m.communitiesManager.DeleteScheduledEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	response.CommunityChanges = []*communities.CommunityChanges{changes}
+
+	return response, nil
+}
+
+// RsvpCommunityScheduledEvent stores our answer to a scheduled event and shares it with the community members
+func (m *Messenger) RsvpCommunityScheduledEvent(request *requests.RsvpCommunityScheduledEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsvp := &communities.ScheduledEventRsvp{
+		CommunityID: request.CommunityID,
+		EventID:     request.EventID,
+		Response:    request.Response,
+		Clock:       m.GetCurrentTimeInMillis(),
+	}
+
+	_, err = m.communitiesManager.SaveScheduledEventRsvp(&m.identity.PublicKey, rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(rsvp.ToProtobuf())
+	if err != nil {
+		return nil, err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID: community.MemberUpdateChannelID(),
+		Payload:     payload,
+		MessageType: protobuf.ApplicationMetadataMessage_COMMUNITY_SCHEDULED_EVENT_RSVP,
+		PubsubTopic: community.PubsubTopic(),
+	}
+
+	_, err = m.sender.SendPublic(context.Background(), rawMessage.LocalChatID, rawMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddScheduledEventRsvp(rsvp)
+
+	return response, nil
+}
+
+func (m *Messenger) HandleCommunityScheduledEventRsvp(state *ReceivedMessageState, message *protobuf.CommunityScheduledEventRsvp, statusMessage *v1protocol.StatusMessage) error {
+	signer := state.CurrentMessageState.PublicKey
+	rsvp := communities.ScheduledEventRsvpFromProtobuf(common.PubkeyToHex(signer), message)
+
+	saved, err := m.communitiesManager.SaveScheduledEventRsvp(signer, rsvp)
+	if err == communities.ErrScheduledEventNotFound {
+		// The event may have been removed, or its community description not received yet
+		m.logger.Debug("ignoring rsvp for unknown scheduled event", zap.String("eventID", rsvp.EventID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if saved {
+		state.Response.AddScheduledEventRsvp(rsvp)
+	}
+
+	return nil
+}
+
+func (m *Messenger) CommunityScheduledEventRsvps(eventID string) ([]*communities.ScheduledEventRsvp, error) {
+	return m.communitiesManager.GetScheduledEventRsvps(eventID)
+}
+
+func (m *Messenger) watchCommunityScheduledEventReminders() {
+	m.logger.Debug("watching community scheduled event reminders")
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Minute):
+				response, err := m.CheckCommunityScheduledEventReminders()
+				if err != nil {
+					m.logger.Error("failed to check community scheduled event reminders", zap.Error(err))
+					continue
+				}
+
+				if !response.IsEmpty() {
+					m.PublishMessengerResponse(response)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// CheckCommunityScheduledEventReminders reminds us of the events we are going, or might go, to
+// and that are about to start. Each event is reminded only once.
+func (m *Messenger) CheckCommunityScheduledEventReminders() (*MessengerResponse, error) {
+	joinedCommunities, err := m.communitiesManager.Joined()
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.GetCurrentTimeInMillis()
+	window := uint64(scheduledEventReminderWindow.Milliseconds())
+	response := &MessengerResponse{}
+
+	for _, community := range joinedCommunities {
+		rsvps, err := m.communitiesManager.GetMemberScheduledEventRsvps(community.ID(), &m.identity.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rsvp := range rsvps {
+			if !rsvp.Attending() {
+				continue
+			}
+
+			event := community.ScheduledEvent(rsvp.EventID)
+			if event == nil || event.StartTime <= now || event.StartTime > now+window {
+				continue
+			}
+
+			err := m.remindCommunityScheduledEvent(community, event, response)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) remindCommunityScheduledEvent(community *communities.Community, event *protobuf.CommunityScheduledEvent, response *MessengerResponse) error {
+	// The start time is part of the ID, so that a rescheduled event is reminded again
+	notificationID := types.HexBytes(crypto.Keccak256([]byte(fmt.Sprintf("%s-%s-%d", community.IDString(), event.Id, event.StartTime))))
+
+	existing, err := m.persistence.GetActivityCenterNotificationByID(notificationID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	notification := &ActivityCenterNotification{
+		ID:          notificationID,
+		Type:        ActivityCenterNotificationTypeCommunityScheduledEventReminder,
+		Name:        event.Title,
+		Timestamp:   m.getTimesource().GetCurrentTime(),
+		CommunityID: community.IDString(),
+		UpdatedAt:   m.GetCurrentTimeInMillis(),
+	}
+	if event.ChatId != "" {
+		notification.ChatID = community.ChatID(event.ChatId)
+	}
+
+	err = m.addActivityCenterNotification(response, notification, nil)
+	if err != nil {
+		return err
+	}
+
+	response.AddNotification(NewCommunityScheduledEventReminderNotification(notificationID.String(), community, event))
+
+	return nil
+}
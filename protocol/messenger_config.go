@@ -99,6 +99,8 @@ type config struct {
 	verifyTransactionClient  EthClient
 	verifyENSURL             string
 	verifyENSContractAddress string
+	// ensExpiryLeadTimes are how long before our ENS names expire we get reminded of it
+	ensExpiryLeadTimes []time.Duration
 
 	anonMetricsClientConfig *anonmetrics.ClientConfig
 	anonMetricsServerConfig *anonmetrics.ServerConfig
@@ -126,6 +128,7 @@ func messengerDefaultConfig() config {
 	c := config{
 		messageResendMinDelay: 30 * time.Second,
 		messageResendMaxCount: 3,
+		ensExpiryLeadTimes:    []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour},
 	}
 
 	c.codeControlFlags.AutoRequestHistoricMessages = true
@@ -331,6 +334,13 @@ func WithENSVerificationConfig(url, address string) Option {
 	}
 }
 
+func WithENSExpiryLeadTimes(leadTimes []time.Duration) Option {
+	return func(c *config) error {
+		c.ensExpiryLeadTimes = leadTimes
+		return nil
+	}
+}
+
 func WithClusterConfig(cc params.ClusterConfig) Option {
 	return func(c *config) error {
 		c.clusterConfig = cc
@@ -1,6 +1,162 @@
 package protocol
 
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	ensservice "github.com/status-im/status-go/services/ens"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+)
+
+const (
+	ensExpiryCheckInterval = 1 * time.Hour
+	ensExpiryCheckTimeout  = 1 * time.Minute
+)
+
 func (m *Messenger) ENSVerified(pubkey, ensName string) error {
 	clock := m.getTimesource().GetCurrentTime()
 	return m.ensVerifier.ENSVerified(pubkey, ensName, clock)
 }
+
+// FetchContactENSProfile loads the text records and avatar of the verified ENS name of a contact,
+// profiles are fetched on demand only as resolving them takes several network calls
+func (m *Messenger) FetchContactENSProfile(ctx context.Context, contactID string) (*MessengerResponse, error) {
+	if m.ensAPI == nil {
+		return nil, ErrENSAPINotEnabled
+	}
+
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok {
+		return nil, ErrContactNotFound
+	}
+	if !contact.ENSVerified || contact.EnsName == "" {
+		return nil, ErrENSNotVerified
+	}
+
+	profile, err := m.ensAPI.Profile(ctx, walletCommon.EthereumMainnet, contact.EnsName)
+	if err != nil {
+		return nil, err
+	}
+	contact.ENSProfile = profile
+
+	response := &MessengerResponse{}
+	response.AddContact(contact)
+	return response, nil
+}
+
+func (m *Messenger) watchENSExpiry() {
+	if m.ensAPI == nil || len(m.config.ensExpiryLeadTimes) == 0 {
+		return
+	}
+
+	m.logger.Debug("watching ENS names expiry")
+	go func() {
+		check := func() {
+			ctx, cancel := context.WithTimeout(context.Background(), ensExpiryCheckTimeout)
+			defer cancel()
+
+			response, err := m.CheckENSExpiry(ctx)
+			if err != nil {
+				m.logger.Error("failed to check ENS names expiry", zap.Error(err))
+				return
+			}
+
+			if !response.IsEmpty() {
+				m.PublishMessengerResponse(response)
+			}
+		}
+
+		check()
+		for {
+			select {
+			case <-time.After(ensExpiryCheckInterval):
+				check()
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// CheckENSExpiry reminds us of our ENS names about to expire, once for each of the configured lead times
+func (m *Messenger) CheckENSExpiry(ctx context.Context) (*MessengerResponse, error) {
+	if m.ensAPI == nil {
+		return nil, ErrENSAPINotEnabled
+	}
+
+	response := &MessengerResponse{}
+	if len(m.config.ensExpiryLeadTimes) == 0 {
+		return response, nil
+	}
+
+	leadTimes := make([]time.Duration, len(m.config.ensExpiryLeadTimes))
+	copy(leadTimes, m.config.ensExpiryLeadTimes)
+	sort.Slice(leadTimes, func(i, j int) bool { return leadTimes[i] < leadTimes[j] })
+
+	now := time.UnixMilli(int64(m.GetCurrentTimeInMillis()))
+	names, err := m.ensAPI.ExpiringNames(ctx, now.Add(leadTimes[len(leadTimes)-1]))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		// The shortest lead time reached, expired names are reminded once more
+		var leadTime time.Duration
+		if name.ExpiresAt.After(now) {
+			remaining := name.ExpiresAt.Sub(now)
+			for _, leadTime = range leadTimes {
+				if remaining <= leadTime {
+					break
+				}
+			}
+		}
+
+		err := m.remindENSExpiry(name, leadTime, response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) remindENSExpiry(name *ensservice.ExpiringName, leadTime time.Duration, response *MessengerResponse) error {
+	// The expiry is part of the ID, so that a renewed name is reminded again
+	notificationID := types.HexBytes(crypto.Keccak256([]byte(fmt.Sprintf("ens-expiry-%d-%s-%d-%d", name.ChainID, name.Username, name.ExpiresAt.Unix(), leadTime))))
+
+	existing, err := m.persistence.GetActivityCenterNotificationByID(notificationID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	notification := &ActivityCenterNotification{
+		ID:        notificationID,
+		Type:      ActivityCenterNotificationTypeENSNameExpiring,
+		Name:      name.Username,
+		Timestamp: m.getTimesource().GetCurrentTime(),
+		TokenData: &ActivityTokenData{
+			ChainID:   name.ChainID,
+			Name:      name.Username,
+			ExpiresAt: uint64(name.ExpiresAt.Unix()),
+		},
+		UpdatedAt: m.GetCurrentTimeInMillis(),
+	}
+
+	err = m.addActivityCenterNotification(response, notification, nil)
+	if err != nil {
+		return err
+	}
+
+	response.AddNotification(NewENSExpiryNotification(notificationID.String(), name))
+
+	return nil
+}
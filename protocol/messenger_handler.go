@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/status-im/status-go/services/browsers"
-	"github.com/status-im/status-go/signal"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -1321,7 +1320,7 @@ func (m *Messenger) HandleSyncPairInstallation(state *ReceivedMessageState, mess
 	return nil
 }
 
-func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, magnetlink string, clock uint64) error {
+func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, advertisement *communities.ArchiveAdvertisement, clock uint64) error {
 	id := types.HexBytes(crypto.CompressPubkey(communityPubKey))
 
 	community, err := m.communitiesManager.GetByID(id)
@@ -1342,7 +1341,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 		return nil
 	}
 
-	if m.archiveManager.IsReady() && settings.HistoryArchiveSupportEnabled {
+	if m.archiveManager.CanDownloadHistoryArchives(advertisement) && settings.HistoryArchiveSupportEnabled {
 		lastClock, err := m.communitiesManager.GetMagnetlinkMessageClock(id)
 		if err != nil {
 			return err
@@ -1355,7 +1354,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 		// if it originates from a community that the current account is
 		// part of and doesn't own the private key at the same time
 		if !community.IsControlNode() && community.Joined() && clock >= lastClock {
-			if lastSeenMagnetlink == magnetlink {
+			if advertisement.MagnetURI != "" && lastSeenMagnetlink == advertisement.MagnetURI {
 				m.logger.Debug("already processed this magnetlink")
 				return nil
 			}
@@ -1387,7 +1386,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 				// this wait groups tracks all ongoing tasks across communities
 				m.shutdownWaitGroup.Add(1)
 				defer m.shutdownWaitGroup.Done()
-				m.downloadAndImportHistoryArchives(communityID, magnetlink, task.CancelChan)
+				m.downloadAndImportHistoryArchives(communityID, advertisement, task.CancelChan)
 			}(currentTask, id)
 
 			return m.communitiesManager.UpdateMagnetlinkMessageClock(id, clock)
@@ -1396,13 +1395,13 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 	return nil
 }
 
-func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetlink string, cancel chan struct{}) {
-	downloadTaskInfo, err := m.archiveManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
+func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, advertisement *communities.ArchiveAdvertisement, cancel chan struct{}) {
+	downloadTaskInfo, err := m.archiveManager.DownloadHistoryArchives(id, advertisement, cancel)
 	if err != nil {
 		logMsg := "failed to download history archive data"
 		if err == communities.ErrTorrentTimedout {
 			m.logger.Debug("torrent has timed out, trying once more...")
-			downloadTaskInfo, err = m.archiveManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
+			downloadTaskInfo, err = m.archiveManager.DownloadHistoryArchives(id, advertisement, cancel)
 			if err != nil {
 				m.logger.Error(logMsg, zap.Error(err))
 				return
@@ -1420,7 +1419,7 @@ func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetli
 		return
 	}
 
-	err = m.communitiesManager.UpdateLastSeenMagnetlink(id, magnetlink)
+	err = m.communitiesManager.UpdateLastSeenMagnetlink(id, advertisement.MagnetURI)
 	if err != nil {
 		m.logger.Error("couldn't update last seen magnetlink", zap.Error(err))
 	}
@@ -1522,7 +1521,7 @@ func (m *Messenger) HandleCommunityCancelRequestToJoin(state *ReceivedMessageSta
 		response := &MessengerResponse{}
 		response.AddActivityCenterNotification(notification)
 
-		signal.SendNewMessages(response)
+		m.signals().SendNewMessages(response)
 	}
 
 	return nil
@@ -1734,8 +1733,8 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 			}
 		}
 
-		magnetlink := requestToJoinResponseProto.MagnetUri
-		if m.archiveManager.IsReady() && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled && magnetlink != "" {
+		advertisement := communities.ArchiveAdvertisementFromRequestToJoinResponse(requestToJoinResponseProto)
+		if m.archiveManager.CanDownloadHistoryArchives(advertisement) && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled {
 
 			currentTask := m.archiveManager.GetHistoryArchiveDownloadTask(community.IDString())
 			go func(currentTask *communities.HistoryArchiveDownloadTask) {
@@ -1759,7 +1758,7 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 				m.shutdownWaitGroup.Add(1)
 				defer m.shutdownWaitGroup.Done()
 
-				m.downloadAndImportHistoryArchives(community.ID(), magnetlink, task.CancelChan)
+				m.downloadAndImportHistoryArchives(community.ID(), advertisement, task.CancelChan)
 			}(currentTask)
 
 			clock := requestToJoinResponseProto.Community.ArchiveMagnetlinkClock
@@ -1781,14 +1780,12 @@ func (m *Messenger) HandleCommunityRequestToLeave(state *ReceivedMessageState, r
 		return err
 	}
 
-	response, err := m.RemoveUserFromCommunity(requestToLeaveProto.CommunityId, common.PubkeyToHex(signer))
+	community, err := m.communitiesManager.RemoveUserFromCommunity(requestToLeaveProto.CommunityId, signer)
 	if err != nil {
 		return err
 	}
 
-	if len(response.Communities()) > 0 {
-		state.Response.AddCommunity(response.Communities()[0])
-	}
+	state.Response.AddCommunity(community)
 
 	return nil
 }
@@ -1934,6 +1931,7 @@ func (m *Messenger) handleDeleteMessage(state *ReceivedMessageState, deleteMessa
 			if !canDeleteMessageForEveryone {
 				return ErrInvalidDeletePermission
 			}
+			m.recordCommunityModerationAction(chat.CommunityID, deleteMessage.From, protobuf.CommunityModerationLogEntry_MESSAGE_DELETE, messageID, deleteMessage.Clock)
 		} else if chat.ChatType == ChatTypePrivateGroupChat {
 			canDeleteMessageForEveryone = m.CanDeleteMessageForEveryoneInPrivateGroupChat(chat, fromPublicKey)
 			if !canDeleteMessageForEveryone {
@@ -3929,7 +3927,7 @@ func (m *Messenger) HandleSyncTrustedUser(state *ReceivedMessageState, message *
 	return nil
 }
 func (m *Messenger) HandleCommunityMessageArchiveMagnetlink(state *ReceivedMessageState, message *protobuf.CommunityMessageArchiveMagnetlink, statusMessage *v1protocol.StatusMessage) error {
-	return m.HandleHistoryArchiveMagnetlinkMessage(state, state.CurrentMessageState.PublicKey, message.MagnetUri, message.Clock)
+	return m.HandleHistoryArchiveMagnetlinkMessage(state, state.CurrentMessageState.PublicKey, communities.ArchiveAdvertisementFromMagnetlinkMessage(message), message.Clock)
 }
 
 func (m *Messenger) addNewKeypairAddedOnPairedDeviceACNotification(keyUID string, response *MessengerResponse) error {
@@ -265,6 +265,15 @@ func (m *Messenger) dispatchToHandler(messageState *ReceivedMessageState, protoB
            case protobuf.ApplicationMetadataMessage_COMMUNITY_SHARED_ADDRESSES_RESPONSE:
 		return m.handleCommunitySharedAddressesResponseProtobuf(messageState, protoBytes, msg, filter)
         
+           case protobuf.ApplicationMetadataMessage_COMMUNITY_SCHEDULED_EVENT_RSVP:
+		return m.handleCommunityScheduledEventRsvpProtobuf(messageState, protoBytes, msg, filter)
+        
+           case protobuf.ApplicationMetadataMessage_USER_STICKER_PACK:
+		return m.handleUserStickerPackProtobuf(messageState, protoBytes, msg, filter)
+        
+           case protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_PROPOSAL:
+		return m.handleSafeTransactionProposalProtobuf(messageState, protoBytes, msg, filter)
+        
 	default:
 		m.logger.Info("protobuf type not found", zap.String("type", string(msg.ApplicationLayer.Type)))
                 return errors.New("protobuf type not found")
@@ -1896,3 +1905,57 @@ func (m *Messenger) handleCommunitySharedAddressesResponseProtobuf(messageState
 }
 
 
+func (m *Messenger) handleCommunityScheduledEventRsvpProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling CommunityScheduledEventRsvp")
+	
+
+	
+	p := &protobuf.CommunityScheduledEventRsvp{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleCommunityScheduledEventRsvp(messageState, p, msg)
+	
+}
+
+
+func (m *Messenger) handleUserStickerPackProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling UserStickerPack")
+	
+
+	
+	p := &protobuf.UserStickerPack{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleUserStickerPack(messageState, p, msg, filter)
+	
+}
+
+
+func (m *Messenger) handleSafeTransactionProposalProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling SafeTransactionProposal")
+	
+
+	
+	p := &protobuf.SafeTransactionProposal{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleSafeTransactionProposal(messageState, p, msg, filter)
+	
+}
+
+
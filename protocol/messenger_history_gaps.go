@@ -0,0 +1,359 @@
+package protocol
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+// maxHistoryGapDuration caps how far back the missing messages of a hole are searched for
+const maxHistoryGapDuration = oneDayDuration
+
+func historyGapMessageID(chatID string, messageID string) string {
+	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s-gap-%s", chatID, messageID))))
+}
+
+// missingMessage describes the message revealed missing by a received message
+type missingMessage int
+
+const (
+	noMissingMessage missingMessage = iota
+	// missingRepliedMessage is a message replied to that we don't have
+	missingRepliedMessage
+	// missingPreviousMessage is a message whose clock bumped the clock of the received message.
+	// A clock is only ahead of the timestamp of its message when the sender saw a message with
+	// the previous clock value, see `Chat.NextClockAndTimestamp`.
+	missingPreviousMessage
+)
+
+// findMissingMessages returns the messages of a chat revealing a hole in its timeline, by message ID.
+// The timeline is queried once for all the messages.
+func (m *Messenger) findMissingMessages(chatID string, messages []*common.Message) (map[string]missingMessage, error) {
+	var clocks []uint64
+	var repliedIDs []string
+	for _, message := range messages {
+		if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_GAP || message.From == common.PubkeyToHex(&m.identity.PublicKey) {
+			continue
+		}
+		if message.Clock > message.Timestamp {
+			clocks = append(clocks, message.Clock-1)
+		}
+		if message.ResponseTo != "" {
+			repliedIDs = append(repliedIDs, message.ResponseTo)
+		}
+	}
+
+	result := make(map[string]missingMessage)
+	if len(clocks) == 0 && len(repliedIDs) == 0 {
+		return result, nil
+	}
+
+	timelineClocks, err := m.persistence.timelineClocks(chatID, clocks)
+	if err != nil {
+		return nil, err
+	}
+	repliedExist, err := m.persistence.MessagesExist(repliedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, message := range messages {
+		if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_GAP || message.From == common.PubkeyToHex(&m.identity.PublicKey) {
+			continue
+		}
+		if message.Clock > message.Timestamp && !timelineClocks[message.Clock-1] {
+			result[message.ID] = missingPreviousMessage
+		} else if message.ResponseTo != "" && !repliedExist[message.ResponseTo] {
+			result[message.ID] = missingRepliedMessage
+		}
+	}
+	return result, nil
+}
+
+// detectChatHistoryGaps returns gap messages for the holes revealed by messages of a chat.
+// Each hole spans from the previous message we have in the chat up to the message revealing it.
+func (m *Messenger) detectChatHistoryGaps(chat *Chat, messages []*common.Message) ([]*common.Message, error) {
+	if chat.SyncedFrom == 0 {
+		return nil, nil
+	}
+
+	// Messages older than the synced range are regular history, not holes
+	var synced []*common.Message
+	for _, message := range messages {
+		if uint32(message.WhisperTimestamp/1000) >= chat.SyncedFrom {
+			synced = append(synced, message)
+		}
+	}
+	if len(synced) == 0 {
+		return nil, nil
+	}
+
+	missing, err := m.findMissingMessages(chat.ID, synced)
+	if err != nil || len(missing) == 0 {
+		return nil, err
+	}
+
+	var candidates []*common.Message
+	var gapIDs []string
+	var clocks []uint64
+	for _, message := range synced {
+		if _, ok := missing[message.ID]; !ok {
+			continue
+		}
+		candidates = append(candidates, message)
+		gapIDs = append(gapIDs, historyGapMessageID(chat.ID, message.ID))
+		clocks = append(clocks, message.Clock)
+	}
+
+	gapsExist, err := m.persistence.MessagesExist(gapIDs)
+	if err != nil {
+		return nil, err
+	}
+	previousTimestamps, err := m.persistence.previousMessagesWhisperTimestamps(chat.ID, clocks)
+	if err != nil {
+		return nil, err
+	}
+
+	var gaps []*common.Message
+	for i, message := range candidates {
+		if gapsExist[gapIDs[i]] {
+			continue
+		}
+
+		sentAt := uint32(message.WhisperTimestamp / 1000)
+		to := sentAt
+		if missing[message.ID] == missingPreviousMessage {
+			// The clock of the missing message is at least its timestamp
+			if previousClock := uint32((message.Clock - 1) / 1000); previousClock < to {
+				to = previousClock
+			}
+		}
+		to += tolerance
+
+		from := chat.SyncedFrom
+		if maxFrom := sentAt - uint32(maxHistoryGapDuration.Seconds()); sentAt > uint32(maxHistoryGapDuration.Seconds()) && maxFrom > from {
+			from = maxFrom
+		}
+		previousTimestamp, ok := previousTimestamps[message.Clock]
+		if !ok && missing[message.ID] == missingRepliedMessage {
+			// Nothing tells where the replied message is
+			continue
+		}
+		if previous := uint32(previousTimestamp / 1000); ok && previous > from+tolerance {
+			from = previous - tolerance
+		}
+		if from >= to {
+			continue
+		}
+
+		timestamp := m.getTimesource().GetCurrentTime()
+		gaps = append(gaps, &common.Message{
+			ChatMessage: &protobuf.ChatMessage{
+				ChatId:      chat.ID,
+				Text:        "Gap message",
+				MessageType: protobuf.MessageType_SYSTEM_MESSAGE_GAP,
+				ContentType: protobuf.ChatMessage_SYSTEM_MESSAGE_GAP,
+				Clock:       message.Clock - 1,
+				Timestamp:   timestamp,
+			},
+			GapParameters: &common.GapParameters{
+				From: from,
+				To:   to,
+			},
+			From:             common.PubkeyToHex(&m.identity.PublicKey),
+			WhisperTimestamp: timestamp,
+			LocalChatID:      chat.ID,
+			Seen:             true,
+			ID:               gapIDs[i],
+		})
+	}
+	return gaps, nil
+}
+
+// isDetectedHistoryGap returns whether the gap was created by detectHistoryGap for the message
+// following it, rather than for a range of history that wasn't fetched
+func (m *Messenger) isDetectedHistoryGap(chatID string, gap *common.Message) (bool, error) {
+	ids, err := m.persistence.messageIDsByClock(chatID, gap.Clock+1)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if historyGapMessageID(chatID, id) == gap.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// filledHistoryGaps returns the IDs of the gaps filled once their range has been fetched.
+// A range gap is filled by the fetch itself, a detected gap once its missing messages are retrieved.
+func (m *Messenger) filledHistoryGaps(chatID string, gaps []*common.Message) ([]string, error) {
+	var filled []string
+	for _, gap := range gaps {
+		detected, err := m.isDetectedHistoryGap(chatID, gap)
+		if err != nil {
+			return nil, err
+		}
+		if detected {
+			resolved, err := m.historyGapResolved(chatID, gap.GapParameters)
+			if err != nil {
+				return nil, err
+			}
+			if !resolved {
+				continue
+			}
+		}
+		filled = append(filled, gap.ID)
+	}
+	return filled, nil
+}
+
+// historyGapResolved returns whether the messages missing in the range of a gap have been retrieved
+func (m *Messenger) historyGapResolved(chatID string, gap *common.GapParameters) (bool, error) {
+	messages, err := m.persistence.MessagesByChatIDAndWhisperTimestamp(chatID, uint64(gap.From)*1000, uint64(gap.To+tolerance)*1000)
+	if err != nil {
+		return false, err
+	}
+	missing, err := m.findMissingMessages(chatID, messages)
+	if err != nil {
+		return false, err
+	}
+	return len(missing) == 0, nil
+}
+
+// detectHistoryGaps looks for holes revealed by received messages. Holes are filled with targeted
+// store queries when a storenode is available, gaps are only shown for the holes that remain.
+func (m *Messenger) detectHistoryGaps(messages []*common.Message, response *MessengerResponse) {
+	messagesByChat := make(map[string][]*common.Message)
+	for _, message := range messages {
+		messagesByChat[message.LocalChatID] = append(messagesByChat[message.LocalChatID], message)
+	}
+
+	gapsByChat := make(map[string][]*common.Message)
+	for chatID, chatMessages := range messagesByChat {
+		chat, ok := m.allChats.Load(chatID)
+		if !ok {
+			continue
+		}
+		gaps, err := m.detectChatHistoryGaps(chat, chatMessages)
+		if err != nil {
+			m.logger.Error("failed to detect history gaps", zap.String("chatID", chatID), zap.Error(err))
+			continue
+		}
+		for _, gap := range gaps {
+			// Gaps being backfilled aren't saved yet, they must not be backfilled twice
+			if _, inFlight := m.historyGapsInFlight.LoadOrStore(gap.ID, struct{}{}); inFlight {
+				continue
+			}
+			gapsByChat[chatID] = append(gapsByChat[chatID], gap)
+		}
+	}
+
+	for chatID, gaps := range gapsByChat {
+		chat, _ := m.allChats.Load(chatID)
+		if shouldSync, err := m.shouldSync(); err != nil || !shouldSync || m.getActiveMailserver(chat.CommunityID) == nil {
+			m.saveHistoryGaps(gaps, response)
+			m.releaseHistoryGaps(gaps)
+			continue
+		}
+
+		m.shutdownWaitGroup.Add(1)
+		go func(chat *Chat, gaps []*common.Message) {
+			defer m.shutdownWaitGroup.Done()
+			defer m.releaseHistoryGaps(gaps)
+			m.backfillHistoryGaps(chat, gaps)
+		}(chat, gaps)
+	}
+}
+
+// releaseHistoryGaps lets the gaps be detected again, once they have been saved or resolved
+func (m *Messenger) releaseHistoryGaps(gaps []*common.Message) {
+	for _, gap := range gaps {
+		m.historyGapsInFlight.Delete(gap.ID)
+	}
+}
+
+func (m *Messenger) saveHistoryGaps(gaps []*common.Message, response *MessengerResponse) {
+	if len(gaps) == 0 {
+		return
+	}
+	if err := m.persistence.SaveMessages(gaps); err != nil {
+		m.logger.Error("failed to save history gaps", zap.Error(err))
+		return
+	}
+	response.AddMessages(gaps)
+}
+
+// fetchHistoryGaps queries the storenode of a chat for the range covered by gaps
+// and processes the envelopes retrieved
+func (m *Messenger) fetchHistoryGaps(chat *Chat, gaps []*common.GapParameters) error {
+	pubsubTopic, topics, err := m.topicsForChat(chat.ID)
+	if err != nil {
+		return err
+	}
+
+	var lowestFrom, highestTo uint32
+	for _, gap := range gaps {
+		if lowestFrom == 0 || lowestFrom > gap.From {
+			lowestFrom = gap.From
+		}
+		if highestTo < gap.To {
+			highestTo = gap.To
+		}
+	}
+
+	batch := MailserverBatch{
+		ChatIDs:     []string{chat.ID},
+		To:          highestTo,
+		From:        lowestFrom,
+		PubsubTopic: pubsubTopic,
+		Topics:      topics,
+	}
+
+	ms := m.getActiveMailserver(chat.CommunityID)
+	_, err = m.performMailserverRequest(ms, func(ms mailservers.Mailserver) (*MessengerResponse, error) {
+		return nil, m.processMailserverBatch(ms, batch)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Handle the retrieved envelopes right away to know which holes have been filled
+	m.ProcessAllMessages()
+	return nil
+}
+
+func (m *Messenger) backfillHistoryGaps(chat *Chat, gaps []*common.Message) {
+	logger := m.logger.With(zap.String("chatID", chat.ID))
+
+	gapsParameters := make([]*common.GapParameters, 0, len(gaps))
+	for _, gap := range gaps {
+		gapsParameters = append(gapsParameters, gap.GapParameters)
+	}
+	if err := m.fetchHistoryGaps(chat, gapsParameters); err != nil {
+		logger.Warn("failed to backfill history gaps", zap.Error(err))
+	}
+
+	var unresolved []*common.Message
+	for _, gap := range gaps {
+		resolved, err := m.historyGapResolved(chat.ID, gap.GapParameters)
+		if err != nil {
+			logger.Error("failed to check history gap", zap.Error(err))
+			continue
+		}
+		if !resolved {
+			unresolved = append(unresolved, gap)
+		}
+	}
+	logger.Debug("backfilled history gaps", zap.Int("gaps", len(gaps)), zap.Int("unresolved", len(unresolved)))
+
+	response := &MessengerResponse{}
+	m.saveHistoryGaps(unresolved, response)
+	m.PublishMessengerResponse(response)
+}
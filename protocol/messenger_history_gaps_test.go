@@ -0,0 +1,223 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestMessengerHistoryGapsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerHistoryGapsSuite))
+}
+
+type MessengerHistoryGapsSuite struct {
+	MessengerBaseTestSuite
+
+	chat   *Chat
+	sender string
+	now    time.Time
+}
+
+func (s *MessengerHistoryGapsSuite) SetupTest() {
+	s.MessengerBaseTestSuite.SetupTest()
+
+	s.now = time.Now()
+	s.chat = CreatePublicChat("history-gaps", s.m.transport)
+	s.chat.SyncedFrom = uint32(s.now.Add(-2 * time.Hour).Unix())
+	s.Require().NoError(s.m.SaveChat(s.chat))
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.sender = types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+}
+
+func (s *MessengerHistoryGapsSuite) receivedMessage(id string, sentAt time.Time, clock uint64) *common.Message {
+	message := buildTestMessage(*s.chat)
+	message.ID = id
+	message.From = s.sender
+	message.Timestamp = uint64(sentAt.UnixMilli())
+	message.WhisperTimestamp = uint64(sentAt.UnixMilli())
+	message.Clock = clock
+	return message
+}
+
+// detectHistoryGap returns the gap detected for a single message, nil if there is none
+func (s *MessengerHistoryGapsSuite) detectHistoryGap(message *common.Message) (*common.Message, error) {
+	gaps, err := s.m.detectChatHistoryGaps(s.chat, []*common.Message{message})
+	if err != nil || len(gaps) == 0 {
+		return nil, err
+	}
+	s.Require().Len(gaps, 1)
+	return gaps[0], nil
+}
+
+func (s *MessengerHistoryGapsSuite) TestMissingPreviousMessage() {
+	previous := s.receivedMessage("0x01", s.now.Add(-time.Hour), uint64(s.now.Add(-time.Hour).UnixMilli()))
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{previous}))
+
+	// The clock of the message is ahead of its timestamp, the sender saw a message we don't have
+	sentAt := s.now.Add(-time.Minute)
+	missingClock := uint64(sentAt.Add(10 * time.Minute).UnixMilli())
+	message := s.receivedMessage("0x02", sentAt, missingClock+1)
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{message}))
+
+	gap, err := s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().NotNil(gap)
+	s.Require().Equal(protobuf.ChatMessage_SYSTEM_MESSAGE_GAP, gap.ContentType)
+	s.Require().Equal(missingClock, gap.Clock)
+	s.Require().Equal(uint32(previous.WhisperTimestamp/1000)-tolerance, gap.GapParameters.From)
+	s.Require().Equal(uint32(sentAt.Unix())+tolerance, gap.GapParameters.To)
+
+	// No storenode, the gap is shown right away
+	response := &MessengerResponse{}
+	s.m.detectHistoryGaps([]*common.Message{message}, response)
+	s.Require().Len(response.Messages(), 1)
+	s.Require().Equal(gap.ID, response.Messages()[0].ID)
+
+	resolved, err := s.m.historyGapResolved(s.chat.ID, gap.GapParameters)
+	s.Require().NoError(err)
+	s.Require().False(resolved)
+
+	// A gap is only created once
+	gap, err = s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().Nil(gap)
+
+	// The missing message is retrieved, its sender's time is ahead
+	missing := s.receivedMessage("0x03", sentAt.Add(-10*time.Minute), missingClock)
+	missing.Timestamp = missingClock
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{missing}))
+
+	resolved, err = s.m.historyGapResolved(s.chat.ID, response.Messages()[0].GapParameters)
+	s.Require().NoError(err)
+	s.Require().True(resolved)
+}
+
+func (s *MessengerHistoryGapsSuite) TestMissingRepliedMessage() {
+	sentAt := s.now.Add(-time.Minute)
+	message := s.receivedMessage("0x02", sentAt, uint64(sentAt.UnixMilli()))
+	message.ResponseTo = "0x01"
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{message}))
+
+	// Nothing tells where the replied message is
+	gap, err := s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().Nil(gap)
+
+	previous := s.receivedMessage("0x00", s.now.Add(-time.Hour), uint64(s.now.Add(-time.Hour).UnixMilli()))
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{previous}))
+
+	gap, err = s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().NotNil(gap)
+	s.Require().Equal(uint32(previous.WhisperTimestamp/1000)-tolerance, gap.GapParameters.From)
+	s.Require().Equal(uint32(message.WhisperTimestamp/1000)+tolerance, gap.GapParameters.To)
+}
+
+func (s *MessengerHistoryGapsSuite) TestNoGap() {
+	sentAt := s.now.Add(-time.Minute)
+	message := s.receivedMessage("0x01", sentAt, uint64(sentAt.UnixMilli()))
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{message}))
+
+	gap, err := s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().Nil(gap)
+
+	// Messages older than the synced range are not gaps
+	old := s.receivedMessage("0x02", s.now.Add(-3*time.Hour), uint64(s.now.UnixMilli()))
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{old}))
+
+	gap, err = s.detectHistoryGap(old)
+	s.Require().NoError(err)
+	s.Require().Nil(gap)
+}
+
+// missingPreviousMessageGap saves a message whose clock reveals a missing message and returns the gap detected for it
+func (s *MessengerHistoryGapsSuite) missingPreviousMessageGap() (*common.Message, *common.Message) {
+	previous := s.receivedMessage("0x01", s.now.Add(-time.Hour), uint64(s.now.Add(-time.Hour).UnixMilli()))
+	sentAt := s.now.Add(-time.Minute)
+	message := s.receivedMessage("0x02", sentAt, uint64(sentAt.Add(10*time.Minute).UnixMilli())+1)
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{previous, message}))
+
+	gap, err := s.detectHistoryGap(message)
+	s.Require().NoError(err)
+	s.Require().NotNil(gap)
+	return message, gap
+}
+
+func (s *MessengerHistoryGapsSuite) TestFilledHistoryGaps() {
+	message, gap := s.missingPreviousMessageGap()
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{gap}))
+
+	s.chat.SyncedTo = uint32(s.now.Add(-30 * time.Minute).Unix())
+	rangeGap, err := s.m.calculateGapForChat(s.chat, uint32(s.now.Unix()))
+	s.Require().NoError(err)
+	s.Require().NotNil(rangeGap)
+
+	detected, err := s.m.isDetectedHistoryGap(s.chat.ID, gap)
+	s.Require().NoError(err)
+	s.Require().True(detected)
+	detected, err = s.m.isDetectedHistoryGap(s.chat.ID, rangeGap)
+	s.Require().NoError(err)
+	s.Require().False(detected)
+
+	// A range gap is filled by fetching its range, a detected gap only once its message is retrieved
+	filled, err := s.m.filledHistoryGaps(s.chat.ID, []*common.Message{gap, rangeGap})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{rangeGap.ID}, filled)
+
+	missing := s.receivedMessage("0x03", s.now.Add(-11*time.Minute), message.Clock-1)
+	missing.Timestamp = missing.Clock
+	s.Require().NoError(s.m.persistence.SaveMessages([]*common.Message{missing}))
+
+	filled, err = s.m.filledHistoryGaps(s.chat.ID, []*common.Message{gap, rangeGap})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{gap.ID, rangeGap.ID}, filled)
+}
+
+func (s *MessengerHistoryGapsSuite) TestDetectHistoryGapsSkipsGapsInFlight() {
+	message, gap := s.missingPreviousMessageGap()
+
+	// The gap is being backfilled, it isn't saved yet
+	s.m.historyGapsInFlight.Store(gap.ID, struct{}{})
+	response := &MessengerResponse{}
+	s.m.detectHistoryGaps([]*common.Message{message}, response)
+	s.Require().Empty(response.Messages())
+
+	s.m.releaseHistoryGaps([]*common.Message{gap})
+	s.m.detectHistoryGaps([]*common.Message{message}, response)
+	s.Require().Len(response.Messages(), 1)
+	s.Require().Equal(gap.ID, response.Messages()[0].ID)
+
+	_, inFlight := s.m.historyGapsInFlight.Load(gap.ID)
+	s.Require().False(inFlight)
+}
+
+func (s *MessengerHistoryGapsSuite) TestDetectChatHistoryGapsBatch() {
+	previous := s.receivedMessage("0x01", s.now.Add(-time.Hour), uint64(s.now.Add(-time.Hour).UnixMilli()))
+
+	sentAt := s.now.Add(-time.Minute)
+	ahead := s.receivedMessage("0x02", sentAt, uint64(sentAt.Add(10*time.Minute).UnixMilli())+1)
+	reply := s.receivedMessage("0x03", sentAt.Add(time.Second), ahead.Clock+1)
+	reply.Timestamp = reply.Clock
+	reply.ResponseTo = "0x04"
+	regular := s.receivedMessage("0x05", sentAt.Add(2*time.Second), reply.Clock+1)
+	regular.Timestamp = regular.Clock
+	messages := []*common.Message{ahead, reply, regular}
+	s.Require().NoError(s.m.persistence.SaveMessages(append([]*common.Message{previous}, messages...)))
+
+	gaps, err := s.m.detectChatHistoryGaps(s.chat, messages)
+	s.Require().NoError(err)
+	s.Require().Len(gaps, 2)
+	s.Require().Equal(historyGapMessageID(s.chat.ID, ahead.ID), gaps[0].ID)
+	s.Require().Equal(historyGapMessageID(s.chat.ID, reply.ID), gaps[1].ID)
+	s.Require().Equal(uint32(previous.WhisperTimestamp/1000)-tolerance, gaps[0].GapParameters.From)
+	s.Require().Equal(uint32(ahead.WhisperTimestamp/1000)-tolerance, gaps[1].GapParameters.From)
+}
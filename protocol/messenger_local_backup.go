@@ -0,0 +1,447 @@
+package protocol
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+const (
+	localBackupVersion          = 1
+	localBackupSaltLength       = 16
+	localBackupKeyLength        = 32
+	localBackupMessagesPerBatch = 500
+)
+
+var (
+	ErrUnsupportedLocalBackupVersion = errors.New("unsupported local backup version")
+	ErrInvalidLocalBackupPassphrase  = errors.New("invalid local backup passphrase")
+)
+
+// ExportLocalBackup writes the data we back up over waku, along with the message history,
+// to a file encrypted with the given passphrase
+func (m *Messenger) ExportLocalBackup(ctx context.Context, request *requests.ExportLocalBackup) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	localBackup, err := m.prepareLocalBackup(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := proto.Marshal(localBackup)
+	if err != nil {
+		return err
+	}
+
+	file, err := encryptLocalBackup(payload, request.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(request.FilePath, file, 0600)
+}
+
+// ImportLocalBackup merges a local backup file into the current profile.
+// Data we already have is kept unless the backup holds a newer version of it,
+// so that importing the same backup more than once has no further effect.
+func (m *Messenger) ImportLocalBackup(request *requests.ImportLocalBackup) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	file, err := os.ReadFile(request.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := decryptLocalBackup(file, request.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	localBackup := &protobuf.LocalBackup{}
+	err = proto.Unmarshal(payload, localBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	state := m.buildMessageState()
+	for _, backup := range localBackup.Backups {
+		for _, err := range m.applyBackup(state, backup) {
+			m.logger.Warn("failed to import backup", zap.Error(err))
+		}
+	}
+
+	err = m.importBackedUpMessages(state, localBackup.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.importBackedUpPinMessages(localBackup.PinMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.importBackedUpEmojiReactions(localBackup.EmojiReactions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.importBackedUpActivityCenterNotifications(localBackup.ActivityCenterNotifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.saveDataAndPrepareResponse(state)
+}
+
+func (m *Messenger) prepareLocalBackup(ctx context.Context) (*protobuf.LocalBackup, error) {
+	clock, _ := m.getLastClockWithRelatedChat()
+	backups, err := m.prepareBackupMessages(ctx, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := m.backupMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	pinMessages, err := m.persistence.AllPinMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	emojiReactions, err := m.persistence.AllEmojiReactions()
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := m.backupActivityCenterNotifications()
+	if err != nil {
+		return nil, err
+	}
+
+	localBackup := &protobuf.LocalBackup{
+		Clock:                       clock,
+		Backups:                     backups,
+		Messages:                    messages,
+		ActivityCenterNotifications: notifications,
+	}
+
+	for _, pinMessage := range pinMessages {
+		localBackup.PinMessages = append(localBackup.PinMessages, &protobuf.BackedUpPinMessage{
+			Id:               pinMessage.ID,
+			LocalChatId:      pinMessage.LocalChatID,
+			From:             pinMessage.From,
+			WhisperTimestamp: pinMessage.WhisperTimestamp,
+			Message:          pinMessage.PinMessage,
+		})
+	}
+
+	for _, emojiReaction := range emojiReactions {
+		localBackup.EmojiReactions = append(localBackup.EmojiReactions, &protobuf.BackedUpEmojiReaction{
+			LocalChatId: emojiReaction.LocalChatID,
+			From:        emojiReaction.From,
+			Reaction:    emojiReaction.EmojiReaction,
+		})
+	}
+
+	return localBackup, nil
+}
+
+func (m *Messenger) backupMessages() ([]*protobuf.BackedUpMessage, error) {
+	ids, err := m.persistence.MessageIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*protobuf.BackedUpMessage
+	for i := 0; i < len(ids); i += localBackupMessagesPerBatch {
+		j := i + localBackupMessagesPerBatch
+		if j > len(ids) {
+			j = len(ids)
+		}
+
+		messages, err := m.persistence.MessagesByIDs(ids[i:j])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			result = append(result, &protobuf.BackedUpMessage{
+				Id:                       message.ID,
+				LocalChatId:              message.LocalChatID,
+				From:                     message.From,
+				WhisperTimestamp:         message.WhisperTimestamp,
+				Message:                  message.ChatMessage,
+				Seen:                     message.Seen,
+				OutgoingStatus:           message.OutgoingStatus,
+				Replied:                  message.Replied,
+				EditedAt:                 message.EditedAt,
+				Deleted:                  message.Deleted,
+				DeletedBy:                message.DeletedBy,
+				DeletedForMe:             message.DeletedForMe,
+				CommunityId:              message.CommunityID,
+				ContactRequestState:      int32(message.ContactRequestState),
+				ContactVerificationState: int32(message.ContactVerificationState),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func (m *Messenger) backupActivityCenterNotifications() ([][]byte, error) {
+	ids, err := m.persistence.GetActivityCenterNotificationIds()
+	if err != nil {
+		return nil, err
+	}
+
+	notificationIDs := make([]types.HexBytes, 0, len(ids))
+	for _, id := range ids {
+		notificationIDs = append(notificationIDs, id)
+	}
+
+	notifications, err := m.persistence.GetActivityCenterNotificationsByID(notificationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var result [][]byte
+	for _, notification := range notifications {
+		encodedNotification, err := json.Marshal(notification)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encodedNotification)
+	}
+
+	return result, nil
+}
+
+// importBackedUpMessages saves the messages we don't have yet
+func (m *Messenger) importBackedUpMessages(state *ReceivedMessageState, backedUpMessages []*protobuf.BackedUpMessage) error {
+	latestMessages := make(map[string]*common.Message)
+
+	for i := 0; i < len(backedUpMessages); i += localBackupMessagesPerBatch {
+		j := i + localBackupMessagesPerBatch
+		if j > len(backedUpMessages) {
+			j = len(backedUpMessages)
+		}
+		batch := backedUpMessages[i:j]
+
+		ids := make([]string, 0, len(batch))
+		for _, backedUpMessage := range batch {
+			ids = append(ids, backedUpMessage.Id)
+		}
+
+		existing, err := m.persistence.MessagesExist(ids)
+		if err != nil {
+			return err
+		}
+
+		var messages []*common.Message
+		for _, backedUpMessage := range batch {
+			if existing[backedUpMessage.Id] || backedUpMessage.Message == nil {
+				continue
+			}
+
+			message := &common.Message{
+				ChatMessage:              backedUpMessage.Message,
+				ID:                       backedUpMessage.Id,
+				LocalChatID:              backedUpMessage.LocalChatId,
+				From:                     backedUpMessage.From,
+				WhisperTimestamp:         backedUpMessage.WhisperTimestamp,
+				Seen:                     backedUpMessage.Seen,
+				OutgoingStatus:           backedUpMessage.OutgoingStatus,
+				Replied:                  backedUpMessage.Replied,
+				EditedAt:                 backedUpMessage.EditedAt,
+				Deleted:                  backedUpMessage.Deleted,
+				DeletedBy:                backedUpMessage.DeletedBy,
+				DeletedForMe:             backedUpMessage.DeletedForMe,
+				CommunityID:              backedUpMessage.CommunityId,
+				ContactRequestState:      common.ContactRequestState(backedUpMessage.ContactRequestState),
+				ContactVerificationState: common.ContactVerificationState(backedUpMessage.ContactVerificationState),
+			}
+
+			err = message.PrepareContent(common.PubkeyToHex(&m.identity.PublicKey))
+			if err != nil {
+				m.logger.Warn("failed to prepare backed up message", zap.String("id", message.ID), zap.Error(err))
+				continue
+			}
+
+			messages = append(messages, message)
+
+			latest, ok := latestMessages[message.LocalChatID]
+			if !ok || latest.Clock < message.Clock {
+				latestMessages[message.LocalChatID] = message
+			}
+		}
+
+		if len(messages) == 0 {
+			continue
+		}
+
+		err = m.persistence.SaveMessages(messages)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Restored chats have no last message yet
+	for chatID, message := range latestMessages {
+		chat, ok := state.AllChats.Load(chatID)
+		if !ok || (chat.LastMessage != nil && chat.LastMessage.Clock >= message.Clock) {
+			continue
+		}
+
+		chat.LastMessage = message
+		err := m.saveChat(chat)
+		if err != nil {
+			return err
+		}
+		state.Response.AddChat(chat)
+	}
+
+	return nil
+}
+
+func (m *Messenger) importBackedUpPinMessages(backedUpPinMessages []*protobuf.BackedUpPinMessage) error {
+	pinMessages := make([]*common.PinMessage, 0, len(backedUpPinMessages))
+	for _, backedUpPinMessage := range backedUpPinMessages {
+		if backedUpPinMessage.Message == nil {
+			continue
+		}
+
+		pinMessages = append(pinMessages, &common.PinMessage{
+			PinMessage:       backedUpPinMessage.Message,
+			ID:               backedUpPinMessage.Id,
+			LocalChatID:      backedUpPinMessage.LocalChatId,
+			From:             backedUpPinMessage.From,
+			WhisperTimestamp: backedUpPinMessage.WhisperTimestamp,
+		})
+	}
+
+	// Only pin messages more recent than ours are saved
+	return m.persistence.SavePinMessages(pinMessages)
+}
+
+func (m *Messenger) importBackedUpEmojiReactions(backedUpEmojiReactions []*protobuf.BackedUpEmojiReaction) error {
+	for _, backedUpEmojiReaction := range backedUpEmojiReactions {
+		if backedUpEmojiReaction.Reaction == nil {
+			continue
+		}
+
+		emojiReaction := &EmojiReaction{
+			EmojiReaction: backedUpEmojiReaction.Reaction,
+			From:          backedUpEmojiReaction.From,
+			LocalChatID:   backedUpEmojiReaction.LocalChatId,
+		}
+
+		existing, err := m.persistence.EmojiReactionByID(emojiReaction.ID())
+		if err != nil && err != common.ErrRecordNotFound {
+			return err
+		}
+		if existing != nil && existing.Clock >= emojiReaction.Clock {
+			continue
+		}
+
+		err = m.persistence.SaveEmojiReaction(emojiReaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) importBackedUpActivityCenterNotifications(encodedNotifications [][]byte) error {
+	for _, encodedNotification := range encodedNotifications {
+		notification := &ActivityCenterNotification{}
+		err := json.Unmarshal(encodedNotification, notification)
+		if err != nil {
+			return err
+		}
+
+		existing, err := m.persistence.GetActivityCenterNotificationByID(notification.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.UpdatedAt >= notification.UpdatedAt {
+			continue
+		}
+
+		_, err = m.persistence.SaveActivityCenterNotification(notification, false)
+		if err != nil {
+			m.logger.Warn("failed to import activity center notification", zap.String("id", notification.ID.String()), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func encryptLocalBackup(payload []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, localBackupSaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := localBackupKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedBackup, err := common.Encrypt(payload, key, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&protobuf.LocalBackupFile{
+		Version:         localBackupVersion,
+		Salt:            salt,
+		EncryptedBackup: encryptedBackup,
+	})
+}
+
+func decryptLocalBackup(file []byte, passphrase string) ([]byte, error) {
+	localBackupFile := &protobuf.LocalBackupFile{}
+	err := proto.Unmarshal(file, localBackupFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if localBackupFile.Version == 0 || localBackupFile.Version > localBackupVersion {
+		return nil, ErrUnsupportedLocalBackupVersion
+	}
+
+	key, err := localBackupKey(passphrase, localBackupFile.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := common.Decrypt(localBackupFile.EncryptedBackup, key)
+	if err != nil {
+		return nil, ErrInvalidLocalBackupPassphrase
+	}
+
+	return payload, nil
+}
+
+func localBackupKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, localBackupKeyLength)
+}
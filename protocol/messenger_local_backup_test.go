@@ -0,0 +1,79 @@
+package protocol
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func TestMessengerLocalBackupSuite(t *testing.T) {
+	suite.Run(t, new(MessengerLocalBackupSuite))
+}
+
+type MessengerLocalBackupSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerLocalBackupSuite) TestExportImportLocalBackup() {
+	bob1 := s.m
+
+	bob2, err := newMessengerWithKey(s.shh, bob1.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, bob2)
+
+	contactKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	chat := CreateOneToOneChat("Their 1TO1", &contactKey.PublicKey, bob1.transport)
+	err = bob1.SaveChat(chat)
+	s.Require().NoError(err)
+
+	inputMessage := buildTestMessage(*chat)
+	_, err = bob1.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	pinMessage := common.NewPinMessage()
+	pinMessage.LocalChatID = chat.ID
+	pinMessage.MessageId = inputMessage.ID
+	pinMessage.Pinned = true
+	pinMessage.ChatId = chat.ID
+	_, err = bob1.SendPinMessage(context.Background(), pinMessage)
+	s.Require().NoError(err)
+
+	_, err = bob1.SendEmojiReaction(context.Background(), chat.ID, inputMessage.ID, protobuf.EmojiReaction_LOVE)
+	s.Require().NoError(err)
+
+	filePath := filepath.Join(s.T().TempDir(), "backup")
+	err = bob1.ExportLocalBackup(context.Background(), &requests.ExportLocalBackup{FilePath: filePath, Passphrase: "passphrase"})
+	s.Require().NoError(err)
+
+	_, err = bob2.ImportLocalBackup(&requests.ImportLocalBackup{FilePath: filePath, Passphrase: "wrong"})
+	s.Require().ErrorIs(err, ErrInvalidLocalBackupPassphrase)
+
+	// Importing twice must not duplicate anything
+	for i := 0; i < 2; i++ {
+		_, err = bob2.ImportLocalBackup(&requests.ImportLocalBackup{FilePath: filePath, Passphrase: "passphrase"})
+		s.Require().NoError(err)
+
+		messages, _, err := bob2.MessageByChatID(chat.ID, "", 10)
+		s.Require().NoError(err)
+		s.Require().Len(messages, 2)
+
+		pinnedMessages, _, err := bob2.PinnedMessageByChatID(chat.ID, "", 10)
+		s.Require().NoError(err)
+		s.Require().Len(pinnedMessages, 1)
+		s.Require().Equal(inputMessage.ID, pinnedMessages[0].Message.ID)
+
+		emojiReactions, err := bob2.EmojiReactionsByChatIDMessageID(chat.ID, inputMessage.ID)
+		s.Require().NoError(err)
+		s.Require().Len(emojiReactions, 1)
+		s.Require().Equal(protobuf.EmojiReaction_LOVE, emojiReactions[0].Type)
+	}
+}
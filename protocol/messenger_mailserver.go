@@ -138,6 +138,15 @@ func (m *Messenger) performMailserverRequest(ms *mailservers.Mailserver, fn func
 		// Increment failed requests
 		ms.FailedRequests++
 
+		// Fail over from an unhealthy community storenode to the fleet one
+		if m.communityStorenodes.IsCommunityStoreNode(ms.ID) && !m.storenodeScores.Healthy(ms.ID) && m.mailserverCycle.activeMailserver != nil {
+			m.logger.Info("community storenode is unhealthy, failing over to the fleet storenode",
+				zap.String("mailserverID", ms.ID),
+				zap.String("fleetMailserverID", m.mailserverCycle.activeMailserver.ID))
+			ms = m.mailserverCycle.activeMailserver
+			continue
+		}
+
 		// Change mailserver
 		if ms.FailedRequests >= mailserverMaxFailedRequests {
 			return nil, errors.New("too many failed requests")
@@ -922,7 +931,14 @@ func (m *Messenger) processMailserverBatch(ms mailservers.Mailserver, batch Mail
 		return err
 	}
 	logger := m.logger.With(zap.String("mailserverID", ms.ID))
-	return processMailserverBatch(m.ctx, m.transport, batch, mailserverID, logger, defaultStoreNodeRequestPageSize, nil, false)
+	requester := m.scoringRequester(ms.ID)
+	err = processMailserverBatch(m.ctx, requester, batch, mailserverID, logger, defaultStoreNodeRequestPageSize, nil, false)
+	if err != nil {
+		return err
+	}
+	// All pages were fetched, the result can be compared with other storenodes
+	requester.recordBatchEnvelopes(batch)
+	return nil
 }
 
 func (m *Messenger) processMailserverBatchWithOptions(ms mailservers.Mailserver, batch MailserverBatch, pageLimit uint32, shouldProcessNextPage func(int) (bool, uint32), processEnvelopes bool) error {
@@ -939,7 +955,7 @@ func (m *Messenger) processMailserverBatchWithOptions(ms mailservers.Mailserver,
 		return err
 	}
 	logger := m.logger.With(zap.String("mailserverID", ms.ID))
-	return processMailserverBatch(m.ctx, m.transport, batch, mailserverID, logger, pageLimit, shouldProcessNextPage, processEnvelopes)
+	return processMailserverBatch(m.ctx, m.scoringRequester(ms.ID), batch, mailserverID, logger, pageLimit, shouldProcessNextPage, processEnvelopes)
 }
 
 type MailserverBatch struct {
@@ -1014,6 +1030,8 @@ func (m *Messenger) SyncChatFromSyncedFrom(chatID string) (uint32, error) {
 	return from, nil
 }
 
+// FillGaps fetches the history missing in the given gaps. Range gaps are removed once fetched,
+// detected gaps whose missing messages are still unknown are kept so that they can be retried
 func (m *Messenger) FillGaps(chatID string, messageIDs []string) error {
 	messages, err := m.persistence.MessagesByIDs(messageIDs)
 	if err != nil {
@@ -1025,41 +1043,19 @@ func (m *Messenger) FillGaps(chatID string, messageIDs []string) error {
 		return errors.New("chat not existing")
 	}
 
-	pubsubTopic, topics, err := m.topicsForChat(chatID)
-	if err != nil {
-		return err
-	}
-
-	var lowestFrom, highestTo uint32
-
+	var gaps []*common.GapParameters
 	for _, message := range messages {
 		if message.GapParameters == nil {
 			return errors.New("can't sync non-gap message")
 		}
-
-		if lowestFrom == 0 || lowestFrom > message.GapParameters.From {
-			lowestFrom = message.GapParameters.From
-		}
-
-		if highestTo < message.GapParameters.To {
-			highestTo = message.GapParameters.To
-		}
-	}
-
-	batch := MailserverBatch{
-		ChatIDs:     []string{chatID},
-		To:          highestTo,
-		From:        lowestFrom,
-		PubsubTopic: pubsubTopic,
-		Topics:      topics,
+		gaps = append(gaps, message.GapParameters)
 	}
 
 	if m.config.messengerSignalsHandler != nil {
 		m.config.messengerSignalsHandler.HistoryRequestStarted(1)
 	}
 
-	ms := m.getActiveMailserver(chat.CommunityID)
-	err = m.processMailserverBatch(*ms, batch)
+	err = m.fetchHistoryGaps(chat, gaps)
 	if err != nil {
 		return err
 	}
@@ -1068,7 +1064,12 @@ func (m *Messenger) FillGaps(chatID string, messageIDs []string) error {
 		m.config.messengerSignalsHandler.HistoryRequestCompleted()
 	}
 
-	return m.persistence.DeleteMessages(messageIDs)
+	filled, err := m.filledHistoryGaps(chatID, messages)
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.DeleteMessages(filled)
 }
 
 func (m *Messenger) waitUntilP2PMessagesProcessed() { // nolint: unused
@@ -23,7 +23,6 @@ import (
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/protocol/storenodes"
 	"github.com/status-im/status-go/services/mailservers"
-	"github.com/status-im/status-go/signal"
 )
 
 const defaultBackoff = 10 * time.Second
@@ -34,6 +33,9 @@ const findNearestMailServer = !isAndroidEmulator
 const overrideDNS = runtime.GOOS == "android" || runtime.GOOS == "ios"
 const bootstrapDNS = "8.8.8.8:53"
 
+// minMailserverScore avoids dividing by a null score when ranking mailservers
+const minMailserverScore = 0.05
+
 func (m *Messenger) mailserversByFleet(fleet string) []mailservers.Mailserver {
 	return mailservers.DefaultMailserversByFleet(fleet)
 }
@@ -52,7 +54,7 @@ func (s byRTTMsAndCanConnectBefore) Less(i, j int) bool {
 	// Slightly inaccurate as time sensitive sorting, but it does not matter so much
 	now := time.Now()
 	if s[i].CanConnectAfter.Before(now) && s[j].CanConnectAfter.Before(now) {
-		return s[i].RTTMs < s[j].RTTMs
+		return s[i].rank() < s[j].rank()
 	}
 	return s[i].CanConnectAfter.Before(s[j].CanConnectAfter)
 }
@@ -150,7 +152,7 @@ func (m *Messenger) disconnectActiveMailserver(backoffDuration time.Duration) {
 	if err != nil {
 		m.logger.Error("failed to disconnect mailserver", zap.Error(err))
 	}
-	signal.SendMailserverChanged("", "")
+	m.signals().SendMailserverChanged("", "")
 }
 
 func (m *Messenger) cycleMailservers() {
@@ -239,6 +241,17 @@ type SortedMailserver struct {
 	Address         string
 	RTTMs           int
 	CanConnectAfter time.Time
+	// Score is the health score of the mailserver, from 0 to 1
+	Score float64
+}
+
+// rank weights the round trip time of a mailserver by its score, lower is better
+func (s SortedMailserver) rank() float64 {
+	score := s.Score
+	if score < minMailserverScore {
+		score = minMailserverScore
+	}
+	return float64(s.RTTMs) / score
 }
 
 func (m *Messenger) findNewMailserver() error {
@@ -322,6 +335,7 @@ func (m *Messenger) findNewMailserver() error {
 			sortedMailserver := SortedMailserver{
 				Address: address,
 				RTTMs:   *ping.RTTMs,
+				Score:   m.storenodeScores.Value(ms.ID),
 			}
 			m.mailPeersMutex.Lock()
 			pInfo, ok := m.mailserverCycle.peers[ms.ID]
@@ -331,13 +345,16 @@ func (m *Messenger) findNewMailserver() error {
 					continue // We can't connect to this node yet
 				}
 			}
+			if !m.storenodeScores.Healthy(ms.ID) {
+				continue // The node has been failing recently
+			}
 
 			sortedMailservers = append(sortedMailservers, sortedMailserver)
 
 		}
 		sort.Sort(byRTTMsAndCanConnectBefore(sortedMailservers))
 
-		// Picks a random mailserver amongs the ones with the lowest latency
+		// Picks a random mailserver amongs the ones with the lowest latency weighted by their score
 		// The pool size is 1/4 of the mailservers were pinged successfully
 		pSize := poolSize(len(sortedMailservers) - 1)
 		if pSize <= 0 {
@@ -400,7 +417,7 @@ func (m *Messenger) connectToMailserver(ms mailservers.Mailserver) error {
 	m.logger.Info("connecting to mailserver", zap.Any("peer", ms.ID))
 
 	m.mailserverCycle.activeMailserver = &ms
-	signal.SendMailserverChanged(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
+	m.signals().SendMailserverChanged(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
 
 	// Adding a peer and marking it as connected can't be executed sync in WakuV1, because
 	// There's a delay between requesting a peer being added, and a signal being
@@ -445,7 +462,7 @@ func (m *Messenger) connectToMailserver(ms mailservers.Mailserver) error {
 			m.mailserverCycle.activeMailserver.FailedRequests = 0
 			m.logger.Info("mailserver available", zap.String("address", m.mailserverCycle.activeMailserver.UniqueID()))
 			m.EmitMailserverAvailable()
-			signal.SendMailserverAvailable(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
+			m.signals().SendMailserverAvailable(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
 			peerID, err := m.mailserverCycle.activeMailserver.PeerID()
 			if err != nil {
 				m.logger.Error("could not decode the peer id of mailserver", zap.Error(err))
@@ -460,7 +477,7 @@ func (m *Messenger) connectToMailserver(ms mailservers.Mailserver) error {
 }
 
 // getActiveMailserver returns the active mailserver if a communityID is present then it'll return the mailserver
-// for that community if it has a mailserver setup otherwise it'll return the global mailserver
+// for that community if it has a healthy mailserver setup otherwise it'll return the global mailserver
 func (m *Messenger) getActiveMailserver(communityID ...string) *mailservers.Mailserver {
 	if len(communityID) == 0 || communityID[0] == "" {
 		return m.mailserverCycle.activeMailserver
@@ -473,6 +490,9 @@ func (m *Messenger) getActiveMailserver(communityID ...string) *mailservers.Mail
 		// if we don't find a specific mailserver for the community, we just use the regular mailserverCycle's one
 		return m.mailserverCycle.activeMailserver
 	}
+	if !m.communityStorenodeHealthy(communityID[0], ms.ID) {
+		return m.mailserverCycle.activeMailserver
+	}
 	return &ms
 }
 
@@ -594,7 +614,7 @@ func (m *Messenger) handleMailserverCycleEvent(connectedPeers []ConnectedPeer) e
 					m.mailserverCycle.activeMailserver.FailedRequests = 0
 					m.logger.Info("mailserver available", zap.String("address", connectedPeer.UniqueID))
 					m.EmitMailserverAvailable()
-					signal.SendMailserverAvailable(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
+					m.signals().SendMailserverAvailable(m.mailserverCycle.activeMailserver.Address, m.mailserverCycle.activeMailserver.ID)
 				}
 				// Query mailserver
 				if m.config.codeControlFlags.AutoRequestHistoricMessages {
@@ -610,7 +630,7 @@ func (m *Messenger) handleMailserverCycleEvent(connectedPeers []ConnectedPeer) e
 	if m.mailserverCycle.activeMailserver != nil {
 		if m.mailserverCycle.activeMailserver.FailedRequests >= mailserverMaxFailedRequests {
 			m.penalizeMailserver(m.mailserverCycle.activeMailserver.ID)
-			signal.SendMailserverNotWorking()
+			m.signals().SendMailserverNotWorking()
 			m.logger.Info("connecting too many failed requests")
 			m.mailserverCycle.activeMailserver.FailedRequests = 0
 
@@ -625,7 +645,7 @@ func (m *Messenger) handleMailserverCycleEvent(connectedPeers []ConnectedPeer) e
 			if pInfo.status != connected && pInfo.lastConnectionAttempt.Add(20*time.Second).Before(time.Now()) {
 				m.logger.Info("penalizing mailserver & disconnecting connecting", zap.String("id", m.mailserverCycle.activeMailserver.ID))
 
-				signal.SendMailserverNotWorking()
+				m.signals().SendMailserverNotWorking()
 				m.penalizeMailserver(m.mailserverCycle.activeMailserver.ID)
 				m.disconnectActiveMailserver(graylistBackoff)
 			}
@@ -783,7 +803,7 @@ func (m *Messenger) disconnectStorenodeIfRequired() error {
 	// Check whether we want to disconnect the active storenode
 	if m.mailserverCycle.activeMailserver.FailedRequests >= mailserverMaxFailedRequests {
 		m.penalizeMailserver(m.mailserverCycle.activeMailserver.ID)
-		signal.SendMailserverNotWorking()
+		m.signals().SendMailserverNotWorking()
 		m.logger.Info("too many failed requests", zap.String("storenode", m.mailserverCycle.activeMailserver.UniqueID()))
 		m.mailserverCycle.activeMailserver.FailedRequests = 0
 		return m.connectToNewMailserverAndWait()
@@ -240,6 +240,10 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		return nil, err
 	}
 
+	if deletedBy != "" && message.MessageType == protobuf.MessageType_COMMUNITY_CHAT {
+		m.recordCommunityModerationAction(chat.CommunityID, deletedBy, protobuf.CommunityModerationLogEntry_MESSAGE_DELETE, messageID, clock)
+	}
+
 	response := &MessengerResponse{}
 	for _, messageToDelete := range messagesToDelete {
 		messageToDelete.Deleted = true
@@ -23,26 +23,28 @@ func TestMessengerMessagesTrackingSuite(t *testing.T) {
 	suite.Run(t, new(MessengerMessagesTrackingSuite))
 }
 
-type EnvelopeSignalHandlerMock struct{}
+type EnvelopeSignalHandlerMock struct {
+	signals signal.Namespace
+}
 
 // EnvelopeSent triggered when envelope delivered atleast to 1 peer.
 func (h EnvelopeSignalHandlerMock) EnvelopeSent(identifiers [][]byte) {
-	signal.SendEnvelopeSent(identifiers)
+	h.signals.SendEnvelopeSent(identifiers)
 }
 
 // EnvelopeExpired triggered when envelope is expired but wasn't delivered to any peer.
 func (h EnvelopeSignalHandlerMock) EnvelopeExpired(identifiers [][]byte, err error) {
-	signal.SendEnvelopeExpired(identifiers, err)
+	h.signals.SendEnvelopeExpired(identifiers, err)
 }
 
 // MailServerRequestCompleted triggered when the mailserver sends a message to notify that the request has been completed
 func (h EnvelopeSignalHandlerMock) MailServerRequestCompleted(requestID types.Hash, lastEnvelopeHash types.Hash, cursor []byte, err error) {
-	signal.SendMailServerRequestCompleted(requestID, lastEnvelopeHash, cursor, err)
+	h.signals.SendMailServerRequestCompleted(requestID, lastEnvelopeHash, cursor, err)
 }
 
 // MailServerRequestExpired triggered when the mailserver request expires
 func (h EnvelopeSignalHandlerMock) MailServerRequestExpired(hash types.Hash) {
-	signal.SendMailServerRequestExpired(hash)
+	h.signals.SendMailServerRequestExpired(hash)
 }
 
 type EnvelopeEventsInterceptorMock struct {
@@ -24,6 +24,8 @@ import (
 	"github.com/status-im/status-go/protocol/verification"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/services/mailservers"
+	"github.com/status-im/status-go/services/stickers"
+	"github.com/status-im/status-go/services/wallet/safe"
 )
 
 type RemovedMessage struct {
@@ -93,6 +95,9 @@ type MessengerResponse struct {
 	ensUsernameDetails               []*ensservice.UsernameDetail
 	updatedProfileShowcaseContactIDs map[string]bool
 	seenAndUnseenMessages            map[string]*SeenUnseenMessages
+	scheduledEventRsvps              map[string]*communities.ScheduledEventRsvp
+	userStickerPacks                 map[string]*stickers.UserStickerPack
+	safeProposals                    map[string]*safe.Proposal
 }
 
 func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
@@ -141,6 +146,9 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		EnsUsernameDetails               []*ensservice.UsernameDetail            `json:"ensUsernameDetails,omitempty"`
 		UpdatedProfileShowcaseContactIDs []string                                `json:"updatedProfileShowcaseContactIDs,omitempty"`
 		SeenAndUnseenMessages            []*SeenUnseenMessages                   `json:"seenAndUnseenMessages,omitempty"`
+		ScheduledEventRsvps              []*communities.ScheduledEventRsvp       `json:"scheduledEventRsvps,omitempty"`
+		UserStickerPacks                 []*stickers.UserStickerPack             `json:"userStickerPacks,omitempty"`
+		SafeProposals                    []*safe.Proposal                        `json:"safeProposals,omitempty"`
 	}{
 		Contacts:                r.Contacts,
 		Installations:           r.Installations(),
@@ -182,6 +190,9 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		EnsUsernameDetails:               r.EnsUsernameDetails(),
 		UpdatedProfileShowcaseContactIDs: r.GetUpdatedProfileShowcaseContactIDs(),
 		SeenAndUnseenMessages:            r.GetSeenAndUnseenMessages(),
+		ScheduledEventRsvps:              r.ScheduledEventRsvps(),
+		UserStickerPacks:                 r.UserStickerPacks(),
+		SafeProposals:                    r.SafeProposals(),
 	}
 
 	responseItem.TrustStatus = r.TrustStatus()
@@ -334,6 +345,9 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.savedAddresses)+
 		len(r.updatedProfileShowcaseContactIDs)+
 		len(r.seenAndUnseenMessages)+
+		len(r.scheduledEventRsvps)+
+		len(r.userStickerPacks)+
+		len(r.safeProposals)+
 		len(r.ensUsernameDetails) == 0 &&
 		r.currentStatus == nil &&
 		r.activityCenterState == nil &&
@@ -373,6 +387,9 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.AddBookmarks(response.GetBookmarks())
 	r.AddSeveralUpdatedProfileShowcaseContactIDs(response.GetUpdatedProfileShowcaseContactIDs())
 	r.AddSeveralSeenAndUnseenMessages(response.GetSeenAndUnseenMessages())
+	r.AddScheduledEventRsvps(response.ScheduledEventRsvps())
+	r.AddUserStickerPacks(response.UserStickerPacks())
+	r.AddSafeProposals(response.SafeProposals())
 	r.CommunityChanges = append(r.CommunityChanges, response.CommunityChanges...)
 	r.BackupHandled = response.BackupHandled
 	r.CustomizationColor = response.CustomizationColor
@@ -551,6 +568,60 @@ func (r *MessengerResponse) EnsUsernameDetails() []*ensservice.UsernameDetail {
 	return r.ensUsernameDetails
 }
 
+func (r *MessengerResponse) AddScheduledEventRsvp(rsvp *communities.ScheduledEventRsvp) {
+	if r.scheduledEventRsvps == nil {
+		r.scheduledEventRsvps = make(map[string]*communities.ScheduledEventRsvp)
+	}
+
+	r.scheduledEventRsvps[rsvp.EventID+rsvp.Member] = rsvp
+}
+
+func (r *MessengerResponse) AddScheduledEventRsvps(rsvps []*communities.ScheduledEventRsvp) {
+	for _, rsvp := range rsvps {
+		r.AddScheduledEventRsvp(rsvp)
+	}
+}
+
+func (r *MessengerResponse) ScheduledEventRsvps() []*communities.ScheduledEventRsvp {
+	return maps.Values(r.scheduledEventRsvps)
+}
+
+func (r *MessengerResponse) AddUserStickerPack(pack *stickers.UserStickerPack) {
+	if r.userStickerPacks == nil {
+		r.userStickerPacks = make(map[string]*stickers.UserStickerPack)
+	}
+
+	r.userStickerPacks[pack.ID] = pack
+}
+
+func (r *MessengerResponse) AddUserStickerPacks(packs []*stickers.UserStickerPack) {
+	for _, pack := range packs {
+		r.AddUserStickerPack(pack)
+	}
+}
+
+func (r *MessengerResponse) UserStickerPacks() []*stickers.UserStickerPack {
+	return maps.Values(r.userStickerPacks)
+}
+
+func (r *MessengerResponse) AddSafeProposal(proposal *safe.Proposal) {
+	if r.safeProposals == nil {
+		r.safeProposals = make(map[string]*safe.Proposal)
+	}
+
+	r.safeProposals[proposal.SafeTxHash.Hex()] = proposal
+}
+
+func (r *MessengerResponse) AddSafeProposals(proposals []*safe.Proposal) {
+	for _, proposal := range proposals {
+		r.AddSafeProposal(proposal)
+	}
+}
+
+func (r *MessengerResponse) SafeProposals() []*safe.Proposal {
+	return maps.Values(r.safeProposals)
+}
+
 func (r *MessengerResponse) AddNotification(n *localnotifications.Notification) {
 	if r.notifications == nil {
 		r.notifications = make(map[string]*localnotifications.Notification)
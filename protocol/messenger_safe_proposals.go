@@ -0,0 +1,170 @@
+package protocol
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/services/wallet/safe"
+)
+
+// ShareSafeProposal sends a pending transaction of one of our Safes, with the confirmations we know of, to a contact
+// or a group chat of its owners. The owners receiving it can confirm it and share it back.
+func (m *Messenger) ShareSafeProposal(ctx context.Context, request *requests.ShareSafeProposal) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+	if !chat.OneToOne() && !chat.PrivateGroupChat() {
+		return nil, ErrSafeProposalChat
+	}
+
+	proposal, err := m.safeDB.GetProposal(gethcommon.HexToHash(request.SafeTxHash))
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, safe.ErrProposalNotFound
+	}
+	if proposal.ExecutedTxHash != nil {
+		return nil, safe.ErrProposalExecuted
+	}
+
+	message := safeProposalToProtobuf(proposal)
+	message.Clock = m.getTimesource().GetCurrentTime()
+	message.ChatId = chat.ID
+
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_PROPOSAL,
+		ResendType:           chat.DefaultResendType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{}, nil
+}
+
+// sharesGroupChat tells whether the contact is a member of one of our active group chats
+func (m *Messenger) sharesGroupChat(contactID string) bool {
+	shared := false
+	m.allChats.Range(func(chatID string, chat *Chat) bool {
+		shared = chat.Active && chat.PrivateGroupChat() && chat.HasMember(contactID) && chat.HasMember(m.myHexIdentity())
+		return !shared
+	})
+	return shared
+}
+
+func safeProposalToProtobuf(proposal *safe.Proposal) *protobuf.SafeTransactionProposal {
+	t := proposal.Transaction
+	message := &protobuf.SafeTransactionProposal{
+		ChainId:        proposal.ChainID,
+		Safe:           proposal.Safe.Bytes(),
+		SafeTxHash:     proposal.SafeTxHash.Bytes(),
+		To:             t.To.Bytes(),
+		Value:          t.Value.ToInt().Bytes(),
+		Data:           t.Data,
+		Operation:      uint32(t.Operation),
+		SafeTxGas:      t.SafeTxGas.ToInt().Bytes(),
+		BaseGas:        t.BaseGas.ToInt().Bytes(),
+		GasPrice:       t.GasPrice.ToInt().Bytes(),
+		GasToken:       t.GasToken.Bytes(),
+		RefundReceiver: t.RefundReceiver.Bytes(),
+		Nonce:          t.Nonce,
+		Proposer:       proposal.Proposer.Bytes(),
+	}
+	for owner, signature := range proposal.Signatures {
+		message.Signatures = append(message.Signatures, &protobuf.SafeSignature{
+			Owner:     owner.Bytes(),
+			Signature: signature,
+		})
+	}
+	return message
+}
+
+func safeProposalFromProtobuf(message *protobuf.SafeTransactionProposal) *safe.Proposal {
+	toBig := func(b []byte) *hexutil.Big {
+		return (*hexutil.Big)(new(big.Int).SetBytes(b))
+	}
+
+	proposal := &safe.Proposal{
+		SafeTxHash: gethcommon.BytesToHash(message.SafeTxHash),
+		ChainID:    message.ChainId,
+		Safe:       gethcommon.BytesToAddress(message.Safe),
+		Transaction: &safe.Transaction{
+			To:             gethcommon.BytesToAddress(message.To),
+			Value:          toBig(message.Value),
+			Data:           message.Data,
+			Operation:      uint8(message.Operation),
+			SafeTxGas:      toBig(message.SafeTxGas),
+			BaseGas:        toBig(message.BaseGas),
+			GasPrice:       toBig(message.GasPrice),
+			GasToken:       gethcommon.BytesToAddress(message.GasToken),
+			RefundReceiver: gethcommon.BytesToAddress(message.RefundReceiver),
+			Nonce:          message.Nonce,
+		},
+		Proposer:   gethcommon.BytesToAddress(message.Proposer),
+		Signatures: make(map[gethcommon.Address]hexutil.Bytes),
+		CreatedAt:  int64(message.Clock / 1000),
+	}
+	for _, signature := range message.Signatures {
+		proposal.Signatures[gethcommon.BytesToAddress(signature.Owner)] = signature.Signature
+	}
+	return proposal
+}
+
+func (m *Messenger) HandleSafeTransactionProposal(state *ReceivedMessageState, message *protobuf.SafeTransactionProposal, statusMessage *v1protocol.StatusMessage, filter transport.Filter) error {
+	signer := state.CurrentMessageState.PublicKey
+	if common.IsPubKeyEqual(signer, &m.identity.PublicKey) {
+		return nil
+	}
+
+	// Proposals are shared in one to one and group chats, both reach us over the private channel of the sender
+	// so the chat id of the payload can't be trusted. They're accepted from mutual contacts, or from members
+	// of a group chat we're part of.
+	contactID := state.CurrentMessageState.Contact.ID
+	if filter.IsPublic() {
+		m.logger.Debug("ignoring safe proposal received in a public chat", zap.String("chatID", filter.ChatID))
+		return nil
+	}
+	if !state.CurrentMessageState.Contact.mutual() && !m.sharesGroupChat(contactID) {
+		m.logger.Debug("ignoring safe proposal from non mutual contact", zap.String("contactID", contactID))
+		return nil
+	}
+
+	// The proposal is checked against the owners of the Safe, which are only known for our Safes
+	proposal, err := m.safeDB.ImportProposal(safeProposalFromProtobuf(message))
+	if err == safe.ErrSafeNotFound {
+		m.logger.Debug("ignoring proposal of unknown safe", zap.String("safe", gethcommon.BytesToAddress(message.Safe).Hex()))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddSafeProposal(proposal)
+
+	return nil
+}
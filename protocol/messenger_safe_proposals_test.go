@@ -0,0 +1,192 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/services/wallet/safe"
+)
+
+func TestMessengerSafeProposalsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSafeProposalsSuite))
+}
+
+type MessengerSafeProposalsSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerSafeProposalsSuite) generateOwner() (*ecdsa.PrivateKey, gethcommon.Address) {
+	key, err := gethcrypto.GenerateKey()
+	s.Require().NoError(err)
+	return key, gethcrypto.PubkeyToAddress(key.PublicKey)
+}
+
+func (s *MessengerSafeProposalsSuite) confirm(m *Messenger, proposal *safe.Proposal, key *ecdsa.PrivateKey) {
+	signature, err := proposal.Transaction.Sign(proposal.ChainID, proposal.Safe, key)
+	s.Require().NoError(err)
+	proposal.Signatures[gethcrypto.PubkeyToAddress(key.PublicKey)] = signature
+	s.Require().NoError(m.safeDB.SaveProposal(proposal))
+}
+
+func (s *MessengerSafeProposalsSuite) TestShareSafeProposalWithContact() {
+	alice := s.m
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bob, err := newMessengerWithKey(s.shh, key, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, bob)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(bob, &alice.identity.PublicKey))
+
+	// Both are owners of the Safe, each with their own wallet
+	aliceKey, aliceOwner := s.generateOwner()
+	bobKey, bobOwner := s.generateOwner()
+	treasury := &safe.Safe{ChainID: 1, Address: gethcommon.HexToAddress("0x1234"), Owners: []gethcommon.Address{aliceOwner, bobOwner}, Threshold: 2}
+	s.Require().NoError(alice.safeDB.SaveSafe(treasury))
+	s.Require().NoError(bob.safeDB.SaveSafe(treasury))
+
+	tx := safe.NewTransaction(gethcommon.HexToAddress("0x5678"), big.NewInt(1000), []byte{0x01}, 0)
+	hash, err := tx.Hash(treasury.ChainID, treasury.Address)
+	s.Require().NoError(err)
+	proposal := &safe.Proposal{
+		SafeTxHash:  hash,
+		ChainID:     treasury.ChainID,
+		Safe:        treasury.Address,
+		Transaction: tx,
+		Proposer:    aliceOwner,
+		Signatures:  make(map[gethcommon.Address]hexutil.Bytes),
+	}
+	s.confirm(alice, proposal, aliceKey)
+
+	chat := CreateOneToOneChat(common.PubkeyToHex(&bob.identity.PublicKey), &bob.identity.PublicKey, alice.transport)
+	s.Require().NoError(alice.SaveChat(chat))
+
+	_, err = alice.ShareSafeProposal(context.Background(), &requests.ShareSafeProposal{SafeTxHash: hash.Hex(), ChatID: chat.ID})
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool { return len(r.SafeProposals()) == 1 },
+		"no safe proposal",
+	)
+	s.Require().NoError(err)
+
+	received := response.SafeProposals()[0]
+	s.Require().Equal(hash, received.SafeTxHash)
+	s.Require().Equal(tx, received.Transaction)
+	s.Require().Len(received.Signatures, 1)
+
+	// The confirmation of bob is shared back and merged with the one of alice
+	s.confirm(bob, received, bobKey)
+	bobChat := CreateOneToOneChat(common.PubkeyToHex(&alice.identity.PublicKey), &alice.identity.PublicKey, bob.transport)
+	s.Require().NoError(bob.SaveChat(bobChat))
+
+	_, err = bob.ShareSafeProposal(context.Background(), &requests.ShareSafeProposal{SafeTxHash: hash.Hex(), ChatID: bobChat.ID})
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool {
+			return len(r.SafeProposals()) == 1 && len(r.SafeProposals()[0].Signatures) == 2
+		},
+		"no confirmed safe proposal",
+	)
+	s.Require().NoError(err)
+
+	saved, err := alice.safeDB.GetProposal(hash)
+	s.Require().NoError(err)
+	s.Require().Len(saved.Signatures, 2)
+}
+
+func (s *MessengerSafeProposalsSuite) TestHandleSafeProposalMembership() {
+	alice := s.m
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := BuildContactFromPublicKey(&key.PublicKey)
+	s.Require().NoError(err)
+
+	ownerKey, owner := s.generateOwner()
+	treasury := &safe.Safe{ChainID: 1, Address: gethcommon.HexToAddress("0x1234"), Owners: []gethcommon.Address{owner}, Threshold: 1}
+	s.Require().NoError(alice.safeDB.SaveSafe(treasury))
+
+	tx := safe.NewTransaction(gethcommon.HexToAddress("0x5678"), big.NewInt(1000), nil, 0)
+	hash, err := tx.Hash(treasury.ChainID, treasury.Address)
+	s.Require().NoError(err)
+	signature, err := tx.Sign(treasury.ChainID, treasury.Address, ownerKey)
+	s.Require().NoError(err)
+	proposal := &safe.Proposal{
+		SafeTxHash:  hash,
+		ChainID:     treasury.ChainID,
+		Safe:        treasury.Address,
+		Transaction: tx,
+		Proposer:    owner,
+		Signatures:  map[gethcommon.Address]hexutil.Bytes{owner: signature},
+	}
+
+	// The sender, not a contact, claims to share the proposal in a group chat we left
+	group := CreateGroupChat(alice.getTimesource())
+	group.ID = "group-chat"
+	group.Active = false
+	group.Members = []ChatMember{{ID: contact.ID}, {ID: alice.myHexIdentity()}}
+	s.Require().NoError(alice.SaveChat(&group))
+
+	message := safeProposalToProtobuf(proposal)
+	message.ChatId = group.ID
+
+	handle := func(filter transport.Filter) *MessengerResponse {
+		state := &ReceivedMessageState{
+			Response: &MessengerResponse{},
+			CurrentMessageState: &CurrentMessageState{
+				PublicKey: &key.PublicKey,
+				Contact:   contact,
+			},
+		}
+		s.Require().NoError(alice.HandleSafeTransactionProposal(state, message, nil, filter))
+		return state.Response
+	}
+
+	private := transport.Filter{ChatID: "partitioned", OneToOne: true}
+	s.Require().Empty(handle(private).SafeProposals())
+	s.Require().Empty(handle(transport.Filter{ChatID: group.ID}).SafeProposals())
+
+	group.Active = true
+	s.Require().NoError(alice.SaveChat(&group))
+	s.Require().Len(handle(private).SafeProposals(), 1)
+}
+
+func (s *MessengerSafeProposalsSuite) TestSafeProposalProtobuf() {
+	key, owner := s.generateOwner()
+	tx := safe.NewTransaction(gethcommon.HexToAddress("0x5678"), big.NewInt(1000), nil, 3)
+	hash, err := tx.Hash(1, gethcommon.HexToAddress("0x1234"))
+	s.Require().NoError(err)
+	signature, err := tx.Sign(1, gethcommon.HexToAddress("0x1234"), key)
+	s.Require().NoError(err)
+
+	proposal := &safe.Proposal{
+		SafeTxHash:  hash,
+		ChainID:     1,
+		Safe:        gethcommon.HexToAddress("0x1234"),
+		Transaction: tx,
+		Proposer:    owner,
+		Signatures:  map[gethcommon.Address]hexutil.Bytes{owner: signature},
+	}
+
+	decoded := safeProposalFromProtobuf(safeProposalToProtobuf(proposal))
+	s.Require().Equal(proposal, decoded)
+	s.Require().NoError(safe.ValidateProposal(&safe.Safe{ChainID: 1, Address: proposal.Safe, Owners: []gethcommon.Address{owner}}, decoded))
+}
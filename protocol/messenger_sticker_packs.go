@@ -0,0 +1,195 @@
+package protocol
+
+import (
+	"context"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/ipfs"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/services/stickers"
+)
+
+// ShareStickerPack sends one of our sticker packs, images included, to a contact or a community chat.
+// Stickers of the pack are then sent like any other sticker, referenced by their content hash.
+func (m *Messenger) ShareStickerPack(ctx context.Context, request *requests.ShareStickerPack) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	if m.httpServer == nil {
+		return nil, ErrNoMediaServer
+	}
+
+	chat, ok := m.allChats.Load(request.ChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+	if !chat.OneToOne() && !chat.CommunityChat() {
+		return nil, ErrStickerPackChat
+	}
+
+	pack, err := stickers.NewStickersDatabase(m.database).GetUserPack(request.PackID)
+	if err != nil {
+		return nil, err
+	}
+	if pack == nil {
+		return nil, stickers.ErrUserPackNotFound
+	}
+
+	message := &protobuf.UserStickerPack{
+		Clock:     m.getTimesource().GetCurrentTime(),
+		Id:        pack.ID,
+		Name:      pack.Name,
+		Author:    pack.Author,
+		Thumbnail: pack.Thumbnail,
+		ChatId:    chat.ID,
+	}
+	for _, sticker := range pack.Stickers {
+		payload, err := m.httpServer.GetSticker(sticker.Hash)
+		if err != nil {
+			return nil, err
+		}
+		message.Stickers = append(message.Stickers, &protobuf.UserSticker{
+			Hash:    sticker.Hash,
+			Payload: payload,
+		})
+	}
+
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_USER_STICKER_PACK,
+		ResendType:           chat.DefaultResendType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerResponse{}, nil
+}
+
+// validateUserStickerPack checks a received pack matches its ID and its stickers their hashes,
+// so that a pack can't be passed off as another one
+func validateUserStickerPack(message *protobuf.UserStickerPack) error {
+	err := stickers.ValidateUserPack(message.Name, len(message.Stickers))
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]string, 0, len(message.Stickers))
+	for _, sticker := range message.Stickers {
+		err = stickers.ValidateUserSticker(sticker.Payload)
+		if err != nil {
+			return err
+		}
+
+		hash, err := ipfs.ContentHash(sticker.Payload)
+		if err != nil {
+			return err
+		}
+		if hash != sticker.Hash {
+			return stickers.ErrUserStickerHashWrong
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if !slices.Contains(hashes, message.Thumbnail) {
+		return stickers.ErrUserPackIDWrong
+	}
+
+	if message.Id != stickers.UserStickerPackID(message.Name, message.Author, hashes) {
+		return stickers.ErrUserPackIDWrong
+	}
+
+	return nil
+}
+
+func (m *Messenger) HandleUserStickerPack(state *ReceivedMessageState, message *protobuf.UserStickerPack, statusMessage *v1protocol.StatusMessage, filter transport.Filter) error {
+	signer := state.CurrentMessageState.PublicKey
+	if common.IsPubKeyEqual(signer, &m.identity.PublicKey) {
+		return nil
+	}
+
+	if m.httpServer == nil {
+		return ErrNoMediaServer
+	}
+
+	// Packs are accepted from mutual contacts, or from members of the community chat they are received in.
+	// The chat is the one of the filter, the chat id of the payload can't be trusted.
+	chatID := state.CurrentMessageState.Contact.ID
+	if filter.IsPublic() {
+		chat, ok := m.allChats.Load(filter.ChatID)
+		if !ok || !chat.CommunityChat() {
+			m.logger.Debug("ignoring sticker pack received in a non community chat", zap.String("chatID", filter.ChatID))
+			return nil
+		}
+		community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+		if err != nil {
+			return err
+		}
+		if !community.HasMember(signer) {
+			return ErrUserNotMember
+		}
+		chatID = chat.ID
+	} else if !state.CurrentMessageState.Contact.mutual() {
+		m.logger.Debug("ignoring sticker pack from non mutual contact", zap.String("contactID", chatID))
+		return nil
+	}
+
+	err := validateUserStickerPack(message)
+	if err != nil {
+		return err
+	}
+
+	stickersDB := stickers.NewStickersDatabase(m.database)
+	existing, err := stickersDB.GetUserPack(message.Id)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Clock >= message.Clock {
+		return nil
+	}
+
+	pack := &stickers.UserStickerPack{
+		ID:        message.Id,
+		Name:      message.Name,
+		Author:    message.Author,
+		Thumbnail: message.Thumbnail,
+		SharedBy:  state.CurrentMessageState.Contact.ID,
+		ChatID:    chatID,
+		Clock:     message.Clock,
+	}
+	for _, sticker := range message.Stickers {
+		_, err = m.httpServer.StoreSticker(sticker.Payload)
+		if err != nil {
+			return err
+		}
+		pack.Stickers = append(pack.Stickers, stickers.Sticker{
+			Hash: sticker.Hash,
+			URL:  m.httpServer.MakeStickerURL(sticker.Hash),
+		})
+	}
+
+	err = stickersDB.SaveUserPack(pack)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddUserStickerPack(pack)
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/ipfs"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/services/stickers"
+)
+
+func TestMessengerStickerPacksSuite(t *testing.T) {
+	suite.Run(t, new(MessengerStickerPacksSuite))
+}
+
+type MessengerStickerPacksSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerStickerPacksSuite) setMediaServer(m *Messenger) {
+	downloader := ipfs.NewDownloader(s.T().TempDir())
+	s.T().Cleanup(downloader.Stop)
+
+	mediaServer, err := server.NewMediaServer(nil, downloader, nil, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(mediaServer.Start())
+	s.T().Cleanup(func() { _ = mediaServer.Stop() })
+	m.SetMediaServer(mediaServer)
+}
+
+func testStickerPayload(s *suite.Suite, size int) []byte {
+	buf := &bytes.Buffer{}
+	s.Require().NoError(png.Encode(buf, image.NewNRGBA(image.Rect(0, 0, size, size))))
+	return buf.Bytes()
+}
+
+func (s *MessengerStickerPacksSuite) createPack(m *Messenger) *stickers.UserStickerPack {
+	pack := &stickers.UserStickerPack{Name: "pack", Author: "alice"}
+	for _, size := range []int{32, 64} {
+		hash, err := m.httpServer.StoreSticker(testStickerPayload(&s.Suite, size))
+		s.Require().NoError(err)
+		pack.Stickers = append(pack.Stickers, stickers.Sticker{Hash: hash})
+	}
+	pack.Thumbnail = pack.Stickers[0].Hash
+	pack.ID = stickers.UserStickerPackID(pack.Name, pack.Author, pack.StickerHashes())
+
+	s.Require().NoError(stickers.NewStickersDatabase(m.database).SaveUserPack(pack))
+	return pack
+}
+
+func (s *MessengerStickerPacksSuite) TestShareStickerPackWithContact() {
+	alice := s.m
+	s.setMediaServer(alice)
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bob, err := newMessengerWithKey(s.shh, key, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, bob)
+	s.setMediaServer(bob)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(bob, &alice.identity.PublicKey))
+
+	chat := CreateOneToOneChat(common.PubkeyToHex(&bob.identity.PublicKey), &bob.identity.PublicKey, alice.transport)
+	s.Require().NoError(alice.SaveChat(chat))
+
+	pack := s.createPack(alice)
+
+	_, err = alice.ShareStickerPack(context.Background(), &requests.ShareStickerPack{PackID: pack.ID, ChatID: chat.ID})
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool { return len(r.UserStickerPacks()) == 1 },
+		"no sticker pack",
+	)
+	s.Require().NoError(err)
+
+	received := response.UserStickerPacks()[0]
+	s.Require().Equal(pack.ID, received.ID)
+	s.Require().Equal(pack.StickerHashes(), received.StickerHashes())
+	s.Require().Equal(common.PubkeyToHex(&alice.identity.PublicKey), received.SharedBy)
+
+	// The pack is saved and its stickers served from bob's store
+	saved, err := stickers.NewStickersDatabase(bob.database).GetUserPack(pack.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(saved)
+
+	content, err := bob.httpServer.GetSticker(pack.Thumbnail)
+	s.Require().NoError(err)
+	s.Require().Equal(testStickerPayload(&s.Suite, 32), content)
+}
+
+func (s *MessengerStickerPacksSuite) TestHandleStickerPackMembership() {
+	alice := s.m
+	s.setMediaServer(alice)
+	_, communityChat := createCommunity(&s.Suite, alice)
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := BuildContactFromPublicKey(&key.PublicKey)
+	s.Require().NoError(err)
+
+	payload := testStickerPayload(&s.Suite, 32)
+	hash, err := ipfs.ContentHash(payload)
+	s.Require().NoError(err)
+	message := &protobuf.UserStickerPack{
+		Clock:     1,
+		Id:        stickers.UserStickerPackID("pack", "mallory", []string{hash}),
+		Name:      "pack",
+		Author:    "mallory",
+		Thumbnail: hash,
+		Stickers:  []*protobuf.UserSticker{{Hash: hash, Payload: payload}},
+		ChatId:    communityChat.ID,
+	}
+
+	handle := func(filter transport.Filter) (*MessengerResponse, error) {
+		state := &ReceivedMessageState{
+			Response: &MessengerResponse{},
+			CurrentMessageState: &CurrentMessageState{
+				PublicKey: &key.PublicKey,
+				Contact:   contact,
+			},
+		}
+		err := alice.HandleUserStickerPack(state, message, nil, filter)
+		return state.Response, err
+	}
+
+	// Claiming the community chat in the payload isn't enough when the pack is sent privately
+	response, err := handle(transport.Filter{ChatID: "partitioned", OneToOne: true})
+	s.Require().NoError(err)
+	s.Require().Empty(response.UserStickerPacks())
+
+	// Received in the community chat from a non member
+	_, err = handle(transport.Filter{ChatID: communityChat.ID})
+	s.Require().ErrorIs(err, ErrUserNotMember)
+
+	// Received in a chat which isn't a community chat
+	response, err = handle(transport.Filter{ChatID: "public-chat"})
+	s.Require().NoError(err)
+	s.Require().Empty(response.UserStickerPacks())
+}
+
+func (s *MessengerStickerPacksSuite) TestValidateUserStickerPack() {
+	payload := testStickerPayload(&s.Suite, 32)
+	hash, err := ipfs.ContentHash(payload)
+	s.Require().NoError(err)
+
+	newMessage := func() *protobuf.UserStickerPack {
+		return &protobuf.UserStickerPack{
+			Id:        stickers.UserStickerPackID("pack", "alice", []string{hash}),
+			Name:      "pack",
+			Author:    "alice",
+			Thumbnail: hash,
+			Stickers:  []*protobuf.UserSticker{{Hash: hash, Payload: payload}},
+		}
+	}
+
+	s.Require().NoError(validateUserStickerPack(newMessage()))
+
+	message := newMessage()
+	message.Stickers[0].Payload = testStickerPayload(&s.Suite, 64)
+	s.Require().ErrorIs(validateUserStickerPack(message), stickers.ErrUserStickerHashWrong)
+
+	message = newMessage()
+	message.Name = "another pack"
+	s.Require().ErrorIs(validateUserStickerPack(message), stickers.ErrUserPackIDWrong)
+
+	message = newMessage()
+	message.Thumbnail = types.EncodeHex([]byte("thumbnail"))
+	s.Require().ErrorIs(validateUserStickerPack(message), stickers.ErrUserPackIDWrong)
+}
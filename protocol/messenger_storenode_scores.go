@@ -0,0 +1,80 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/storenodes"
+)
+
+// scoringMessageRequester records the outcome of every store query in the storenode scores
+type scoringMessageRequester struct {
+	messageRequester
+	scores      *storenodes.Scores
+	storenodeID string
+	envelopes   int64
+}
+
+func (r *scoringMessageRequester) SendMessagesRequestForTopics(
+	ctx context.Context,
+	peerID []byte,
+	from, to uint32,
+	previousCursor []byte,
+	previousStoreCursor types.StoreRequestCursor,
+	pubsubTopic string,
+	contentTopics []types.TopicType,
+	limit uint32,
+	waitForResponse bool,
+	processEnvelopes bool,
+) ([]byte, types.StoreRequestCursor, int, error) {
+	start := time.Now()
+	cursor, storeCursor, envelopesCount, err := r.messageRequester.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, pubsubTopic, contentTopics, limit, waitForResponse, processEnvelopes)
+	switch {
+	case err == nil:
+		r.scores.RecordSuccess(r.storenodeID, time.Since(start))
+		atomic.AddInt64(&r.envelopes, int64(envelopesCount))
+	case errors.Is(err, context.Canceled):
+		// The batch was interrupted, the storenode is not to blame
+	default:
+		r.scores.RecordFailure(r.storenodeID)
+	}
+	return cursor, storeCursor, envelopesCount, err
+}
+
+func (m *Messenger) scoringRequester(storenodeID string) *scoringMessageRequester {
+	return &scoringMessageRequester{
+		messageRequester: m.transport,
+		scores:           m.storenodeScores,
+		storenodeID:      storenodeID,
+	}
+}
+
+// recordBatchEnvelopes compares the envelopes returned for a fully fetched batch with the results of other storenodes
+func (r *scoringMessageRequester) recordBatchEnvelopes(batch MailserverBatch) {
+	r.scores.RecordEnvelopes(r.storenodeID, storenodes.QueryKey(batch.PubsubTopic, batch.Topics, batch.From, batch.To), int(atomic.LoadInt64(&r.envelopes)))
+}
+
+// communityStorenodeHealthy returns whether the storenode of a community can be used,
+// unhealthy community storenodes are replaced by the fleet storenode until they get another chance
+func (m *Messenger) communityStorenodeHealthy(communityID string, storenodeID string) bool {
+	if m.storenodeScores.Healthy(storenodeID) {
+		return true
+	}
+	if m.mailserverCycle.activeMailserver == nil {
+		// Nothing to fail over to
+		return true
+	}
+	m.logger.Debug("community storenode is unhealthy, using the fleet storenode",
+		zap.String("communityID", communityID), zap.String("storenodeID", storenodeID))
+	return false
+}
+
+// StorenodeScores returns the health scores of the storenodes queried so far
+func (m *Messenger) StorenodeScores() []storenodes.Score {
+	return m.storenodeScores.All()
+}
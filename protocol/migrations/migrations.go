@@ -143,6 +143,8 @@
 // 1719906191_add_community_token_version.up.sql (65B)
 // 1720636181_add_community_encryption_keys_requests.up.sql (236B)
 // 1721222369_add_shared_addresses.up.sql (98B)
+// 1721836180_add_community_moderation_log.up.sql (417B)
+// 1722268800_add_community_scheduled_event_rsvps.up.sql (370B)
 // README.md (554B)
 // doc.go (870B)
 
@@ -3072,6 +3074,46 @@ func _1721222369_add_shared_addressesUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1721836180_add_community_moderation_logUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x31\x6b\xc3\x30\x10\x46\x77\xfd\x8a\x6f\x4b\x03\x19\xba\x67\xb2\x9b\x0b\x88\xaa\x72\x71\x14\x70\x26\x63\x64\x11\x44\x64\x1d\xa8\xea\xd0\x7f\x5f\x88\x43\x6a\x75\x68\xb3\xde\x77\xef\x0d\xef\xa5\xa5\xca\x10\x4c\x55\x2b\x82\xdc\x43\x37\x06\xd4\xc9\x83\x39\xc0\xf2\x34\x7d\x46\x9f\xbf\xfa\x89\x47\x97\x86\xec\x39\xf6\x81\xcf\x78\x12\x00\xe0\x47\x18\xea\x0c\xde\x5b\xf9\x56\xb5\x27\xbc\xd2\x69\x73\x1d\x7e\x38\x3f\xa2\x56\x4d\x7d\x95\xea\xa3\x52\xf3\x3e\xd8\xcc\x69\x66\xcb\x21\x0f\xe9\xec\x72\xb9\x60\x47\xfb\xea\xa8\x0c\x56\xab\x3b\xed\x39\x42\xea\xdf\x74\xe2\xe0\x8a\xf3\x1d\x7d\xbe\x3d\xb8\xe1\x83\xe3\x3f\x7a\x1b\xd8\x5e\x0a\x8d\x58\x6f\x85\xb8\x65\x92\x7a\x47\xdd\x83\x99\xfa\x65\x87\x7e\xf6\x36\xfa\x8f\xaa\xcb\xff\x0d\x6c\x60\x7b\x59\x6f\xc5\xf7\x00\x99\x34\x5c\x14\xa1\x01\x00\x00")
+
+func _1721836180_add_community_moderation_logUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1721836180_add_community_moderation_logUpSql,
+		"1721836180_add_community_moderation_log.up.sql",
+	)
+}
+
+func _1721836180_add_community_moderation_logUpSql() (*asset, error) {
+	bytes, err := _1721836180_add_community_moderation_logUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1721836180_add_community_moderation_log.up.sql", size: 417, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0x97, 0xa4, 0x38, 0x1d, 0xd2, 0xc8, 0xbf, 0x5, 0x65, 0xcf, 0x80, 0x9e, 0x82, 0x72, 0x7a, 0x5a, 0xcf, 0xc2, 0xb2, 0x95, 0xcd, 0x22, 0x41, 0xc0, 0xe6, 0x99, 0xcb, 0xb3, 0x92, 0xe5, 0xde}}
+	return a, nil
+}
+
+var __1722268800_add_community_scheduled_event_rsvpsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x39\xda\x90\x43\xef\x39\xc9\xcd\x06\x44\x55\xb9\x38\x0a\x38\x27\x41\xa5\x85\x9a\x46\x76\xb0\x62\x43\xff\xbe\x60\xbb\xd4\x2e\x85\xd2\xeb\xbe\x37\x3b\xcc\x63\x45\xd2\x12\xac\x2c\x34\x41\x1d\x61\x4a\x0b\xaa\xd5\xc9\x9e\xe0\xbb\x18\x87\xb6\xb9\x7f\xb8\xe4\xdf\x38\x0c\x57\x0e\x8e\x47\x6e\xef\xae\x4f\xe3\x2d\x21\x13\x00\x56\x56\x13\x50\xe8\xb2\x98\x5e\x98\xb3\xd6\xbb\x89\xcf\x89\x26\xc0\x52\x6d\x7f\xb0\xc8\xf1\x95\xfb\xdf\x48\xcf\xe9\xd6\xb5\x89\xa1\xcc\x37\xc2\x81\x8e\xf2\xac\x2d\x1e\x66\xc9\x5f\x3b\xff\xbe\x31\xe6\xfb\x4b\xa5\x9e\x65\x75\xc1\x13\x5d\x90\x7d\xf5\xef\x96\xb6\x5c\xe4\x7b\x21\x96\xd9\xca\x1c\xa8\xfe\xdf\x6c\xb7\x19\x5c\x9a\xbf\x7c\x64\xeb\x40\xbe\x17\x9f\x03\x00\xeb\x28\xfa\x6b\x72\x01\x00\x00")
+
+func _1722268800_add_community_scheduled_event_rsvpsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722268800_add_community_scheduled_event_rsvpsUpSql,
+		"1722268800_add_community_scheduled_event_rsvps.up.sql",
+	)
+}
+
+func _1722268800_add_community_scheduled_event_rsvpsUpSql() (*asset, error) {
+	bytes, err := _1722268800_add_community_scheduled_event_rsvpsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722268800_add_community_scheduled_event_rsvps.up.sql", size: 370, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x39, 0x56, 0xf3, 0x2, 0x69, 0xb, 0xa1, 0x36, 0x9e, 0x32, 0x83, 0xb0, 0x99, 0x59, 0x53, 0x5a, 0xe9, 0x4a, 0x66, 0xbb, 0xca, 0xfa, 0x14, 0x3a, 0x9b, 0xb3, 0x9d, 0xad, 0x3a, 0x80, 0xbb, 0x3f}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -3346,8 +3388,10 @@ var _bindata = map[string]func() (*asset, error){
 	"1719906191_add_community_token_version.up.sql":                               _1719906191_add_community_token_versionUpSql,
 	"1720636181_add_community_encryption_keys_requests.up.sql":                    _1720636181_add_community_encryption_keys_requestsUpSql,
 	"1721222369_add_shared_addresses.up.sql":                                      _1721222369_add_shared_addressesUpSql,
-	"README.md":                                                                   readmeMd,
-	"doc.go":                                                                      docGo,
+	"1721836180_add_community_moderation_log.up.sql":                              _1721836180_add_community_moderation_logUpSql,
+	"1722268800_add_community_scheduled_event_rsvps.up.sql":                       _1722268800_add_community_scheduled_event_rsvpsUpSql,
+	"README.md": readmeMd,
+	"doc.go":    docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -3539,8 +3583,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1719906191_add_community_token_version.up.sql":                               {_1719906191_add_community_token_versionUpSql, map[string]*bintree{}},
 	"1720636181_add_community_encryption_keys_requests.up.sql":                    {_1720636181_add_community_encryption_keys_requestsUpSql, map[string]*bintree{}},
 	"1721222369_add_shared_addresses.up.sql":                                      {_1721222369_add_shared_addressesUpSql, map[string]*bintree{}},
-	"README.md":                                                                   {readmeMd, map[string]*bintree{}},
-	"doc.go":                                                                      {docGo, map[string]*bintree{}},
+	"1721836180_add_community_moderation_log.up.sql":                              {_1721836180_add_community_moderation_logUpSql, map[string]*bintree{}},
+	"1722268800_add_community_scheduled_event_rsvps.up.sql":                       {_1722268800_add_community_scheduled_event_rsvpsUpSql, map[string]*bintree{}},
+	"README.md": {readmeMd, map[string]*bintree{}},
+	"doc.go":    {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS community_moderation_log (
+    id TEXT PRIMARY KEY,
+    community_id BLOB NOT NULL,
+    actor TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    action INT NOT NULL,
+    role INT NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    clock INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS community_moderation_log_community_id_clock ON community_moderation_log (community_id, clock);
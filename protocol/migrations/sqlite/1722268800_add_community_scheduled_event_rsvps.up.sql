@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS community_scheduled_event_rsvps (
+    community_id BLOB NOT NULL,
+    event_id TEXT NOT NULL,
+    member TEXT NOT NULL,
+    response INT NOT NULL DEFAULT 0,
+    clock INT NOT NULL,
+    PRIMARY KEY (event_id, member)
+);
+
+CREATE INDEX IF NOT EXISTS community_scheduled_event_rsvps_community_id ON community_scheduled_event_rsvps (community_id);
@@ -8,6 +8,10 @@ import (
 
 const selectTimestampsQuery = "SELECT whisper_timestamp FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ?"
 const selectCountQuery = "SELECT COUNT(*) FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ?"
+const selectAuthorsQuery = "SELECT DISTINCT source FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ? ORDER BY source"
+const selectCountPerChatQuery = "SELECT local_chat_id, COUNT(*) FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ? GROUP BY local_chat_id"
+const selectTopAuthorsQuery = "SELECT source, COUNT(*) AS messages_count FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ? GROUP BY source ORDER BY messages_count DESC, source LIMIT ?"
+const selectReactionsCountQuery = "SELECT COUNT(*) FROM emoji_reactions WHERE %s NOT retracted AND clock_value >= ? AND clock_value <= ?"
 
 func querySeveralChats(chatIDs []string) string {
 	if len(chatIDs) == 0 {
@@ -61,3 +65,92 @@ func (db sqlitePersistence) SelectMessagesCountForChatsByPeriod(chatIDs []string
 
 	return count, nil
 }
+
+func (db sqlitePersistence) SelectMessagesAuthorsForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) ([]string, error) {
+	query := fmt.Sprintf(selectAuthorsQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, startTimestamp, endTimestamp)
+	if err != nil {
+		return []string{}, err
+	}
+	defer rows.Close()
+
+	var authors []string
+	for rows.Next() {
+		var author string
+		err := rows.Scan(&author)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return []string{}, err
+	}
+
+	return authors, nil
+}
+
+func (db sqlitePersistence) SelectMessagesCountPerChatForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) (map[string]int, error) {
+	query := fmt.Sprintf(selectCountPerChatQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, startTimestamp, endTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var chatID string
+		var count int
+		err := rows.Scan(&chatID, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[chatID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// SelectTopMessagesAuthorsForChatsByPeriod returns the authors with the most messages, most active first
+func (db sqlitePersistence) SelectTopMessagesAuthorsForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64, limit int) ([]MetricsContributor, error) {
+	query := fmt.Sprintf(selectTopAuthorsQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, startTimestamp, endTimestamp, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contributors []MetricsContributor
+	for rows.Next() {
+		var contributor MetricsContributor
+		err := rows.Scan(&contributor.PublicKey, &contributor.Count)
+		if err != nil {
+			return nil, err
+		}
+		contributors = append(contributors, contributor)
+	}
+
+	return contributors, rows.Err()
+}
+
+// SelectReactionsCountForChatsByPeriod counts the reactions that were not retracted.
+// Reactions have no timestamp, their clock value is used instead.
+func (db sqlitePersistence) SelectReactionsCountForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) (int, error) {
+	query := fmt.Sprintf(selectReactionsCountQuery, querySeveralChats(chatIDs))
+
+	var count int
+	if err := db.db.QueryRow(query, startTimestamp, endTimestamp).Scan(&count); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return count, nil
+}
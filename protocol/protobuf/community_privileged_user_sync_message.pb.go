@@ -28,6 +28,7 @@ const (
 	CommunityPrivilegedUserSyncMessage_CONTROL_NODE_REJECT_REQUEST_TO_JOIN       CommunityPrivilegedUserSyncMessage_EventType = 2
 	CommunityPrivilegedUserSyncMessage_CONTROL_NODE_ALL_SYNC_REQUESTS_TO_JOIN    CommunityPrivilegedUserSyncMessage_EventType = 3
 	CommunityPrivilegedUserSyncMessage_CONTROL_NODE_MEMBER_EDIT_SHARED_ADDRESSES CommunityPrivilegedUserSyncMessage_EventType = 4
+	CommunityPrivilegedUserSyncMessage_CONTROL_NODE_MODERATION_LOG               CommunityPrivilegedUserSyncMessage_EventType = 5
 )
 
 // Enum value maps for CommunityPrivilegedUserSyncMessage_EventType.
@@ -38,6 +39,7 @@ var (
 		2: "CONTROL_NODE_REJECT_REQUEST_TO_JOIN",
 		3: "CONTROL_NODE_ALL_SYNC_REQUESTS_TO_JOIN",
 		4: "CONTROL_NODE_MEMBER_EDIT_SHARED_ADDRESSES",
+		5: "CONTROL_NODE_MODERATION_LOG",
 	}
 	CommunityPrivilegedUserSyncMessage_EventType_value = map[string]int32{
 		"UNKNOWN":                                   0,
@@ -45,6 +47,7 @@ var (
 		"CONTROL_NODE_REJECT_REQUEST_TO_JOIN":       2,
 		"CONTROL_NODE_ALL_SYNC_REQUESTS_TO_JOIN":    3,
 		"CONTROL_NODE_MEMBER_EDIT_SHARED_ADDRESSES": 4,
+		"CONTROL_NODE_MODERATION_LOG":               5,
 	}
 )
 
@@ -75,6 +78,82 @@ func (CommunityPrivilegedUserSyncMessage_EventType) EnumDescriptor() ([]byte, []
 	return file_community_privileged_user_sync_message_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type CommunityModerationLogEntry_Action int32
+
+const (
+	CommunityModerationLogEntry_UNKNOWN                 CommunityModerationLogEntry_Action = 0
+	CommunityModerationLogEntry_MEMBER_KICK             CommunityModerationLogEntry_Action = 1
+	CommunityModerationLogEntry_MEMBER_BAN              CommunityModerationLogEntry_Action = 2
+	CommunityModerationLogEntry_MEMBER_UNBAN            CommunityModerationLogEntry_Action = 3
+	CommunityModerationLogEntry_MEMBER_ROLE_ADD         CommunityModerationLogEntry_Action = 4
+	CommunityModerationLogEntry_MEMBER_ROLE_REMOVE      CommunityModerationLogEntry_Action = 5
+	CommunityModerationLogEntry_MESSAGE_DELETE          CommunityModerationLogEntry_Action = 6
+	CommunityModerationLogEntry_MEMBER_MESSAGES_DELETE  CommunityModerationLogEntry_Action = 7
+	CommunityModerationLogEntry_TOKEN_PERMISSION_CHANGE CommunityModerationLogEntry_Action = 8
+	CommunityModerationLogEntry_TOKEN_PERMISSION_DELETE CommunityModerationLogEntry_Action = 9
+	CommunityModerationLogEntry_REQUEST_TO_JOIN_ACCEPT  CommunityModerationLogEntry_Action = 10
+	CommunityModerationLogEntry_REQUEST_TO_JOIN_DECLINE CommunityModerationLogEntry_Action = 11
+)
+
+// Enum value maps for CommunityModerationLogEntry_Action.
+var (
+	CommunityModerationLogEntry_Action_name = map[int32]string{
+		0:  "UNKNOWN",
+		1:  "MEMBER_KICK",
+		2:  "MEMBER_BAN",
+		3:  "MEMBER_UNBAN",
+		4:  "MEMBER_ROLE_ADD",
+		5:  "MEMBER_ROLE_REMOVE",
+		6:  "MESSAGE_DELETE",
+		7:  "MEMBER_MESSAGES_DELETE",
+		8:  "TOKEN_PERMISSION_CHANGE",
+		9:  "TOKEN_PERMISSION_DELETE",
+		10: "REQUEST_TO_JOIN_ACCEPT",
+		11: "REQUEST_TO_JOIN_DECLINE",
+	}
+	CommunityModerationLogEntry_Action_value = map[string]int32{
+		"UNKNOWN":                 0,
+		"MEMBER_KICK":             1,
+		"MEMBER_BAN":              2,
+		"MEMBER_UNBAN":            3,
+		"MEMBER_ROLE_ADD":         4,
+		"MEMBER_ROLE_REMOVE":      5,
+		"MESSAGE_DELETE":          6,
+		"MEMBER_MESSAGES_DELETE":  7,
+		"TOKEN_PERMISSION_CHANGE": 8,
+		"TOKEN_PERMISSION_DELETE": 9,
+		"REQUEST_TO_JOIN_ACCEPT":  10,
+		"REQUEST_TO_JOIN_DECLINE": 11,
+	}
+)
+
+func (x CommunityModerationLogEntry_Action) Enum() *CommunityModerationLogEntry_Action {
+	p := new(CommunityModerationLogEntry_Action)
+	*p = x
+	return p
+}
+
+func (x CommunityModerationLogEntry_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommunityModerationLogEntry_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_community_privileged_user_sync_message_proto_enumTypes[1].Descriptor()
+}
+
+func (CommunityModerationLogEntry_Action) Type() protoreflect.EnumType {
+	return &file_community_privileged_user_sync_message_proto_enumTypes[1]
+}
+
+func (x CommunityModerationLogEntry_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommunityModerationLogEntry_Action.Descriptor instead.
+func (CommunityModerationLogEntry_Action) EnumDescriptor() ([]byte, []int) {
+	return file_community_privileged_user_sync_message_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type SyncCommunityEditSharedAddresses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -141,6 +220,7 @@ type CommunityPrivilegedUserSyncMessage struct {
 	RequestToJoin           map[string]*CommunityRequestToJoin           `protobuf:"bytes,4,rep,name=request_to_join,json=requestToJoin,proto3" json:"request_to_join,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	SyncRequestsToJoin      []*SyncCommunityRequestsToJoin               `protobuf:"bytes,5,rep,name=sync_requests_to_join,json=syncRequestsToJoin,proto3" json:"sync_requests_to_join,omitempty"`
 	SyncEditSharedAddresses *SyncCommunityEditSharedAddresses            `protobuf:"bytes,6,opt,name=sync_edit_shared_addresses,json=syncEditSharedAddresses,proto3" json:"sync_edit_shared_addresses,omitempty"`
+	ModerationLogEntries    []*CommunityModerationLogEntry               `protobuf:"bytes,7,rep,name=moderation_log_entries,json=moderationLogEntries,proto3" json:"moderation_log_entries,omitempty"`
 }
 
 func (x *CommunityPrivilegedUserSyncMessage) Reset() {
@@ -217,6 +297,108 @@ func (x *CommunityPrivilegedUserSyncMessage) GetSyncEditSharedAddresses() *SyncC
 	return nil
 }
 
+func (x *CommunityPrivilegedUserSyncMessage) GetModerationLogEntries() []*CommunityModerationLogEntry {
+	if x != nil {
+		return x.ModerationLogEntries
+	}
+	return nil
+}
+
+type CommunityModerationLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor  string                             `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Target string                             `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Action CommunityModerationLogEntry_Action `protobuf:"varint,4,opt,name=action,proto3,enum=protobuf.CommunityModerationLogEntry_Action" json:"action,omitempty"`
+	Role   CommunityMember_Roles              `protobuf:"varint,5,opt,name=role,proto3,enum=protobuf.CommunityMember_Roles" json:"role,omitempty"`
+	Reason string                             `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Clock  uint64                             `protobuf:"varint,7,opt,name=clock,proto3" json:"clock,omitempty"`
+}
+
+func (x *CommunityModerationLogEntry) Reset() {
+	*x = CommunityModerationLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_community_privileged_user_sync_message_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityModerationLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityModerationLogEntry) ProtoMessage() {}
+
+func (x *CommunityModerationLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_community_privileged_user_sync_message_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityModerationLogEntry.ProtoReflect.Descriptor instead.
+func (*CommunityModerationLogEntry) Descriptor() ([]byte, []int) {
+	return file_community_privileged_user_sync_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CommunityModerationLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommunityModerationLogEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *CommunityModerationLogEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CommunityModerationLogEntry) GetAction() CommunityModerationLogEntry_Action {
+	if x != nil {
+		return x.Action
+	}
+	return CommunityModerationLogEntry_UNKNOWN
+}
+
+func (x *CommunityModerationLogEntry) GetRole() CommunityMember_Roles {
+	if x != nil {
+		return x.Role
+	}
+	return CommunityMember_ROLE_NONE
+}
+
+func (x *CommunityModerationLogEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CommunityModerationLogEntry) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
 var File_community_privileged_user_sync_message_proto protoreflect.FileDescriptor
 
 var file_community_privileged_user_sync_message_proto_rawDesc = []byte{
@@ -235,7 +417,7 @@ var file_community_privileged_user_sync_message_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
 	0x45, 0x64, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x65, 0x73, 0x52, 0x11, 0x65, 0x64, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x81, 0x06, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xff, 0x06, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x6d, 0x75,
 	0x6e, 0x69, 0x74, 0x79, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x55, 0x73,
 	0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
 	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c,
@@ -264,26 +446,68 @@ var file_community_privileged_user_sync_message_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
 	0x74, 0x79, 0x45, 0x64, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x17, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x64, 0x69, 0x74, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x62,
-	0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xc5, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x27, 0x0a,
-	0x23, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x43,
-	0x43, 0x45, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
-	0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
-	0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x02, 0x12,
-	0x2a, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
-	0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x2d, 0x0a, 0x29, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42,
-	0x45, 0x52, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x41,
-	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x45, 0x53, 0x10, 0x04, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x16, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x67,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x12, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xe6, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49,
+	0x4e, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x5f, 0x54,
+	0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x2d, 0x0a, 0x29, 0x43, 0x4f, 0x4e, 0x54,
+	0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f,
+	0x45, 0x44, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x45, 0x53, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x54, 0x52,
+	0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x05, 0x22, 0x9f, 0x04, 0x0a, 0x1b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x98, 0x02, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x45, 0x4d, 0x42, 0x45,
+	0x52, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x5f, 0x42, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x45, 0x4d, 0x42,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x4d,
+	0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x53, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x50, 0x45,
+	0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x09, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
+	0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x0a, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4a, 0x4f, 0x49, 0x4e,
+	0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x0b, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f,
 	0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x33,
 }
@@ -300,29 +524,35 @@ func file_community_privileged_user_sync_message_proto_rawDescGZIP() []byte {
 	return file_community_privileged_user_sync_message_proto_rawDescData
 }
 
-var file_community_privileged_user_sync_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_community_privileged_user_sync_message_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_community_privileged_user_sync_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_community_privileged_user_sync_message_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_community_privileged_user_sync_message_proto_goTypes = []interface{}{
 	(CommunityPrivilegedUserSyncMessage_EventType)(0), // 0: protobuf.CommunityPrivilegedUserSyncMessage.EventType
-	(*SyncCommunityEditSharedAddresses)(nil),          // 1: protobuf.SyncCommunityEditSharedAddresses
-	(*CommunityPrivilegedUserSyncMessage)(nil),        // 2: protobuf.CommunityPrivilegedUserSyncMessage
-	nil,                                  // 3: protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry
-	(*CommunityEditSharedAddresses)(nil), // 4: protobuf.CommunityEditSharedAddresses
-	(*SyncCommunityRequestsToJoin)(nil),  // 5: protobuf.SyncCommunityRequestsToJoin
-	(*CommunityRequestToJoin)(nil),       // 6: protobuf.CommunityRequestToJoin
+	(CommunityModerationLogEntry_Action)(0),           // 1: protobuf.CommunityModerationLogEntry.Action
+	(*SyncCommunityEditSharedAddresses)(nil),          // 2: protobuf.SyncCommunityEditSharedAddresses
+	(*CommunityPrivilegedUserSyncMessage)(nil),        // 3: protobuf.CommunityPrivilegedUserSyncMessage
+	(*CommunityModerationLogEntry)(nil),               // 4: protobuf.CommunityModerationLogEntry
+	nil,                                               // 5: protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry
+	(*CommunityEditSharedAddresses)(nil),              // 6: protobuf.CommunityEditSharedAddresses
+	(*SyncCommunityRequestsToJoin)(nil),               // 7: protobuf.SyncCommunityRequestsToJoin
+	(CommunityMember_Roles)(0),                        // 8: protobuf.CommunityMember.Roles
+	(*CommunityRequestToJoin)(nil),                    // 9: protobuf.CommunityRequestToJoin
 }
 var file_community_privileged_user_sync_message_proto_depIdxs = []int32{
-	4, // 0: protobuf.SyncCommunityEditSharedAddresses.edit_shared_address:type_name -> protobuf.CommunityEditSharedAddresses
+	6, // 0: protobuf.SyncCommunityEditSharedAddresses.edit_shared_address:type_name -> protobuf.CommunityEditSharedAddresses
 	0, // 1: protobuf.CommunityPrivilegedUserSyncMessage.type:type_name -> protobuf.CommunityPrivilegedUserSyncMessage.EventType
-	3, // 2: protobuf.CommunityPrivilegedUserSyncMessage.request_to_join:type_name -> protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry
-	5, // 3: protobuf.CommunityPrivilegedUserSyncMessage.sync_requests_to_join:type_name -> protobuf.SyncCommunityRequestsToJoin
-	1, // 4: protobuf.CommunityPrivilegedUserSyncMessage.sync_edit_shared_addresses:type_name -> protobuf.SyncCommunityEditSharedAddresses
-	6, // 5: protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry.value:type_name -> protobuf.CommunityRequestToJoin
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5, // 2: protobuf.CommunityPrivilegedUserSyncMessage.request_to_join:type_name -> protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry
+	7, // 3: protobuf.CommunityPrivilegedUserSyncMessage.sync_requests_to_join:type_name -> protobuf.SyncCommunityRequestsToJoin
+	2, // 4: protobuf.CommunityPrivilegedUserSyncMessage.sync_edit_shared_addresses:type_name -> protobuf.SyncCommunityEditSharedAddresses
+	4, // 5: protobuf.CommunityPrivilegedUserSyncMessage.moderation_log_entries:type_name -> protobuf.CommunityModerationLogEntry
+	1, // 6: protobuf.CommunityModerationLogEntry.action:type_name -> protobuf.CommunityModerationLogEntry.Action
+	8, // 7: protobuf.CommunityModerationLogEntry.role:type_name -> protobuf.CommunityMember.Roles
+	9, // 8: protobuf.CommunityPrivilegedUserSyncMessage.RequestToJoinEntry.value:type_name -> protobuf.CommunityRequestToJoin
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_community_privileged_user_sync_message_proto_init() }
@@ -357,14 +587,26 @@ func file_community_privileged_user_sync_message_proto_init() {
 				return nil
 			}
 		}
+		file_community_privileged_user_sync_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityModerationLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_community_privileged_user_sync_message_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
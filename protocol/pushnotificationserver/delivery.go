@@ -0,0 +1,394 @@
+package pushnotificationserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	defaultDeliveryMaxAttempts  = 3
+	defaultDeliveryRetryDelay   = 500 * time.Millisecond
+	defaultWebhookTimeout       = 10 * time.Second
+	unifiedPushMaxPayloadLength = 4096
+
+	webhookWorkers         = 4
+	webhookQueueSize       = 256
+	webhookEndpointBackoff = 30 * time.Second
+	maxWebhookBackoff      = 30 * time.Minute
+)
+
+// ErrWebhookQueueFull is returned when notifications are dropped because the delivery queue is full
+var ErrWebhookQueueFull = errors.New("webhook delivery queue is full")
+
+var errWebhookAddressNotAllowed = errors.New("webhook address is not a public address")
+
+// Delivery sends push notifications through a given backend
+type Delivery interface {
+	Send(requestAndRegistrations []*RequestAndRegistration) error
+}
+
+// RetryPolicy describes how deliveries are retried, the delay doubles after each failed attempt
+type RetryPolicy struct {
+	MaxAttempts int
+	Delay       time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultDeliveryMaxAttempts
+	}
+	if p.Delay <= 0 {
+		p.Delay = defaultDeliveryRetryDelay
+	}
+	return p
+}
+
+// retryableError marks the errors worth another delivery attempt
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// do calls fn until it succeeds, fails with a non retryable error or quit is closed
+func (p RetryPolicy) do(quit <-chan struct{}, fn func() error) error {
+	delay := p.Delay
+	var err error
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		err = fn()
+		retryable, ok := err.(*retryableError)
+		if !ok {
+			return err
+		}
+		err = retryable.err
+		if attempt < p.MaxAttempts {
+			select {
+			case <-time.After(delay):
+			case <-quit:
+				return err
+			}
+			delay *= 2
+		}
+	}
+	return err
+}
+
+// gorushDelivery sends APN and Firebase notifications through gorush
+type gorushDelivery struct {
+	url    string
+	logger *zap.Logger
+}
+
+func (d *gorushDelivery) Send(requestAndRegistrations []*RequestAndRegistration) error {
+	goRushRequest := PushNotificationRegistrationToGoRushRequest(requestAndRegistrations)
+	return sendGoRushNotification(goRushRequest, d.url, d.logger)
+}
+
+// WebhookNotification is the body POSTed to UnifiedPush endpoints and webhooks
+type WebhookNotification struct {
+	Message          string `json:"message"`
+	EncryptedMessage string `json:"encryptedMessage,omitempty"`
+	ChatID           string `json:"chatId"`
+	PublicKey        string `json:"publicKey"`
+}
+
+// isPublicIP tells whether ip may be reached by webhook deliveries
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+func webhookHostAllowed(host string, allowedHosts []string) bool {
+	for _, allowedHost := range allowedHosts {
+		if strings.EqualFold(host, allowedHost) {
+			return true
+		}
+	}
+	return false
+}
+
+// newWebhookClient returns a client which refuses to connect to non public addresses, so that
+// registrants can't make the server reach its own network. The check is done on the resolved
+// address when dialing, hosts listed in allowedHosts are exempted.
+func newWebhookClient(allowedHosts []string) *http.Client {
+	dialer := &net.Dialer{Timeout: defaultWebhookTimeout}
+	publicDialer := &net.Dialer{
+		Timeout: defaultWebhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return errWebhookAddressNotAllowed
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: defaultWebhookTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+				if webhookHostAllowed(host, allowedHosts) {
+					return dialer.DialContext(ctx, network, address)
+				}
+				return publicDialer.DialContext(ctx, network, address)
+			},
+			TLSHandshakeTimeout: defaultWebhookTimeout,
+			MaxIdleConnsPerHost: webhookWorkers,
+		},
+	}
+}
+
+type webhookJob struct {
+	endpoint string
+	payload  []byte
+}
+
+// endpointBackoff keeps an endpoint from being delivered to after consecutive failed deliveries
+type endpointBackoff struct {
+	failures int
+	until    time.Time
+}
+
+// webhookDelivery POSTs each notification to the url given as device token by the registration.
+// Notifications are queued and delivered by a bounded number of workers, so that slow endpoints
+// don't hold the handling of messages.
+type webhookDelivery struct {
+	client *http.Client
+	retry  RetryPolicy
+	// maxPayloadLength drops the encrypted message from bigger notifications, when set
+	maxPayloadLength int
+	logger           *zap.Logger
+
+	queue     chan *webhookJob
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	backoffsLock sync.Mutex
+	backoffs     map[string]*endpointBackoff
+}
+
+func newWebhookDelivery(retry RetryPolicy, maxPayloadLength int, allowedHosts []string, logger *zap.Logger) *webhookDelivery {
+	return &webhookDelivery{
+		client:           newWebhookClient(allowedHosts),
+		retry:            retry.withDefaults(),
+		maxPayloadLength: maxPayloadLength,
+		logger:           logger,
+		queue:            make(chan *webhookJob, webhookQueueSize),
+		quit:             make(chan struct{}),
+		backoffs:         make(map[string]*endpointBackoff),
+	}
+}
+
+func (d *webhookDelivery) buildPayload(request *protobuf.PushNotification) ([]byte, error) {
+	notification := &WebhookNotification{
+		Message:          notificationText(request),
+		EncryptedMessage: types.EncodeHex(request.Message),
+		ChatID:           types.EncodeHex(request.ChatId),
+		PublicKey:        types.EncodeHex(request.PublicKey),
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+	if d.maxPayloadLength == 0 || len(payload) <= d.maxPayloadLength {
+		return payload, nil
+	}
+
+	// The device fetches the message from the network instead
+	notification.EncryptedMessage = ""
+	return json.Marshal(notification)
+}
+
+func (d *webhookDelivery) post(endpoint string, payload []byte) error {
+	response, err := d.client.Post(endpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		if errors.Is(err, errWebhookAddressNotAllowed) {
+			return err
+		}
+		return &retryableError{err}
+	}
+	defer response.Body.Close()
+	_, _ = ioutil.ReadAll(response.Body)
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	err = fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	if response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests {
+		return &retryableError{err}
+	}
+	return err
+}
+
+// Send queues the notifications, endpoints currently backing off are skipped
+func (d *webhookDelivery) Send(requestAndRegistrations []*RequestAndRegistration) error {
+	d.startOnce.Do(d.start)
+
+	var lastErr error
+	for _, requestAndRegistration := range requestAndRegistrations {
+		endpoint := requestAndRegistration.Registration.DeviceToken
+		if d.backingOff(endpoint) {
+			d.logger.Debug("skipping webhook notification, endpoint is backing off")
+			continue
+		}
+
+		payload, err := d.buildPayload(requestAndRegistration.Request)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case d.queue <- &webhookJob{endpoint: endpoint, payload: payload}:
+		default:
+			d.logger.Warn("dropping webhook notification", zap.Error(ErrWebhookQueueFull))
+			lastErr = ErrWebhookQueueFull
+		}
+	}
+	return lastErr
+}
+
+func (d *webhookDelivery) start() {
+	for i := 0; i < webhookWorkers; i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for {
+				select {
+				case job := <-d.queue:
+					d.deliver(job)
+				case <-d.quit:
+					return
+				}
+			}
+		}()
+	}
+}
+
+// Stop stops the workers, queued notifications are dropped
+func (d *webhookDelivery) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.quit)
+		d.wg.Wait()
+	})
+}
+
+func (d *webhookDelivery) deliver(job *webhookJob) {
+	// The endpoint might have started backing off while the job was queued
+	if d.backingOff(job.endpoint) {
+		return
+	}
+
+	err := d.retry.do(d.quit, func() error {
+		return d.post(job.endpoint, job.payload)
+	})
+	if err != nil {
+		d.logger.Warn("failed to deliver webhook notification", zap.Error(err))
+	}
+	d.updateBackoff(job.endpoint, err)
+}
+
+func (d *webhookDelivery) backingOff(endpoint string) bool {
+	d.backoffsLock.Lock()
+	defer d.backoffsLock.Unlock()
+
+	backoff, ok := d.backoffs[endpoint]
+	return ok && time.Now().Before(backoff.until)
+}
+
+// updateBackoff doubles the backoff of an endpoint after each failed delivery and resets it on success
+func (d *webhookDelivery) updateBackoff(endpoint string, err error) {
+	d.backoffsLock.Lock()
+	defer d.backoffsLock.Unlock()
+
+	if err == nil {
+		delete(d.backoffs, endpoint)
+		return
+	}
+
+	backoff, ok := d.backoffs[endpoint]
+	if !ok {
+		backoff = &endpointBackoff{}
+		d.backoffs[endpoint] = backoff
+	}
+	backoff.failures++
+
+	delay := webhookEndpointBackoff
+	for i := 1; i < backoff.failures && delay < maxWebhookBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookBackoff {
+		delay = maxWebhookBackoff
+	}
+	backoff.until = time.Now().Add(delay)
+}
+
+func isWebhookTokenType(tokenType protobuf.PushNotificationRegistration_TokenType) bool {
+	return tokenType == protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN ||
+		tokenType == protobuf.PushNotificationRegistration_WEBHOOK_TOKEN
+}
+
+// validateWebhookURL makes sure the device token of a webhook registration is an absolute https url,
+// plain http is only accepted when allowed by the server configuration.
+// Hosts which aren't allowed explicitly must not point to a loopback, private or link-local address,
+// host names are checked once resolved, when delivering.
+func validateWebhookURL(deviceToken string, allowInsecure bool, allowedHosts []string) error {
+	u, err := url.Parse(deviceToken)
+	if err != nil || u.Host == "" {
+		return ErrMalformedPushNotificationRegistrationDeviceToken
+	}
+	if u.Scheme != "https" && !(allowInsecure && u.Scheme == "http") {
+		return ErrMalformedPushNotificationRegistrationDeviceToken
+	}
+
+	host := u.Hostname()
+	if webhookHostAllowed(host, allowedHosts) {
+		return nil
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return ErrMalformedPushNotificationRegistrationDeviceToken
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return ErrMalformedPushNotificationRegistrationDeviceToken
+	}
+	return nil
+}
+
+func defaultDeliveries(config *Config) map[protobuf.PushNotificationRegistration_TokenType]Delivery {
+	gorush := &gorushDelivery{url: config.GorushURL, logger: config.Logger}
+	return map[protobuf.PushNotificationRegistration_TokenType]Delivery{
+		protobuf.PushNotificationRegistration_APN_TOKEN:          gorush,
+		protobuf.PushNotificationRegistration_FIREBASE_TOKEN:     gorush,
+		protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN: newWebhookDelivery(config.WebhookRetryPolicy, unifiedPushMaxPayloadLength, config.WebhookAllowedHosts, config.Logger),
+		protobuf.PushNotificationRegistration_WEBHOOK_TOKEN:      newWebhookDelivery(config.WebhookRetryPolicy, 0, config.WebhookAllowedHosts, config.Logger),
+	}
+}
@@ -0,0 +1,249 @@
+package pushnotificationserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+type webhookStandIn struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+}
+
+func (w *webhookStandIn) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.bodies = append(w.bodies, body)
+
+	status := http.StatusOK
+	if len(w.statuses) > 0 {
+		status = w.statuses[0]
+		w.statuses = w.statuses[1:]
+	}
+	rw.WriteHeader(status)
+}
+
+func (w *webhookStandIn) received() [][]byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.bodies
+}
+
+type mockDelivery struct {
+	sent []*RequestAndRegistration
+}
+
+func (d *mockDelivery) Send(requestAndRegistrations []*RequestAndRegistration) error {
+	d.sent = append(d.sent, requestAndRegistrations...)
+	return nil
+}
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, Delay: time.Millisecond}
+
+func webhookRequestAndRegistration(endpoint string, tokenType protobuf.PushNotificationRegistration_TokenType, message []byte) *RequestAndRegistration {
+	return &RequestAndRegistration{
+		Request: &protobuf.PushNotification{
+			ChatId:    []byte("chat-id"),
+			Type:      protobuf.PushNotification_MESSAGE,
+			PublicKey: []byte("public-key"),
+			Message:   message,
+		},
+		Registration: &protobuf.PushNotificationRegistration{
+			DeviceToken: endpoint,
+			TokenType:   tokenType,
+		},
+	}
+}
+
+var testAllowedHosts = []string{"127.0.0.1"}
+
+func TestWebhookDeliveryRetries(t *testing.T) {
+	standIn := &webhookStandIn{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	delivery := newWebhookDelivery(testRetryPolicy, 0, testAllowedHosts, tt.MustCreateTestLogger())
+	defer delivery.Stop()
+
+	err := delivery.Send([]*RequestAndRegistration{
+		webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")),
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(standIn.received()) == 3 }, time.Second, 10*time.Millisecond)
+	bodies := standIn.received()
+
+	var notification WebhookNotification
+	require.NoError(t, json.Unmarshal(bodies[2], &notification))
+	require.Equal(t, defaultNewMessageNotificationText, notification.Message)
+	require.Equal(t, types.EncodeHex([]byte("message")), notification.EncryptedMessage)
+	require.Equal(t, types.EncodeHex([]byte("chat-id")), notification.ChatID)
+	require.Equal(t, types.EncodeHex([]byte("public-key")), notification.PublicKey)
+}
+
+func TestWebhookDeliveryGivesUp(t *testing.T) {
+	standIn := &webhookStandIn{statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	goneStandIn := &webhookStandIn{statuses: []int{http.StatusGone}}
+	goneServer := httptest.NewServer(goneStandIn)
+	defer goneServer.Close()
+
+	okStandIn := &webhookStandIn{}
+	okServer := httptest.NewServer(okStandIn)
+	defer okServer.Close()
+
+	delivery := newWebhookDelivery(testRetryPolicy, 0, testAllowedHosts, tt.MustCreateTestLogger())
+	defer delivery.Stop()
+
+	requests := []*RequestAndRegistration{
+		webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")),
+		webhookRequestAndRegistration(goneServer.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")),
+		webhookRequestAndRegistration(okServer.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")),
+	}
+	require.NoError(t, delivery.Send(requests))
+
+	// Failing endpoints back off once they've been given up on
+	require.Eventually(t, func() bool {
+		return delivery.backingOff(server.URL) && delivery.backingOff(goneServer.URL)
+	}, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(okStandIn.received()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// Retried until the maximum number of attempts
+	require.Len(t, standIn.received(), 3)
+	// Client errors aren't retried
+	require.Len(t, goneStandIn.received(), 1)
+
+	// Endpoints backing off are skipped, the other devices still get their notification
+	require.NoError(t, delivery.Send(requests))
+	require.Eventually(t, func() bool { return len(okStandIn.received()) == 2 }, time.Second, 10*time.Millisecond)
+	require.Len(t, standIn.received(), 3)
+	require.Len(t, goneStandIn.received(), 1)
+}
+
+func TestWebhookDeliveryRefusesPrivateAddresses(t *testing.T) {
+	standIn := &webhookStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	delivery := newWebhookDelivery(testRetryPolicy, 0, nil, tt.MustCreateTestLogger())
+	defer delivery.Stop()
+
+	err := delivery.Send([]*RequestAndRegistration{
+		webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")),
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return delivery.backingOff(server.URL) }, time.Second, 10*time.Millisecond)
+	require.Empty(t, standIn.received())
+}
+
+func TestWebhookDeliveryDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	delivery := newWebhookDelivery(testRetryPolicy, 0, testAllowedHosts, tt.MustCreateTestLogger())
+	defer delivery.Stop()
+
+	var requests []*RequestAndRegistration
+	for i := 0; i < 2*webhookWorkers; i++ {
+		requests = append(requests, webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_WEBHOOK_TOKEN, []byte("message")))
+	}
+
+	sent := make(chan error)
+	go func() {
+		sent <- delivery.Send(requests)
+	}()
+
+	select {
+	case err := <-sent:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.FailNow(t, "sending notifications is blocked by a slow endpoint")
+	}
+}
+
+func TestUnifiedPushPayloadLength(t *testing.T) {
+	standIn := &webhookStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	delivery := newWebhookDelivery(testRetryPolicy, unifiedPushMaxPayloadLength, testAllowedHosts, tt.MustCreateTestLogger())
+	defer delivery.Stop()
+
+	err := delivery.Send([]*RequestAndRegistration{
+		webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN, []byte(strings.Repeat("a", unifiedPushMaxPayloadLength))),
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(standIn.received()) == 1 }, time.Second, 10*time.Millisecond)
+	bodies := standIn.received()
+	require.LessOrEqual(t, len(bodies[0]), unifiedPushMaxPayloadLength)
+
+	var notification WebhookNotification
+	require.NoError(t, json.Unmarshal(bodies[0], &notification))
+	require.Empty(t, notification.EncryptedMessage)
+	require.Equal(t, types.EncodeHex([]byte("chat-id")), notification.ChatID)
+}
+
+func TestSendPushNotificationByTokenType(t *testing.T) {
+	standIn := &webhookStandIn{}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	apn := &mockDelivery{}
+	s := New(&Config{
+		Logger:              tt.MustCreateTestLogger(),
+		WebhookRetryPolicy:  testRetryPolicy,
+		WebhookAllowedHosts: testAllowedHosts,
+		Deliveries: map[protobuf.PushNotificationRegistration_TokenType]Delivery{
+			protobuf.PushNotificationRegistration_APN_TOKEN: apn,
+		},
+	}, nil, nil)
+	defer func() { require.NoError(t, s.Stop()) }()
+
+	apnRequest := webhookRequestAndRegistration("apn-token", protobuf.PushNotificationRegistration_APN_TOKEN, []byte("message-1"))
+	err := s.sendPushNotification([]*RequestAndRegistration{
+		apnRequest,
+		webhookRequestAndRegistration(server.URL, protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN, []byte("message-2")),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []*RequestAndRegistration{apnRequest}, apn.sent)
+	require.Eventually(t, func() bool { return len(standIn.received()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	require.NoError(t, validateWebhookURL("https://push.example.org/up/abc", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("http://push.example.org/up/abc", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("not-an-url", true, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("ftp://push.example.org", true, nil))
+
+	// Loopback, private and link-local hosts are refused unless allowed by the operator
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("https://127.0.0.1:8080/up", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("https://localhost/up", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("https://192.168.1.10/up", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("https://169.254.169.254/latest", false, nil))
+	require.Equal(t, ErrMalformedPushNotificationRegistrationDeviceToken, validateWebhookURL("https://[fe80::1]/up", false, nil))
+	require.NoError(t, validateWebhookURL("http://127.0.0.1:8080/up", true, testAllowedHosts))
+}
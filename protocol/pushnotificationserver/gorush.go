@@ -49,19 +49,22 @@ func tokenTypeToGoRushPlatform(tokenType protobuf.PushNotificationRegistration_T
 	return 0
 }
 
+func notificationText(request *protobuf.PushNotification) string {
+	switch request.Type {
+	case protobuf.PushNotification_MESSAGE:
+		return defaultNewMessageNotificationText
+	case protobuf.PushNotification_REQUEST_TO_JOIN_COMMUNITY:
+		return defaultRequestToJoinCommunityNotificationText
+	}
+	return defaultMentionNotificationText
+}
+
 func PushNotificationRegistrationToGoRushRequest(requestAndRegistrations []*RequestAndRegistration) *GoRushRequest {
 	goRushRequests := &GoRushRequest{}
 	for _, requestAndRegistration := range requestAndRegistrations {
 		request := requestAndRegistration.Request
 		registration := requestAndRegistration.Registration
-		var text string
-		if request.Type == protobuf.PushNotification_MESSAGE {
-			text = defaultNewMessageNotificationText
-		} else if request.Type == protobuf.PushNotification_REQUEST_TO_JOIN_COMMUNITY {
-			text = defaultRequestToJoinCommunityNotificationText
-		} else {
-			text = defaultMentionNotificationText
-		}
+		text := notificationText(request)
 		goRushRequests.Notifications = append(goRushRequests.Notifications,
 			&GoRushRequestNotification{
 				Tokens:   []string{registration.DeviceToken},
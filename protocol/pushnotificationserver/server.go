@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
@@ -29,6 +30,15 @@ type Config struct {
 	Identity *ecdsa.PrivateKey
 	// GorushUrl is the url for the gorush service
 	GorushURL string
+	// Deliveries overrides the delivery backend of the given token types
+	Deliveries map[protobuf.PushNotificationRegistration_TokenType]Delivery
+	// WebhookRetryPolicy is used for UnifiedPush and webhook deliveries
+	WebhookRetryPolicy RetryPolicy
+	// AllowInsecureWebhooks accepts plain http webhook urls, for local setups
+	AllowInsecureWebhooks bool
+	// WebhookAllowedHosts lists the webhook hosts allowed to resolve to loopback, private
+	// or link-local addresses, for self-hosted endpoints
+	WebhookAllowedHosts []string
 
 	Logger *zap.Logger
 }
@@ -38,7 +48,9 @@ type Server struct {
 	config        *Config
 	messageSender *common.MessageSender
 	// SentRequests keeps track of the requests sent to gorush, for testing only
-	SentRequests int64
+	SentRequests   int64
+	deliveries     map[protobuf.PushNotificationRegistration_TokenType]Delivery
+	deliveriesOnce sync.Once
 }
 
 func New(config *Config, persistence Persistence, messageSender *common.MessageSender) *Server {
@@ -94,6 +106,16 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// Stop stops the delivery backends running in the background
+func (s *Server) Stop() error {
+	for _, delivery := range s.allDeliveries() {
+		if stoppable, ok := delivery.(interface{ Stop() }); ok {
+			stoppable.Stop()
+		}
+	}
+	return nil
+}
+
 // HandlePushNotificationRegistration builds a response for the registration and sends it back to the user
 func (s *Server) HandlePushNotificationRegistration(publicKey *ecdsa.PublicKey, payload []byte) error {
 	response := s.buildPushNotificationRegistrationResponse(publicKey, payload)
@@ -306,6 +328,12 @@ func (s *Server) validateRegistration(publicKey *ecdsa.PublicKey, payload []byte
 		return nil, ErrUnknownPushNotificationRegistrationTokenType
 	}
 
+	if isWebhookTokenType(registration.TokenType) {
+		if err := validateWebhookURL(registration.DeviceToken, s.config.AllowInsecureWebhooks, s.config.WebhookAllowedHosts); err != nil {
+			return nil, err
+		}
+	}
+
 	return registration, nil
 }
 
@@ -457,8 +485,47 @@ func (s *Server) sendPushNotification(requestAndRegistrations []*RequestAndRegis
 		return nil
 	}
 	s.SentRequests++
-	goRushRequest := PushNotificationRegistrationToGoRushRequest(requestAndRegistrations)
-	return sendGoRushNotification(goRushRequest, s.config.GorushURL, s.config.Logger)
+
+	byTokenType := make(map[protobuf.PushNotificationRegistration_TokenType][]*RequestAndRegistration)
+	var tokenTypes []protobuf.PushNotificationRegistration_TokenType
+	for _, requestAndRegistration := range requestAndRegistrations {
+		tokenType := requestAndRegistration.Registration.TokenType
+		if _, ok := byTokenType[tokenType]; !ok {
+			tokenTypes = append(tokenTypes, tokenType)
+		}
+		byTokenType[tokenType] = append(byTokenType[tokenType], requestAndRegistration)
+	}
+
+	var lastErr error
+	for _, tokenType := range tokenTypes {
+		delivery := s.delivery(tokenType)
+		if delivery == nil {
+			s.config.Logger.Warn("no delivery backend for token type", zap.Stringer("token-type", tokenType))
+			continue
+		}
+		err := delivery.Send(byTokenType[tokenType])
+		if err != nil {
+			s.config.Logger.Error("failed to deliver push notifications", zap.Stringer("token-type", tokenType), zap.Error(err))
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// delivery returns the backend delivering the notifications of the given token type
+func (s *Server) delivery(tokenType protobuf.PushNotificationRegistration_TokenType) Delivery {
+	return s.allDeliveries()[tokenType]
+}
+
+// allDeliveries builds the delivery backends once, after Start has set the logger of the config
+func (s *Server) allDeliveries() map[protobuf.PushNotificationRegistration_TokenType]Delivery {
+	s.deliveriesOnce.Do(func() {
+		s.deliveries = defaultDeliveries(s.config)
+		for t, delivery := range s.config.Deliveries {
+			s.deliveries[t] = delivery
+		}
+	})
+	return s.deliveries
 }
 
 // listenToPublicKeyQueryTopic listen to a topic derived from the hashed public key
@@ -13,6 +13,7 @@ type BanUserFromCommunity struct {
 	CommunityID       types.HexBytes `json:"communityId"`
 	User              types.HexBytes `json:"user"`
 	DeleteAllMessages bool           `json:"deleteAllMessages"`
+	Reason            string         `json:"reason,omitempty"`
 }
 
 func (b *BanUserFromCommunity) Validate() error {
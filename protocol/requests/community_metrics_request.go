@@ -8,6 +8,7 @@ import (
 
 var ErrNoCommunityID = errors.New("community metrics request has no community id")
 var ErrInvalidTimestampIntervals = errors.New("community metrics request invalid time intervals")
+var ErrInvalidMetricsLimit = errors.New("community metrics request invalid limit")
 
 type CommunityMetricsRequestType uint
 
@@ -16,8 +17,17 @@ const (
 	CommunityMetricsRequestMessagesCount
 	CommunityMetricsRequestMembers
 	CommunityMetricsRequestControlNodeUptime
+	CommunityMetricsRequestActivePosters
+	CommunityMetricsRequestRetainedPosters
+	CommunityMetricsRequestMemberJoins
+	CommunityMetricsRequestMemberLeaves
+	CommunityMetricsRequestChannelMessages
+	CommunityMetricsRequestReactionsCount
+	CommunityMetricsRequestTopContributors
 )
 
+const DefaultCommunityMetricsTopContributorsLimit = 10
+
 type MetricsIntervalRequest struct {
 	StartTimestamp uint64 `json:"startTimestamp"`
 	EndTimestamp   uint64 `json:"endTimestamp"`
@@ -27,6 +37,8 @@ type CommunityMetricsRequest struct {
 	CommunityID types.HexBytes              `json:"communityId"`
 	Type        CommunityMetricsRequestType `json:"type"`
 	Intervals   []MetricsIntervalRequest    `json:"intervals"`
+	// Limit is the number of top contributors returned per interval
+	Limit int `json:"limit,omitempty"`
 }
 
 func (r *CommunityMetricsRequest) Validate() error {
@@ -40,5 +52,9 @@ func (r *CommunityMetricsRequest) Validate() error {
 		}
 	}
 
+	if r.Limit < 0 {
+		return ErrInvalidMetricsLimit
+	}
+
 	return nil
 }
@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityScheduledEventInvalidCommunityID = errors.New("create-community-scheduled-event: invalid community id")
+var ErrCreateCommunityScheduledEventInvalidTitle = errors.New("create-community-scheduled-event: invalid title")
+var ErrCreateCommunityScheduledEventInvalidTime = errors.New("create-community-scheduled-event: invalid time")
+
+type CreateCommunityScheduledEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	// Unix timestamps in milliseconds
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime,omitempty"`
+	Location  string `json:"location,omitempty"`
+	ChatID    string `json:"chatId,omitempty"`
+}
+
+func (j *CreateCommunityScheduledEvent) Validate() error {
+	if len(j.CommunityID) == 0 {
+		return ErrCreateCommunityScheduledEventInvalidCommunityID
+	}
+
+	if len(j.Title) == 0 {
+		return ErrCreateCommunityScheduledEventInvalidTitle
+	}
+
+	if j.StartTime == 0 || (j.EndTime != 0 && j.EndTime < j.StartTime) {
+		return ErrCreateCommunityScheduledEventInvalidTime
+	}
+
+	return nil
+}
+
+func (j *CreateCommunityScheduledEvent) ToScheduledEvent(eventID string) *protobuf.CommunityScheduledEvent {
+	return &protobuf.CommunityScheduledEvent{
+		Id:          eventID,
+		Title:       j.Title,
+		Description: j.Description,
+		StartTime:   j.StartTime,
+		EndTime:     j.EndTime,
+		Location:    j.Location,
+		ChatId:      j.ChatID,
+	}
+}
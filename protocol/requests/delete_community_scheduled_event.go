@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrDeleteCommunityScheduledEventInvalidCommunityID = errors.New("delete-community-scheduled-event: invalid community id")
+var ErrDeleteCommunityScheduledEventInvalidEventID = errors.New("delete-community-scheduled-event: invalid event id")
+
+type DeleteCommunityScheduledEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	EventID     string         `json:"eventId"`
+}
+
+func (j *DeleteCommunityScheduledEvent) Validate() error {
+	if len(j.CommunityID) == 0 {
+		return ErrDeleteCommunityScheduledEventInvalidCommunityID
+	}
+
+	if len(j.EventID) == 0 {
+		return ErrDeleteCommunityScheduledEventInvalidEventID
+	}
+
+	return nil
+}
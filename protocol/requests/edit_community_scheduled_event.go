@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrEditCommunityScheduledEventInvalidCommunityID = errors.New("edit-community-scheduled-event: invalid community id")
+var ErrEditCommunityScheduledEventInvalidEventID = errors.New("edit-community-scheduled-event: invalid event id")
+var ErrEditCommunityScheduledEventInvalidTitle = errors.New("edit-community-scheduled-event: invalid title")
+var ErrEditCommunityScheduledEventInvalidTime = errors.New("edit-community-scheduled-event: invalid time")
+
+type EditCommunityScheduledEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	EventID     string         `json:"eventId"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	// Unix timestamps in milliseconds
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime,omitempty"`
+	Location  string `json:"location,omitempty"`
+	ChatID    string `json:"chatId,omitempty"`
+}
+
+func (j *EditCommunityScheduledEvent) Validate() error {
+	if len(j.CommunityID) == 0 {
+		return ErrEditCommunityScheduledEventInvalidCommunityID
+	}
+
+	if len(j.EventID) == 0 {
+		return ErrEditCommunityScheduledEventInvalidEventID
+	}
+
+	if len(j.Title) == 0 {
+		return ErrEditCommunityScheduledEventInvalidTitle
+	}
+
+	if j.StartTime == 0 || (j.EndTime != 0 && j.EndTime < j.StartTime) {
+		return ErrEditCommunityScheduledEventInvalidTime
+	}
+
+	return nil
+}
+
+func (j *EditCommunityScheduledEvent) ToScheduledEvent() *protobuf.CommunityScheduledEvent {
+	return &protobuf.CommunityScheduledEvent{
+		Id:          j.EventID,
+		Title:       j.Title,
+		Description: j.Description,
+		StartTime:   j.StartTime,
+		EndTime:     j.EndTime,
+		Location:    j.Location,
+		ChatId:      j.ChatID,
+	}
+}
@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrExportLocalBackupInvalidFilePath = errors.New("export-local-backup: invalid file path")
+var ErrExportLocalBackupInvalidPassphrase = errors.New("export-local-backup: invalid passphrase")
+
+type ExportLocalBackup struct {
+	FilePath   string `json:"filePath"`
+	Passphrase string `json:"passphrase"`
+}
+
+func (e *ExportLocalBackup) Validate() error {
+	if len(e.FilePath) == 0 {
+		return ErrExportLocalBackupInvalidFilePath
+	}
+
+	if len(e.Passphrase) == 0 {
+		return ErrExportLocalBackupInvalidPassphrase
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"errors"
+)
+
+var (
+	ErrImportTelegramCommunityMissingFilesToImport = errors.New("import-telegram-community: missing files to import")
+	ErrImportSlackCommunityMissingFilesToImport    = errors.New("import-slack-community: missing files to import")
+)
+
+// ImportTelegramCommunity creates a community out of Telegram Desktop JSON exports (`result.json`)
+type ImportTelegramCommunity struct {
+	CreateCommunity
+	FilesToImport []string
+	From          int64
+}
+
+func (u *ImportTelegramCommunity) Validate() error {
+	if len(u.FilesToImport) == 0 {
+		return ErrImportTelegramCommunityMissingFilesToImport
+	}
+
+	return u.CreateCommunity.Validate()
+}
+
+func (u *ImportTelegramCommunity) ToCreateCommunityRequest() *CreateCommunity {
+	return &u.CreateCommunity
+}
+
+// ImportSlackCommunity creates a community out of Slack workspace export zip files
+type ImportSlackCommunity struct {
+	CreateCommunity
+	FilesToImport []string
+	From          int64
+}
+
+func (u *ImportSlackCommunity) Validate() error {
+	if len(u.FilesToImport) == 0 {
+		return ErrImportSlackCommunityMissingFilesToImport
+	}
+
+	return u.CreateCommunity.Validate()
+}
+
+func (u *ImportSlackCommunity) ToCreateCommunityRequest() *CreateCommunity {
+	return &u.CreateCommunity
+}
@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrImportLocalBackupInvalidFilePath = errors.New("import-local-backup: invalid file path")
+var ErrImportLocalBackupInvalidPassphrase = errors.New("import-local-backup: invalid passphrase")
+
+type ImportLocalBackup struct {
+	FilePath   string `json:"filePath"`
+	Passphrase string `json:"passphrase"`
+}
+
+func (i *ImportLocalBackup) Validate() error {
+	if len(i.FilePath) == 0 {
+		return ErrImportLocalBackupInvalidFilePath
+	}
+
+	if len(i.Passphrase) == 0 {
+		return ErrImportLocalBackupInvalidPassphrase
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrRsvpCommunityScheduledEventInvalidCommunityID = errors.New("rsvp-community-scheduled-event: invalid community id")
+var ErrRsvpCommunityScheduledEventInvalidEventID = errors.New("rsvp-community-scheduled-event: invalid event id")
+var ErrRsvpCommunityScheduledEventInvalidResponse = errors.New("rsvp-community-scheduled-event: invalid response")
+
+type RsvpCommunityScheduledEvent struct {
+	CommunityID types.HexBytes                                `json:"communityId"`
+	EventID     string                                        `json:"eventId"`
+	Response    protobuf.CommunityScheduledEventRsvp_Response `json:"response"`
+}
+
+func (j *RsvpCommunityScheduledEvent) Validate() error {
+	if len(j.CommunityID) == 0 {
+		return ErrRsvpCommunityScheduledEventInvalidCommunityID
+	}
+
+	if len(j.EventID) == 0 {
+		return ErrRsvpCommunityScheduledEventInvalidEventID
+	}
+
+	if _, ok := protobuf.CommunityScheduledEventRsvp_Response_name[int32(j.Response)]; !ok || j.Response == protobuf.CommunityScheduledEventRsvp_UNKNOWN {
+		return ErrRsvpCommunityScheduledEventInvalidResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var ErrShareSafeProposalInvalidSafeTxHash = errors.New("share-safe-proposal: invalid safe tx hash")
+var ErrShareSafeProposalInvalidChatID = errors.New("share-safe-proposal: invalid chat id")
+
+type ShareSafeProposal struct {
+	SafeTxHash string `json:"safeTxHash"`
+	ChatID     string `json:"chatId"`
+}
+
+func (r *ShareSafeProposal) Validate() error {
+	hash, err := hexutil.Decode(r.SafeTxHash)
+	if err != nil || len(hash) != 32 {
+		return ErrShareSafeProposalInvalidSafeTxHash
+	}
+
+	if len(r.ChatID) == 0 {
+		return ErrShareSafeProposalInvalidChatID
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrShareStickerPackInvalidPackID = errors.New("share-sticker-pack: invalid pack id")
+var ErrShareStickerPackInvalidChatID = errors.New("share-sticker-pack: invalid chat id")
+
+type ShareStickerPack struct {
+	PackID string `json:"packId"`
+	ChatID string `json:"chatId"`
+}
+
+func (r *ShareStickerPack) Validate() error {
+	if len(r.PackID) == 0 {
+		return ErrShareStickerPackInvalidPackID
+	}
+
+	if len(r.ChatID) == 0 {
+		return ErrShareStickerPackInvalidChatID
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ var ErrUnbanUserFromCommunityInvalidUser = errors.New("unban-user-from-community
 type UnbanUserFromCommunity struct {
 	CommunityID types.HexBytes `json:"communityId"`
 	User        types.HexBytes `json:"user"`
+	Reason      string         `json:"reason,omitempty"`
 }
 
 func (b *UnbanUserFromCommunity) Validate() error {
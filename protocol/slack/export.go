@@ -0,0 +1,358 @@
+package slack
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"html"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/protocol/discord"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// Matches links, user and channel mentions, e.g. `<@U024BE7LH>`, `<#C024BE7LR|general>`, `<https://status.app|Status>`
+var linkRegexp = regexp.MustCompile(`<([^<>|]+)(?:\|([^<>]*))?>`)
+
+type export struct {
+	files    map[string]*zip.File
+	root     string
+	users    map[string]*User
+	channels map[string]*Channel
+}
+
+// ReadExport parses a Slack workspace export zip file and converts every
+// public and private channel into Discord export data, so it can be imported the same way
+func ReadExport(filePath string) ([]*discord.ExportedData, error) {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	e := &export{
+		files:    make(map[string]*zip.File),
+		users:    make(map[string]*User),
+		channels: make(map[string]*Channel),
+	}
+
+	for _, file := range reader.File {
+		e.files[file.Name] = file
+		// Exports can be nested in a top level directory after being re-zipped
+		if path.Base(file.Name) == channelsFile {
+			e.root = path.Dir(file.Name)
+		}
+	}
+
+	var users []*User
+	if err := e.readJSON(usersFile, &users); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		e.users[user.ID] = user
+	}
+
+	var channels []*Channel
+	for _, fileName := range []string{channelsFile, groupsFile} {
+		var list []*Channel
+		if err := e.readJSON(fileName, &list); err != nil {
+			return nil, err
+		}
+		channels = append(channels, list...)
+	}
+	for _, channel := range channels {
+		e.channels[channel.ID] = channel
+	}
+
+	var exportedData []*discord.ExportedData
+	for _, channel := range channels {
+		messages, err := e.readChannelMessages(channel)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) == 0 {
+			continue
+		}
+		exportedData = append(exportedData, e.toExportedData(channel, messages))
+	}
+
+	if len(exportedData) == 0 {
+		return nil, ErrNoChannelData
+	}
+
+	return exportedData, nil
+}
+
+// readJSON ignores missing files, as exports only hold the files of the exported conversation types
+func (e *export) readJSON(name string, value interface{}) error {
+	file, ok := e.files[path.Join(e.root, name)]
+	if !ok {
+		return nil
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	bytes, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bytes, value)
+}
+
+// readChannelMessages reads the messages of the channel, which are split in one file per day
+func (e *export) readChannelMessages(channel *Channel) ([]*Message, error) {
+	var dayFiles []string
+	channelDir := path.Join(e.root, channel.Name)
+	for name := range e.files {
+		if path.Dir(name) == channelDir && path.Ext(name) == ".json" {
+			dayFiles = append(dayFiles, path.Base(name))
+		}
+	}
+	sort.Strings(dayFiles)
+
+	var messages []*Message
+	for _, dayFile := range dayFiles {
+		var dayMessages []*Message
+		if err := e.readJSON(path.Join(channel.Name, dayFile), &dayMessages); err != nil {
+			return nil, err
+		}
+		messages = append(messages, dayMessages...)
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return parseTs(messages[i].Ts).Before(parseTs(messages[j].Ts))
+	})
+
+	return messages, nil
+}
+
+func (e *export) toExportedData(channel *Channel, messages []*Message) *discord.ExportedData {
+	description := channel.Purpose.Value
+	if description == "" {
+		description = channel.Topic.Value
+	}
+
+	discordMessages := make([]*protobuf.DiscordMessage, 0, len(messages))
+	pins := make(map[string]*Pin)
+	for _, pin := range channel.Pins {
+		pins[pin.ID] = pin
+	}
+
+	for _, message := range messages {
+		if message.Type != "message" || !importedSubtypes[message.Subtype] {
+			continue
+		}
+
+		discordMessages = append(discordMessages, e.convertMessage(channel, message))
+
+		for _, channelID := range message.PinnedTo {
+			if _, ok := pins[message.Ts]; channelID == channel.ID && !ok {
+				pins[message.Ts] = &Pin{ID: message.Ts, User: message.User}
+			}
+		}
+	}
+
+	// Pins are appended after all the messages, the importer only pins messages it has already processed
+	discordMessages = append(discordMessages, e.convertPins(channel, pins, discordMessages)...)
+
+	return &discord.ExportedData{
+		Channel: discord.Channel{
+			ID:          channel.ID,
+			Name:        channel.Name,
+			Description: description,
+		},
+		Messages:     discordMessages,
+		MessageCount: len(discordMessages),
+	}
+}
+
+func (e *export) convertMessage(channel *Channel, message *Message) *protobuf.DiscordMessage {
+	discordMessage := &protobuf.DiscordMessage{
+		Id:        messageID(channel, message.Ts),
+		Type:      string(discord.MessageTypeDefault),
+		Timestamp: formatTs(message.Ts),
+		Content:   e.formatText(message.Text),
+		Author:    e.convertAuthor(message.User, message.Username),
+	}
+
+	if message.Edited != nil {
+		discordMessage.TimestampEdited = formatTs(message.Edited.Ts)
+	}
+
+	// Thread replies reference the thread parent message
+	if message.ThreadTs != "" && message.ThreadTs != message.Ts {
+		discordMessage.Type = string(discord.MessageTypeReply)
+		discordMessage.Reference = &protobuf.DiscordMessageReference{
+			MessageId: messageID(channel, message.ThreadTs),
+			ChannelId: channel.ID,
+		}
+	}
+
+	for _, file := range message.Files {
+		url := file.URLPrivateDownload
+		if url == "" {
+			url = file.URLPrivate
+		}
+		if url == "" {
+			continue
+		}
+
+		discordMessage.Attachments = append(discordMessage.Attachments, &protobuf.DiscordMessageAttachment{
+			Id:            file.ID,
+			Url:           url,
+			FileName:      file.Name,
+			FileSizeBytes: file.Size,
+			ContentType:   file.Mimetype,
+		})
+	}
+
+	return discordMessage
+}
+
+func (e *export) convertPins(channel *Channel, pins map[string]*Pin, messages []*protobuf.DiscordMessage) []*protobuf.DiscordMessage {
+	importedMessages := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		importedMessages[message.Id] = true
+	}
+
+	var discordMessages []*protobuf.DiscordMessage
+	for _, pin := range pins {
+		pinnedMessageID := messageID(channel, pin.ID)
+		if !importedMessages[pinnedMessageID] {
+			continue
+		}
+
+		timestamp := formatTs(pin.ID)
+		if pin.Created != 0 {
+			timestamp = time.Unix(pin.Created, 0).UTC().Format(time.RFC3339)
+		}
+
+		discordMessages = append(discordMessages, &protobuf.DiscordMessage{
+			Id:        pinnedMessageID + "-pin",
+			Type:      string(discord.MessageTypeChannelPinned),
+			Timestamp: timestamp,
+			Author:    e.convertAuthor(pin.User, ""),
+			Reference: &protobuf.DiscordMessageReference{
+				MessageId: pinnedMessageID,
+				ChannelId: channel.ID,
+			},
+		})
+	}
+
+	sort.Slice(discordMessages, func(i, j int) bool {
+		return discordMessages[i].Timestamp < discordMessages[j].Timestamp
+	})
+
+	return discordMessages
+}
+
+func (e *export) convertAuthor(userID string, username string) *protobuf.DiscordMessageAuthor {
+	user, ok := e.users[userID]
+	if !ok {
+		name := username
+		if name == "" {
+			name = userID
+		}
+		return &protobuf.DiscordMessageAuthor{
+			Id:   "slack-" + userID,
+			Name: name,
+		}
+	}
+
+	avatarURL := user.Profile.Image192
+	if avatarURL == "" {
+		avatarURL = user.Profile.Image72
+	}
+
+	return &protobuf.DiscordMessageAuthor{
+		Id:        "slack-" + user.ID,
+		Name:      user.displayName(),
+		Nickname:  user.RealName,
+		AvatarUrl: avatarURL,
+	}
+}
+
+func (u *User) displayName() string {
+	if u.Profile.DisplayName != "" {
+		return u.Profile.DisplayName
+	}
+	if u.RealName != "" {
+		return u.RealName
+	}
+	return u.Name
+}
+
+// formatText converts Slack markup into markdown
+func (e *export) formatText(text string) string {
+	text = linkRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		groups := linkRegexp.FindStringSubmatch(match)
+		target, label := groups[1], groups[2]
+
+		switch {
+		case strings.HasPrefix(target, "@"):
+			if user, ok := e.users[target[1:]]; ok {
+				return "@" + user.displayName()
+			}
+			if label != "" {
+				return "@" + label
+			}
+			return target
+		case strings.HasPrefix(target, "#"):
+			if channel, ok := e.channels[target[1:]]; ok {
+				return "#" + channel.Name
+			}
+			if label != "" {
+				return "#" + label
+			}
+			return target
+		case strings.HasPrefix(target, "!"):
+			// Special mentions, e.g. `<!here>` or `<!channel>`
+			return "@" + strings.TrimPrefix(target, "!")
+		case label != "":
+			return "[" + label + "](" + target + ")"
+		}
+		return target
+	})
+
+	return html.UnescapeString(text)
+}
+
+func messageID(channel *Channel, ts string) string {
+	return channel.ID + "-" + ts
+}
+
+// parseTs parses Slack timestamps, e.g. `1672574400.000100`
+func parseTs(ts string) time.Time {
+	parts := strings.SplitN(ts, ".", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	var micros int64
+	if len(parts) == 2 {
+		micros, _ = strconv.ParseInt(parts[1], 10, 64)
+	}
+
+	return time.Unix(seconds, micros*int64(time.Microsecond))
+}
+
+// formatTs returns an RFC3339 timestamp, as used by Discord exports
+func formatTs(ts string) string {
+	timestamp := parseTs(ts)
+	if timestamp.IsZero() {
+		// Keep the original value, the importer reports it as invalid
+		return ts
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/discord"
+)
+
+func writeExport(t *testing.T, files map[string]string) string {
+	filePath := filepath.Join(t.TempDir(), "export.zip")
+	file, err := os.Create(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return filePath
+}
+
+func TestReadExport(t *testing.T) {
+	filePath := writeExport(t, map[string]string{
+		"workspace/users.json": `[
+			{"id": "U1", "name": "alice", "real_name": "Alice A", "profile": {"display_name": "alice", "image_192": "https://avatars/alice.png"}},
+			{"id": "U2", "name": "bob", "profile": {}}
+		]`,
+		"workspace/channels.json": `[
+			{"id": "C1", "name": "general", "purpose": {"value": "General talk"}, "pins": [{"id": "1672567200.000100", "type": "C", "created": 1672567500, "user": "U2"}]},
+			{"id": "C2", "name": "empty"}
+		]`,
+		"workspace/general/2023-01-02.json": `[
+			{"type": "message", "user": "U2", "text": "answer", "ts": "1672653600.000200", "thread_ts": "1672567200.000100"}
+		]`,
+		"workspace/general/2023-01-01.json": `[
+			{"type": "message", "user": "U1", "text": "hi <@U2> see <https://status.app|Status> &amp; <#C1>", "ts": "1672567200.000100",
+			 "edited": {"user": "U1", "ts": "1672567260.000000"},
+			 "files": [{"id": "F1", "name": "a.png", "mimetype": "image/png", "size": 10, "url_private_download": "https://files/a.png"}]},
+			{"type": "message", "subtype": "channel_join", "user": "U2", "text": "<@U2> has joined the channel", "ts": "1672567100.000100"}
+		]`,
+	})
+
+	exportedData, err := ReadExport(filePath)
+	require.NoError(t, err)
+	require.Len(t, exportedData, 1)
+
+	data := exportedData[0]
+	require.Equal(t, "C1", data.Channel.ID)
+	require.Equal(t, "general", data.Channel.Name)
+	require.Equal(t, "General talk", data.Channel.Description)
+	require.Len(t, data.Messages, 3)
+	require.Equal(t, 3, data.MessageCount)
+
+	message := data.Messages[0]
+	require.Equal(t, "C1-1672567200.000100", message.Id)
+	require.Equal(t, string(discord.MessageTypeDefault), message.Type)
+	require.Equal(t, "2023-01-01T10:00:00Z", message.Timestamp)
+	require.Equal(t, "2023-01-01T10:01:00Z", message.TimestampEdited)
+	require.Equal(t, "hi @bob see [Status](https://status.app) & #general", message.Content)
+	require.Equal(t, "slack-U1", message.Author.Id)
+	require.Equal(t, "alice", message.Author.Name)
+	require.Equal(t, "https://avatars/alice.png", message.Author.AvatarUrl)
+	require.Len(t, message.Attachments, 1)
+	require.Equal(t, "https://files/a.png", message.Attachments[0].Url)
+	require.Equal(t, uint64(10), message.Attachments[0].FileSizeBytes)
+
+	reply := data.Messages[1]
+	require.Equal(t, string(discord.MessageTypeReply), reply.Type)
+	require.Equal(t, message.Id, reply.Reference.MessageId)
+
+	pin := data.Messages[2]
+	require.Equal(t, string(discord.MessageTypeChannelPinned), pin.Type)
+	require.Equal(t, message.Id, pin.Reference.MessageId)
+	require.Equal(t, "slack-U2", pin.Author.Id)
+	require.Equal(t, "2023-01-01T10:05:00Z", pin.Timestamp)
+}
+
+func TestReadExportWithoutChannels(t *testing.T) {
+	filePath := writeExport(t, map[string]string{
+		"users.json":    `[]`,
+		"channels.json": `[{"id": "C1", "name": "general"}]`,
+	})
+
+	_, err := ReadExport(filePath)
+	require.ErrorIs(t, err, ErrNoChannelData)
+}
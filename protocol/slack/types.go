@@ -0,0 +1,84 @@
+package slack
+
+import (
+	"errors"
+)
+
+var (
+	ErrNoChannelData = errors.New("No channels to import messages from")
+)
+
+const (
+	channelsFile = "channels.json"
+	groupsFile   = "groups.json"
+	usersFile    = "users.json"
+)
+
+// Message subtypes that are kept during the import, all the other ones
+// (joins, topic changes, bot integrations...) have no equivalent in communities
+var importedSubtypes = map[string]bool{
+	"":                 true,
+	"file_share":       true,
+	"thread_broadcast": true,
+	"me_message":       true,
+}
+
+type Channel struct {
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Topic   TextValue `json:"topic"`
+	Purpose TextValue `json:"purpose"`
+	Pins    []*Pin    `json:"pins"`
+}
+
+type TextValue struct {
+	Value string `json:"value"`
+}
+
+type Pin struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	User    string `json:"user"`
+}
+
+type User struct {
+	ID       string      `json:"id"`
+	Name     string      `json:"name"`
+	RealName string      `json:"real_name"`
+	Profile  UserProfile `json:"profile"`
+}
+
+type UserProfile struct {
+	DisplayName string `json:"display_name"`
+	RealName    string `json:"real_name"`
+	Image72     string `json:"image_72"`
+	Image192    string `json:"image_192"`
+}
+
+type Message struct {
+	Type     string   `json:"type"`
+	Subtype  string   `json:"subtype"`
+	User     string   `json:"user"`
+	Username string   `json:"username"`
+	Text     string   `json:"text"`
+	Ts       string   `json:"ts"`
+	ThreadTs string   `json:"thread_ts"`
+	Edited   *Edited  `json:"edited"`
+	Files    []*File  `json:"files"`
+	PinnedTo []string `json:"pinned_to"`
+}
+
+type Edited struct {
+	User string `json:"user"`
+	Ts   string `json:"ts"`
+}
+
+type File struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Mimetype           string `json:"mimetype"`
+	Size               uint64 `json:"size"`
+	URLPrivate         string `json:"url_private"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
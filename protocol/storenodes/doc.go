@@ -1,6 +1,6 @@
 // package storenodes provides functionality to work with community specific storenodes
 // Current limitations:
 // - we support only one storenode per community
-// - we assume that the storenode is always active
+// - an unhealthy storenode is replaced by the fleet storenode, based on the scores of `scoring.go`
 // - we don't support a way to regularly check connection similar to the `messenger_mailserver_cycle.go`
 package storenodes
@@ -0,0 +1,275 @@
+package storenodes
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	// scoreSmoothing is the weight given to the latest query outcome
+	scoreSmoothing = 0.3
+	// referenceLatency is the query latency at which the latency factor is 0.5
+	referenceLatency = 2 * time.Second
+
+	// the success rate scales the score, which is otherwise made of the completeness and latency
+	completenessWeight = 0.7
+	latencyWeight      = 0.3
+
+	// minQueriesForFailover is the number of queries a storenode needs before it can be failed over
+	minQueriesForFailover = 3
+	// FailoverThreshold is the score under which a storenode is considered unhealthy
+	FailoverThreshold = 0.5
+	// failoverBackoff is how long an unhealthy storenode is avoided before being given another chance
+	failoverBackoff = 3 * time.Minute
+
+	// maxTrackedQueries bounds the number of queries kept around to compare storenodes results
+	maxTrackedQueries = 200
+)
+
+// Score is the health of a storenode, computed from the outcome of the queries sent to it
+type Score struct {
+	StorenodeID string `json:"storenodeId"`
+	// Value is the overall score, from 0 to 1
+	Value float64 `json:"score"`
+	// SuccessRate is the smoothed ratio of successful queries
+	SuccessRate float64 `json:"successRate"`
+	// Completeness is the smoothed ratio of envelopes returned compared to the best result of other storenodes
+	Completeness float64 `json:"completeness"`
+	// LatencyMs is the smoothed query latency
+	LatencyMs int64 `json:"latencyMs"`
+	Queries   uint  `json:"queries"`
+	Failures  uint  `json:"failures"`
+	// FailedOver is true while the storenode is avoided because of a low score
+	FailedOver bool `json:"failedOver"`
+}
+
+type storenodeScore struct {
+	successRate  float64
+	completeness float64
+	latency      time.Duration
+	queries      uint
+	failures     uint
+	failedOverAt time.Time
+	latencyKnown bool
+}
+
+func newStorenodeScore() *storenodeScore {
+	return &storenodeScore{
+		successRate:  1,
+		completeness: 1,
+	}
+}
+
+func smooth(previous, sample float64) float64 {
+	return (1-scoreSmoothing)*previous + scoreSmoothing*sample
+}
+
+func (s *storenodeScore) value() float64 {
+	latencyFactor := 1.0
+	if s.latencyKnown {
+		latencyFactor = float64(referenceLatency) / float64(referenceLatency+s.latency)
+	}
+	return s.successRate * (completenessWeight*s.completeness + latencyWeight*latencyFactor)
+}
+
+// queryResults keeps the number of envelopes returned by each storenode for a given query
+type queryResults map[string]int
+
+// Scores tracks the health of storenodes, it is used to rank the fleet storenodes
+// and to fail over from unhealthy community storenodes
+type Scores struct {
+	mutex  sync.RWMutex
+	scores map[string]*storenodeScore
+
+	queries     map[string]queryResults
+	queriesKeys []string
+
+	now    func() time.Time
+	logger *zap.Logger
+}
+
+func NewScores(logger *zap.Logger) *Scores {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Scores{
+		scores:  make(map[string]*storenodeScore),
+		queries: make(map[string]queryResults),
+		now:     time.Now,
+		logger:  logger.With(zap.Namespace("StorenodeScores")),
+	}
+}
+
+func (s *Scores) get(storenodeID string) *storenodeScore {
+	score, ok := s.scores[storenodeID]
+	if !ok {
+		score = newStorenodeScore()
+		s.scores[storenodeID] = score
+	}
+	return score
+}
+
+func (s *Scores) updateFailover(storenodeID string, score *storenodeScore) {
+	if score.queries < minQueriesForFailover || !score.failedOverAt.IsZero() {
+		return
+	}
+	if value := score.value(); value < FailoverThreshold {
+		s.logger.Info("storenode is unhealthy", zap.String("storenodeID", storenodeID), zap.Float64("score", value))
+		score.failedOverAt = s.now()
+	}
+}
+
+// RecordSuccess records a successful query to a storenode
+func (s *Scores) RecordSuccess(storenodeID string, latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	score := s.get(storenodeID)
+	score.queries++
+	score.successRate = smooth(score.successRate, 1)
+	if score.latencyKnown {
+		score.latency = time.Duration(smooth(float64(score.latency), float64(latency)))
+	} else {
+		score.latency = latency
+		score.latencyKnown = true
+	}
+}
+
+// RecordFailure records a failed query to a storenode
+func (s *Scores) RecordFailure(storenodeID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	score := s.get(storenodeID)
+	score.queries++
+	score.failures++
+	score.successRate = smooth(score.successRate, 0)
+	s.updateFailover(storenodeID, score)
+}
+
+// QueryKey identifies a query so that the results of different storenodes can be compared
+func QueryKey(pubsubTopic string, topics []types.TopicType, from, to uint32) string {
+	topicStrings := make([]string, 0, len(topics))
+	for _, t := range topics {
+		topicStrings = append(topicStrings, t.String())
+	}
+	sort.Strings(topicStrings)
+	return strings.Join([]string{pubsubTopic, strings.Join(topicStrings, ","), time.Unix(int64(from), 0).UTC().Format(time.RFC3339), time.Unix(int64(to), 0).UTC().Format(time.RFC3339)}, "|")
+}
+
+// RecordEnvelopes records the number of envelopes a storenode returned for a query,
+// the completeness of every storenode that answered the same query is then updated
+func (s *Scores) RecordEnvelopes(storenodeID string, queryKey string, envelopes int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	results, ok := s.queries[queryKey]
+	if !ok {
+		results = make(queryResults)
+		s.queries[queryKey] = results
+		s.queriesKeys = append(s.queriesKeys, queryKey)
+		if len(s.queriesKeys) > maxTrackedQueries {
+			delete(s.queries, s.queriesKeys[0])
+			s.queriesKeys = s.queriesKeys[1:]
+		}
+	}
+	previousBest := 0
+	for id, count := range results {
+		if id != storenodeID && count > previousBest {
+			previousBest = count
+		}
+	}
+	results[storenodeID] = envelopes
+
+	if len(results) < 2 {
+		return
+	}
+
+	best := previousBest
+	if envelopes > best {
+		best = envelopes
+	}
+	if best == 0 {
+		return
+	}
+
+	s.sampleCompleteness(storenodeID, float64(envelopes)/float64(best))
+	if envelopes > previousBest {
+		// The other storenodes are now known to be missing envelopes
+		for id, count := range results {
+			if id != storenodeID {
+				s.sampleCompleteness(id, float64(count)/float64(best))
+			}
+		}
+	}
+}
+
+func (s *Scores) sampleCompleteness(storenodeID string, completeness float64) {
+	score := s.get(storenodeID)
+	score.completeness = smooth(score.completeness, completeness)
+	s.updateFailover(storenodeID, score)
+}
+
+// Healthy returns whether a storenode should be queried. An unhealthy storenode
+// is avoided for a while, after which it is given another chance with a fresh score
+func (s *Scores) Healthy(storenodeID string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	score, ok := s.scores[storenodeID]
+	if !ok || score.failedOverAt.IsZero() {
+		return true
+	}
+	if s.now().Before(score.failedOverAt.Add(failoverBackoff)) {
+		return false
+	}
+
+	s.logger.Info("giving unhealthy storenode another chance", zap.String("storenodeID", storenodeID))
+	s.scores[storenodeID] = newStorenodeScore()
+	return true
+}
+
+// Value returns the score of a storenode, storenodes that haven't been queried yet get the best score
+func (s *Scores) Value(storenodeID string) float64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	score, ok := s.scores[storenodeID]
+	if !ok {
+		return newStorenodeScore().value()
+	}
+	return score.value()
+}
+
+// All returns the scores of every storenode queried so far, best first
+func (s *Scores) All() []Score {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make([]Score, 0, len(s.scores))
+	for id, score := range s.scores {
+		result = append(result, Score{
+			StorenodeID:  id,
+			Value:        score.value(),
+			SuccessRate:  score.successRate,
+			Completeness: score.completeness,
+			LatencyMs:    score.latency.Milliseconds(),
+			Queries:      score.queries,
+			Failures:     score.failures,
+			FailedOver:   !score.failedOverAt.IsZero() && s.now().Before(score.failedOverAt.Add(failoverBackoff)),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Value == result[j].Value {
+			return result[i].StorenodeID < result[j].StorenodeID
+		}
+		return result[i].Value > result[j].Value
+	})
+	return result
+}
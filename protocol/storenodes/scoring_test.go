@@ -0,0 +1,90 @@
+package storenodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func newTestScores() (*Scores, *time.Time) {
+	now := time.Now()
+	scores := NewScores(nil)
+	scores.now = func() time.Time { return now }
+	return scores, &now
+}
+
+func TestScoresFailover(t *testing.T) {
+	scores, now := newTestScores()
+
+	require.True(t, scores.Healthy("storenode001"))
+	require.Equal(t, 1.0, scores.Value("storenode001"))
+
+	for i := 0; i < minQueriesForFailover; i++ {
+		scores.RecordFailure("storenode001")
+	}
+	require.Less(t, scores.Value("storenode001"), FailoverThreshold)
+	require.False(t, scores.Healthy("storenode001"))
+
+	all := scores.All()
+	require.Len(t, all, 1)
+	require.True(t, all[0].FailedOver)
+	require.Equal(t, uint(minQueriesForFailover), all[0].Failures)
+
+	// The storenode gets another chance after the backoff
+	*now = now.Add(failoverBackoff)
+	require.True(t, scores.Healthy("storenode001"))
+	require.Equal(t, 1.0, scores.Value("storenode001"))
+	require.False(t, scores.All()[0].FailedOver)
+}
+
+func TestScoresNoFailoverWithFewQueries(t *testing.T) {
+	scores, _ := newTestScores()
+
+	scores.RecordFailure("storenode001")
+	scores.RecordFailure("storenode001")
+	require.True(t, scores.Healthy("storenode001"))
+}
+
+func TestScoresLatency(t *testing.T) {
+	scores, _ := newTestScores()
+
+	scores.RecordSuccess("fast", 100*time.Millisecond)
+	scores.RecordSuccess("slow", 5*time.Second)
+	require.Greater(t, scores.Value("fast"), scores.Value("slow"))
+
+	all := scores.All()
+	require.Equal(t, "fast", all[0].StorenodeID)
+	require.Equal(t, int64(100), all[0].LatencyMs)
+	require.True(t, scores.Healthy("slow"))
+}
+
+func TestScoresCompleteness(t *testing.T) {
+	scores, _ := newTestScores()
+	topics := []types.TopicType{{0x01}, {0x02}}
+
+	for i := uint32(0); i < 10; i++ {
+		key := QueryKey("pubsub", topics, 100*i, 100*(i+1))
+		scores.RecordSuccess("complete", time.Millisecond)
+		scores.RecordSuccess("incomplete", time.Millisecond)
+		scores.RecordEnvelopes("incomplete", key, 1)
+		scores.RecordEnvelopes("complete", key, 10)
+	}
+
+	all := scores.All()
+	require.Equal(t, "complete", all[0].StorenodeID)
+	require.Equal(t, 1.0, all[0].Completeness)
+	require.Less(t, all[1].Completeness, 0.5)
+	require.False(t, scores.Healthy("incomplete"))
+	require.True(t, scores.Healthy("complete"))
+}
+
+func TestQueryKey(t *testing.T) {
+	topic1 := types.TopicType{0x01}
+	topic2 := types.TopicType{0x02}
+
+	require.Equal(t, QueryKey("pubsub", []types.TopicType{topic1, topic2}, 1, 2), QueryKey("pubsub", []types.TopicType{topic2, topic1}, 1, 2))
+	require.NotEqual(t, QueryKey("pubsub", []types.TopicType{topic1}, 1, 2), QueryKey("pubsub", []types.TopicType{topic1}, 1, 3))
+}
@@ -0,0 +1,193 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/protocol/discord"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const dateLayout = "2006-01-02T15:04:05"
+
+const deletedAccountName = "Deleted Account"
+
+// ReadExport parses a Telegram Desktop JSON export and converts every chat
+// holding messages into Discord export data, so it can be imported the same way
+func ReadExport(filePath string) ([]*discord.ExportedData, error) {
+	bytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var export Export
+	err = json.Unmarshal(bytes, &export)
+	if err != nil {
+		return nil, err
+	}
+
+	chats := []*Chat{&export.Chat}
+	if export.Chats != nil {
+		chats = export.Chats.List
+	}
+
+	baseDir := filepath.Dir(filePath)
+
+	var exportedData []*discord.ExportedData
+	for _, chat := range chats {
+		if len(chat.Messages) == 0 {
+			continue
+		}
+		exportedData = append(exportedData, chat.ToExportedData(baseDir))
+	}
+
+	if len(exportedData) == 0 {
+		return nil, ErrNoChatData
+	}
+
+	return exportedData, nil
+}
+
+// ToExportedData converts the chat into Discord export data.
+// Media paths are resolved relatively to `baseDir`, the directory of the export.
+func (c *Chat) ToExportedData(baseDir string) *discord.ExportedData {
+	channel := discord.Channel{
+		ID:   c.channelID(),
+		Name: c.Name,
+	}
+
+	messages := make([]*protobuf.DiscordMessage, 0, len(c.Messages))
+	for _, message := range c.Messages {
+		var discordMessage *protobuf.DiscordMessage
+		switch message.Type {
+		case MessageTypeMessage:
+			discordMessage = c.convertMessage(message, baseDir)
+		case MessageTypeService:
+			discordMessage = c.convertServiceMessage(message)
+		}
+
+		if discordMessage != nil {
+			messages = append(messages, discordMessage)
+		}
+	}
+
+	return &discord.ExportedData{
+		Channel:      channel,
+		Messages:     messages,
+		MessageCount: len(messages),
+	}
+}
+
+func (c *Chat) channelID() string {
+	return fmt.Sprintf("telegram-%d", c.ID)
+}
+
+// Telegram message IDs are only unique within a chat
+func (c *Chat) messageID(id int64) string {
+	return fmt.Sprintf("%s-%d", c.channelID(), id)
+}
+
+func (c *Chat) convertMessage(message *Message, baseDir string) *protobuf.DiscordMessage {
+	discordMessage := &protobuf.DiscordMessage{
+		Id:              c.messageID(message.ID),
+		Type:            string(discord.MessageTypeDefault),
+		Timestamp:       formatTimestamp(message.DateUnixtime, message.Date),
+		TimestampEdited: formatTimestamp(message.EditedUnixtime, message.Edited),
+		Content:         string(message.Text),
+		Author:          convertAuthor(message.FromID, message.From),
+	}
+
+	if message.ReplyToMessageID != 0 {
+		discordMessage.Type = string(discord.MessageTypeReply)
+		discordMessage.Reference = &protobuf.DiscordMessageReference{
+			MessageId: c.messageID(message.ReplyToMessageID),
+			ChannelId: c.channelID(),
+		}
+	}
+
+	for _, media := range []string{message.Photo, message.File} {
+		if media == "" || strings.HasPrefix(media, fileNotIncludedPrefix) {
+			continue
+		}
+
+		fileName := filepath.Base(media)
+		if media == message.File && message.FileName != "" {
+			fileName = message.FileName
+		}
+
+		path := filepath.Join(baseDir, media)
+		attachment := &protobuf.DiscordMessageAttachment{
+			Id:          fmt.Sprintf("%s-%d", discordMessage.Id, len(discordMessage.Attachments)),
+			Url:         "file://" + path,
+			FileName:    fileName,
+			ContentType: message.MimeType,
+		}
+		if fileInfo, err := os.Stat(path); err == nil {
+			attachment.FileSizeBytes = uint64(fileInfo.Size())
+		}
+
+		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
+	}
+
+	return discordMessage
+}
+
+// convertServiceMessage only keeps pins, other service messages
+// (joins, title changes, calls...) have no equivalent in communities
+func (c *Chat) convertServiceMessage(message *Message) *protobuf.DiscordMessage {
+	if message.Action != ActionPinMessage || message.MessageID == 0 {
+		return nil
+	}
+
+	return &protobuf.DiscordMessage{
+		Id:        c.messageID(message.ID),
+		Type:      string(discord.MessageTypeChannelPinned),
+		Timestamp: formatTimestamp(message.DateUnixtime, message.Date),
+		Author:    convertAuthor(message.ActorID, message.Actor),
+		Reference: &protobuf.DiscordMessageReference{
+			MessageId: c.messageID(message.MessageID),
+			ChannelId: c.channelID(),
+		},
+	}
+}
+
+func convertAuthor(id string, name string) *protobuf.DiscordMessageAuthor {
+	if name == "" {
+		name = deletedAccountName
+	}
+	if id == "" {
+		id = name
+	}
+
+	return &protobuf.DiscordMessageAuthor{
+		Id:   "telegram-" + id,
+		Name: name,
+	}
+}
+
+// formatTimestamp returns an RFC3339 timestamp, as used by Discord exports.
+// Recent exports provide unix timestamps, older ones only have the date in local time.
+func formatTimestamp(unixtime string, date string) string {
+	if unixtime != "" {
+		seconds, err := strconv.ParseInt(unixtime, 10, 64)
+		if err == nil {
+			return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+		}
+	}
+
+	if date == "" {
+		return ""
+	}
+
+	timestamp, err := time.ParseInLocation(dateLayout, date, time.Local)
+	if err != nil {
+		// Keep the original value, the importer reports it as invalid
+		return date
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,122 @@
+package telegram
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/discord"
+)
+
+const singleChatExport = `{
+  "name": "Status",
+  "type": "public_supergroup",
+  "id": 1234,
+  "messages": [
+    {
+      "id": 1,
+      "type": "message",
+      "date": "2023-01-01T10:00:00",
+      "date_unixtime": "1672567200",
+      "from": "Alice",
+      "from_id": "user1",
+      "text": ["hello ", {"type": "bold", "text": "world"}],
+      "photo": "photos/photo_1.jpg"
+    },
+    {
+      "id": 2,
+      "type": "message",
+      "date": "2023-01-01T10:01:00",
+      "date_unixtime": "1672567260",
+      "edited_unixtime": "1672567270",
+      "from": "Bob",
+      "from_id": "user2",
+      "reply_to_message_id": 1,
+      "text": "hi",
+      "file": "(File not included. Change data exporting settings to download.)"
+    },
+    {
+      "id": 3,
+      "type": "service",
+      "date_unixtime": "1672567300",
+      "actor": "Alice",
+      "actor_id": "user1",
+      "action": "pin_message",
+      "message_id": 1,
+      "text": ""
+    },
+    {
+      "id": 4,
+      "type": "service",
+      "date_unixtime": "1672567400",
+      "actor": "Carol",
+      "actor_id": "user3",
+      "action": "invite_members",
+      "text": ""
+    }
+  ]
+}`
+
+func TestReadExport(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "result.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(singleChatExport), 0600))
+
+	exportedData, err := ReadExport(filePath)
+	require.NoError(t, err)
+	require.Len(t, exportedData, 1)
+
+	data := exportedData[0]
+	require.Equal(t, "telegram-1234", data.Channel.ID)
+	require.Equal(t, "Status", data.Channel.Name)
+	require.Equal(t, 3, data.MessageCount)
+	require.Len(t, data.Messages, 3)
+
+	message := data.Messages[0]
+	require.Equal(t, "telegram-1234-1", message.Id)
+	require.Equal(t, string(discord.MessageTypeDefault), message.Type)
+	require.Equal(t, "2023-01-01T10:00:00Z", message.Timestamp)
+	require.Equal(t, "hello **world**", message.Content)
+	require.Equal(t, "telegram-user1", message.Author.Id)
+	require.Equal(t, "Alice", message.Author.Name)
+	require.Len(t, message.Attachments, 1)
+	require.Equal(t, "file://"+filepath.Join(dir, "photos/photo_1.jpg"), message.Attachments[0].Url)
+	require.Equal(t, "photo_1.jpg", message.Attachments[0].FileName)
+
+	reply := data.Messages[1]
+	require.Equal(t, string(discord.MessageTypeReply), reply.Type)
+	require.Equal(t, "telegram-1234-1", reply.Reference.MessageId)
+	require.Equal(t, "2023-01-01T10:01:10Z", reply.TimestampEdited)
+	require.Len(t, reply.Attachments, 0)
+
+	pin := data.Messages[2]
+	require.Equal(t, string(discord.MessageTypeChannelPinned), pin.Type)
+	require.Equal(t, "telegram-1234-1", pin.Reference.MessageId)
+}
+
+func TestReadExportFullAccount(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "result.json")
+	export := `{"chats": {"list": [
+		{"name": "a", "id": 1, "messages": [{"id": 1, "type": "message", "date_unixtime": "1672567200", "text": "a"}]},
+		{"name": "empty", "id": 2, "messages": []},
+		{"name": "b", "id": 3, "messages": [{"id": 1, "type": "message", "date_unixtime": "1672567200", "text": "b"}]}
+	]}}`
+	require.NoError(t, os.WriteFile(filePath, []byte(export), 0600))
+
+	exportedData, err := ReadExport(filePath)
+	require.NoError(t, err)
+	require.Len(t, exportedData, 2)
+	require.Equal(t, "telegram-1-1", exportedData[0].Messages[0].Id)
+	require.Equal(t, "telegram-3-1", exportedData[1].Messages[0].Id)
+	require.Equal(t, deletedAccountName, exportedData[0].Messages[0].Author.Name)
+}
+
+func TestReadExportWithoutMessages(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "result.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"name": "a", "id": 1, "messages": []}`), 0600))
+
+	_, err := ReadExport(filePath)
+	require.ErrorIs(t, err, ErrNoChatData)
+}
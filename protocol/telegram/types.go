@@ -0,0 +1,119 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrNoChatData = errors.New("No chats to import messages from")
+)
+
+type MessageType string
+
+const (
+	MessageTypeMessage MessageType = "message"
+	MessageTypeService MessageType = "service"
+)
+
+const ActionPinMessage = "pin_message"
+
+// Telegram replaces media that wasn't selected for the export with a placeholder like this one
+const fileNotIncludedPrefix = "(File not included"
+
+// Export is the content of the `result.json` file created by Telegram Desktop.
+// It's either a single chat export or a full account export holding a list of chats.
+type Export struct {
+	Chat
+	Chats *ChatList `json:"chats"`
+}
+
+type ChatList struct {
+	List []*Chat `json:"list"`
+}
+
+type Chat struct {
+	ID       int64      `json:"id"`
+	Name     string     `json:"name"`
+	Type     string     `json:"type"`
+	Messages []*Message `json:"messages"`
+}
+
+type Message struct {
+	ID               int64       `json:"id"`
+	Type             MessageType `json:"type"`
+	Date             string      `json:"date"`
+	DateUnixtime     string      `json:"date_unixtime"`
+	Edited           string      `json:"edited"`
+	EditedUnixtime   string      `json:"edited_unixtime"`
+	From             string      `json:"from"`
+	FromID           string      `json:"from_id"`
+	Actor            string      `json:"actor"`
+	ActorID          string      `json:"actor_id"`
+	Action           string      `json:"action"`
+	MessageID        int64       `json:"message_id"`
+	ReplyToMessageID int64       `json:"reply_to_message_id"`
+	Photo            string      `json:"photo"`
+	File             string      `json:"file"`
+	FileName         string      `json:"file_name"`
+	MimeType         string      `json:"mime_type"`
+	Text             Text        `json:"text"`
+}
+
+// Text is either a plain string or a list of plain strings and formatted entities
+type Text string
+
+type textEntity struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+	Href string `json:"href"`
+}
+
+func (t *Text) UnmarshalJSON(data []byte) error {
+	var plain string
+	if err := json.Unmarshal(data, &plain); err == nil {
+		*t = Text(plain)
+		return nil
+	}
+
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+
+	var builder strings.Builder
+	for _, part := range parts {
+		if err := json.Unmarshal(part, &plain); err == nil {
+			builder.WriteString(plain)
+			continue
+		}
+
+		var entity textEntity
+		if err := json.Unmarshal(part, &entity); err != nil {
+			return err
+		}
+		builder.WriteString(entity.markdown())
+	}
+
+	*t = Text(builder.String())
+	return nil
+}
+
+func (e textEntity) markdown() string {
+	switch e.Type {
+	case "bold":
+		return "**" + e.Text + "**"
+	case "italic":
+		return "*" + e.Text + "*"
+	case "strikethrough":
+		return "~~" + e.Text + "~~"
+	case "code":
+		return "`" + e.Text + "`"
+	case "pre":
+		return "```\n" + e.Text + "\n```"
+	case "text_link":
+		return "[" + e.Text + "](" + e.Href + ")"
+	}
+	return e.Text
+}
@@ -0,0 +1,593 @@
+package pairing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/signal"
+)
+
+/*
+|--------------------------------------------------------------------------
+| Chunked transfers
+|--------------------------------------------------------------------------
+|
+| Payloads are transferred in chunks, each checked against its hash, so that
+| a dropped connection only costs the chunk in flight. The client retries it
+| and carries on from the last chunk acknowledged by the server, also when the
+| payload is sent again after the retries ran out.
+| Servers advertise chunked uploads along with the challenge, the client sends
+| the whole payload at once to servers which don't. Peers that don't send the
+| chunk headers exchange the whole payload at once.
+|
+*/
+
+// transferChunkSize and transferRetryDelay are vars to allow tests to transfer small payloads
+// in several chunks without waiting between retries
+var (
+	transferChunkSize  = 256 * 1024
+	transferRetryDelay = 2 * time.Second
+)
+
+const (
+	transferMaxRetries = 5
+	// maxTransferChunkSize and maxTransferPayloadSize bound what a receiver accepts,
+	// whatever the sizes announced by the other device
+	maxTransferChunkSize   = 4 * 1024 * 1024
+	maxTransferPayloadSize = 512 * 1024 * 1024
+
+	queryChunkIndex = "chunk"
+
+	headerChunkedTransfer = "X-Pairing-Chunked-Transfer"
+	headerTransferID      = "X-Pairing-Transfer-ID"
+	headerChunkIndex      = "X-Pairing-Chunk-Index"
+	headerChunkCount      = "X-Pairing-Chunk-Count"
+	headerChunkHash       = "X-Pairing-Chunk-Hash"
+	headerTotalSize       = "X-Pairing-Total-Size"
+	headerNextChunk       = "X-Pairing-Next-Chunk"
+)
+
+var (
+	ErrChunkHashMismatch   = errors.New("chunk hash mismatch")
+	ErrUnexpectedChunk     = errors.New("unexpected chunk")
+	ErrInvalidChunkHeaders = errors.New("invalid chunk headers")
+)
+
+func chunkCount(size int) int {
+	if size == 0 {
+		return 1
+	}
+	return (size + transferChunkSize - 1) / transferChunkSize
+}
+
+func chunkBounds(index int, size int) (int, int) {
+	start := index * transferChunkSize
+	end := start + transferChunkSize
+	if end > size {
+		end = size
+	}
+	return start, end
+}
+
+func chunkHash(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func sendTransferProgress(action Action, transferred int, total int) {
+	signal.SendLocalPairingEvent(Event{
+		Type:   EventTransferProgress,
+		Action: action,
+		Data:   TransferProgress{BytesTransferred: transferred, TotalBytes: total},
+	})
+}
+
+// chunkAssembler rebuilds a payload from the chunks received in order
+type chunkAssembler struct {
+	id        string
+	payload   []byte
+	count     int
+	totalSize int
+	next      int
+
+	processed  bool
+	processErr error
+}
+
+// add appends the chunk of the transfer `id` to the payload and returns the index of the next expected chunk.
+// Chunks already received are acknowledged again without being stored,
+// so that a client which didn't get our response can carry on.
+func (a *chunkAssembler) add(id string, index int, count int, totalSize int, hash string, data []byte) (int, bool, error) {
+	if chunkHash(data) != hash {
+		return a.next, false, ErrChunkHashMismatch
+	}
+
+	if index == 0 {
+		// A new transfer
+		*a = chunkAssembler{
+			id:        id,
+			count:     count,
+			totalSize: totalSize,
+		}
+	}
+
+	if id != a.id || count != a.count || totalSize != a.totalSize {
+		// A chunk of another payload, which has to be sent from the start
+		return 0, false, ErrUnexpectedChunk
+	}
+	if index > a.next {
+		return a.next, false, ErrUnexpectedChunk
+	}
+
+	if index < a.next {
+		return a.next, false, nil
+	}
+
+	// The payload grows with the chunks received, it's never allocated from the announced size
+	if len(a.payload)+len(data) > a.totalSize {
+		return a.next, false, ErrUnexpectedChunk
+	}
+	a.payload = append(a.payload, data...)
+	a.next++
+
+	if a.next < a.count {
+		return a.next, false, nil
+	}
+
+	if len(a.payload) != a.totalSize {
+		return a.next, false, ErrUnexpectedChunk
+	}
+	return a.next, true, nil
+}
+
+func (a *chunkAssembler) setProcessResult(err error) {
+	a.processed = true
+	a.processErr = err
+}
+
+func parseChunkHeaders(h http.Header) (int, int, int, error) {
+	index, err := strconv.Atoi(h.Get(headerChunkIndex))
+	if err != nil {
+		return 0, 0, 0, ErrInvalidChunkHeaders
+	}
+	count, err := strconv.Atoi(h.Get(headerChunkCount))
+	if err != nil {
+		return 0, 0, 0, ErrInvalidChunkHeaders
+	}
+	totalSize, err := strconv.Atoi(h.Get(headerTotalSize))
+	if err != nil {
+		return 0, 0, 0, ErrInvalidChunkHeaders
+	}
+	if index < 0 || count <= 0 || totalSize < 0 || totalSize > maxTransferPayloadSize {
+		return 0, 0, 0, ErrInvalidChunkHeaders
+	}
+	// Every chunk but an empty payload carries at least a byte, and no more than maxTransferChunkSize
+	if count-1 > totalSize || totalSize > count*maxTransferChunkSize {
+		return 0, 0, 0, ErrInvalidChunkHeaders
+	}
+	return index, count, totalSize, nil
+}
+
+// handleReceiveChunked receives a payload sent with BaseClient.sendChunked and hands it to the PayloadReceiver
+func handleReceiveChunked(logger *zap.Logger, action Action, pr PayloadReceiver) http.HandlerFunc {
+	// The client sends chunks one after the other, requests are serialised so that
+	// a retried chunk waits for the result of the original one
+	var mu sync.Mutex
+	assembler := new(chunkAssembler)
+
+	receive := func(w http.ResponseWriter, payload []byte) error {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+
+		err := pr.Receive(payload)
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: action})
+			logger.Error("handleReceiveChunked pr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return err
+		}
+		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: action})
+		return nil
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		limit := int64(maxTransferPayloadSize)
+		if r.Header.Get(headerChunkCount) != "" {
+			limit = maxTransferChunkSize
+		}
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, ErrInvalidChunkHeaders.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			logger.Error("handleReceiveChunked io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		if r.Header.Get(headerChunkCount) == "" {
+			signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: action})
+			_ = receive(w, data)
+			return
+		}
+
+		index, count, totalSize, err := parseChunkHeaders(r.Header)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if index == 0 {
+			signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: action})
+		}
+
+		next, complete, err := assembler.add(r.Header.Get(headerTransferID), index, count, totalSize, r.Header.Get(headerChunkHash), data)
+		w.Header().Set(headerNextChunk, strconv.Itoa(next))
+		switch err {
+		case nil:
+		case ErrChunkHashMismatch:
+			logger.Warn("handleReceiveChunked chunk hash mismatch", zap.Int("index", index))
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		case ErrUnexpectedChunk:
+			logger.Warn("handleReceiveChunked unexpected chunk", zap.Int("index", index), zap.Int("next", next))
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		default:
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		if !complete {
+			if assembler.processed && assembler.processErr != nil {
+				// The final chunk was sent again, give the same answer
+				http.Error(w, "error", http.StatusInternalServerError)
+				return
+			}
+			if !assembler.processed {
+				sendTransferProgress(action, len(assembler.payload), totalSize)
+			}
+			return
+		}
+
+		sendTransferProgress(action, len(assembler.payload), totalSize)
+		assembler.setProcessResult(receive(w, assembler.payload))
+	}
+}
+
+// handleSendChunked serves the payload of the PayloadMounter to BaseClient.receiveChunked.
+// The payload is mounted when the first chunk is requested and locked once the last one is sent.
+func handleSendChunked(logger *zap.Logger, action Action, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
+	var mu sync.Mutex
+	var payload []byte
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		index := 0
+		chunked := r.URL.Query().Has(queryChunkIndex)
+		if chunked {
+			var err error
+			index, err = strconv.Atoi(r.URL.Query().Get(queryChunkIndex))
+			if err != nil || index < 0 {
+				http.Error(w, ErrInvalidChunkHeaders.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+
+		if index == 0 {
+			signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: action})
+			err := pm.Mount()
+			if err != nil {
+				signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+				logger.Error("handleSendChunked pm.Mount()", zap.Error(err))
+				http.Error(w, "error", http.StatusInternalServerError)
+				return
+			}
+
+			beforeSending()
+			payload = pm.ToSend()
+		}
+
+		count := chunkCount(len(payload))
+		if chunked && index >= count {
+			http.Error(w, ErrUnexpectedChunk.Error(), http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		data := payload
+		if chunked {
+			start, end := chunkBounds(index, len(payload))
+			data = payload[start:end]
+
+			w.Header().Set(headerChunkIndex, strconv.Itoa(index))
+			w.Header().Set(headerChunkCount, strconv.Itoa(count))
+			w.Header().Set(headerTotalSize, strconv.Itoa(len(payload)))
+			w.Header().Set(headerChunkHash, chunkHash(data))
+		}
+
+		_, err := w.Write(data)
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			logger.Error("handleSendChunked w.Write(data)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		if chunked {
+			_, end := chunkBounds(index, len(payload))
+			sendTransferProgress(action, end, len(payload))
+		}
+
+		if !chunked || index == count-1 {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+			pm.LockPayload()
+		}
+	}
+}
+
+// receiveChunked downloads a payload served by handleSendChunked
+func (c *BaseClient) receiveChunked(path string, action Action, description string) ([]byte, error) {
+	var payload []byte
+
+	for index, count := 0, 1; index < count; index++ {
+		var chunk []byte
+		var totalSize int
+		var err error
+
+		for attempt := 0; ; attempt++ {
+			var retry bool
+			// The challenge changes after every successful request
+			chunk, count, totalSize, retry, err = c.fetchChunk(path, index, index > 0 || attempt > 0, description)
+			if err == nil || !retry || attempt == transferMaxRetries {
+				break
+			}
+			time.Sleep(transferRetryDelay * time.Duration(attempt+1))
+		}
+
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			return nil, err
+		}
+
+		payload = append(payload, chunk...)
+		sendTransferProgress(action, len(payload), totalSize)
+	}
+
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+	return payload, nil
+}
+
+// fetchChunk returns the requested chunk, the number of chunks and the size of the payload.
+// The returned bool tells whether the request is worth retrying.
+func (c *BaseClient) fetchChunk(path string, index int, refreshChallenge bool, description string) ([]byte, int, int, bool, error) {
+	if refreshChallenge {
+		err := c.getChallenge()
+		if err != nil {
+			return nil, 0, 0, true, err
+		}
+	}
+
+	u := *c.baseAddress
+	u.Path = path
+	u.RawQuery = url.Values{queryChunkIndex: {strconv.Itoa(index)}}.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, 0, 0, false, err
+	}
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return nil, 0, 0, false, err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, 0, 0, true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, 0, false, fmt.Errorf("[client] status not ok when receiving %s, received '%s'", description, resp.Status)
+	}
+
+	chunk, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, 0, true, err
+	}
+
+	if resp.Header.Get(headerChunkCount) == "" {
+		// The server sent the whole payload at once
+		return chunk, 1, len(chunk), false, nil
+	}
+
+	_, count, totalSize, err := parseChunkHeaders(resp.Header)
+	if err != nil {
+		return nil, 0, 0, false, err
+	}
+
+	if chunkHash(chunk) != resp.Header.Get(headerChunkHash) {
+		return nil, 0, 0, true, ErrChunkHashMismatch
+	}
+
+	return chunk, count, totalSize, false, nil
+}
+
+// pendingUpload is a payload the server didn't acknowledge entirely, sending it again resumes from `next`
+type pendingUpload struct {
+	payload []byte
+	next    int
+}
+
+// mountUpload returns the payload to send to the given path, an upload interrupted earlier
+// is resumed with the same payload instead of a newly mounted one
+func (c *BaseClient) mountUpload(path string, pm PayloadMounter) ([]byte, error) {
+	if pending, ok := c.pendingUploads[path]; ok {
+		return pending.payload, nil
+	}
+
+	err := pm.Mount()
+	if err != nil {
+		return nil, err
+	}
+	return pm.ToSend(), nil
+}
+
+// sendChunked uploads a payload to handleReceiveChunked, or at once if the server didn't advertise chunked uploads.
+// The first request answers the challenge got beforehand, the following ones get a new challenge.
+func (c *BaseClient) sendChunked(path string, action Action, description string, payload []byte) error {
+	if !c.chunkedUploads {
+		err := c.postPayload(path, payload, description)
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			return err
+		}
+		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+		return nil
+	}
+
+	id := chunkHash(payload)
+	count := chunkCount(len(payload))
+
+	index := 0
+	if pending, ok := c.pendingUploads[path]; ok && bytes.Equal(pending.payload, payload) {
+		index = pending.next
+	}
+	delete(c.pendingUploads, path)
+
+	for requests := 0; index < count; {
+		var next int
+		var err error
+
+		for attempt := 0; ; attempt++ {
+			var retry bool
+			next, retry, err = c.postChunk(path, id, index, count, payload, requests > 0, description)
+			requests++
+			if err == nil || !retry || attempt == transferMaxRetries {
+				break
+			}
+			time.Sleep(transferRetryDelay * time.Duration(attempt+1))
+		}
+
+		if err != nil {
+			c.pendingUploads[path] = &pendingUpload{payload: payload, next: index}
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			return err
+		}
+
+		if next > count {
+			next = count
+		}
+		_, transferred := chunkBounds(next-1, len(payload))
+		if next == 0 {
+			transferred = 0
+		}
+		sendTransferProgress(action, transferred, len(payload))
+
+		index = next
+	}
+
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+	return nil
+}
+
+// postPayload sends the whole payload in a single request
+func (c *BaseClient) postPayload(path string, payload []byte, description string) error {
+	u := *c.baseAddress
+	u.Path = path
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("[client] status not ok when sending %s, received '%s'", description, resp.Status)
+	}
+	return nil
+}
+
+// postChunk sends the chunk and returns the index of the next chunk expected by the server.
+// The returned bool tells whether the request is worth retrying.
+func (c *BaseClient) postChunk(path string, id string, index int, count int, payload []byte, refreshChallenge bool, description string) (int, bool, error) {
+	if refreshChallenge {
+		err := c.getChallenge()
+		if err != nil {
+			return 0, true, err
+		}
+	}
+
+	start, end := chunkBounds(index, len(payload))
+	chunk := payload[start:end]
+
+	u := *c.baseAddress
+	u.Path = path
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(chunk))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set(headerTransferID, id)
+	req.Header.Set(headerChunkIndex, strconv.Itoa(index))
+	req.Header.Set(headerChunkCount, strconv.Itoa(count))
+	req.Header.Set(headerTotalSize, strconv.Itoa(len(payload)))
+	req.Header.Set(headerChunkHash, chunkHash(chunk))
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return 0, false, err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusConflict:
+		// On conflict the server tells us which chunk it expects
+		next, err := strconv.Atoi(resp.Header.Get(headerNextChunk))
+		if err != nil {
+			// The server took the chunk as the whole payload
+			return count, false, nil
+		}
+		return next, false, nil
+	case http.StatusUnprocessableEntity:
+		return 0, true, ErrChunkHashMismatch
+	default:
+		return 0, false, fmt.Errorf("[client] status not ok when sending %s, received '%s'", description, resp.Status)
+	}
+}
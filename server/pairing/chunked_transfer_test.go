@@ -0,0 +1,69 @@
+package pairing
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkAssembler(t *testing.T) {
+	defer func(size int) { transferChunkSize = size }(transferChunkSize)
+	transferChunkSize = 4
+
+	payload := make([]byte, 10)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	count := chunkCount(len(payload))
+	require.Equal(t, 3, count)
+
+	chunk := func(index int) ([]byte, string) {
+		start, end := chunkBounds(index, len(payload))
+		return payload[start:end], chunkHash(payload[start:end])
+	}
+
+	id := chunkHash(payload)
+	a := new(chunkAssembler)
+
+	data, hash := chunk(0)
+	next, complete, err := a.add(id, 0, count, len(payload), hash, data)
+	require.NoError(t, err)
+	require.False(t, complete)
+	require.Equal(t, 1, next)
+
+	// A corrupted chunk is rejected
+	data, _ = chunk(1)
+	_, _, err = a.add(id, 1, count, len(payload), chunkHash([]byte("corrupted")), data)
+	require.ErrorIs(t, err, ErrChunkHashMismatch)
+
+	// Skipping a chunk tells the client where to resume from
+	data, hash = chunk(2)
+	next, _, err = a.add(id, 2, count, len(payload), hash, data)
+	require.ErrorIs(t, err, ErrUnexpectedChunk)
+	require.Equal(t, 1, next)
+
+	// A chunk of another payload must be sent from the start
+	data, hash = chunk(1)
+	next, _, err = a.add(chunkHash([]byte("other")), 1, count, len(payload), hash, data)
+	require.ErrorIs(t, err, ErrUnexpectedChunk)
+	require.Equal(t, 0, next)
+
+	next, complete, err = a.add(id, 1, count, len(payload), hash, data)
+	require.NoError(t, err)
+	require.False(t, complete)
+	require.Equal(t, 2, next)
+
+	// A chunk sent again is acknowledged without being stored twice
+	next, complete, err = a.add(id, 1, count, len(payload), hash, data)
+	require.NoError(t, err)
+	require.False(t, complete)
+	require.Equal(t, 2, next)
+
+	data, hash = chunk(2)
+	next, complete, err = a.add(id, 2, count, len(payload), hash, data)
+	require.NoError(t, err)
+	require.True(t, complete)
+	require.Equal(t, 3, next)
+	require.Equal(t, payload, a.payload)
+}
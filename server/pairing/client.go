@@ -2,6 +2,7 @@ package pairing
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -38,6 +39,15 @@ type BaseClient struct {
 	serverCert     *x509.Certificate
 	baseAddress    *url.URL
 	challengeTaker *ChallengeTaker
+	// chunkedUploads is set when the server advertised chunked uploads along with the challenge
+	chunkedUploads bool
+	pendingUploads map[string]*pendingUpload
+
+	sasRequired  bool
+	sasEncryptor *PayloadEncryptor
+	serverPK     *ecdsa.PublicKey
+	// awaitSASConfirmation blocks until the local user confirmed or rejected the SAS
+	awaitSASConfirmation func(sas *SAS) error
 }
 
 func findServerCert(c *ConnectionParams, reachableIPs []net.IP) (*url.URL, *x509.Certificate, error) {
@@ -161,6 +171,11 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 		serverCert:     serverCert,
 		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(c.aesKey)),
 		baseAddress:    baseAddress,
+		pendingUploads: make(map[string]*pendingUpload),
+
+		sasEncryptor:         NewPayloadEncryptor(c.aesKey),
+		serverPK:             c.publicKey,
+		awaitSASConfirmation: awaitLocalSASConfirmation,
 	}, nil
 }
 
@@ -176,6 +191,7 @@ func (c *BaseClient) getChallenge() error {
 		return fmt.Errorf("[client] status not ok when getting challenge, received '%s'", resp.Status)
 	}
 
+	c.chunkedUploads = resp.Header.Get(headerChunkedTransfer) != ""
 	return c.challengeTaker.SetChallenge(resp)
 }
 
@@ -210,6 +226,7 @@ func NewSenderClient(backend *api.GethStatusBackend, c *ConnectionParams, config
 	if err != nil {
 		return nil, err
 	}
+	bc.sasRequired = config.ClientConfig != nil && config.ClientConfig.VerifySAS
 
 	return &SenderClient{
 		BaseClient:          bc,
@@ -220,51 +237,27 @@ func NewSenderClient(backend *api.GethStatusBackend, c *ConnectionParams, config
 }
 
 func (c *SenderClient) sendAccountData() error {
-	err := c.accountMounter.Mount()
+	payload, err := c.mountUpload(pairingReceiveAccount, c.accountMounter)
 	if err != nil {
 		return err
 	}
 
-	c.baseAddress.Path = pairingReceiveAccount
-	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.accountMounter.ToSend()))
+	err = c.sendChunked(pairingReceiveAccount, ActionPairingAccount, "account data", payload)
 	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when sending account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
 	}
 
-	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
 	c.accountMounter.LockPayload()
 	return nil
 }
 
 func (c *SenderClient) sendSyncDeviceData() error {
-	err := c.rawMessageMounter.Mount()
+	payload, err := c.mountUpload(pairingReceiveSyncDevice, c.rawMessageMounter)
 	if err != nil {
 		return err
 	}
 
-	c.baseAddress.Path = pairingReceiveSyncDevice
-	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.rawMessageMounter.ToSend()))
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not okay when sending sync device data, status: %s", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-	return nil
+	return c.sendChunked(pairingReceiveSyncDevice, ActionSyncDevice, "sync device data", payload)
 }
 
 func (c *SenderClient) receiveInstallationData() error {
@@ -308,26 +301,36 @@ func (c *SenderClient) receiveInstallationData() error {
 	return nil
 }
 
-// setupSendingClient creates a new SenderClient after parsing string inputs
-func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
+// parseSenderClientConfig parses and validates the string inputs of a sending client
+func parseSenderClientConfig(backend *api.GethStatusBackend, cs, configJSON string) (*ConnectionParams, *SenderClientConfig, error) {
 	ccp := new(ConnectionParams)
 	err := ccp.FromString(cs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf := NewSenderClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = validateAndVerifyPassword(conf, conf.SenderConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf.SenderConfig.DB = backend.GetMultiaccountDB()
 
+	return ccp, conf, nil
+}
+
+// setupSendingClient creates a new SenderClient after parsing string inputs
+func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
+	ccp, conf, err := parseSenderClientConfig(backend, cs, configJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	return NewSenderClient(backend, ccp, conf)
 }
 
@@ -337,10 +340,23 @@ func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string)
 	if err != nil {
 		return err
 	}
+	err = c.verifySAS()
+	if err != nil {
+		return err
+	}
+	// The receiver tells along with the challenge whether it accepts chunked uploads
+	err = c.getChallenge()
+	if err != nil {
+		return err
+	}
 	err = c.sendAccountData()
 	if err != nil {
 		return err
 	}
+	err = c.getChallenge()
+	if err != nil {
+		return err
+	}
 	err = c.sendSyncDeviceData()
 	if err != nil {
 		return err
@@ -385,6 +401,7 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 	if err != nil {
 		return nil, err
 	}
+	bc.sasRequired = config.ClientConfig != nil && config.ClientConfig.VerifySAS
 
 	return &ReceiverClient{
 		BaseClient:           bc,
@@ -395,36 +412,10 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 }
 
 func (c *ReceiverClient) receiveAccountData() error {
-	c.baseAddress.Path = pairingSendAccount
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.receiveChunked(pairingSendAccount, ActionPairingAccount, "account data")
 	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
 	}
-	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
 
 	err = c.accountReceiver.Receive(payload)
 	if err != nil {
@@ -436,36 +427,10 @@ func (c *ReceiverClient) receiveAccountData() error {
 }
 
 func (c *ReceiverClient) receiveSyncDeviceData() error {
-	c.baseAddress.Path = pairingSendSyncDevice
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving sync device data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.receiveChunked(pairingSendSyncDevice, ActionSyncDevice, "sync device data")
 	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
 		return err
 	}
-	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
 
 	err = c.rawMessageReceiver.Receive(payload)
 	if err != nil {
@@ -511,18 +476,18 @@ func (c *ReceiverClient) sendInstallationData() error {
 	return nil
 }
 
-// setupReceivingClient creates a new ReceiverClient after parsing string inputs
-func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) (*ReceiverClient, error) {
+// parseReceiverClientConfig parses and validates the string inputs of a receiving client
+func parseReceiverClientConfig(backend *api.GethStatusBackend, cs, configJSON string) (*ConnectionParams, *ReceiverClientConfig, error) {
 	ccp := new(ConnectionParams)
 	err := ccp.FromString(cs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf := NewReceiverClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// This is a temporal solution to allow clients not to pass DeviceType.
@@ -531,7 +496,7 @@ func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string)
 
 	err = validateReceiverConfig(conf, conf.ReceiverConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// ignore err because we allow no active account here
@@ -542,6 +507,16 @@ func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string)
 
 	conf.ReceiverConfig.DB = backend.GetMultiaccountDB()
 
+	return ccp, conf, nil
+}
+
+// setupReceivingClient creates a new ReceiverClient after parsing string inputs
+func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) (*ReceiverClient, error) {
+	ccp, conf, err := parseReceiverClientConfig(backend, cs, configJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	return NewReceiverClient(backend, ccp, conf)
 }
 
@@ -552,6 +527,10 @@ func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON strin
 		return err
 	}
 
+	err = c.verifySAS()
+	if err != nil {
+		return err
+	}
 	err = c.getChallenge()
 	if err != nil {
 		return err
@@ -601,6 +580,7 @@ func NewKeystoreFilesReceiverClient(backend *api.GethStatusBackend, c *Connectio
 	if err != nil {
 		return nil, err
 	}
+	bc.sasRequired = config.ClientConfig != nil && config.ClientConfig.VerifySAS
 
 	return &KeystoreFilesReceiverClient{
 		BaseClient:            bc,
@@ -609,37 +589,11 @@ func NewKeystoreFilesReceiverClient(backend *api.GethStatusBackend, c *Connectio
 }
 
 func (c *KeystoreFilesReceiverClient) receiveKeystoreFilesData() error {
-	c.baseAddress.Path = pairingSendAccount
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
+	payload, err := c.receiveChunked(pairingSendAccount, ActionKeystoreFilesTransfer, "account data")
 	if err != nil {
 		return err
 	}
 
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionKeystoreFilesTransfer})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionKeystoreFilesTransfer})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionKeystoreFilesTransfer})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionKeystoreFilesTransfer})
-		return err
-	}
-	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionKeystoreFilesTransfer})
-
 	err = c.keystoreFilesReceiver.Receive(payload)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionKeystoreFilesTransfer})
@@ -677,6 +631,10 @@ func StartUpKeystoreFilesReceivingClient(backend *api.GethStatusBackend, cs, con
 		return err
 	}
 
+	err = c.verifySAS()
+	if err != nil {
+		return err
+	}
 	err = c.getChallenge()
 	if err != nil {
 		return err
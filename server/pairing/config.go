@@ -20,6 +20,9 @@ type SenderConfig struct {
 	Password string `json:"password" validate:"required"`
 	ChatKey  string `json:"chatKey"` // set only in case of a Keycard user, otherwise empty
 
+	// SyncScope limits the data sent to the paired device
+	SyncScope *SyncScope `json:"syncScope"`
+
 	DB *multiaccounts.Database `json:"-"`
 }
 
@@ -31,6 +34,9 @@ type ReceiverConfig struct {
 	// The field will be removed in https://github.com/status-im/status-go/issues/3351 is fully implemented.
 	DeviceType string `json:"-"`
 
+	// SyncScope limits the synced data applied on this device
+	SyncScope *SyncScope `json:"syncScope"`
+
 	DB             *multiaccounts.Database `json:"-"`
 	LoggedInKeyUID string                  `json:"-"`
 }
@@ -54,6 +60,8 @@ type KeystoreFilesReceiverConfig struct {
 type ServerConfig struct {
 	// Timeout the number of milliseconds after which the pairing server will automatically terminate
 	Timeout uint `json:"timeout" validate:"omitempty,gte=0"`
+	// VerifySAS requires both users to confirm the short authentication string before any data is transferred
+	VerifySAS bool `json:"verifySAS"`
 
 	// Connection fields, not json (un)marshalled
 	// Required for the server, but MUST NOT come from client
@@ -67,7 +75,10 @@ type ServerConfig struct {
 	KeyUID         string           `json:"-"`
 }
 
-type ClientConfig struct{}
+type ClientConfig struct {
+	// VerifySAS makes the client go through the short authentication string verification before transferring data
+	VerifySAS bool `json:"verifySAS"`
+}
 
 type SenderServerConfig struct {
 	SenderConfig *SenderConfig `json:"senderConfig" validate:"required"`
@@ -13,7 +13,11 @@ const (
 	EventConnectionSuccess    EventType = "connection-success"
 	EventTransferError        EventType = "transfer-error"
 	EventTransferSuccess      EventType = "transfer-success"
+	EventTransferProgress     EventType = "transfer-progress"
 	EventReceivedInstallation EventType = "received-installation"
+	EventSASReady             EventType = "sas-ready"
+	EventSASConfirmed         EventType = "sas-confirmed"
+	EventSASRejected          EventType = "sas-rejected"
 
 	// Only Receiver side
 
@@ -40,6 +44,7 @@ const (
 	ActionPairingInstallation
 	ActionPeerDiscovery
 	ActionKeystoreFilesTransfer
+	ActionSASVerification
 )
 
 type AccountData struct {
@@ -47,3 +52,9 @@ type AccountData struct {
 	Password string                 `json:"password,omitempty"`
 	ChatKey  string                 `json:"chatKey,omitempty"`
 }
+
+// TransferProgress is the data of EventTransferProgress events
+type TransferProgress struct {
+	BytesTransferred int `json:"bytesTransferred"`
+	TotalBytes       int `json:"totalBytes"`
+}
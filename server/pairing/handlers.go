@@ -19,110 +19,29 @@ const (
 	pairingReceiveSyncDevice   = pairingBase + "/receiveSyncDevice"
 	pairingSendInstallation    = pairingBase + "/sendInstallation"
 	pairingReceiveInstallation = pairingBase + "/receiveInstallation"
+	pairingSAS                 = pairingBase + "/sas"
+	pairingSASReveal           = pairingBase + "/sasReveal"
+	pairingSASConfirmation     = pairingBase + "/sasConfirmation"
 )
 
 // Account handling
 
 func handleReceiveAccount(logger *zap.Logger, pr PayloadReceiver) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingAccount})
-		payload, err := io.ReadAll(r.Body)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleReceiveAccount io.ReadAll(r.Body)", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
-		err = pr.Receive(payload)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleReceiveAccount pr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionPairingAccount})
-	}
+	return handleReceiveChunked(logger.Named("handleReceiveAccount"), ActionPairingAccount, pr)
 }
 
 func handleSendAccount(logger *zap.Logger, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingAccount})
-		w.Header().Set("Content-Type", "application/octet-stream")
-		err := pm.Mount()
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		beforeSending()
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
-		pm.LockPayload()
-	}
+	return handleSendChunked(logger.Named("handleSendAccount"), ActionPairingAccount, pm, beforeSending)
 }
 
 // Device sync handling
 
 func handleParingSyncDeviceReceive(logger *zap.Logger, pr PayloadReceiver) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionSyncDevice})
-		payload, err := io.ReadAll(r.Body)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handleParingSyncDeviceReceive io.ReadAll(r.Body)", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-
-		err = pr.Receive(payload)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handleParingSyncDeviceReceive pr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionSyncDevice})
-	}
+	return handleReceiveChunked(logger.Named("handleParingSyncDeviceReceive"), ActionSyncDevice, pr)
 }
 
 func handlePairingSyncDeviceSend(logger *zap.Logger, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionSyncDevice})
-		w.Header().Set("Content-Type", "application/octet-stream")
-
-		err := pm.Mount()
-		if err != nil {
-			// maybe better to use a new event type here instead of EventTransferError?
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		beforeSending()
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-
-		pm.LockPayload()
-	}
+	return handleSendChunked(logger.Named("handlePairingSyncDeviceSend"), ActionSyncDevice, pm, beforeSending)
 }
 
 // Installation data handling
@@ -209,6 +128,7 @@ func handlePairingChallenge(cg *ChallengeGiver) http.HandlerFunc {
 		}
 
 		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set(headerChunkedTransfer, "1")
 		_, err = w.Write(challenge)
 		if err != nil {
 			cg.logger.Error("failed to Write(challenge) in handlePairingChallenge", zap.Error(err))
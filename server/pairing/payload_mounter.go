@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/api"
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 )
@@ -70,7 +71,7 @@ func (bpm *BasePayloadMounter) Mount() error {
 
 // NewAccountPayloadMounter generates a new and initialised AccountPayload flavoured BasePayloadMounter
 // responsible for the whole lifecycle of an AccountPayload
-func NewAccountPayloadMounter(pe *PayloadEncryptor, config *SenderConfig, logger *zap.Logger) (*BasePayloadMounter, error) {
+func NewAccountPayloadMounter(pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig, logger *zap.Logger) (*BasePayloadMounter, error) {
 	l := logger.Named("AccountPayloadLoader")
 	l.Debug("fired", zap.Any("config", config))
 
@@ -82,6 +83,7 @@ func NewAccountPayloadMounter(pe *PayloadEncryptor, config *SenderConfig, logger
 	if err != nil {
 		return nil, err
 	}
+	apl.backend = backend
 
 	return NewBasePayloadMounter(
 		apl,
@@ -97,6 +99,8 @@ type AccountPayloadLoader struct {
 	multiaccountsDB *multiaccounts.Database
 	keystorePath    string
 	keyUID          string
+	syncScope       *SyncScope
+	backend         *api.GethStatusBackend
 }
 
 func NewAccountPayloadLoader(p *AccountPayload, config *SenderConfig) (*AccountPayloadLoader, error) {
@@ -113,6 +117,7 @@ func NewAccountPayloadLoader(p *AccountPayload, config *SenderConfig) (*AccountP
 	ppr.password = config.Password
 	ppr.chatKey = config.ChatKey
 	ppr.keystorePath = config.KeystorePath
+	ppr.syncScope = config.SyncScope
 	return ppr, nil
 }
 
@@ -128,6 +133,17 @@ func (apl *AccountPayloadLoader) Load() error {
 		return err
 	}
 
+	if apl.syncScope.excludesWallet() {
+		chatAddress, err := apl.chatAddress()
+		if err != nil {
+			return err
+		}
+		apl.keys, err = apl.syncScope.filterKeys(apl.keys, chatAddress)
+		if err != nil {
+			return err
+		}
+	}
+
 	apl.multiaccount, err = apl.multiaccountsDB.GetAccount(apl.keyUID)
 	if err != nil {
 		return err
@@ -136,6 +152,24 @@ func (apl *AccountPayloadLoader) Load() error {
 	return nil
 }
 
+func (apl *AccountPayloadLoader) chatAddress() (types.Address, error) {
+	if apl.backend == nil {
+		return types.Address{}, fmt.Errorf("backend is nil when resolving the chat address")
+	}
+
+	kp, err := apl.backend.StatusNode().AccountService().GetKeypairByKeyUID(apl.keyUID)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	for _, account := range kp.Accounts {
+		if account.Chat {
+			return account.Address, nil
+		}
+	}
+	return types.Address{}, fmt.Errorf("no chat account for keyUID %s", apl.keyUID)
+}
+
 /*
 |--------------------------------------------------------------------------
 | RawMessagePayload
@@ -163,6 +197,7 @@ type RawMessageLoader struct {
 	syncRawMessageHandler *SyncRawMessageHandler
 	keyUID                string
 	deviceType            string
+	syncScope             *SyncScope
 }
 
 func NewRawMessageLoader(backend *api.GethStatusBackend, payload *RawMessagesPayload, config *SenderConfig) *RawMessageLoader {
@@ -171,11 +206,12 @@ func NewRawMessageLoader(backend *api.GethStatusBackend, payload *RawMessagesPay
 		payload:               payload,
 		keyUID:                config.KeyUID,
 		deviceType:            config.DeviceType,
+		syncScope:             config.SyncScope,
 	}
 }
 
 func (r *RawMessageLoader) Load() (err error) {
-	r.payload.rawMessages, r.payload.profileKeypair, r.payload.setting, err = r.syncRawMessageHandler.PrepareRawMessage(r.keyUID, r.deviceType)
+	r.payload.rawMessages, r.payload.profileKeypair, r.payload.setting, err = r.syncRawMessageHandler.PrepareRawMessage(r.keyUID, r.deviceType, r.syncScope)
 	return err
 }
 
@@ -238,7 +274,7 @@ func (r *InstallationPayloadLoader) Load() error {
 // NewPayloadMounters returns PayloadMounter s configured to handle local pairing transfers of:
 //   - AccountPayload, RawMessagePayload and InstallationPayload
 func NewPayloadMounters(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) (PayloadMounter, PayloadMounter, PayloadMounterReceiver, error) {
-	am, err := NewAccountPayloadMounter(pe, config, logger)
+	am, err := NewAccountPayloadMounter(pe, backend, config, logger)
 	if err != nil {
 		return nil, nil, nil, err
 	}
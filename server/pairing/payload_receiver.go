@@ -242,6 +242,7 @@ type RawMessageStorer struct {
 	accountPayload        *AccountPayload
 	createAccount         *requests.CreateAccount
 	deviceType            string
+	syncScope             *SyncScope
 }
 
 func NewRawMessageStorer(backend *api.GethStatusBackend, payload *RawMessagesPayload, accountPayload *AccountPayload, config *ReceiverConfig) *RawMessageStorer {
@@ -251,6 +252,7 @@ func NewRawMessageStorer(backend *api.GethStatusBackend, payload *RawMessagesPay
 		accountPayload:        accountPayload,
 		deviceType:            config.DeviceType,
 		createAccount:         config.CreateAccount,
+		syncScope:             config.SyncScope,
 	}
 }
 
@@ -258,7 +260,7 @@ func (r *RawMessageStorer) Store() error {
 	if r.accountPayload == nil || r.accountPayload.multiaccount == nil {
 		return fmt.Errorf("no known multiaccount when storing raw messages")
 	}
-	return r.syncRawMessageHandler.HandleRawMessage(r.accountPayload, r.createAccount, r.deviceType, r.payload)
+	return r.syncRawMessageHandler.HandleRawMessage(r.accountPayload, r.createAccount, r.deviceType, r.syncScope, r.payload)
 }
 
 /*
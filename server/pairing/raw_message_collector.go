@@ -10,9 +10,14 @@ import (
 
 type RawMessageCollector struct {
 	rawMessages []*common.RawMessage
+	// scope leaves out the messages outside of it, nil collects everything
+	scope *SyncScope
 }
 
 func (r *RawMessageCollector) dispatchMessage(_ context.Context, rawMessage common.RawMessage) (common.RawMessage, error) {
+	if !r.scope.includesRawMessage(rawMessage.MessageType, rawMessage.Payload) {
+		return rawMessage, nil
+	}
 	r.rawMessages = append(r.rawMessages, &rawMessage)
 	return rawMessage, nil
 }
@@ -40,7 +40,7 @@ func (s *SyncRawMessageHandler) CollectInstallationData(rawMessageCollector *Raw
 	return err
 }
 
-func (s *SyncRawMessageHandler) PrepareRawMessage(keyUID, deviceType string) (rm []*protobuf.RawMessage, kp *accounts.Keypair, syncSettings *settings.Settings, err error) {
+func (s *SyncRawMessageHandler) PrepareRawMessage(keyUID, deviceType string, scope *SyncScope) (rm []*protobuf.RawMessage, kp *accounts.Keypair, syncSettings *settings.Settings, err error) {
 	syncSettings = new(settings.Settings)
 	messenger := s.backend.Messenger()
 	if messenger == nil {
@@ -59,7 +59,7 @@ func (s *SyncRawMessageHandler) PrepareRawMessage(keyUID, deviceType string) (rm
 	defer func() {
 		messenger.SetLocalPairing(false)
 	}()
-	rawMessageCollector := new(RawMessageCollector)
+	rawMessageCollector := &RawMessageCollector{scope: scope}
 	err = messenger.SyncDevices(context.TODO(), currentAccount.Name, currentAccount.Identicon, rawMessageCollector.dispatchMessage)
 	if err != nil {
 		return
@@ -79,10 +79,13 @@ func (s *SyncRawMessageHandler) PrepareRawMessage(keyUID, deviceType string) (rm
 	if err != nil {
 		return
 	}
+	scope.filterProfileKeypair(kp)
+
 	*syncSettings, err = accountService.GetSettings()
 	if err != nil {
 		return
 	}
+	scope.filterSettings(syncSettings)
 
 	return
 }
@@ -91,8 +94,13 @@ func (s *SyncRawMessageHandler) HandleRawMessage(
 	accountPayload *AccountPayload,
 	createAccountRequest *requests.CreateAccount,
 	deviceType string,
+	scope *SyncScope,
 	rmp *RawMessagesPayload) (err error) {
 
+	rmp.rawMessages = scope.filterRawMessages(rmp.rawMessages)
+	scope.filterProfileKeypair(rmp.profileKeypair)
+	scope.filterSettings(rmp.setting)
+
 	activeAccount, _ := s.backend.GetActiveAccount()
 	if activeAccount == nil { // not login yet
 		err = s.login(accountPayload, createAccountRequest, rmp)
@@ -0,0 +1,534 @@
+package pairing
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/signal"
+)
+
+/*
+|--------------------------------------------------------------------------
+| Short authentication string (SAS) verification
+|--------------------------------------------------------------------------
+|
+| When enabled, the client and the server exchange random nonces encrypted with the
+| shared AES key and both derive a short authentication string from the handshake.
+| The client commits to its nonce before learning the server nonce and only reveals it
+| afterwards, so neither side can steer the SAS. The string also covers the server TLS
+| certificate, which ties it to the connection being verified.
+| Users compare it on both screens and the server only serves or accepts pairing data
+| once its user confirmed it. The client carries on once both users confirmed it.
+|
+*/
+
+const (
+	sasNonceLength         = 32
+	sasConfirmationTimeout = 5 * time.Minute
+	sasPollInterval        = time.Second
+	sasInfo                = "status-pairing-sas"
+)
+
+var (
+	ErrSASRejected       = errors.New("short authentication string rejected")
+	ErrSASNotConfirmed   = errors.New("short authentication string not confirmed")
+	ErrSASTimeout        = errors.New("short authentication string confirmation timed out")
+	ErrNoSASVerification = errors.New("no short authentication string verification in progress")
+	ErrInvalidSASNonce   = errors.New("invalid short authentication string nonce")
+	ErrSASInProgress     = errors.New("short authentication string verification already in progress")
+	ErrSASNotCommitted   = errors.New("short authentication string nonce revealed without commitment")
+	ErrSASCommitMismatch = errors.New("short authentication string nonce doesn't match its commitment")
+)
+
+var sasEmojis = [64]string{
+	"🐶", "🐱", "🦁", "🐎", "🦄", "🐷", "🐘", "🐰",
+	"🐼", "🐓", "🐧", "🐢", "🐟", "🐙", "🦋", "🌷",
+	"🌳", "🌵", "🍄", "🌏", "🌙", "☁️", "🔥", "🍌",
+	"🍎", "🍓", "🌽", "🍕", "🎂", "❤️", "😀", "🤖",
+	"🎩", "👓", "🔧", "🎅", "👍", "☂️", "⌛", "⏰",
+	"🎁", "💡", "📕", "✏️", "📎", "✂️", "🔒", "🔑",
+	"🔨", "☎️", "🏁", "🚂", "🚲", "✈️", "🚀", "🏆",
+	"⚽", "🎸", "🎺", "🔔", "⚓", "🎧", "📁", "📌",
+}
+
+// SAS is the short authentication string shown to both users, as emojis or as numbers
+type SAS struct {
+	Emojis  []string `json:"emojis"`
+	Decimal []int    `json:"decimal"`
+}
+
+// SASReady is the data of the EventSASReady event, ID identifies the verification to pass to ConfirmSAS
+type SASReady struct {
+	ID string `json:"id"`
+	*SAS
+}
+
+// deriveSAS derives the short authentication string from the handshake transcript
+func deriveSAS(aesKey []byte, serverPK *ecdsa.PublicKey, serverCert []byte, clientNonce []byte, serverNonce []byte) *SAS {
+	mac := hmac.New(sha256.New, aesKey)
+	mac.Write([]byte(sasInfo))
+	mac.Write(elliptic.MarshalCompressed(serverPK.Curve, serverPK.X, serverPK.Y))
+	mac.Write(serverCert)
+	mac.Write(clientNonce)
+	mac.Write(serverNonce)
+	sum := mac.Sum(nil)
+
+	// 48 bits, 42 are used for the emojis and 39 for the numbers
+	bits := binary.BigEndian.Uint64(append([]byte{0, 0}, sum[:6]...))
+
+	sas := &SAS{}
+	for i := 0; i < 7; i++ {
+		sas.Emojis = append(sas.Emojis, sasEmojis[(bits>>(42-6*(i+1)))&0x3f])
+	}
+	for i := 0; i < 3; i++ {
+		sas.Decimal = append(sas.Decimal, int((bits>>(48-13*(i+1)))&0x1fff)+1000)
+	}
+	return sas
+}
+
+func sasCommitment(clientNonce []byte) []byte {
+	commitment := sha256.Sum256(clientNonce)
+	return commitment[:]
+}
+
+func randomSASNonce() ([]byte, error) {
+	nonce := make([]byte, sasNonceLength)
+	_, err := rand.Read(nonce)
+	return nonce, err
+}
+
+type sasState int
+
+const (
+	sasPending sasState = iota
+	sasConfirmed
+	sasRejected
+)
+
+// sasVerification holds the decision of the local user about a SAS
+type sasVerification struct {
+	id string
+
+	mu    sync.Mutex
+	sas   *SAS
+	state sasState
+	done  chan struct{}
+}
+
+func newSASVerification(sas *SAS) (*sasVerification, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sasVerification{
+		id:   hex.EncodeToString(id),
+		sas:  sas,
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (v *sasVerification) resolve(confirmed bool) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.state != sasPending {
+		return false
+	}
+	if confirmed {
+		v.state = sasConfirmed
+	} else {
+		v.state = sasRejected
+	}
+	close(v.done)
+	return true
+}
+
+func (v *sasVerification) getState() sasState {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.state
+}
+
+func (v *sasVerification) wait(timeout time.Duration) error {
+	select {
+	case <-v.done:
+	case <-time.After(timeout):
+		return ErrSASTimeout
+	}
+
+	if v.getState() != sasConfirmed {
+		return ErrSASRejected
+	}
+	return nil
+}
+
+// activeSASVerifications indexes the verifications owned by running pairing servers and clients
+// so that ConfirmSAS can reach them, a verification is only reachable through its own ID
+var (
+	activeSASVerificationsMutex sync.Mutex
+	activeSASVerifications      = make(map[string]*sasVerification)
+)
+
+// trackSASVerification makes the verification reachable through ConfirmSAS until it's resolved,
+// it's rejected if the user doesn't answer within sasConfirmationTimeout
+func trackSASVerification(v *sasVerification) {
+	activeSASVerificationsMutex.Lock()
+	activeSASVerifications[v.id] = v
+	activeSASVerificationsMutex.Unlock()
+
+	go func() {
+		select {
+		case <-v.done:
+		case <-time.After(sasConfirmationTimeout):
+			v.resolve(false)
+		}
+
+		activeSASVerificationsMutex.Lock()
+		delete(activeSASVerifications, v.id)
+		activeSASVerificationsMutex.Unlock()
+	}()
+}
+
+// ConfirmSAS records whether the user confirmed the short authentication string of the verification with the given ID
+func ConfirmSAS(id string, confirmed bool) error {
+	activeSASVerificationsMutex.Lock()
+	v, ok := activeSASVerifications[id]
+	activeSASVerificationsMutex.Unlock()
+
+	if !ok || !v.resolve(confirmed) {
+		return ErrNoSASVerification
+	}
+
+	if confirmed {
+		signal.SendLocalPairingEvent(Event{Type: EventSASConfirmed, Action: ActionSASVerification})
+	} else {
+		signal.SendLocalPairingEvent(Event{Type: EventSASRejected, Action: ActionSASVerification})
+	}
+	return nil
+}
+
+// awaitLocalSASConfirmation waits for the local user to confirm the SAS through ConfirmSAS
+func awaitLocalSASConfirmation(sas *SAS) error {
+	v, err := newSASVerification(sas)
+	if err != nil {
+		return err
+	}
+	trackSASVerification(v)
+	signal.SendLocalPairingEvent(Event{Type: EventSASReady, Action: ActionSASVerification, Data: SASReady{ID: v.id, SAS: sas}})
+
+	return v.wait(sasConfirmationTimeout)
+}
+
+// sasSession is the server side state of a SAS handshake with a client
+type sasSession struct {
+	commitment   []byte
+	serverNonce  []byte
+	verification *sasVerification
+}
+
+// SASGiver is responsible for the server side of the SAS verification
+type SASGiver struct {
+	encryptor  *PayloadEncryptor
+	serverPK   *ecdsa.PublicKey
+	serverCert []byte
+	logger     *zap.Logger
+
+	mu      sync.Mutex
+	session *sasSession
+}
+
+func NewSASGiver(e *PayloadEncryptor, serverPK *ecdsa.PublicKey, serverCert []byte, logger *zap.Logger) *SASGiver {
+	return &SASGiver{
+		encryptor:  e.Renew(),
+		serverPK:   serverPK,
+		serverCert: serverCert,
+		logger:     logger,
+	}
+}
+
+func (g *SASGiver) getVerification() *sasVerification {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.session == nil {
+		return nil
+	}
+	return g.session.verification
+}
+
+func (g *SASGiver) confirmed() bool {
+	v := g.getVerification()
+	return v != nil && v.getState() == sasConfirmed
+}
+
+// commit records the commitment of the client to its nonce and returns the encrypted server nonce.
+// A new handshake can only replace a previous one that the user rejected
+func (g *SASGiver) commit(encryptedCommitment []byte) ([]byte, error) {
+	commitment, err := g.encryptor.decryptPlain(encryptedCommitment)
+	if err != nil {
+		return nil, err
+	}
+	if len(commitment) != sha256.Size {
+		return nil, ErrInvalidSASNonce
+	}
+
+	serverNonce, err := randomSASNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.session != nil && (g.session.verification == nil || g.session.verification.getState() != sasRejected) {
+		return nil, ErrSASInProgress
+	}
+	g.session = &sasSession{
+		commitment:  commitment,
+		serverNonce: serverNonce,
+	}
+
+	return g.encryptor.encryptPlain(serverNonce)
+}
+
+// reveal checks the client nonce against its commitment and starts the verification of the resulting SAS
+func (g *SASGiver) reveal(encryptedClientNonce []byte) error {
+	clientNonce, err := g.encryptor.decryptPlain(encryptedClientNonce)
+	if err != nil {
+		return err
+	}
+	if len(clientNonce) != sasNonceLength {
+		return ErrInvalidSASNonce
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.session == nil || g.session.verification != nil {
+		return ErrSASNotCommitted
+	}
+	if subtle.ConstantTimeCompare(sasCommitment(clientNonce), g.session.commitment) != 1 {
+		// The handshake can't succeed anymore, let the client start over
+		g.session = nil
+		return ErrSASCommitMismatch
+	}
+
+	sas := deriveSAS(g.encryptor.aesKey, g.serverPK, g.serverCert, clientNonce, g.session.serverNonce)
+	v, err := newSASVerification(sas)
+	if err != nil {
+		return err
+	}
+	g.session.verification = v
+	trackSASVerification(v)
+
+	signal.SendLocalPairingEvent(Event{Type: EventSASReady, Action: ActionSASVerification, Data: SASReady{ID: v.id, SAS: sas}})
+	return nil
+}
+
+func handlePairingSAS(g *SASGiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		encryptedCommitment, err := io.ReadAll(r.Body)
+		if err != nil {
+			g.logger.Error("handlePairingSAS io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		encryptedServerNonce, err := g.commit(encryptedCommitment)
+		if errors.Is(err, ErrSASInProgress) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if err != nil {
+			g.logger.Error("handlePairingSAS g.commit(encryptedCommitment)", zap.Error(err))
+			http.Error(w, "error", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, err = w.Write(encryptedServerNonce)
+		if err != nil {
+			g.logger.Error("handlePairingSAS w.Write(encryptedServerNonce)", zap.Error(err))
+		}
+	}
+}
+
+func handlePairingSASReveal(g *SASGiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		encryptedClientNonce, err := io.ReadAll(r.Body)
+		if err != nil {
+			g.logger.Error("handlePairingSASReveal io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		err = g.reveal(encryptedClientNonce)
+		if err != nil {
+			g.logger.Error("handlePairingSASReveal g.reveal(encryptedClientNonce)", zap.Error(err))
+			http.Error(w, "error", http.StatusBadRequest)
+			return
+		}
+	}
+}
+
+func handlePairingSASConfirmation(g *SASGiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		v := g.getVerification()
+		if v == nil {
+			http.Error(w, ErrNoSASVerification.Error(), http.StatusForbidden)
+			return
+		}
+
+		switch v.getState() {
+		case sasConfirmed:
+			w.WriteHeader(http.StatusOK)
+		case sasRejected:
+			http.Error(w, ErrSASRejected.Error(), http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}
+}
+
+// middlewareSAS only lets requests through once the SAS has been confirmed, if a SAS verification is required
+func middlewareSAS(g *SASGiver, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g != nil && !g.confirmed() {
+			http.Error(w, ErrSASNotConfirmed.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// verifySAS derives the SAS with the server and returns once both users confirmed it
+func (c *BaseClient) verifySAS() error {
+	if !c.sasRequired {
+		return nil
+	}
+
+	err := c.startSAS()
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventSASRejected, Error: err.Error(), Action: ActionSASVerification})
+		return err
+	}
+
+	err = c.awaitServerSASConfirmation()
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventSASRejected, Error: err.Error(), Action: ActionSASVerification})
+		return err
+	}
+
+	return nil
+}
+
+func (c *BaseClient) postSAS(path string, plain []byte) ([]byte, error) {
+	encrypted, err := c.sasEncryptor.encryptPlain(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	u := *c.baseAddress
+	u.Path = path
+	resp, err := c.Post(u.String(), "application/octet-stream", bytes.NewReader(encrypted))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("[client] status not ok when starting sas verification, received '%s'", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (c *BaseClient) startSAS() error {
+	clientNonce, err := randomSASNonce()
+	if err != nil {
+		return err
+	}
+
+	encryptedServerNonce, err := c.postSAS(pairingSAS, sasCommitment(clientNonce))
+	if err != nil {
+		return err
+	}
+
+	serverNonce, err := c.sasEncryptor.decryptPlain(encryptedServerNonce)
+	if err != nil {
+		return err
+	}
+	if len(serverNonce) != sasNonceLength {
+		return ErrInvalidSASNonce
+	}
+
+	_, err = c.postSAS(pairingSASReveal, clientNonce)
+	if err != nil {
+		return err
+	}
+
+	sas := deriveSAS(c.sasEncryptor.aesKey, c.serverPK, c.serverCert.Raw, clientNonce, serverNonce)
+	return c.awaitSASConfirmation(sas)
+}
+
+func (c *BaseClient) awaitServerSASConfirmation() error {
+	u := *c.baseAddress
+	u.Path = pairingSASConfirmation
+
+	deadline := time.Now().Add(sasConfirmationTimeout)
+	for {
+		resp, err := c.Get(u.String())
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil
+		case http.StatusAccepted:
+		case http.StatusForbidden:
+			return ErrSASRejected
+		default:
+			return fmt.Errorf("[client] status not ok when waiting for sas confirmation, received '%s'", resp.Status)
+		}
+
+		if time.Now().After(deadline) {
+			return ErrSASTimeout
+		}
+		time.Sleep(sasPollInterval)
+	}
+}
@@ -32,6 +32,7 @@ import (
 type BaseServer struct {
 	server.Server
 	challengeGiver *ChallengeGiver
+	sasGiver       *SASGiver
 
 	config ServerConfig
 }
@@ -53,6 +54,9 @@ func NewBaseServer(logger *zap.Logger, e *PayloadEncryptor, config *ServerConfig
 		challengeGiver: cg,
 		config:         *config,
 	}
+	if config.VerifySAS {
+		bs.sasGiver = NewSASGiver(e, config.PK, config.Cert.Certificate[0], logger)
+	}
 	bs.SetTimeout(config.Timeout)
 	return bs, nil
 }
@@ -147,14 +151,17 @@ func (s *SenderServer) startSendingData() error {
 		}
 	}
 	s.SetHandlers(server.HandlerPatternMap{
-		pairingChallenge:      handlePairingChallenge(s.challengeGiver),
-		pairingSendAccount:    middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.accountMounter, beforeSending)),
-		pairingSendSyncDevice: middlewareChallenge(s.challengeGiver, handlePairingSyncDeviceSend(logger, s.rawMessageMounter, beforeSending)),
+		pairingChallenge:       handlePairingChallenge(s.challengeGiver),
+		pairingSAS:             handlePairingSAS(s.sasGiver),
+		pairingSASReveal:       handlePairingSASReveal(s.sasGiver),
+		pairingSASConfirmation: handlePairingSASConfirmation(s.sasGiver),
+		pairingSendAccount:     middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.accountMounter, beforeSending))),
+		pairingSendSyncDevice:  middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handlePairingSyncDeviceSend(logger, s.rawMessageMounter, beforeSending))),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// receive installation data from receiver
-		pairingReceiveInstallation: middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.installationMounter)),
+		pairingReceiveInstallation: middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.installationMounter))),
 	})
 	return s.Start()
 }
@@ -258,13 +265,16 @@ func (s *ReceiverServer) startReceivingData() error {
 	}
 	s.SetHandlers(server.HandlerPatternMap{
 		pairingChallenge:         handlePairingChallenge(s.challengeGiver),
-		pairingReceiveAccount:    handleReceiveAccount(logger, s.accountReceiver),
-		pairingReceiveSyncDevice: handleParingSyncDeviceReceive(logger, s.rawMessageReceiver),
+		pairingSAS:               handlePairingSAS(s.sasGiver),
+		pairingSASReveal:         handlePairingSASReveal(s.sasGiver),
+		pairingSASConfirmation:   handlePairingSASConfirmation(s.sasGiver),
+		pairingReceiveAccount:    middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleReceiveAccount(logger, s.accountReceiver))),
+		pairingReceiveSyncDevice: middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleParingSyncDeviceReceive(logger, s.rawMessageReceiver))),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// send installation data back to sender
-		pairingSendInstallation: middlewareChallenge(s.challengeGiver, handleSendInstallation(logger, s.installationReceiver, beforeSending)),
+		pairingSendInstallation: middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleSendInstallation(logger, s.installationReceiver, beforeSending))),
 	})
 	return s.Start()
 }
@@ -369,8 +379,11 @@ func (s *KeystoreFilesSenderServer) startSendingData() error {
 		}
 	}
 	s.SetHandlers(server.HandlerPatternMap{
-		pairingChallenge:   handlePairingChallenge(s.challengeGiver),
-		pairingSendAccount: middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.keystoreFilesMounter, beforeSending)),
+		pairingChallenge:       handlePairingChallenge(s.challengeGiver),
+		pairingSAS:             handlePairingSAS(s.sasGiver),
+		pairingSASReveal:       handlePairingSASReveal(s.sasGiver),
+		pairingSASConfirmation: handlePairingSASConfirmation(s.sasGiver),
+		pairingSendAccount:     middlewareSAS(s.sasGiver, middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.keystoreFilesMounter, beforeSending))),
 	})
 	return s.Start()
 }
@@ -1,12 +1,15 @@
 package pairing
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
@@ -159,6 +162,8 @@ func (s *PairingServerSuite) TestPairingServer_StartPairingReceive() {
 	c.accountMounter = NewMockPayloadMounter(s.EphemeralAES)
 	s.Require().NoError(err)
 
+	err = c.getChallenge()
+	s.Require().NoError(err)
 	err = c.sendAccountData()
 	s.Require().NoError(err)
 
@@ -166,6 +171,312 @@ func (s *PairingServerSuite) TestPairingServer_StartPairingReceive() {
 	s.Require().Equal(s.RS.accountReceiver.(*MockPayloadReceiver).encryptor.getEncrypted(), c.accountMounter.(*MockPayloadMounter).encryptor.payload.encrypted)
 }
 
+func (s *PairingServerSuite) TestPairingServer_ChunkedTransfer() {
+	defer func(size int) { transferChunkSize = size }(transferChunkSize)
+	transferChunkSize = 16
+
+	// Receive chunks from the SenderServer
+	c := s.sendingSetup()
+
+	err := c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().NoError(err)
+
+	mounter := s.SS.accountMounter.(*MockPayloadMounter)
+	s.Require().Greater(chunkCount(len(mounter.encryptor.payload.encrypted)), 1)
+	s.Require().Equal(mounter.encryptor.payload.plain, c.accountReceiver.Received())
+
+	// Send chunks to the ReceiverServer, which advertises chunked uploads along with the challenge
+	sc := s.receivingSetup()
+	err = sc.getChallenge()
+	s.Require().NoError(err)
+	s.Require().True(sc.chunkedUploads)
+
+	transport := &droppingTransport{RoundTripper: sc.Transport}
+	sc.Transport = transport
+
+	err = sc.sendAccountData()
+	s.Require().NoError(err)
+	s.Require().Equal(sc.accountMounter.(*MockPayloadMounter).encryptor.payload.plain, s.RS.accountReceiver.Received())
+	s.Require().Greater(len(transport.chunks), 1)
+}
+
+func (s *PairingServerSuite) TestPairingServer_SendWholePayloadToOlderReceiver() {
+	defer func(size int) { transferChunkSize = size }(transferChunkSize)
+	transferChunkSize = 16
+
+	// Without the advertisement of chunked uploads, the payload is sent in a single request
+	sc := s.receivingSetup()
+	err := sc.getChallenge()
+	s.Require().NoError(err)
+	sc.chunkedUploads = false
+
+	transport := &droppingTransport{RoundTripper: sc.Transport}
+	sc.Transport = transport
+
+	err = sc.sendAccountData()
+	s.Require().NoError(err)
+	s.Require().Equal(sc.accountMounter.(*MockPayloadMounter).encryptor.payload.plain, s.RS.accountReceiver.Received())
+	s.Require().Equal([]string{""}, transport.chunks)
+}
+
+func (s *PairingServerSuite) TestPairingServer_ChunkedTransferResume() {
+	defer func(size int, delay time.Duration) {
+		transferChunkSize = size
+		transferRetryDelay = delay
+	}(transferChunkSize, transferRetryDelay)
+	transferChunkSize = 8
+	transferRetryDelay = time.Millisecond
+
+	sc := s.receivingSetup()
+	err := sc.getChallenge()
+	s.Require().NoError(err)
+
+	// The connection drops once the server got the second chunk, before its acknowledgement reaches us
+	transport := &droppingTransport{RoundTripper: sc.Transport, dropAfter: 2}
+	sc.Transport = transport
+
+	err = sc.sendAccountData()
+	s.Require().Error(err)
+	s.Require().Nil(s.RS.accountReceiver.Received())
+
+	// Once the connection is back, the same payload is sent again from the last acknowledged chunk
+	transport.dropAfter = 0
+	err = sc.getChallenge()
+	s.Require().NoError(err)
+	err = sc.sendAccountData()
+	s.Require().NoError(err)
+
+	mounter := sc.accountMounter.(*MockPayloadMounter)
+	s.Require().Equal(mounter.encryptor.payload.plain, s.RS.accountReceiver.Received())
+
+	count := chunkCount(len(mounter.encryptor.payload.encrypted))
+	s.Require().Greater(count, 3)
+	expected := []string{"0", "1", "1"}
+	for i := 2; i < count; i++ {
+		expected = append(expected, strconv.Itoa(i))
+	}
+	s.Require().Equal(expected, transport.chunks)
+}
+
+func (s *PairingServerSuite) TestPairingServer_ChunkedTransferOversizedPayload() {
+	sc := s.receivingSetup()
+
+	post := func(totalSize string) *http.Response {
+		u := *sc.baseAddress
+		u.Path = pairingReceiveAccount
+		req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader([]byte{1}))
+		s.Require().NoError(err)
+		req.Header.Set(headerTransferID, chunkHash([]byte{1}))
+		req.Header.Set(headerChunkIndex, "0")
+		req.Header.Set(headerChunkCount, "1")
+		req.Header.Set(headerTotalSize, totalSize)
+		req.Header.Set(headerChunkHash, chunkHash([]byte{1}))
+		s.Require().NoError(sc.challengeTaker.DoChallenge(req))
+
+		resp, err := sc.Do(req)
+		s.Require().NoError(err)
+		resp.Body.Close()
+		return resp
+	}
+
+	// Uploads without a challenge response are refused
+	resp := post("1")
+	s.Require().Equal(http.StatusForbidden, resp.StatusCode)
+
+	// The announced size is bounded by the chunk count and by the maximum payload size
+	for _, totalSize := range []string{strconv.Itoa(maxTransferChunkSize + 1), strconv.Itoa(maxTransferPayloadSize + 1), "9223372036854775807"} {
+		s.Require().NoError(sc.getChallenge())
+		resp = post(totalSize)
+		s.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+	}
+	s.Require().Nil(s.RS.accountReceiver.Received())
+}
+
+// receivingSetup starts the ReceiverServer and returns a SenderClient connected to it
+func (s *PairingServerSuite) receivingSetup() *SenderClient {
+	s.RS.accountReceiver = NewMockPayloadReceiver(s.EphemeralAES)
+	err := s.RS.startReceivingData()
+	s.Require().NoError(err)
+
+	cp, err := s.RS.MakeConnectionParams()
+	s.Require().NoError(err)
+
+	ccp := new(ConnectionParams)
+	err = ccp.FromString(cp.ToString())
+	s.Require().NoError(err)
+
+	sc, err := NewSenderClient(nil, ccp, &SenderClientConfig{SenderConfig: &SenderConfig{}, ClientConfig: &ClientConfig{}})
+	s.Require().NoError(err)
+	sc.accountMounter = NewMockPayloadMounter(s.EphemeralAES)
+	return sc
+}
+
+// droppingTransport records the chunk index of every upload reaching the server,
+// and drops the connection once `dropAfter` uploads reached it
+type droppingTransport struct {
+	http.RoundTripper
+	dropAfter int
+	chunks    []string
+}
+
+func (t *droppingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.dropAfter > 0 && len(t.chunks) >= t.dropAfter {
+		return nil, errors.New("connection dropped")
+	}
+
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil || req.Method != http.MethodPost {
+		return resp, err
+	}
+
+	t.chunks = append(t.chunks, req.Header.Get(headerChunkIndex))
+	if t.dropAfter > 0 && len(t.chunks) == t.dropAfter {
+		resp.Body.Close()
+		return nil, errors.New("connection dropped")
+	}
+	return resp, nil
+}
+
+func (s *PairingServerSuite) sasSendingSetup() *ReceiverClient {
+	s.SS.sasGiver = NewSASGiver(NewPayloadEncryptor(s.EphemeralAES), s.SS.config.PK, s.Cert.Certificate[0], s.SS.GetLogger())
+	c := s.sendingSetup()
+	c.sasRequired = true
+	return c
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASRequired() {
+	c := s.sasSendingSetup()
+	c.sasRequired = false
+
+	// The server requires a SAS verification, skipping it must not give access to the data
+	err := c.verifySAS()
+	s.Require().NoError(err)
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "403")
+	s.Require().Nil(c.accountReceiver.Received())
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASRejectedByClient() {
+	c := s.sasSendingSetup()
+	c.awaitSASConfirmation = func(sas *SAS) error {
+		s.Require().Equal(s.SS.sasGiver.getVerification().sas, sas)
+		return ErrSASRejected
+	}
+
+	err := c.verifySAS()
+	s.Require().ErrorIs(err, ErrSASRejected)
+
+	// The server user didn't confirm either, the data stays locked
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().Error(err)
+	s.Require().Nil(c.accountReceiver.Received())
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASRejectedByServer() {
+	c := s.sasSendingSetup()
+	c.awaitSASConfirmation = func(sas *SAS) error {
+		s.Require().Equal(s.SS.sasGiver.getVerification().sas, sas)
+		s.Require().NoError(ConfirmSAS(s.SS.sasGiver.getVerification().id, false))
+		return nil
+	}
+
+	err := c.verifySAS()
+	s.Require().ErrorIs(err, ErrSASRejected)
+
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().Error(err)
+	s.Require().Nil(c.accountReceiver.Received())
+
+	// The verification is over, there is nothing left to confirm
+	s.Require().ErrorIs(ConfirmSAS(s.SS.sasGiver.getVerification().id, true), ErrNoSASVerification)
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASConfirmed() {
+	c := s.sasSendingSetup()
+	c.awaitSASConfirmation = func(sas *SAS) error {
+		s.Require().Equal(s.SS.sasGiver.getVerification().sas, sas)
+		s.Require().Len(sas.Emojis, 7)
+		s.Require().Len(sas.Decimal, 3)
+		s.Require().ErrorIs(ConfirmSAS("unknown", true), ErrNoSASVerification)
+		s.Require().NoError(ConfirmSAS(s.SS.sasGiver.getVerification().id, true))
+		return nil
+	}
+
+	err := c.verifySAS()
+	s.Require().NoError(err)
+
+	err = c.getChallenge()
+	s.Require().NoError(err)
+	err = c.receiveAccountData()
+	s.Require().NoError(err)
+	s.Require().Equal(s.SS.accountMounter.(*MockPayloadMounter).encryptor.payload.plain, c.accountReceiver.Received())
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASInProgressNotReplaced() {
+	c := s.sasSendingSetup()
+	c.awaitSASConfirmation = func(sas *SAS) error {
+		v := s.SS.sasGiver.getVerification()
+
+		// A new handshake can't take over the verification the users are comparing
+		clientNonce, err := randomSASNonce()
+		s.Require().NoError(err)
+		_, err = c.postSAS(pairingSAS, sasCommitment(clientNonce))
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "409")
+		s.Require().Equal(v, s.SS.sasGiver.getVerification())
+
+		s.Require().NoError(ConfirmSAS(v.id, true))
+		return nil
+	}
+
+	err := c.verifySAS()
+	s.Require().NoError(err)
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASRevealMustMatchCommitment() {
+	c := s.sasSendingSetup()
+
+	clientNonce, err := randomSASNonce()
+	s.Require().NoError(err)
+	_, err = c.postSAS(pairingSAS, sasCommitment(clientNonce))
+	s.Require().NoError(err)
+
+	// Revealing another nonce than the committed one must not start a verification
+	otherNonce, err := randomSASNonce()
+	s.Require().NoError(err)
+	_, err = c.postSAS(pairingSASReveal, otherNonce)
+	s.Require().Error(err)
+	s.Require().Nil(s.SS.sasGiver.getVerification())
+
+	// Nor can a nonce be revealed without a commitment
+	_, err = c.postSAS(pairingSASReveal, clientNonce)
+	s.Require().Error(err)
+	s.Require().Nil(s.SS.sasGiver.getVerification())
+}
+
+func (s *PairingServerSuite) TestPairingServer_SASCoversServerCert() {
+	clientNonce, err := randomSASNonce()
+	s.Require().NoError(err)
+	serverNonce, err := randomSASNonce()
+	s.Require().NoError(err)
+
+	otherCert, _, err := GenerateCertFromKey(s.EphemeralPK, time.Now(), s.IPAddresses, []string{})
+	s.Require().NoError(err)
+
+	sas := deriveSAS(s.EphemeralAES, &s.EphemeralPK.PublicKey, s.Cert.Certificate[0], clientNonce, serverNonce)
+	otherSAS := deriveSAS(s.EphemeralAES, &s.EphemeralPK.PublicKey, otherCert.Certificate[0], clientNonce, serverNonce)
+	s.Require().NotEqual(sas, otherSAS)
+}
+
 func (s *PairingServerSuite) sendingSetup() *ReceiverClient {
 	// Replace PairingServer.PayloadManager with a MockPayloadReceiver
 	pm := NewMockPayloadMounter(s.EphemeralAES)
@@ -0,0 +1,195 @@
+package pairing
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// SyncScope limits the data synced to the paired device, a nil SyncScope syncs everything
+type SyncScope struct {
+	// ExcludeWallet skips wallet keypairs, accounts, saved addresses and wallet preferences.
+	// Only the chat key of the profile keypair is transferred.
+	ExcludeWallet bool `json:"excludeWallet"`
+	// CommunityIDs limits the synced communities to the given ones when not empty
+	CommunityIDs []types.HexBytes `json:"communityIds"`
+	// ChatIDs limits the synced chats to the given ones when not empty
+	ChatIDs []string `json:"chatIds"`
+}
+
+var walletRawMessageTypes = map[protobuf.ApplicationMetadataMessage_Type]bool{
+	protobuf.ApplicationMetadataMessage_SYNC_KEYPAIR:                 true,
+	protobuf.ApplicationMetadataMessage_SYNC_ACCOUNT:                 true,
+	protobuf.ApplicationMetadataMessage_SYNC_SAVED_ADDRESS:           true,
+	protobuf.ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS:      true,
+	protobuf.ApplicationMetadataMessage_SYNC_TOKEN_PREFERENCES:       true,
+	protobuf.ApplicationMetadataMessage_SYNC_COLLECTIBLE_PREFERENCES: true,
+}
+
+func (s *SyncScope) excludesWallet() bool {
+	return s != nil && s.ExcludeWallet
+}
+
+func (s *SyncScope) includesCommunity(id []byte) bool {
+	if len(s.CommunityIDs) == 0 {
+		return true
+	}
+	for _, communityID := range s.CommunityIDs {
+		if bytes.Equal(communityID, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SyncScope) includesChat(id string) bool {
+	if len(s.ChatIDs) == 0 {
+		return true
+	}
+	for _, chatID := range s.ChatIDs {
+		if chatID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// includesRawMessage tells whether the sync message belongs to the scope.
+// Messages which can't be decoded are left out.
+func (s *SyncScope) includesRawMessage(messageType protobuf.ApplicationMetadataMessage_Type, payload []byte) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.ExcludeWallet && walletRawMessageTypes[messageType] {
+		return false
+	}
+
+	switch messageType {
+	case protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_COMMUNITY:
+		message := new(protobuf.SyncInstallationCommunity)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		return s.includesCommunity(message.Id)
+
+	case protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_SETTINGS:
+		message := new(protobuf.SyncCommunitySettings)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		id, err := types.DecodeHex(message.CommunityId)
+		if err != nil {
+			return false
+		}
+		return s.includesCommunity(id)
+
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT:
+		message := new(protobuf.SyncChat)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		return s.includesChat(message.Id)
+
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT_REMOVED:
+		message := new(protobuf.SyncChatRemoved)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		return s.includesChat(message.Id)
+
+	case protobuf.ApplicationMetadataMessage_SYNC_CHAT_MESSAGES_READ:
+		message := new(protobuf.SyncChatMessagesRead)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		return s.includesChat(message.Id)
+
+	case protobuf.ApplicationMetadataMessage_SYNC_CLEAR_HISTORY:
+		message := new(protobuf.SyncClearHistory)
+		if proto.Unmarshal(payload, message) != nil {
+			return false
+		}
+		return s.includesChat(message.ChatId)
+	}
+
+	return true
+}
+
+func (s *SyncScope) filterRawMessages(rawMessages []*protobuf.RawMessage) []*protobuf.RawMessage {
+	if s == nil {
+		return rawMessages
+	}
+
+	var result []*protobuf.RawMessage
+	for _, rawMessage := range rawMessages {
+		if s.includesRawMessage(rawMessage.MessageType, rawMessage.Payload) {
+			result = append(result, rawMessage)
+		}
+	}
+	return result
+}
+
+// filterProfileKeypair keeps the chat account and the default wallet account, which is required to log in,
+// when the wallet is excluded. The default wallet account becomes non operable as its key isn't transferred.
+func (s *SyncScope) filterProfileKeypair(kp *accounts.Keypair) {
+	if !s.excludesWallet() || kp == nil {
+		return
+	}
+
+	var profileAccounts []*accounts.Account
+	for _, account := range kp.Accounts {
+		switch {
+		case account.Chat:
+			profileAccounts = append(profileAccounts, account)
+		case account.Wallet:
+			account.Operable = accounts.AccountNonOperable
+			profileAccounts = append(profileAccounts, account)
+		}
+	}
+	kp.Accounts = profileAccounts
+}
+
+// filterSettings clears the mnemonic and the wallet derivation settings when the wallet is excluded,
+// the paired device must not be able to derive wallet accounts
+func (s *SyncScope) filterSettings(setting *settings.Settings) {
+	if !s.excludesWallet() || setting == nil {
+		return
+	}
+
+	setting.Mnemonic = nil
+	setting.WalletRootAddress = types.Address{}
+	setting.EIP1581Address = types.Address{}
+	setting.LatestDerivedPath = 0
+	setting.WalletVisibleTokens = nil
+}
+
+// filterKeys keeps the keystore file of the chat account only when the wallet is excluded
+func (s *SyncScope) filterKeys(keys map[string][]byte, chatAddress types.Address) (map[string][]byte, error) {
+	if !s.excludesWallet() {
+		return keys, nil
+	}
+
+	result := make(map[string][]byte)
+	for name, key := range keys {
+		var keyFile struct {
+			Address string `json:"address"`
+		}
+		err := json.Unmarshal(key, &keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(strings.TrimPrefix(keyFile.Address, "0x"), strings.TrimPrefix(chatAddress.Hex(), "0x")) {
+			result[name] = key
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package pairing
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestSyncScopeRawMessages(t *testing.T) {
+	marshal := func(m proto.Message) []byte {
+		payload, err := proto.Marshal(m)
+		require.NoError(t, err)
+		return payload
+	}
+
+	communityID := types.HexBytes{0x01, 0x02}
+	otherCommunityID := types.HexBytes{0x03, 0x04}
+
+	rawMessages := []*protobuf.RawMessage{
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_KEYPAIR, Payload: marshal(&protobuf.SyncKeypair{Name: "personal"})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_SAVED_ADDRESS, Payload: marshal(&protobuf.SyncSavedAddress{Name: "friend"})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_COMMUNITY, Payload: marshal(&protobuf.SyncInstallationCommunity{Id: communityID})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_COMMUNITY, Payload: marshal(&protobuf.SyncInstallationCommunity{Id: otherCommunityID})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_SETTINGS, Payload: marshal(&protobuf.SyncCommunitySettings{CommunityId: otherCommunityID.String()})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_CHAT, Payload: marshal(&protobuf.SyncChat{Id: "work"})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_CHAT, Payload: marshal(&protobuf.SyncChat{Id: "family"})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_CLEAR_HISTORY, Payload: marshal(&protobuf.SyncClearHistory{ChatId: "family"})},
+		{MessageType: protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT_V2, Payload: marshal(&protobuf.SyncInstallationContactV2{Id: "0x04"})},
+	}
+
+	var scope *SyncScope
+	require.Len(t, scope.filterRawMessages(rawMessages), len(rawMessages))
+
+	scope = &SyncScope{ExcludeWallet: true}
+	require.Len(t, scope.filterRawMessages(rawMessages), len(rawMessages)-2)
+
+	scope = &SyncScope{
+		ExcludeWallet: true,
+		CommunityIDs:  []types.HexBytes{communityID},
+		ChatIDs:       []string{"work"},
+	}
+	filtered := scope.filterRawMessages(rawMessages)
+	require.Len(t, filtered, 3)
+	require.Equal(t, rawMessages[2], filtered[0])
+	require.Equal(t, rawMessages[5], filtered[1])
+	require.Equal(t, rawMessages[8], filtered[2])
+}
+
+func TestSyncScopeExcludeWallet(t *testing.T) {
+	chatAddress := types.HexToAddress("0x1111111111111111111111111111111111111111")
+	walletAddress := types.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	kp := &accounts.Keypair{
+		Accounts: []*accounts.Account{
+			{Address: chatAddress, Chat: true},
+			{Address: walletAddress, Wallet: true, Operable: accounts.AccountFullyOperable},
+			{Address: types.HexToAddress("0x3333333333333333333333333333333333333333"), Operable: accounts.AccountFullyOperable},
+		},
+	}
+
+	keys := map[string][]byte{
+		"chat":   []byte(`{"address":"1111111111111111111111111111111111111111"}`),
+		"wallet": []byte(`{"address":"2222222222222222222222222222222222222222"}`),
+		"master": []byte(`{"address":"4444444444444444444444444444444444444444"}`),
+	}
+
+	var scope *SyncScope
+	scope.filterProfileKeypair(kp)
+	require.Len(t, kp.Accounts, 3)
+	filteredKeys, err := scope.filterKeys(keys, chatAddress)
+	require.NoError(t, err)
+	require.Len(t, filteredKeys, 3)
+
+	scope = &SyncScope{ExcludeWallet: true}
+	scope.filterProfileKeypair(kp)
+	require.Len(t, kp.Accounts, 2)
+	require.Equal(t, accounts.AccountNonOperable, kp.Accounts[1].Operable)
+
+	filteredKeys, err = scope.filterKeys(keys, chatAddress)
+	require.NoError(t, err)
+	require.Len(t, filteredKeys, 1)
+	require.Contains(t, filteredKeys, "chat")
+}
+
+func TestSyncScopeExcludeWalletSettings(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	newSettings := func() *settings.Settings {
+		return &settings.Settings{
+			KeyUID:            "0xdeadbeef",
+			DisplayName:       "alice",
+			Mnemonic:          &mnemonic,
+			WalletRootAddress: types.HexToAddress("0x5555555555555555555555555555555555555555"),
+			EIP1581Address:    types.HexToAddress("0x6666666666666666666666666666666666666666"),
+			LatestDerivedPath: 3,
+		}
+	}
+
+	transfer := func(scope *SyncScope) *settings.Settings {
+		payload := NewRawMessagesPayload()
+		payload.setting = newSettings()
+		scope.filterSettings(payload.setting)
+
+		data, err := NewRawMessagePayloadMarshaller(payload).MarshalProtobuf()
+		require.NoError(t, err)
+
+		received := NewRawMessagesPayload()
+		require.NoError(t, NewRawMessagePayloadMarshaller(received).UnmarshalProtobuf(data))
+		return received.setting
+	}
+
+	received := transfer(nil)
+	require.NotNil(t, received.Mnemonic)
+	require.Equal(t, mnemonic, *received.Mnemonic)
+
+	received = transfer(&SyncScope{ExcludeWallet: true})
+	require.Nil(t, received.Mnemonic)
+	require.Equal(t, types.Address{}, received.WalletRootAddress)
+	require.Equal(t, types.Address{}, received.EIP1581Address)
+	require.Zero(t, received.LatestDerivedPath)
+	require.Equal(t, "0xdeadbeef", received.KeyUID)
+	require.Equal(t, "alice", received.DisplayName)
+}
@@ -0,0 +1,622 @@
+package pairing
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/api"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/logutils"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/common/shard"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/signal"
+	"github.com/status-im/status-go/wakuv2"
+	wakucommon "github.com/status-im/status-go/wakuv2/common"
+)
+
+/*
+|--------------------------------------------------------------------------
+| Waku relay
+|--------------------------------------------------------------------------
+|
+| Fallback for devices which can't reach each other on the local network.
+| The pairing payloads are exchanged through an ephemeral Waku content topic,
+| the topic and the symmetric key of the Waku messages are both derived from
+| the secret shared through the QR code. Payloads are chunked to fit in the
+| node's MaxMessageSize and every chunk is acknowledged by the other device.
+|
+*/
+
+const (
+	wakuRelayTopicInfo        = "status-pairing-waku-topic"
+	wakuRelayKeyInfo          = "status-pairing-waku-key"
+	wakuRelayMessageOverhead  = 1024
+	wakuRelayMessagesBuffer   = 100
+	wakuRelayPostTimeout      = 10 * time.Second
+	wakuRelayAckTimeout       = 2 * time.Second
+	wakuRelayTransferTimeout  = 5 * time.Minute
+	wakuRelayMinimumChunkSize = 1024
+)
+
+var wakuRelayPollInterval = 100 * time.Millisecond
+
+var (
+	ErrWakuRelayUnavailable      = errors.New("waku is not available for the pairing relay")
+	ErrWakuRelayTimeout          = errors.New("timed out waiting for the other device over waku")
+	ErrWakuRelayClosed           = errors.New("waku pairing relay closed")
+	ErrWakuRelayMaxMessageSize   = errors.New("waku max message size is too small for the pairing relay")
+	ErrWakuRelayInconsistentData = errors.New("inconsistent chunk received over waku")
+)
+
+// deriveWakuRelayTopicAndKey derives the content topic and the symmetric key of the relay from the pairing secret
+func deriveWakuRelayTopicAndKey(aesKey []byte) (types.TopicType, []byte) {
+	topicHash := sha256.Sum256(append([]byte(wakuRelayTopicInfo), aesKey...))
+	keyHash := sha256.Sum256(append([]byte(wakuRelayKeyInfo), aesKey...))
+	return types.BytesToTopic(topicHash[:types.TopicLength]), keyHash[:]
+}
+
+// wakuRelay exchanges pairing payloads with the other device over Waku
+type wakuRelay struct {
+	waku      types.Waku
+	api       types.PublicWakuAPI
+	role      protobuf.LocalPairingRelayMessage_Role
+	topic     types.TopicType
+	symKeyID  string
+	filterID  string
+	chunkSize int
+	logger    *zap.Logger
+
+	chunks    chan *protobuf.LocalPairingRelayMessage
+	acks      chan *protobuf.LocalPairingRelayMessage
+	connected bool
+	// completed holds the chunk count of the payloads fully received, to acknowledge chunks sent again
+	completed map[int32]uint32
+	quit      chan struct{}
+}
+
+func newWakuRelay(waku types.Waku, aesKey []byte, role protobuf.LocalPairingRelayMessage_Role, logger *zap.Logger) (*wakuRelay, error) {
+	if waku == nil {
+		return nil, ErrWakuRelayUnavailable
+	}
+
+	chunkSize := int(waku.MaxMessageSize()) - wakuRelayMessageOverhead
+	if chunkSize < wakuRelayMinimumChunkSize {
+		return nil, ErrWakuRelayMaxMessageSize
+	}
+
+	topic, symKey := deriveWakuRelayTopicAndKey(aesKey)
+	symKeyID, err := waku.AddSymKeyDirect(symKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wakuAPI := waku.PublicWakuAPI()
+	filterID, err := wakuAPI.NewMessageFilter(types.Criteria{
+		SymKeyID: symKeyID,
+		Topics:   []types.TopicType{topic},
+	})
+	if err != nil {
+		waku.DeleteSymKey(symKeyID)
+		return nil, err
+	}
+
+	r := &wakuRelay{
+		waku:      waku,
+		api:       wakuAPI,
+		role:      role,
+		topic:     topic,
+		symKeyID:  symKeyID,
+		filterID:  filterID,
+		chunkSize: chunkSize,
+		logger:    logger,
+		chunks:    make(chan *protobuf.LocalPairingRelayMessage, wakuRelayMessagesBuffer),
+		acks:      make(chan *protobuf.LocalPairingRelayMessage, wakuRelayMessagesBuffer),
+		completed: make(map[int32]uint32),
+		quit:      make(chan struct{}),
+	}
+	go r.poll()
+	return r, nil
+}
+
+func (r *wakuRelay) close() {
+	close(r.quit)
+	err := r.waku.Unsubscribe(context.Background(), r.filterID)
+	if err != nil {
+		r.logger.Warn("failed to unsubscribe from the pairing relay topic", zap.Error(err))
+	}
+	r.waku.DeleteSymKey(r.symKeyID)
+}
+
+func (r *wakuRelay) poll() {
+	ticker := time.NewTicker(wakuRelayPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.quit:
+			return
+		case <-ticker.C:
+			messages, err := r.api.GetFilterMessages(r.filterID)
+			if err != nil {
+				r.logger.Warn("failed to get pairing relay messages", zap.Error(err))
+				continue
+			}
+			for _, m := range messages {
+				r.dispatch(m.Payload)
+			}
+		}
+	}
+}
+
+func (r *wakuRelay) dispatch(payload []byte) {
+	message := new(protobuf.LocalPairingRelayMessage)
+	err := proto.Unmarshal(payload, message)
+	if err != nil {
+		r.logger.Warn("failed to unmarshal pairing relay message", zap.Error(err))
+		return
+	}
+
+	// Our own messages are relayed back to us
+	if message.From == r.role {
+		return
+	}
+
+	var ch chan *protobuf.LocalPairingRelayMessage
+	switch message.Type {
+	case protobuf.LocalPairingRelayMessage_CHUNK:
+		ch = r.chunks
+	case protobuf.LocalPairingRelayMessage_ACK:
+		ch = r.acks
+	default:
+		return
+	}
+
+	// Dropped messages are sent again by the other device
+	select {
+	case ch <- message:
+	default:
+		r.logger.Warn("pairing relay buffer full, dropping message", zap.Stringer("type", message.Type))
+	}
+}
+
+func (r *wakuRelay) publish(message *protobuf.LocalPairingRelayMessage) error {
+	message.From = r.role
+	payload, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wakuRelayPostTimeout)
+	defer cancel()
+
+	_, err = r.api.Post(ctx, types.NewMessage{
+		SymKeyID:  r.symKeyID,
+		Topic:     r.topic,
+		Payload:   payload,
+		Ephemeral: true,
+	})
+	return err
+}
+
+func (r *wakuRelay) ack(action int32, index uint32) error {
+	return r.publish(&protobuf.LocalPairingRelayMessage{
+		Type:       protobuf.LocalPairingRelayMessage_ACK,
+		Action:     action,
+		ChunkIndex: index,
+	})
+}
+
+// ackCompleted acknowledges again the chunks of a payload already received,
+// the other device sends them until it gets our acknowledgement
+func (r *wakuRelay) ackCompleted(chunk *protobuf.LocalPairingRelayMessage) error {
+	count, ok := r.completed[chunk.Action]
+	if !ok || chunk.ChunkIndex >= count {
+		return nil
+	}
+	return r.ack(chunk.Action, chunk.ChunkIndex)
+}
+
+// markConnected emits the connection event the first time the other device is heard from
+func (r *wakuRelay) markConnected() {
+	if r.connected {
+		return
+	}
+	r.connected = true
+	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionConnect})
+}
+
+// send publishes the payload chunk by chunk, waiting for each chunk to be acknowledged
+func (r *wakuRelay) send(action Action, payload []byte) error {
+	count := (len(payload) + r.chunkSize - 1) / r.chunkSize
+	if count == 0 {
+		count = 1
+	}
+
+	for i := 0; i < count; i++ {
+		start := i * r.chunkSize
+		end := start + r.chunkSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		err := r.sendChunk(&protobuf.LocalPairingRelayMessage{
+			Type:       protobuf.LocalPairingRelayMessage_CHUNK,
+			Action:     int32(action),
+			ChunkIndex: uint32(i),
+			ChunkCount: uint32(count),
+			TotalSize:  uint64(len(payload)),
+			Data:       payload[start:end],
+		})
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			return err
+		}
+		sendTransferProgress(action, end, len(payload))
+	}
+
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+	return nil
+}
+
+func (r *wakuRelay) sendChunk(chunk *protobuf.LocalPairingRelayMessage) error {
+	deadline := time.After(wakuRelayTransferTimeout)
+	for {
+		err := r.publish(chunk)
+		if err != nil {
+			return err
+		}
+
+		retry := time.After(wakuRelayAckTimeout)
+	waitAck:
+		for {
+			select {
+			case ack := <-r.acks:
+				r.markConnected()
+				if ack.Action == chunk.Action && ack.ChunkIndex == chunk.ChunkIndex {
+					return nil
+				}
+			case previous := <-r.chunks:
+				err = r.ackCompleted(previous)
+				if err != nil {
+					return err
+				}
+			case <-retry:
+				break waitAck
+			case <-deadline:
+				return ErrWakuRelayTimeout
+			case <-r.quit:
+				return ErrWakuRelayClosed
+			}
+		}
+	}
+}
+
+// receive assembles the payload sent for the given action, acknowledging every chunk received
+func (r *wakuRelay) receive(action Action) ([]byte, error) {
+	payload, err := r.receiveChunks(int32(action))
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		return nil, err
+	}
+
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+	return payload, nil
+}
+
+func (r *wakuRelay) receiveChunks(action int32) ([]byte, error) {
+	var payload []byte
+	var next uint32
+
+	timeout := time.NewTimer(wakuRelayTransferTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case chunk := <-r.chunks:
+			r.markConnected()
+
+			if chunk.Action != action {
+				err := r.ackCompleted(chunk)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if chunk.ChunkIndex > next {
+				// Ahead of us, the other device sends it again until acknowledged
+				continue
+			}
+			if chunk.ChunkIndex == next {
+				if chunk.ChunkCount == 0 || chunk.ChunkIndex >= chunk.ChunkCount ||
+					uint64(len(payload)+len(chunk.Data)) > chunk.TotalSize {
+					return nil, ErrWakuRelayInconsistentData
+				}
+				payload = append(payload, chunk.Data...)
+				next++
+				sendTransferProgress(Action(action), len(payload), int(chunk.TotalSize))
+			}
+
+			err := r.ack(action, chunk.ChunkIndex)
+			if err != nil {
+				return nil, err
+			}
+
+			if next == chunk.ChunkCount {
+				if uint64(len(payload)) != chunk.TotalSize {
+					return nil, ErrWakuRelayInconsistentData
+				}
+				r.completed[action] = chunk.ChunkCount
+				return payload, nil
+			}
+
+			if !timeout.Stop() {
+				<-timeout.C
+			}
+			timeout.Reset(wakuRelayTransferTimeout)
+
+		case <-timeout.C:
+			return nil, ErrWakuRelayTimeout
+		case <-r.quit:
+			return nil, ErrWakuRelayClosed
+		}
+	}
+}
+
+// wakuRelayNodeConfig returns the fleet and the configuration of the ephemeral Waku node
+// started for the relay when the backend isn't running one
+var wakuRelayNodeConfig = func() (string, *wakuv2.Config, error) {
+	nodeConfig := &params.NodeConfig{}
+	err := api.SetDefaultFleet(nodeConfig)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return nodeConfig.ClusterConfig.Fleet, &wakuv2.Config{
+		MaxMessageSize:          wakucommon.DefaultMaxMessageSize,
+		Host:                    nodeConfig.WakuV2Config.Host,
+		WakuNodes:               nodeConfig.ClusterConfig.WakuNodes,
+		DiscV5BootstrapNodes:    nodeConfig.ClusterConfig.DiscV5BootstrapNodes,
+		DiscoveryLimit:          nodeConfig.WakuV2Config.DiscoveryLimit,
+		EnableDiscV5:            true,
+		AutoUpdate:              nodeConfig.WakuV2Config.AutoUpdate,
+		DefaultShardPubsubTopic: shard.DefaultShardPubsubTopic(),
+		ClusterID:               nodeConfig.ClusterConfig.ClusterID,
+	}, nil
+}
+
+// startEphemeralWakuNode starts a Waku node only used for the relay, for devices without a running node,
+// e.g. a new device receiving its first account
+func startEphemeralWakuNode() (types.Waku, func(), error) {
+	fleet, cfg, err := wakuRelayNodeConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger := logutils.ZapLogger().Named("WakuRelayNode")
+	node, err := wakuv2.New(nil, fleet, cfg, logger, nil, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = node.Start()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stop := func() {
+		err := node.Stop()
+		if err != nil {
+			logger.Warn("failed to stop the pairing relay waku node", zap.Error(err))
+		}
+	}
+	return gethbridge.NewGethWakuV2Wrapper(node), stop, nil
+}
+
+// wakuFromBackend returns the Waku node of the backend, or starts an ephemeral one if the backend isn't running any.
+// The returned function releases the node once the relay is over.
+func wakuFromBackend(backend *api.GethStatusBackend) (types.Waku, func(), error) {
+	if backend != nil && backend.StatusNode() != nil && backend.StatusNode().IsRunning() && backend.StatusNode().WakuV2Service() != nil {
+		return gethbridge.NewGethWakuV2Wrapper(backend.StatusNode().WakuV2Service()), func() {}, nil
+	}
+	return startEphemeralWakuNode()
+}
+
+/*
+|--------------------------------------------------------------------------
+| WakuRelaySender
+|--------------------------------------------------------------------------
+|
+| Same flow as the SenderClient, over Waku
+|
+*/
+
+// WakuRelaySender sends pairing data to a WakuRelayReceiver over Waku
+type WakuRelaySender struct {
+	relay               *wakuRelay
+	accountMounter      PayloadMounter
+	rawMessageMounter   PayloadMounter
+	installationMounter PayloadMounterReceiver
+}
+
+// NewWakuRelaySender returns a WakuRelaySender relaying through the given Waku node
+func NewWakuRelaySender(waku types.Waku, backend *api.GethStatusBackend, c *ConnectionParams, config *SenderClientConfig) (*WakuRelaySender, error) {
+	logger := logutils.ZapLogger().Named("WakuRelaySender")
+	pe := NewPayloadEncryptor(c.aesKey)
+
+	am, rmm, imr, err := NewPayloadMounters(logger, pe, backend, config.SenderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	relay, err := newWakuRelay(waku, c.aesKey, protobuf.LocalPairingRelayMessage_SENDER, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WakuRelaySender{
+		relay:               relay,
+		accountMounter:      am,
+		rawMessageMounter:   rmm,
+		installationMounter: imr,
+	}, nil
+}
+
+// Run sends the account and sync data, then receives the installation data of the other device
+func (s *WakuRelaySender) Run() error {
+	defer s.relay.close()
+
+	err := s.accountMounter.Mount()
+	if err != nil {
+		return err
+	}
+	err = s.relay.send(ActionPairingAccount, s.accountMounter.ToSend())
+	if err != nil {
+		return err
+	}
+	s.accountMounter.LockPayload()
+
+	err = s.rawMessageMounter.Mount()
+	if err != nil {
+		return err
+	}
+	err = s.relay.send(ActionSyncDevice, s.rawMessageMounter.ToSend())
+	if err != nil {
+		return err
+	}
+
+	payload, err := s.relay.receive(ActionPairingInstallation)
+	if err != nil {
+		return err
+	}
+	err = s.installationMounter.Receive(payload)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingInstallation})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionPairingInstallation})
+	return nil
+}
+
+// StartUpWakuRelaySender sends the pairing data over Waku, using the secret of the given connection string
+func StartUpWakuRelaySender(backend *api.GethStatusBackend, cs, configJSON string) error {
+	ccp, conf, err := parseSenderClientConfig(backend, cs, configJSON)
+	if err != nil {
+		return err
+	}
+
+	waku, release, err := wakuFromBackend(backend)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	s, err := NewWakuRelaySender(waku, backend, ccp, conf)
+	if err != nil {
+		return err
+	}
+	return s.Run()
+}
+
+/*
+|--------------------------------------------------------------------------
+| WakuRelayReceiver
+|--------------------------------------------------------------------------
+|
+| Same flow as the ReceiverClient, over Waku
+|
+*/
+
+// WakuRelayReceiver receives pairing data from a WakuRelaySender over Waku
+type WakuRelayReceiver struct {
+	relay                *wakuRelay
+	accountReceiver      PayloadReceiver
+	rawMessageReceiver   PayloadReceiver
+	installationReceiver PayloadMounterReceiver
+}
+
+// NewWakuRelayReceiver returns a WakuRelayReceiver relaying through the given Waku node
+func NewWakuRelayReceiver(waku types.Waku, backend *api.GethStatusBackend, c *ConnectionParams, config *ReceiverClientConfig) (*WakuRelayReceiver, error) {
+	logger := logutils.ZapLogger().Named("WakuRelayReceiver")
+	pe := NewPayloadEncryptor(c.aesKey)
+
+	ar, rmr, imr, err := NewPayloadReceivers(logger, pe, backend, config.ReceiverConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	relay, err := newWakuRelay(waku, c.aesKey, protobuf.LocalPairingRelayMessage_RECEIVER, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WakuRelayReceiver{
+		relay:                relay,
+		accountReceiver:      ar,
+		rawMessageReceiver:   rmr,
+		installationReceiver: imr,
+	}, nil
+}
+
+func (r *WakuRelayReceiver) receiveAndProcess(action Action, pr PayloadReceiver) error {
+	payload, err := r.relay.receive(action)
+	if err != nil {
+		return err
+	}
+
+	err = pr.Receive(payload)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: action})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: action})
+	return nil
+}
+
+// Run receives the account and sync data, then sends the installation data back to the other device
+func (r *WakuRelayReceiver) Run() error {
+	defer r.relay.close()
+
+	err := r.receiveAndProcess(ActionPairingAccount, r.accountReceiver)
+	if err != nil {
+		return err
+	}
+
+	err = r.receiveAndProcess(ActionSyncDevice, r.rawMessageReceiver)
+	if err != nil {
+		return err
+	}
+
+	err = r.installationReceiver.Mount()
+	if err != nil {
+		return err
+	}
+	return r.relay.send(ActionPairingInstallation, r.installationReceiver.ToSend())
+}
+
+// StartUpWakuRelayReceiver receives the pairing data over Waku, using the secret of the given connection string.
+// A new device isn't logged in yet, an ephemeral Waku node is then started for the duration of the relay.
+func StartUpWakuRelayReceiver(backend *api.GethStatusBackend, cs, configJSON string) error {
+	ccp, conf, err := parseReceiverClientConfig(backend, cs, configJSON)
+	if err != nil {
+		return err
+	}
+
+	waku, release, err := wakuFromBackend(backend)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	r, err := NewWakuRelayReceiver(waku, backend, ccp, conf)
+	if err != nil {
+		return err
+	}
+	return r.Run()
+}
@@ -0,0 +1,186 @@
+package pairing
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/api"
+	"github.com/status-im/status-go/appdatabase"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/logutils"
+	"github.com/status-im/status-go/protocol/common/shard"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+type mockSizedPayloadMounter struct {
+	*MockPayloadMounter
+	size int
+}
+
+func (m *mockSizedPayloadMounter) Mount() error {
+	data := make([]byte, m.size)
+	_, err := rand.Read(data)
+	if err != nil {
+		return err
+	}
+
+	return m.encryptor.encrypt(data)
+}
+
+type mockPayloadMounterReceiver struct {
+	*MockPayloadMounter
+	receiver *MockPayloadReceiver
+}
+
+func (m *mockPayloadMounterReceiver) Receive(data []byte) error {
+	return m.receiver.Receive(data)
+}
+
+func (m *mockPayloadMounterReceiver) Received() []byte {
+	return m.receiver.Received()
+}
+
+func TestWakuRelaySuite(t *testing.T) {
+	suite.Run(t, new(WakuRelaySuite))
+}
+
+type WakuRelaySuite struct {
+	suite.Suite
+	TestPairingServerComponents
+
+	nodes []*wakuv2.Waku
+}
+
+func (s *WakuRelaySuite) SetupTest() {
+	s.SetupPairingServerComponents(s.T())
+
+	s.nodes = nil
+	for i := 0; i < 2; i++ {
+		db, err := helpers.SetupTestMemorySQLDB(appdatabase.DbInitializer{})
+		s.Require().NoError(err)
+
+		node, err := wakuv2.New(nil, "", &wakuv2.Config{
+			ClusterID:      shard.MainStatusShardCluster,
+			EnableDiscV5:   false,
+			MaxMessageSize: 2048,
+		}, nil, db, nil, nil, nil)
+		s.Require().NoError(err)
+		s.Require().NoError(node.Start())
+		s.nodes = append(s.nodes, node)
+	}
+
+	addrs := s.nodes[1].ListenAddresses()
+	s.Require().NotEmpty(addrs)
+	_, err := s.nodes[0].AddRelayPeer(addrs[0])
+	s.Require().NoError(err)
+	s.Require().NoError(s.nodes[0].DialPeer(addrs[0]))
+}
+
+func (s *WakuRelaySuite) TearDownTest() {
+	for _, node := range s.nodes {
+		s.Require().NoError(node.Stop())
+	}
+}
+
+func (s *WakuRelaySuite) newRelay(waku types.Waku, aesKey []byte, role protobuf.LocalPairingRelayMessage_Role) *wakuRelay {
+	relay, err := newWakuRelay(waku, aesKey, role, logutils.ZapLogger())
+	s.Require().NoError(err)
+	return relay
+}
+
+func (s *WakuRelaySuite) TestDeriveTopicAndKey() {
+	topic, key := deriveWakuRelayTopicAndKey(s.EphemeralAES)
+	s.Require().Len(key, 32)
+
+	sameTopic, sameKey := deriveWakuRelayTopicAndKey(s.EphemeralAES)
+	s.Require().Equal(topic, sameTopic)
+	s.Require().Equal(key, sameKey)
+
+	otherTopic, otherKey := deriveWakuRelayTopicAndKey(append([]byte{1}, s.EphemeralAES[1:]...))
+	s.Require().NotEqual(topic, otherTopic)
+	s.Require().NotEqual(key, otherKey)
+	s.Require().NotEqual(types.TopicType{}, topic)
+}
+
+func (s *WakuRelaySuite) TestUnavailableWaku() {
+	_, err := newWakuRelay(nil, s.EphemeralAES, protobuf.LocalPairingRelayMessage_SENDER, logutils.ZapLogger())
+	s.Require().ErrorIs(err, ErrWakuRelayUnavailable)
+}
+
+// pairOverWaku runs the whole pairing flow between a sender and a receiver relaying through the given nodes
+func (s *WakuRelaySuite) pairOverWaku(senderWaku types.Waku, receiverWaku types.Waku) {
+	accountMounter := &mockSizedPayloadMounter{NewMockPayloadMounter(s.EphemeralAES), 5000}
+	rawMessageMounter := NewMockPayloadMounter(s.EphemeralAES)
+	senderInstallation := &mockPayloadMounterReceiver{NewMockPayloadMounter(s.EphemeralAES), NewMockPayloadReceiver(s.EphemeralAES)}
+
+	sender := &WakuRelaySender{
+		relay:               s.newRelay(senderWaku, s.EphemeralAES, protobuf.LocalPairingRelayMessage_SENDER),
+		accountMounter:      accountMounter,
+		rawMessageMounter:   rawMessageMounter,
+		installationMounter: senderInstallation,
+	}
+	s.Require().Greater(accountMounter.size, 2*sender.relay.chunkSize)
+
+	accountReceiver := NewMockPayloadReceiver(s.EphemeralAES)
+	rawMessageReceiver := NewMockPayloadReceiver(s.EphemeralAES)
+	receiverInstallation := &mockPayloadMounterReceiver{NewMockPayloadMounter(s.EphemeralAES), NewMockPayloadReceiver(s.EphemeralAES)}
+
+	receiver := &WakuRelayReceiver{
+		relay:                s.newRelay(receiverWaku, s.EphemeralAES, protobuf.LocalPairingRelayMessage_RECEIVER),
+		accountReceiver:      accountReceiver,
+		rawMessageReceiver:   rawMessageReceiver,
+		installationReceiver: receiverInstallation,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- receiver.Run()
+	}()
+
+	s.Require().NoError(sender.Run())
+	s.Require().NoError(<-errCh)
+
+	s.Require().Len(accountReceiver.Received(), 5000)
+	s.Require().Equal(accountMounter.encryptor.payload.plain, accountReceiver.Received())
+	s.Require().Equal(rawMessageMounter.encryptor.payload.plain, rawMessageReceiver.Received())
+	s.Require().Equal(receiverInstallation.encryptor.payload.plain, senderInstallation.Received())
+}
+
+func (s *WakuRelaySuite) TestPairingOverWaku() {
+	s.pairOverWaku(gethbridge.NewGethWakuV2Wrapper(s.nodes[0]), gethbridge.NewGethWakuV2Wrapper(s.nodes[1]))
+}
+
+func (s *WakuRelaySuite) TestPairingOverWakuWithoutLoggedInAccount() {
+	addrs := s.nodes[0].ListenAddresses()
+	s.Require().NotEmpty(addrs)
+
+	fleet, cfg, err := wakuRelayNodeConfig()
+	s.Require().NoError(err)
+	s.Require().NotEmpty(fleet)
+	s.Require().NotEmpty(cfg.WakuNodes)
+
+	// The ephemeral node connects to the sender's node instead of the fleet
+	defaultNodeConfig := wakuRelayNodeConfig
+	defer func() { wakuRelayNodeConfig = defaultNodeConfig }()
+	wakuRelayNodeConfig = func() (string, *wakuv2.Config, error) {
+		return "", &wakuv2.Config{
+			ClusterID:      shard.MainStatusShardCluster,
+			EnableDiscV5:   false,
+			MaxMessageSize: 2048,
+			WakuNodes:      []string{addrs[0].String()},
+		}, nil
+	}
+
+	// Nobody is logged in on the receiving device, its backend doesn't run any Waku node
+	backend := api.NewGethStatusBackend()
+	receiverWaku, release, err := wakuFromBackend(backend)
+	s.Require().NoError(err)
+	defer release()
+
+	s.pairOverWaku(gethbridge.NewGethWakuV2Wrapper(s.nodes[0]), receiverWaku)
+}
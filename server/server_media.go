@@ -132,6 +132,16 @@ func (s *MediaServer) MakeStickerURL(stickerHash string) string {
 	return u.String()
 }
 
+// StoreSticker keeps a sticker image so that it is served by its content hash like stickers fetched from IPFS
+func (s *MediaServer) StoreSticker(content []byte) (string, error) {
+	return s.downloader.Add(content)
+}
+
+// GetSticker returns a sticker image, from the local store when available
+func (s *MediaServer) GetSticker(stickerHash string) ([]byte, error) {
+	return s.downloader.Get(stickerHash, false)
+}
+
 func (s *MediaServer) MakeQRURL(qurul string,
 	allowProfileImage string,
 	level string,
@@ -50,11 +50,12 @@ type Service struct {
 	walletWatcher   *walletevent.Watcher
 	transactor      *transactions.Transactor
 	feeManager      *router.FeeManager
+	signals         signal.Namespace
 }
 
 // Returns a new Collectibles Service.
 func NewService(rpcClient *rpc.Client, accountsManager *account.GethManager, pendingTracker *transactions.PendingTxTracker,
-	config *params.NodeConfig, appDb *sql.DB, walletFeed *event.Feed, transactor *transactions.Transactor) *Service {
+	config *params.NodeConfig, appDb *sql.DB, walletFeed *event.Feed, transactor *transactions.Transactor, signals signal.Namespace) *Service {
 	return &Service{
 		manager:         &Manager{rpcClient: rpcClient},
 		accountsManager: accountsManager,
@@ -64,6 +65,7 @@ func NewService(rpcClient *rpc.Client, accountsManager *account.GethManager, pen
 		walletFeed:      walletFeed,
 		transactor:      transactor,
 		feeManager:      &router.FeeManager{RPCClient: rpcClient},
+		signals:         signals,
 	}
 }
 
@@ -139,7 +141,7 @@ func (s *Service) handleWalletEvent(event walletevent.Event) {
 			errorStr = tokenErr.Error()
 		}
 
-		signal.SendCommunityTokenTransactionStatusSignal(string(pendingTransaction.Type), p.Status == transactions.Success, pendingTransaction.Hash,
+		s.signals.SendCommunityTokenTransactionStatusSignal(string(pendingTransaction.Type), p.Status == transactions.Success, pendingTransaction.Hash,
 			communityToken, ownerToken, masterToken, errorStr)
 	}
 }
@@ -495,7 +497,7 @@ func (s *Service) ProcessCommunityTokenAction(message *protobuf.CommunityTokenAc
 		communityToken, _ = s.Messenger.GetCommunityTokenByChainAndAddress(int(message.ChainId), message.ContractAddress)
 	}
 
-	signal.SendCommunityTokenActionSignal(communityToken, message.ActionType)
+	s.signals.SendCommunityTokenActionSignal(communityToken, message.ActionType)
 
 	return nil
 }
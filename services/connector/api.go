@@ -22,6 +22,7 @@ type API struct {
 func NewAPI(s *Service) *API {
 	r := NewCommandRegistry()
 	c := commands.NewClientSideHandler()
+	c.Signals = s.signals
 
 	// Transactions and signing
 	r.Register("eth_sendTransaction", &commands.SendTransactionCommand{
@@ -38,6 +39,7 @@ func NewAPI(s *Service) *API {
 	accountsCommand := &commands.RequestAccountsCommand{
 		ClientHandler: c,
 		Db:            s.db,
+		Signals:       s.signals,
 	}
 	r.Register("eth_accounts", accountsCommand)
 	r.Register("eth_requestAccounts", accountsCommand)
@@ -50,12 +52,14 @@ func NewAPI(s *Service) *API {
 	r.Register("wallet_switchEthereumChain", &commands.SwitchEthereumChainCommand{
 		Db:             s.db,
 		NetworkManager: s.nm,
+		Signals:        s.signals,
 	})
 
 	// Permissions
 	r.Register("wallet_requestPermissions", &commands.RequestPermissionsCommand{})
 	r.Register("wallet_revokePermissions", &commands.RevokePermissionsCommand{
-		Db: s.db,
+		Db:      s.db,
+		Signals: s.signals,
 	})
 
 	return &API{
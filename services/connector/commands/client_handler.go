@@ -42,6 +42,8 @@ type Message struct {
 type ClientSideHandler struct {
 	responseChannel  chan Message
 	isRequestRunning int32
+	// Signals is the namespace of the signals sent to the client, that is the profile it runs for
+	Signals signal.Namespace
 }
 
 func NewClientSideHandler() *ClientSideHandler {
@@ -72,7 +74,7 @@ func (c *ClientSideHandler) RequestShareAccountForDApp(dApp signal.ConnectorDApp
 	defer c.clearRequestRunning()
 
 	requestID := c.generateRequestID(dApp)
-	signal.SendConnectorSendRequestAccounts(dApp, requestID)
+	c.Signals.SendConnectorSendRequestAccounts(dApp, requestID)
 
 	timeout := time.After(WalletResponseMaxInterval)
 
@@ -127,7 +129,7 @@ func (c *ClientSideHandler) RequestSendTransaction(dApp signal.ConnectorDApp, ch
 	}
 
 	requestID := c.generateRequestID(dApp)
-	signal.SendConnectorSendTransaction(dApp, chainID, string(txArgsJson), requestID)
+	c.Signals.SendConnectorSendTransaction(dApp, chainID, string(txArgsJson), requestID)
 
 	timeout := time.After(WalletResponseMaxInterval)
 
@@ -177,7 +179,7 @@ func (c *ClientSideHandler) RequestPersonalSign(dApp signal.ConnectorDApp, chall
 	defer c.clearRequestRunning()
 
 	requestID := c.generateRequestID(dApp)
-	signal.SendConnectorPersonalSign(dApp, requestID, challenge, address)
+	c.Signals.SendConnectorPersonalSign(dApp, requestID, challenge, address)
 
 	timeout := time.After(WalletResponseMaxInterval)
 
@@ -18,6 +18,7 @@ var (
 type RequestAccountsCommand struct {
 	ClientHandler ClientSideHandlerInterface
 	Db            *sql.DB
+	Signals       signal.Namespace
 }
 
 type RawAccountsResponse struct {
@@ -61,7 +62,7 @@ func (c *RequestAccountsCommand) Execute(request RPCRequest) (interface{}, error
 		if err != nil {
 			return "", err
 		}
-		signal.SendConnectorDAppPermissionGranted(connectorDApp)
+		c.Signals.SendConnectorDAppPermissionGranted(connectorDApp)
 	}
 
 	return FormatAccountAddressToResponse(dApp.SharedAccount), nil
@@ -8,7 +8,8 @@ import (
 )
 
 type RevokePermissionsCommand struct {
-	Db *sql.DB
+	Db      *sql.DB
+	Signals signal.Namespace
 }
 
 func (c *RevokePermissionsCommand) Execute(request RPCRequest) (interface{}, error) {
@@ -31,7 +32,7 @@ func (c *RevokePermissionsCommand) Execute(request RPCRequest) (interface{}, err
 		return "", err
 	}
 
-	signal.SendConnectorDAppPermissionRevoked(signal.ConnectorDApp{
+	c.Signals.SendConnectorDAppPermissionRevoked(signal.ConnectorDApp{
 		URL:     request.URL,
 		Name:    request.Name,
 		IconURL: request.IconURL,
@@ -22,6 +22,7 @@ var (
 type SwitchEthereumChainCommand struct {
 	NetworkManager NetworkManagerInterface
 	Db             *sql.DB
+	Signals        signal.Namespace
 }
 
 func hexStringToUint64(s string) (uint64, error) {
@@ -94,7 +95,7 @@ func (c *SwitchEthereumChainCommand) Execute(request RPCRequest) (interface{}, e
 		return "", err
 	}
 
-	signal.SendConnectorDAppChainIdSwitched(signal.ConnectorDAppChainIdSwitchedSignal{
+	c.Signals.SendConnectorDAppChainIdSwitched(signal.ConnectorDAppChainIdSwitchedSignal{
 		URL:     request.URL,
 		ChainId: chainId,
 	})
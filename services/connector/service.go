@@ -7,6 +7,7 @@ import (
 
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/services/connector/commands"
+	"github.com/status-im/status-go/signal"
 )
 
 func NewService(db *sql.DB, rpc commands.RPCClientInterface, nm commands.NetworkManagerInterface) *Service {
@@ -18,9 +19,15 @@ func NewService(db *sql.DB, rpc commands.RPCClientInterface, nm commands.Network
 }
 
 type Service struct {
-	db  *sql.DB
-	rpc commands.RPCClientInterface
-	nm  commands.NetworkManagerInterface
+	db      *sql.DB
+	rpc     commands.RPCClientInterface
+	nm      commands.NetworkManagerInterface
+	signals signal.Namespace
+}
+
+// SetSignalsNamespace sets the namespace of the connector signals, that is the profile the service runs for
+func (s *Service) SetSignalsNamespace(signals signal.Namespace) {
+	s.signals = signals
 }
 
 func (s *Service) Start() error {
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"net/http"
 	"net/url"
 	"strings"
 	"sync"
@@ -49,6 +50,7 @@ func NewAPI(rpcClient *rpc.Client, accountsManager *account.GethManager, pending
 		config:          config,
 		addrPerChain:    make(map[uint64]common.Address),
 		db:              NewEnsDatabase(appDb),
+		httpClient:      &http.Client{Timeout: 10 * time.Second},
 
 		quit:               make(chan struct{}),
 		timeSource:         timeSource,
@@ -80,6 +82,8 @@ type API struct {
 	db                 *Database
 	syncUserDetailFunc *syncUsernameDetail
 
+	httpClient *http.Client
+
 	timeSource func() time.Time
 }
 
@@ -177,23 +181,12 @@ func (api *API) ContentHash(ctx context.Context, chainID uint64, username string
 		return nil, err
 	}
 
-	resolverAddress, err := api.Resolver(ctx, chainID, username)
-	if err != nil {
-		return nil, err
-	}
-
-	resolver, err := api.contractMaker.NewPublicResolver(chainID, resolverAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
-	contentHash, err := resolver.Contenthash(callOpts, NameHash(username))
+	values, err := api.callResolver(ctx, chainID, username, "contenthash", NameHash(username))
 	if err != nil {
 		return nil, nil
 	}
 
-	return contentHash, nil
+	return values[0].([]byte), nil
 }
 
 func (api *API) PublicKeyOf(ctx context.Context, chainID uint64, username string) (string, error) {
@@ -202,22 +195,14 @@ func (api *API) PublicKeyOf(ctx context.Context, chainID uint64, username string
 		return "", err
 	}
 
-	resolverAddress, err := api.Resolver(ctx, chainID, username)
+	values, err := api.callResolver(ctx, chainID, username, "pubkey", NameHash(username))
 	if err != nil {
 		return "", err
 	}
 
-	resolver, err := api.contractMaker.NewPublicResolver(chainID, resolverAddress)
-	if err != nil {
-		return "", err
-	}
-
-	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
-	pubKey, err := resolver.Pubkey(callOpts, NameHash(username))
-	if err != nil {
-		return "", err
-	}
-	return "0x04" + hex.EncodeToString(pubKey.X[:]) + hex.EncodeToString(pubKey.Y[:]), nil
+	x := values[0].([32]byte)
+	y := values[1].([32]byte)
+	return "0x04" + hex.EncodeToString(x[:]) + hex.EncodeToString(y[:]), nil
 }
 
 func (api *API) AddressOf(ctx context.Context, chainID uint64, username string) (*common.Address, error) {
@@ -226,22 +211,12 @@ func (api *API) AddressOf(ctx context.Context, chainID uint64, username string)
 		return nil, err
 	}
 
-	resolverAddress, err := api.Resolver(ctx, chainID, username)
-	if err != nil {
-		return nil, err
-	}
-
-	resolver, err := api.contractMaker.NewPublicResolver(chainID, resolverAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
-	addr, err := resolver.Addr(callOpts, NameHash(username))
+	values, err := api.callResolver(ctx, chainID, username, "addr", NameHash(username))
 	if err != nil {
 		return nil, err
 	}
 
+	addr := values[0].(common.Address)
 	return &addr, nil
 }
 
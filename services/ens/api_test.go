@@ -3,17 +3,21 @@ package ens
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/contracts/resolver"
 	"github.com/status-im/status-go/params"
 	statusRPC "github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
 	"github.com/status-im/status-go/t/helpers"
 	"github.com/status-im/status-go/t/utils"
 	"github.com/status-im/status-go/transactions/fake"
@@ -158,3 +162,129 @@ func TestResourceURL(t *testing.T) {
 	require.Equal(t, "noahzinsmeister.com", uri.Host)
 	require.Equal(t, "", uri.Path)
 }
+
+func TestTextRecords(t *testing.T) {
+	t.Skip("skip test using infura")
+	api, cancel := setupTestAPI(t)
+	defer cancel()
+
+	records, err := api.TextRecords(context.Background(), 1, "vitalik.eth", []string{TextKeyURL})
+	require.NoError(t, err)
+	require.Equal(t, "https://vitalik.ca", records[TextKeyURL])
+}
+
+func TestAvatarURL(t *testing.T) {
+	t.Skip("skip test using infura")
+	api, cancel := setupTestAPI(t)
+	defer cancel()
+
+	avatar, err := api.AvatarURL(context.Background(), 1, "vitalik.eth")
+	require.NoError(t, err)
+	require.NotEmpty(t, avatar)
+}
+
+func TestParseNFTAvatar(t *testing.T) {
+	nft, ok := parseNFTAvatar("eip155:1/erc721:0xb47e3cd837dDF8e4c57F05d70Ab865de6e193BBB/1000")
+	require.True(t, ok)
+	require.Equal(t, uint64(1), nft.chainID)
+	require.Equal(t, "erc721", nft.standard)
+	require.Equal(t, common.HexToAddress("0xb47e3cd837dDF8e4c57F05d70Ab865de6e193BBB"), nft.contractAddress)
+	require.Equal(t, "1000", nft.tokenID.String())
+
+	nft, ok = parseNFTAvatar("eip155:10/ERC1155:0x495f947276749ce646f68ac8c248420045cb7b5e/8112316025873927737505937898915153732580103913704334048512380490797008551937")
+	require.True(t, ok)
+	require.Equal(t, uint64(10), nft.chainID)
+	require.Equal(t, "erc1155", nft.standard)
+
+	_, ok = parseNFTAvatar("https://example.com/avatar.png")
+	require.False(t, ok)
+	_, ok = parseNFTAvatar("eip155:1/erc20:0xb47e3cd837dDF8e4c57F05d70Ab865de6e193BBB/1")
+	require.False(t, ok)
+}
+
+func TestResolveAvatarURI(t *testing.T) {
+	uri, err := resolveAvatarURI("https://example.com/avatar.png")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/avatar.png", uri)
+
+	uri, err = resolveAvatarURI("ipfs://ipfs/QmAvatar")
+	require.NoError(t, err)
+	require.Equal(t, tokenuri.DefaultIpfsGatewayURL+"QmAvatar", uri)
+
+	uri, err = resolveAvatarURI("ar://txid")
+	require.NoError(t, err)
+	require.Equal(t, tokenuri.ArweaveGatewayURL+"txid", uri)
+
+	_, err = resolveAvatarURI("ftp://example.com/avatar.png")
+	require.ErrorIs(t, err, ErrUnsupportedAvatar)
+}
+
+func TestTextRecordsData(t *testing.T) {
+	_, err := textRecordsData("test.eth", map[string]string{})
+	require.ErrorIs(t, err, ErrNoTextRecords)
+
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	require.NoError(t, err)
+
+	data, err := textRecordsData("test.eth", map[string]string{TextKeyURL: "https://example.com"})
+	require.NoError(t, err)
+	require.Equal(t, resolverABI.Methods["setText"].ID, data[:4])
+
+	data, err = textRecordsData("test.eth", map[string]string{TextKeyURL: "https://example.com", TextKeyTwitter: "example"})
+	require.NoError(t, err)
+	require.Equal(t, resolverABI.Methods["multicall"].ID, data[:4])
+
+	args, err := resolverABI.Methods["multicall"].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	calls := args[0].([][]byte)
+	require.Len(t, calls, 2)
+
+	// Keys are sorted, com.twitter comes first
+	setText, err := resolverABI.Methods["setText"].Inputs.Unpack(calls[0][4:])
+	require.NoError(t, err)
+	require.Equal(t, TextKeyTwitter, setText[1])
+	require.Equal(t, "example", setText[2])
+}
+
+func TestSplitName(t *testing.T) {
+	label, parent := splitName("sub.name.eth")
+	require.Equal(t, "sub", label)
+	require.Equal(t, "name.eth", parent)
+	require.Equal(t, "sub.name.eth", subname(label, parent))
+}
+
+func TestExpiry(t *testing.T) {
+	t.Skip("skip test using infura")
+	api, cancel := setupTestAPI(t)
+	defer cancel()
+
+	expiresAt, err := api.Expiry(context.Background(), 1, "vitalik.eth")
+	require.NoError(t, err)
+	require.False(t, expiresAt.IsZero())
+
+	price, err := api.RenewPrice(context.Background(), 1, "vitalik.eth", 365*24*60*60)
+	require.NoError(t, err)
+	require.NotEmpty(t, price)
+}
+
+func TestEthLabel(t *testing.T) {
+	label, ok := ethLabel("name.eth")
+	require.True(t, ok)
+	require.Equal(t, "name", label)
+
+	_, ok = ethLabel("sub.name.eth")
+	require.False(t, ok)
+	_, ok = ethLabel("name.stateofus.eth")
+	require.False(t, ok)
+	_, ok = ethLabel(".eth")
+	require.False(t, ok)
+}
+
+func TestExpiryOfSubnames(t *testing.T) {
+	api := &API{}
+	for _, username := range []string{"name.stateofus.eth", "sub.name.eth"} {
+		expiresAt, err := api.Expiry(context.Background(), 1, username)
+		require.NoError(t, err)
+		require.True(t, expiresAt.IsZero(), username)
+	}
+}
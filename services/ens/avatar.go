@@ -0,0 +1,207 @@
+package ens
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
+	"github.com/status-im/status-go/contracts/ierc1155"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
+)
+
+const maxAvatarMetadataSize = 1024 * 1024
+
+// ENSIP-12 NFT avatar, e.g. eip155:1/erc721:0xb47e3cd837dDF8e4c57F05d70Ab865de6e193BBB/1000
+var nftAvatarPattern = regexp.MustCompile(`(?i)^eip155:(\d+)/(erc721|erc1155):(0x[0-9a-f]{40})/(\d+)$`)
+
+var (
+	ErrUnsupportedAvatar = errors.New("unsupported avatar record")
+	ErrAvatarNotOwned    = errors.New("avatar NFT is not owned by the name")
+)
+
+type nftAvatar struct {
+	chainID         uint64
+	standard        string
+	contractAddress common.Address
+	tokenID         *big.Int
+}
+
+func parseNFTAvatar(record string) (*nftAvatar, bool) {
+	matches := nftAvatarPattern.FindStringSubmatch(strings.TrimSpace(record))
+	if matches == nil {
+		return nil, false
+	}
+
+	chainID, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	tokenID, ok := new(big.Int).SetString(matches[4], 10)
+	if !ok {
+		return nil, false
+	}
+
+	return &nftAvatar{
+		chainID:         chainID,
+		standard:        strings.ToLower(matches[2]),
+		contractAddress: common.HexToAddress(matches[3]),
+		tokenID:         tokenID,
+	}, true
+}
+
+// resolveAvatarURI maps an avatar URI to one that can be loaded directly, ipfs and arweave ones through a gateway
+func resolveAvatarURI(uri string) (string, error) {
+	if tokenuri.IsDataURI(uri) {
+		return uri, nil
+	}
+
+	resolved := tokenuri.Resolve(uri)
+	if !tokenuri.IsHTTP(resolved) {
+		return "", ErrUnsupportedAvatar
+	}
+	return resolved, nil
+}
+
+// AvatarURL resolves the avatar text record of a name to the URL of the image, as described by ENSIP-12
+func (api *API) AvatarURL(ctx context.Context, chainID uint64, username string) (string, error) {
+	record, err := api.TextRecord(ctx, chainID, username, TextKeyAvatar)
+	if err != nil {
+		return "", err
+	}
+	if record == "" {
+		return "", nil
+	}
+
+	return api.resolveAvatar(ctx, chainID, username, record)
+}
+
+func (api *API) resolveAvatar(ctx context.Context, chainID uint64, username string, record string) (string, error) {
+	nft, ok := parseNFTAvatar(record)
+	if !ok {
+		return resolveAvatarURI(record)
+	}
+
+	owner, err := api.AddressOf(ctx, chainID, username)
+	if err != nil {
+		return "", err
+	}
+
+	tokenURI, err := api.nftAvatarTokenURI(ctx, nft, *owner)
+	if err != nil {
+		return "", err
+	}
+
+	return api.nftAvatarImage(ctx, tokenURI)
+}
+
+// nftAvatarTokenURI checks that the NFT is owned by the address of the name and returns its metadata URI
+func (api *API) nftAvatarTokenURI(ctx context.Context, nft *nftAvatar, owner common.Address) (string, error) {
+	backend, err := api.contractMaker.RPCClient.EthClient(nft.chainID)
+	if err != nil {
+		return "", err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	if nft.standard == "erc721" {
+		caller, err := collectibles.NewCollectiblesCaller(nft.contractAddress, backend)
+		if err != nil {
+			return "", err
+		}
+
+		tokenOwner, err := caller.OwnerOf(callOpts, nft.tokenID)
+		if err != nil {
+			return "", err
+		}
+		if tokenOwner != owner {
+			return "", ErrAvatarNotOwned
+		}
+
+		return tokenuri.ERC721TokenURI(ctx, backend, nft.contractAddress, nft.tokenID)
+	}
+
+	caller, err := ierc1155.NewIerc1155Caller(nft.contractAddress, backend)
+	if err != nil {
+		return "", err
+	}
+
+	balance, err := caller.BalanceOf(callOpts, owner, nft.tokenID)
+	if err != nil {
+		return "", err
+	}
+	if balance.Sign() <= 0 {
+		return "", ErrAvatarNotOwned
+	}
+
+	return tokenuri.ERC1155URI(ctx, backend, nft.contractAddress, nft.tokenID)
+}
+
+type avatarMetadata struct {
+	Image     string `json:"image"`
+	ImageURL  string `json:"image_url"`
+	ImageData string `json:"image_data"`
+}
+
+// nftAvatarImage reads the image of the NFT metadata at tokenURI
+func (api *API) nftAvatarImage(ctx context.Context, tokenURI string) (string, error) {
+	content, err := api.fetchAvatarMetadata(ctx, tokenURI)
+	if err != nil {
+		return "", err
+	}
+
+	metadata := avatarMetadata{}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return "", err
+	}
+
+	switch {
+	case metadata.Image != "":
+		return resolveAvatarURI(metadata.Image)
+	case metadata.ImageURL != "":
+		return resolveAvatarURI(metadata.ImageURL)
+	case metadata.ImageData != "":
+		// Raw SVG image
+		return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(metadata.ImageData)), nil
+	}
+	return "", errors.New("NFT metadata has no image")
+}
+
+func (api *API) fetchAvatarMetadata(ctx context.Context, tokenURI string) ([]byte, error) {
+	if tokenuri.IsDataURI(tokenURI) {
+		return tokenuri.DecodeDataURI(tokenURI)
+	}
+
+	resolved, err := resolveAvatarURI(tokenURI)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resolved, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code fetching NFT metadata: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxAvatarMetadataSize))
+}
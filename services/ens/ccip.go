@@ -0,0 +1,344 @@
+package ens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/contracts/resolver"
+)
+
+const (
+	// ENSIP-10 IExtendedResolver interface ID, resolve(bytes,bytes)
+	extendedResolverInterfaceID = "0x9061b923"
+	// EIP-3668 recommends following at most 4 offchain lookups per call
+	maxOffchainLookups     = 4
+	maxGatewayResponseSize = 1024 * 1024
+)
+
+var (
+	ErrNoResolver              = errors.New("no resolver set for the name")
+	ErrWildcardNotSupported    = errors.New("resolver of the parent name doesn't support wildcard resolution")
+	ErrOffchainLookupSender    = errors.New("offchain lookup sender doesn't match the called contract")
+	ErrTooManyOffchainLookups  = errors.New("too many offchain lookups")
+	ErrOffchainGatewaysFailure = errors.New("all offchain lookup gateways failed")
+)
+
+var offchainLookupSelector = crypto.Keccak256([]byte("OffchainLookup(address,string[],bytes,bytes4,bytes)"))[:4]
+
+const extendedResolverABI = `[{"inputs":[{"internalType":"bytes","name":"name","type":"bytes"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"resolve","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"view","type":"function"}]`
+
+type contractCaller interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+type offchainLookup struct {
+	sender           common.Address
+	urls             []string
+	callData         []byte
+	callbackFunction [4]byte
+	extraData        []byte
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+var (
+	offchainLookupArguments = abi.Arguments{
+		{Type: mustNewType("address")},
+		{Type: mustNewType("string[]")},
+		{Type: mustNewType("bytes")},
+		{Type: mustNewType("bytes4")},
+		{Type: mustNewType("bytes")},
+	}
+	callbackArguments = abi.Arguments{
+		{Type: mustNewType("bytes")},
+		{Type: mustNewType("bytes")},
+	}
+)
+
+// parseOffchainLookup extracts the EIP-3668 OffchainLookup revert from a call error
+func parseOffchainLookup(err error) (*offchainLookup, bool) {
+	var dataErr gethrpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(data) < 4 || !bytes.Equal(data[:4], offchainLookupSelector) {
+		return nil, false
+	}
+
+	values, unpackErr := offchainLookupArguments.Unpack(data[4:])
+	if unpackErr != nil {
+		return nil, false
+	}
+
+	return &offchainLookup{
+		sender:           values[0].(common.Address),
+		urls:             values[1].([]string),
+		callData:         values[2].([]byte),
+		callbackFunction: values[3].([4]byte),
+		extraData:        values[4].([]byte),
+	}, true
+}
+
+// ccipRead calls a contract, following the offchain lookups it requests as described by EIP-3668.
+// Gateway responses are passed back to the contract callback, which verifies them, e.g. checks
+// they are signed by a trusted signer, so they don't need to be trusted here.
+func ccipRead(ctx context.Context, httpClient *http.Client, caller contractCaller, to common.Address, data []byte) ([]byte, error) {
+	for i := 0; i <= maxOffchainLookups; i++ {
+		out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+		if err == nil {
+			return out, nil
+		}
+
+		lookup, ok := parseOffchainLookup(err)
+		if !ok {
+			return nil, err
+		}
+		if lookup.sender != to {
+			return nil, ErrOffchainLookupSender
+		}
+
+		response, err := fetchGateways(ctx, httpClient, lookup)
+		if err != nil {
+			return nil, err
+		}
+
+		callbackData, err := callbackArguments.Pack(response, lookup.extraData)
+		if err != nil {
+			return nil, err
+		}
+		data = append(lookup.callbackFunction[:], callbackData...)
+	}
+
+	return nil, ErrTooManyOffchainLookups
+}
+
+type gatewayResponse struct {
+	Data string `json:"data"`
+}
+
+// gatewayRequest is a GET when the gateway URL takes the call data, a POST of it otherwise
+func gatewayRequest(ctx context.Context, url string, sender string, callData string) (*http.Request, error) {
+	url = strings.ReplaceAll(url, "{sender}", sender)
+	if strings.Contains(url, "{data}") {
+		return http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(url, "{data}", callData), nil)
+	}
+
+	body, err := json.Marshal(map[string]string{"data": callData, "sender": sender})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// fetchGateways queries the gateways in order until one answers. A client error is final,
+// other failures move on to the next gateway.
+func fetchGateways(ctx context.Context, httpClient *http.Client, lookup *offchainLookup) ([]byte, error) {
+	sender := strings.ToLower(lookup.sender.Hex())
+	callData := hexutil.Encode(lookup.callData)
+
+	for _, url := range lookup.urls {
+		req, err := gatewayRequest(ctx, url, sender, callData)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			log.Warn("offchain lookup gateway failed", "url", url, "err", err)
+			continue
+		}
+
+		content, err := io.ReadAll(io.LimitReader(resp.Body, maxGatewayResponseSize))
+		resp.Body.Close()
+		if err != nil {
+			log.Warn("offchain lookup gateway failed", "url", url, "err", err)
+			continue
+		}
+
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return nil, fmt.Errorf("offchain lookup gateway error %d: %s", resp.StatusCode, string(content))
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Warn("offchain lookup gateway failed", "url", url, "status", resp.StatusCode)
+			continue
+		}
+
+		response := gatewayResponse{}
+		if err := json.Unmarshal(content, &response); err != nil {
+			return nil, err
+		}
+		return hexutil.Decode(response.Data)
+	}
+
+	return nil, ErrOffchainGatewaysFailure
+}
+
+// dnsEncode encodes a name in DNS wire format, as expected by ENSIP-10 resolve
+func dnsEncode(name string) ([]byte, error) {
+	encoded := make([]byte, 0, len(name)+2)
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 255 {
+			return nil, fmt.Errorf("invalid label length in %s", name)
+		}
+		encoded = append(encoded, byte(len(label)))
+		encoded = append(encoded, label...)
+	}
+	return append(encoded, 0), nil
+}
+
+// findResolver returns the resolver of a name or, as described by ENSIP-10, the one of its closest
+// parent with a resolver. exact tells whether the resolver is set for the name itself.
+func (api *API) findResolver(ctx context.Context, chainID uint64, username string) (common.Address, bool, error) {
+	registry, err := api.contractMaker.NewRegistry(chainID)
+	if err != nil {
+		return common.Address{}, false, err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+	for name := username; name != ""; {
+		resolverAddress, err := registry.Resolver(callOpts, NameHash(name))
+		if err != nil {
+			return common.Address{}, false, err
+		}
+		if resolverAddress != (common.Address{}) {
+			return resolverAddress, name == username, nil
+		}
+
+		_, name, _ = strings.Cut(name, ".")
+	}
+
+	return common.Address{}, false, nil
+}
+
+func supportsInterface(ctx context.Context, caller contractCaller, contractAddress common.Address, interfaceID string) bool {
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	if err != nil {
+		return false
+	}
+
+	var id [4]byte
+	copy(id[:], hexutil.MustDecode(interfaceID))
+	data, err := resolverABI.Pack("supportsInterface", id)
+	if err != nil {
+		return false
+	}
+
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: data}, nil)
+	if err != nil {
+		return false
+	}
+
+	values, err := resolverABI.Unpack("supportsInterface", out)
+	if err != nil || len(values) == 0 {
+		return false
+	}
+	supported, _ := values[0].(bool)
+	return supported
+}
+
+// resolveRecord runs a resolver call, e.g. addr(node), for a name. Wildcard resolution (ENSIP-10)
+// and offchain lookups (EIP-3668) are followed.
+func (api *API) resolveRecord(ctx context.Context, chainID uint64, username string, data []byte) ([]byte, error) {
+	resolverAddress, exact, err := api.findResolver(ctx, chainID, username)
+	if err != nil {
+		return nil, err
+	}
+	if resolverAddress == (common.Address{}) {
+		return nil, ErrNoResolver
+	}
+
+	backend, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveWith(ctx, api.httpClient, backend, resolverAddress, exact, username, data)
+}
+
+func resolveWith(ctx context.Context, httpClient *http.Client, caller contractCaller, resolverAddress common.Address, exact bool, username string, data []byte) ([]byte, error) {
+	if !supportsInterface(ctx, caller, resolverAddress, extendedResolverInterfaceID) {
+		if !exact {
+			return nil, ErrWildcardNotSupported
+		}
+		return ccipRead(ctx, httpClient, caller, resolverAddress, data)
+	}
+
+	name, err := dnsEncode(username)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedABI, err := abi.JSON(strings.NewReader(extendedResolverABI))
+	if err != nil {
+		return nil, err
+	}
+
+	resolveData, err := extendedABI.Pack("resolve", name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := ccipRead(ctx, httpClient, caller, resolverAddress, resolveData)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := extendedABI.Unpack("resolve", out)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].([]byte), nil
+}
+
+// callResolver packs a PublicResolver method call, resolves it for the name and unpacks the result
+func (api *API) callResolver(ctx context.Context, chainID uint64, username string, method string, args ...interface{}) ([]interface{}, error) {
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := resolverABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := api.resolveRecord(ctx, chainID, username, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolverABI.Unpack(method, out)
+}
@@ -0,0 +1,203 @@
+package ens
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/contracts/resolver"
+)
+
+type revertError struct {
+	data string
+}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorData() interface{} { return e.data }
+
+var (
+	resolveWithProofSelector = crypto.Keccak256([]byte("resolveWithProof(bytes,bytes)"))[:4]
+	gatewayResponseArguments = abi.Arguments{
+		{Type: mustNewType("bytes")},
+		{Type: mustNewType("bytes")},
+	}
+)
+
+// offchainResolver stands in for an ENSIP-10 resolver answering through a signed CCIP-Read gateway
+type offchainResolver struct {
+	t        *testing.T
+	address  common.Address
+	urls     []string
+	signer   common.Address
+	extended bool
+}
+
+func (r *offchainResolver) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	require.NoError(r.t, err)
+	extendedABI, err := abi.JSON(strings.NewReader(extendedResolverABI))
+	require.NoError(r.t, err)
+
+	switch {
+	case bytes.Equal(call.Data[:4], resolverABI.Methods["supportsInterface"].ID):
+		return resolverABI.Methods["supportsInterface"].Outputs.Pack(r.extended)
+
+	case bytes.Equal(call.Data[:4], extendedABI.Methods["resolve"].ID):
+		lookup, err := offchainLookupArguments.Pack(r.address, r.urls, call.Data, [4]byte(resolveWithProofSelector), call.Data)
+		require.NoError(r.t, err)
+		return nil, &revertError{data: hexutil.Encode(append(offchainLookupSelector, lookup...))}
+
+	case bytes.Equal(call.Data[:4], resolveWithProofSelector):
+		values, err := callbackArguments.Unpack(call.Data[4:])
+		require.NoError(r.t, err)
+		response := values[0].([]byte)
+		extraData := values[1].([]byte)
+
+		values, err = gatewayResponseArguments.Unpack(response)
+		require.NoError(r.t, err)
+		result := values[0].([]byte)
+		signature := values[1].([]byte)
+
+		pubKey, err := crypto.SigToPub(crypto.Keccak256(result, extraData), signature)
+		if err != nil || crypto.PubkeyToAddress(*pubKey) != r.signer {
+			return nil, errors.New("execution reverted: invalid signature")
+		}
+		return extendedABI.Methods["resolve"].Outputs.Pack(result)
+	}
+
+	return nil, errors.New("execution reverted")
+}
+
+// newGateway answers addr(node) with the given address, signed by key
+func newGateway(t *testing.T, key *ecdsa.PrivateKey, addr common.Address) *httptest.Server {
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var callData string
+		if r.Method == http.MethodPost {
+			body := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			callData = body["data"]
+		} else {
+			parts := strings.Split(strings.TrimSuffix(r.URL.Path, ".json"), "/")
+			callData = parts[len(parts)-1]
+		}
+
+		extraData, err := hexutil.Decode(callData)
+		require.NoError(t, err)
+
+		result, err := resolverABI.Methods["addr"].Outputs.Pack(addr)
+		require.NoError(t, err)
+		signature, err := crypto.Sign(crypto.Keccak256(result, extraData), key)
+		require.NoError(t, err)
+		response, err := gatewayResponseArguments.Pack(result, signature)
+		require.NoError(t, err)
+
+		_ = json.NewEncoder(w).Encode(gatewayResponse{Data: hexutil.Encode(response)})
+	}))
+}
+
+func resolveTestAddr(t *testing.T, caller contractCaller, resolverAddress common.Address, exact bool) (common.Address, error) {
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	require.NoError(t, err)
+
+	username := "sub.offchain.eth"
+	data, err := resolverABI.Pack("addr", NameHash(username))
+	require.NoError(t, err)
+
+	out, err := resolveWith(context.Background(), http.DefaultClient, caller, resolverAddress, exact, username, data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	values, err := resolverABI.Unpack("addr", out)
+	require.NoError(t, err)
+	return values[0].(common.Address), nil
+}
+
+func TestCCIPReadWildcard(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	expected := common.HexToAddress("0x1234")
+	resolverAddress := common.HexToAddress("0x5678")
+
+	gateway := newGateway(t, key, expected)
+	defer gateway.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	offchain := &offchainResolver{
+		t:        t,
+		address:  resolverAddress,
+		urls:     []string{failing.URL + "/{sender}/{data}.json", gateway.URL + "/{sender}/{data}.json"},
+		signer:   crypto.PubkeyToAddress(key.PublicKey),
+		extended: true,
+	}
+
+	// Resolved through the second gateway, the first one failing
+	addr, err := resolveTestAddr(t, offchain, resolverAddress, false)
+	require.NoError(t, err)
+	require.Equal(t, expected, addr)
+
+	// POST request when the URL doesn't take the data
+	offchain.urls = []string{gateway.URL}
+	addr, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.NoError(t, err)
+	require.Equal(t, expected, addr)
+
+	// The resolver rejects responses not signed by its signer
+	offchain.signer = common.HexToAddress("0x9999")
+	_, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.Error(t, err)
+	offchain.signer = crypto.PubkeyToAddress(key.PublicKey)
+
+	// Lookups must come from the called contract
+	offchain.address = common.HexToAddress("0x1111")
+	_, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.ErrorIs(t, err, ErrOffchainLookupSender)
+	offchain.address = resolverAddress
+
+	// Client errors aren't retried on other gateways
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	offchain.urls = []string{notFound.URL, gateway.URL}
+	_, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.Error(t, err)
+
+	offchain.urls = []string{failing.URL}
+	_, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.ErrorIs(t, err, ErrOffchainGatewaysFailure)
+
+	// Wildcard resolution needs an extended resolver
+	offchain.extended = false
+	_, err = resolveTestAddr(t, offchain, resolverAddress, false)
+	require.ErrorIs(t, err, ErrWildcardNotSupported)
+}
+
+func TestDNSEncode(t *testing.T) {
+	encoded, err := dnsEncode("sub.name.eth")
+	require.NoError(t, err)
+	require.Equal(t, append([]byte("\x03sub\x04name\x03eth"), 0), encoded)
+
+	_, err = dnsEncode("sub..eth")
+	require.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package ens
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/contracts/ethregistrar"
+	"github.com/status-im/status-go/services/utils"
+	"github.com/status-im/status-go/transactions"
+)
+
+// The rent price is set in USD and converted to ETH by an oracle when the transaction is mined,
+// a buffer avoids failing on small price changes, the controller refunds the excess.
+const renewPriceBufferPercent = 5
+
+var ErrRenewNotSupported = errors.New("only .eth second level names can be renewed")
+
+// ExpiringName is a name of the user together with its expiry
+type ExpiringName struct {
+	ChainID   uint64    `json:"chainId"`
+	Username  string    `json:"username"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ethLabel returns the label of a .eth second level name
+func ethLabel(username string) (string, bool) {
+	label, found := strings.CutSuffix(username, ".eth")
+	if !found || label == "" || strings.Contains(label, ".") {
+		return "", false
+	}
+	return label, true
+}
+
+// Expiry returns when a .eth second level name registered with the base registrar expires,
+// the zero time for other names. Subnames, including .stateofus.eth ones, don't expire:
+// the release time of a .stateofus.eth name only tells when its deposit can be withdrawn.
+func (api *API) Expiry(ctx context.Context, chainID uint64, username string) (time.Time, error) {
+	label, ok := ethLabel(username)
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	baseRegistrar, err := api.contractMaker.NewBaseRegistrar(chainID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+	labelHash := UsernameToLabel(label)
+	expTime, err := baseRegistrar.NameExpires(callOpts, new(big.Int).SetBytes(labelHash[:]))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if expTime.Sign() == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(expTime.Int64(), 0), nil
+}
+
+// ExpiringNames returns the names of the user expiring before the given time
+func (api *API) ExpiringNames(ctx context.Context, before time.Time) ([]*ExpiringName, error) {
+	details, err := api.GetEnsUsernames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiring []*ExpiringName
+	for _, detail := range details {
+		expiresAt, err := api.Expiry(ctx, detail.ChainID, detail.Username)
+		if err != nil {
+			log.Warn("failed to read ENS name expiry", "username", detail.Username, "chainID", detail.ChainID, "err", err)
+			continue
+		}
+		if expiresAt.IsZero() || expiresAt.After(before) {
+			continue
+		}
+
+		expiring = append(expiring, &ExpiringName{
+			ChainID:   detail.ChainID,
+			Username:  detail.Username,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	return expiring, nil
+}
+
+// RenewPrice returns the hex encoded price in wei of renewing a name for duration seconds
+func (api *API) RenewPrice(ctx context.Context, chainID uint64, username string, duration uint64) (string, error) {
+	price, err := api.renewPrice(ctx, chainID, username, duration)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", price), nil
+}
+
+func (api *API) renewPrice(ctx context.Context, chainID uint64, username string, duration uint64) (*big.Int, error) {
+	label, ok := ethLabel(username)
+	if !ok {
+		return nil, ErrRenewNotSupported
+	}
+
+	controller, err := api.contractMaker.NewETHRegistrarController(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+	price, err := controller.RentPrice(callOpts, label, new(big.Int).SetUint64(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	total := new(big.Int).Add(price.Base, price.Premium)
+	buffer := new(big.Int).Div(new(big.Int).Mul(total, big.NewInt(renewPriceBufferPercent)), big.NewInt(100))
+	return total.Add(total, buffer), nil
+}
+
+// Renew extends the registration of a .eth name by duration seconds
+func (api *API) Renew(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, username string, duration uint64) (string, error) {
+	label, ok := ethLabel(username)
+	if !ok {
+		return "", ErrRenewNotSupported
+	}
+
+	price, err := api.renewPrice(ctx, chainID, username, duration)
+	if err != nil {
+		return "", err
+	}
+
+	controller, err := api.contractMaker.NewETHRegistrarController(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	controllerAddress, err := ethregistrar.ControllerAddress(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	txOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
+	txOpts.Value = price
+	tx, err := controller.Renew(txOpts, label, new(big.Int).SetUint64(duration))
+	if err != nil {
+		return "", err
+	}
+
+	return api.trackTransaction(chainID, tx, txArgs, controllerAddress, transactions.RenewENS)
+}
+
+func (api *API) RenewPrepareTxCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, duration uint64) (ethereum.CallMsg, error) {
+	label, ok := ethLabel(username)
+	if !ok {
+		return ethereum.CallMsg{}, ErrRenewNotSupported
+	}
+
+	price, err := api.renewPrice(ctx, chainID, username, duration)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	controllerABI, err := abi.JSON(strings.NewReader(ethregistrar.IETHRegistrarControllerABI))
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	data, err := controllerABI.Pack("renew", label, new(big.Int).SetUint64(duration))
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	controllerAddress, err := ethregistrar.ControllerAddress(chainID)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	return ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    &controllerAddress,
+		Value: price,
+		Data:  data,
+	}, nil
+}
+
+func (api *API) RenewPrepareTx(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, duration uint64) (interface{}, error) {
+	callMsg, err := api.RenewPrepareTxCallMsg(ctx, chainID, txArgs, username, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCallArg(callMsg), nil
+}
+
+func (api *API) RenewEstimate(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, duration uint64) (uint64, error) {
+	callMsg, err := api.RenewPrepareTxCallMsg(ctx, chainID, txArgs, username, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return api.estimate(ctx, chainID, callMsg)
+}
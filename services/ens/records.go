@@ -0,0 +1,249 @@
+package ens
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/contracts/resolver"
+	"github.com/status-im/status-go/services/utils"
+	wcommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/transactions"
+)
+
+// ENSIP-5 text record keys used for profiles
+const (
+	TextKeyAvatar      = "avatar"
+	TextKeyURL         = "url"
+	TextKeyTwitter     = "com.twitter"
+	TextKeyDescription = "description"
+)
+
+var ProfileTextKeys = []string{TextKeyAvatar, TextKeyURL, TextKeyTwitter, TextKeyDescription}
+
+var ErrNoTextRecords = errors.New("no text records to set")
+
+// Profile is the public profile of an ENS name, built from its text records
+type Profile struct {
+	Name string `json:"name"`
+	// Avatar is the URL of the avatar image, AvatarRecord the raw text record it was resolved from
+	Avatar       string `json:"avatar"`
+	AvatarRecord string `json:"avatarRecord"`
+	URL          string `json:"url"`
+	Twitter      string `json:"twitter"`
+	Description  string `json:"description"`
+}
+
+func (api *API) TextRecord(ctx context.Context, chainID uint64, username string, key string) (string, error) {
+	records, err := api.TextRecords(ctx, chainID, username, []string{key})
+	if err != nil {
+		return "", err
+	}
+	return records[key], nil
+}
+
+// TextRecords reads the text records of a name, the profile ones when no key is given.
+// Keys without a value are returned as empty strings.
+func (api *API) TextRecords(ctx context.Context, chainID uint64, username string, keys []string) (map[string]string, error) {
+	err := ValidateENSUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		keys = ProfileTextKeys
+	}
+
+	records := make(map[string]string, len(keys))
+	node := NameHash(username)
+	for _, key := range keys {
+		values, err := api.callResolver(ctx, chainID, username, "text", node, key)
+		if err == ErrNoResolver {
+			records[key] = ""
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records[key] = values[0].(string)
+	}
+
+	return records, nil
+}
+
+// Profile reads the profile text records of a name and resolves its avatar
+func (api *API) Profile(ctx context.Context, chainID uint64, username string) (*Profile, error) {
+	records, err := api.TextRecords(ctx, chainID, username, ProfileTextKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &Profile{
+		Name:         username,
+		AvatarRecord: records[TextKeyAvatar],
+		URL:          records[TextKeyURL],
+		Twitter:      records[TextKeyTwitter],
+		Description:  records[TextKeyDescription],
+	}
+
+	if profile.AvatarRecord != "" {
+		profile.Avatar, err = api.resolveAvatar(ctx, chainID, username, profile.AvatarRecord)
+		if err != nil {
+			log.Warn("failed to resolve ENS avatar", "username", username, "avatar", profile.AvatarRecord, "err", err)
+		}
+	}
+
+	return profile, nil
+}
+
+// textRecordsData packs the setText calls of the records, wrapped in a multicall when there are several
+func textRecordsData(username string, records map[string]string) ([]byte, error) {
+	if len(records) == 0 {
+		return nil, ErrNoTextRecords
+	}
+
+	resolverABI, err := abi.JSON(strings.NewReader(resolver.PublicResolverABI))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	node := NameHash(username)
+	calls := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		data, err := resolverABI.Pack("setText", node, key, records[key])
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, data)
+	}
+
+	if len(calls) == 1 {
+		return calls[0], nil
+	}
+	return resolverABI.Pack("multicall", calls)
+}
+
+func (api *API) SetTextRecords(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, username string, records map[string]string) (string, error) {
+	err := ValidateENSUsername(username)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := textRecordsData(username, records)
+	if err != nil {
+		return "", err
+	}
+
+	resolverAddress, err := api.Resolver(ctx, chainID, username)
+	if err != nil {
+		return "", err
+	}
+	if *resolverAddress == (common.Address{}) {
+		return "", ErrNoResolver
+	}
+
+	backend, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	txOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
+	contract := bind.NewBoundContract(*resolverAddress, abi.ABI{}, backend, backend, backend)
+	tx, err := contract.RawTransact(txOpts, data)
+	if err != nil {
+		return "", err
+	}
+
+	return api.trackTransaction(chainID, tx, txArgs, *resolverAddress, transactions.SetENSTextRecords)
+}
+
+func (api *API) SetTextRecordsPrepareTxCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, records map[string]string) (ethereum.CallMsg, error) {
+	err := ValidateENSUsername(username)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	data, err := textRecordsData(username, records)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	resolverAddress, err := api.Resolver(ctx, chainID, username)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if *resolverAddress == (common.Address{}) {
+		return ethereum.CallMsg{}, ErrNoResolver
+	}
+
+	return ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    resolverAddress,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, nil
+}
+
+func (api *API) SetTextRecordsPrepareTx(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, records map[string]string) (interface{}, error) {
+	callMsg, err := api.SetTextRecordsPrepareTxCallMsg(ctx, chainID, txArgs, username, records)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCallArg(callMsg), nil
+}
+
+func (api *API) SetTextRecordsEstimate(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, records map[string]string) (uint64, error) {
+	callMsg, err := api.SetTextRecordsPrepareTxCallMsg(ctx, chainID, txArgs, username, records)
+	if err != nil {
+		return 0, err
+	}
+
+	return api.estimate(ctx, chainID, callMsg)
+}
+
+func (api *API) estimate(ctx context.Context, chainID uint64, callMsg ethereum.CallMsg) (uint64, error) {
+	ethClient, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	estimate, err := ethClient.EstimateGas(ctx, callMsg)
+	if err != nil {
+		return 0, err
+	}
+	return estimate + 1000, nil
+}
+
+func (api *API) trackTransaction(chainID uint64, tx *types.Transaction, txArgs transactions.SendTxArgs, to common.Address, trxType transactions.PendingTrxType) (string, error) {
+	err := api.pendingTracker.TrackPendingTransaction(
+		wcommon.ChainID(chainID),
+		tx.Hash(),
+		common.Address(txArgs.From),
+		to,
+		trxType,
+		transactions.AutoDelete,
+		"",
+	)
+	if err != nil {
+		log.Error("TrackPendingTransaction error", "error", err)
+		return "", err
+	}
+
+	return tx.Hash().String(), nil
+}
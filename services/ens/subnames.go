@@ -0,0 +1,197 @@
+package ens
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+
+	"github.com/status-im/status-go/contracts/resolver"
+	"github.com/status-im/status-go/services/utils"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	ErrNotNameOwner   = errors.New("account doesn't own the name")
+	ErrInvalidSubname = errors.New("subname label must be a single non empty label")
+)
+
+func subname(label string, parent string) string {
+	return label + "." + parent
+}
+
+// splitName returns the first label of a name and the name of its parent
+func splitName(username string) (string, string) {
+	label, parent, _ := strings.Cut(username, ".")
+	return label, parent
+}
+
+func (api *API) registryCallMsg(chainID uint64, from common.Address, method string, args ...interface{}) (ethereum.CallMsg, error) {
+	registryABI, err := abi.JSON(strings.NewReader(resolver.ENSRegistryWithFallbackABI))
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	data, err := registryABI.Pack(method, args...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	registryAddress, err := resolver.ContractAddress(chainID)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	return ethereum.CallMsg{
+		From:  from,
+		To:    &registryAddress,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, nil
+}
+
+func (api *API) sendRegistryCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, callMsg ethereum.CallMsg, trxType transactions.PendingTrxType) (string, error) {
+	backend, err := api.contractMaker.RPCClient.EthClient(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	txOpts := txArgs.ToTransactOpts(utils.GetSigner(chainID, api.accountsManager, api.config.KeyStoreDir, txArgs.From, password))
+	contract := bind.NewBoundContract(*callMsg.To, abi.ABI{}, backend, backend, backend)
+	tx, err := contract.RawTransact(txOpts, callMsg.Data)
+	if err != nil {
+		return "", err
+	}
+
+	return api.trackTransaction(chainID, tx, txArgs, *callMsg.To, trxType)
+}
+
+// CreateSubname creates label.parent owned by owner, using the resolver of parent. The sender must own parent.
+func (api *API) CreateSubname(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, parent string, label string, owner common.Address) (string, error) {
+	callMsg, err := api.CreateSubnamePrepareTxCallMsg(ctx, chainID, txArgs, parent, label, owner)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := api.sendRegistryCallMsg(ctx, chainID, txArgs, password, callMsg, transactions.CreateENSSubname)
+	if err != nil {
+		return "", err
+	}
+
+	if owner == common.Address(txArgs.From) {
+		err = api.Add(ctx, chainID, subname(label, parent))
+		if err != nil {
+			log.Warn("Creating ENS subname: transaction successful, but adding failed")
+		}
+	}
+
+	return hash, nil
+}
+
+func (api *API) CreateSubnamePrepareTxCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, parent string, label string, owner common.Address) (ethereum.CallMsg, error) {
+	err := ValidateENSUsername(parent)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if label == "" || strings.Contains(label, ".") {
+		return ethereum.CallMsg{}, ErrInvalidSubname
+	}
+
+	parentOwner, err := api.OwnerOf(ctx, chainID, parent)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if *parentOwner != common.Address(txArgs.From) {
+		return ethereum.CallMsg{}, ErrNotNameOwner
+	}
+
+	resolverAddress, err := api.Resolver(ctx, chainID, parent)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	return api.registryCallMsg(chainID, common.Address(txArgs.From), "setSubnodeRecord", NameHash(parent), UsernameToLabel(label), owner, *resolverAddress, uint64(0))
+}
+
+func (api *API) CreateSubnamePrepareTx(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, parent string, label string, owner common.Address) (interface{}, error) {
+	callMsg, err := api.CreateSubnamePrepareTxCallMsg(ctx, chainID, txArgs, parent, label, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCallArg(callMsg), nil
+}
+
+func (api *API) CreateSubnameEstimate(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, parent string, label string, owner common.Address) (uint64, error) {
+	callMsg, err := api.CreateSubnamePrepareTxCallMsg(ctx, chainID, txArgs, parent, label, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	return api.estimate(ctx, chainID, callMsg)
+}
+
+// TransferSubname gives a name to newOwner. The sender must own either the name or its parent.
+func (api *API) TransferSubname(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, password string, username string, newOwner common.Address) (string, error) {
+	callMsg, err := api.TransferSubnamePrepareTxCallMsg(ctx, chainID, txArgs, username, newOwner)
+	if err != nil {
+		return "", err
+	}
+
+	return api.sendRegistryCallMsg(ctx, chainID, txArgs, password, callMsg, transactions.TransferENSName)
+}
+
+func (api *API) TransferSubnamePrepareTxCallMsg(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, newOwner common.Address) (ethereum.CallMsg, error) {
+	err := ValidateENSUsername(username)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+
+	from := common.Address(txArgs.From)
+	owner, err := api.OwnerOf(ctx, chainID, username)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if *owner == from {
+		return api.registryCallMsg(chainID, from, "setOwner", NameHash(username), newOwner)
+	}
+
+	// The owner of the parent can always take back or reassign a subname
+	label, parent := splitName(username)
+	if parent == "" {
+		return ethereum.CallMsg{}, ErrNotNameOwner
+	}
+	parentOwner, err := api.OwnerOf(ctx, chainID, parent)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if *parentOwner != from {
+		return ethereum.CallMsg{}, ErrNotNameOwner
+	}
+
+	return api.registryCallMsg(chainID, from, "setSubnodeOwner", NameHash(parent), UsernameToLabel(label), newOwner)
+}
+
+func (api *API) TransferSubnamePrepareTx(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, newOwner common.Address) (interface{}, error) {
+	callMsg, err := api.TransferSubnamePrepareTxCallMsg(ctx, chainID, txArgs, username, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCallArg(callMsg), nil
+}
+
+func (api *API) TransferSubnameEstimate(ctx context.Context, chainID uint64, txArgs transactions.SendTxArgs, username string, newOwner common.Address) (uint64, error) {
+	callMsg, err := api.TransferSubnamePrepareTxCallMsg(ctx, chainID, txArgs, username, newOwner)
+	if err != nil {
+		return 0, err
+	}
+
+	return api.estimate(ctx, chainID, callMsg)
+}
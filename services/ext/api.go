@@ -41,6 +41,7 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/storenodes"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/protocol/urls"
 	"github.com/status-im/status-go/protocol/verification"
@@ -560,6 +561,19 @@ func (api *PublicAPI) UnbanUserFromCommunity(request *requests.UnbanUserFromComm
 	return api.service.messenger.UnbanUserFromCommunity(request)
 }
 
+// CommunityModerationLog returns a page of moderation actions taken in the community, most recent first
+func (api *PublicAPI) CommunityModerationLog(communityID types.HexBytes, cursor string, limit int) (*ApplicationModerationLogResponse, error) {
+	entries, cursor, err := api.service.messenger.CommunityModerationLog(communityID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationModerationLogResponse{
+		Entries: entries,
+		Cursor:  cursor,
+	}, nil
+}
+
 func (api *PublicAPI) AddRoleToMember(request *requests.AddRoleToMember) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.AddRoleToMember(request)
 }
@@ -705,6 +719,31 @@ func (api *PublicAPI) DeleteCommunityCategory(request *requests.DeleteCommunityC
 	return api.service.messenger.DeleteCommunityCategory(request)
 }
 
+// CreateCommunityScheduledEvent schedules an event, like a meetup or an AMA, within a particular community
+func (api *PublicAPI) CreateCommunityScheduledEvent(request *requests.CreateCommunityScheduledEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateCommunityScheduledEvent(request)
+}
+
+// EditCommunityScheduledEvent modifies a scheduled event within a particular community
+func (api *PublicAPI) EditCommunityScheduledEvent(request *requests.EditCommunityScheduledEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditCommunityScheduledEvent(request)
+}
+
+// DeleteCommunityScheduledEvent deletes a scheduled event within a particular community
+func (api *PublicAPI) DeleteCommunityScheduledEvent(request *requests.DeleteCommunityScheduledEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteCommunityScheduledEvent(request)
+}
+
+// RsvpCommunityScheduledEvent answers whether we are going to a community scheduled event
+func (api *PublicAPI) RsvpCommunityScheduledEvent(request *requests.RsvpCommunityScheduledEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RsvpCommunityScheduledEvent(request)
+}
+
+// CommunityScheduledEventRsvps returns the answers of the community members to a scheduled event
+func (api *PublicAPI) CommunityScheduledEventRsvps(eventID string) ([]*communities.ScheduledEventRsvp, error) {
+	return api.service.messenger.CommunityScheduledEventRsvps(eventID)
+}
+
 func (api *PublicAPI) PromoteSelfToControlNode(communityID types.HexBytes) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.PromoteSelfToControlNode(communityID)
 }
@@ -725,6 +764,11 @@ type ApplicationPinnedMessagesResponse struct {
 	Cursor         string                  `json:"cursor"`
 }
 
+type ApplicationModerationLogResponse struct {
+	Entries []*communities.ModerationLogEntry `json:"entries"`
+	Cursor  string                            `json:"cursor"`
+}
+
 type ApplicationStatusUpdatesResponse struct {
 	StatusUpdates []protocol.UserStatus `json:"statusUpdates"`
 }
@@ -924,6 +968,16 @@ func (api *PublicAPI) SendChatMessage(ctx context.Context, message *common.Messa
 	return api.service.messenger.SendChatMessage(ctx, message)
 }
 
+// ShareStickerPack sends one of our user-created sticker packs to a contact or a community chat
+func (api *PublicAPI) ShareStickerPack(ctx context.Context, request *requests.ShareStickerPack) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ShareStickerPack(ctx, request)
+}
+
+// ShareSafeProposal sends a pending transaction of one of our Safes to a contact or a group chat of its owners
+func (api *PublicAPI) ShareSafeProposal(ctx context.Context, request *requests.ShareSafeProposal) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ShareSafeProposal(ctx, request)
+}
+
 func (api *PublicAPI) ReSendChatMessage(ctx context.Context, messageID string) error {
 	return api.service.messenger.ReSendChatMessage(ctx, messageID)
 }
@@ -1297,6 +1351,11 @@ func (api *PublicAPI) EnsVerified(pk, ensName string) error {
 	return api.service.messenger.ENSVerified(pk, ensName)
 }
 
+// FetchContactENSProfile fetches the text records and avatar of the verified ENS name of a contact
+func (api *PublicAPI) FetchContactENSProfile(ctx context.Context, contactID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.FetchContactENSProfile(ctx, contactID)
+}
+
 // Deprecated: RequestCommunityInfoFromMailserver is deprecated in favor of
 // configurable FetchCommunity.
 func (api *PublicAPI) RequestCommunityInfoFromMailserver(communityID string) (*communities.Community, error) {
@@ -1415,6 +1474,11 @@ func (api *PublicAPI) DisconnectActiveMailserver() {
 	api.service.messenger.DisconnectActiveMailserver()
 }
 
+// StorenodeScores returns the health scores of the storenodes, used to rank them and fail over community storenodes
+func (api *PublicAPI) StorenodeScores() []storenodes.Score {
+	return api.service.messenger.StorenodeScores()
+}
+
 // Echo is a method for testing purposes.
 func (api *PublicAPI) Echo(ctx context.Context, message string) (string, error) {
 	return message, nil
@@ -1553,6 +1617,16 @@ func (api *PublicAPI) BackupData() (uint64, error) {
 	return api.service.messenger.BackupData(context.Background())
 }
 
+// ExportLocalBackup writes a passphrase encrypted backup of the profile, including message history, to a file
+func (api *PublicAPI) ExportLocalBackup(request *requests.ExportLocalBackup) error {
+	return api.service.messenger.ExportLocalBackup(context.Background(), request)
+}
+
+// ImportLocalBackup merges a local backup file into the current profile
+func (api *PublicAPI) ImportLocalBackup(request *requests.ImportLocalBackup) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ImportLocalBackup(request)
+}
+
 func (api *PublicAPI) ImageServerURL() string {
 	return api.service.messenger.ImageServerURL()
 }
@@ -1589,6 +1663,18 @@ func (api *PublicAPI) RequestImportDiscordCommunity(request *requests.ImportDisc
 	api.service.messenger.RequestImportDiscordCommunity(request)
 }
 
+// RequestImportTelegramCommunity reports progress with the same signals as Discord imports,
+// and can be cancelled with RequestCancelDiscordCommunityImport
+func (api *PublicAPI) RequestImportTelegramCommunity(request *requests.ImportTelegramCommunity) {
+	api.service.messenger.RequestImportTelegramCommunity(request)
+}
+
+// RequestImportSlackCommunity reports progress with the same signals as Discord imports,
+// and can be cancelled with RequestCancelDiscordCommunityImport
+func (api *PublicAPI) RequestImportSlackCommunity(request *requests.ImportSlackCommunity) {
+	api.service.messenger.RequestImportSlackCommunity(request)
+}
+
 func (api *PublicAPI) RequestCancelDiscordCommunityImport(id string) {
 	api.service.messenger.MarkDiscordCommunityImportAsCancelled(id)
 }
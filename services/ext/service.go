@@ -55,6 +55,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/collectibles"
 	w_common "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/wakuv2"
 )
 
@@ -139,6 +140,7 @@ func (s *Service) InitProtocol(nodeName string, identity *ecdsa.PrivateKey, appD
 	}
 
 	s.identity = identity
+	s.account = acc
 
 	// This directory should have already been created in loadNodeConfig, keeping this to ensure.
 	dataDir := filepath.Clean(s.config.RootDataDir)
@@ -153,7 +155,7 @@ func (s *Service) InitProtocol(nodeName string, identity *ecdsa.PrivateKey, appD
 		IsMailserver: func(peer types.EnodeID) bool {
 			return s.peerStore.Exist(peer)
 		},
-		EnvelopeEventsHandler: EnvelopeSignalHandler{},
+		EnvelopeEventsHandler: EnvelopeSignalHandler{signals: s.signals()},
 		Logger:                logger,
 	}
 	s.accountsDB, err = accounts.NewDB(appDb)
@@ -161,9 +163,8 @@ func (s *Service) InitProtocol(nodeName string, identity *ecdsa.PrivateKey, appD
 		return err
 	}
 	s.multiAccountsDB = multiAccountDb
-	s.account = acc
 
-	options, err := buildMessengerOptions(s.config, identity, appDb, walletDb, httpServer, s.rpcClient, s.multiAccountsDB, acc, envelopesMonitorConfig, s.accountsDB, walletService, communityTokensService, wakuService, logger, &MessengerSignalsHandler{}, accountManager)
+	options, err := buildMessengerOptions(s.config, identity, appDb, walletDb, httpServer, s.rpcClient, s.multiAccountsDB, acc, envelopesMonitorConfig, s.accountsDB, walletService, communityTokensService, wakuService, logger, &MessengerSignalsHandler{signals: s.signals()}, accountManager)
 	if err != nil {
 		return err
 	}
@@ -192,6 +193,14 @@ func (s *Service) InitProtocol(nodeName string, identity *ecdsa.PrivateKey, appD
 	return s.messenger.InitInstallations()
 }
 
+// signals returns the namespace of the signals sent for the logged in account
+func (s *Service) signals() signal.Namespace {
+	if s.account == nil {
+		return signal.Namespace("")
+	}
+	return signal.NewNamespace(s.account.KeyUID)
+}
+
 func (s *Service) StartMessenger() (*protocol.MessengerResponse, error) {
 	// Start a loop that retrieves all messages and propagates them to status-mobile.
 	s.cancelMessenger = make(chan struct{})
@@ -217,7 +226,7 @@ func (s *Service) retrieveStats(tick time.Duration, cancel <-chan struct{}) {
 		select {
 		case <-ticker.C:
 			response := s.messenger.GetStats()
-			PublisherSignalHandler{}.Stats(response)
+			PublisherSignalHandler{signals: s.signals()}.Stats(response)
 		case <-cancel:
 			return
 		}
@@ -422,6 +431,14 @@ func buildMessengerOptions(
 		protocol.WithAccountManager(accountManager),
 	}
 
+	if len(config.ShhextConfig.ENSExpiryReminderDays) > 0 {
+		leadTimes := make([]time.Duration, 0, len(config.ShhextConfig.ENSExpiryReminderDays))
+		for _, days := range config.ShhextConfig.ENSExpiryReminderDays {
+			leadTimes = append(leadTimes, time.Duration(days)*24*time.Hour)
+		}
+		options = append(options, protocol.WithENSExpiryLeadTimes(leadTimes))
+	}
+
 	if config.ShhextConfig.DataSyncEnabled {
 		options = append(options, protocol.WithDatasync())
 	}
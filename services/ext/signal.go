@@ -10,177 +10,183 @@ import (
 )
 
 // EnvelopeSignalHandler sends signals when envelope is sent or expired.
-type EnvelopeSignalHandler struct{}
+type EnvelopeSignalHandler struct {
+	signals signal.Namespace
+}
 
 // EnvelopeSent triggered when envelope delivered at least to 1 peer.
 func (h EnvelopeSignalHandler) EnvelopeSent(identifiers [][]byte) {
-	signal.SendEnvelopeSent(identifiers)
+	h.signals.SendEnvelopeSent(identifiers)
 }
 
 // EnvelopeExpired triggered when envelope is expired but wasn't delivered to any peer.
 func (h EnvelopeSignalHandler) EnvelopeExpired(identifiers [][]byte, err error) {
-	signal.SendEnvelopeExpired(identifiers, err)
+	h.signals.SendEnvelopeExpired(identifiers, err)
 }
 
 // MailServerRequestCompleted triggered when the mailserver sends a message to notify that the request has been completed
 func (h EnvelopeSignalHandler) MailServerRequestCompleted(requestID types.Hash, lastEnvelopeHash types.Hash, cursor []byte, err error) {
-	signal.SendMailServerRequestCompleted(requestID, lastEnvelopeHash, cursor, err)
+	h.signals.SendMailServerRequestCompleted(requestID, lastEnvelopeHash, cursor, err)
 }
 
 // MailServerRequestExpired triggered when the mailserver request expires
 func (h EnvelopeSignalHandler) MailServerRequestExpired(hash types.Hash) {
-	signal.SendMailServerRequestExpired(hash)
+	h.signals.SendMailServerRequestExpired(hash)
 }
 
 // PublisherSignalHandler sends signals on protocol events
-type PublisherSignalHandler struct{}
+type PublisherSignalHandler struct {
+	signals signal.Namespace
+}
 
 func (h PublisherSignalHandler) DecryptMessageFailed(pubKey string) {
-	signal.SendDecryptMessageFailed(pubKey)
+	h.signals.SendDecryptMessageFailed(pubKey)
 }
 
 func (h PublisherSignalHandler) BundleAdded(identity string, installationID string) {
-	signal.SendBundleAdded(identity, installationID)
+	h.signals.SendBundleAdded(identity, installationID)
 }
 
 func (h PublisherSignalHandler) NewMessages(response *protocol.MessengerResponse) {
-	signal.SendNewMessages(response)
+	h.signals.SendNewMessages(response)
 }
 
 func (h PublisherSignalHandler) Stats(stats types.StatsSummary) {
-	signal.SendStats(stats)
+	h.signals.SendStats(stats)
 }
 
 // MessengerSignalsHandler sends signals on messenger events
-type MessengerSignalsHandler struct{}
+type MessengerSignalsHandler struct {
+	signals signal.Namespace
+}
 
 // MessageDelivered passes information that message was delivered
 func (m *MessengerSignalsHandler) MessageDelivered(chatID string, messageID string) {
-	signal.SendMessageDelivered(chatID, messageID)
+	m.signals.SendMessageDelivered(chatID, messageID)
 }
 
 // BackupPerformed passes information that a backup was performed
 func (m *MessengerSignalsHandler) BackupPerformed(lastBackup uint64) {
-	signal.SendBackupPerformed(lastBackup)
+	m.signals.SendBackupPerformed(lastBackup)
 }
 
 // CommunityInfoFound passes info about community that was requested before
 func (m *MessengerSignalsHandler) CommunityInfoFound(community *communities.Community) {
-	signal.SendCommunityInfoFound(community)
+	m.signals.SendCommunityInfoFound(community)
 }
 
 func (m *MessengerSignalsHandler) MessengerResponse(response *protocol.MessengerResponse) {
-	PublisherSignalHandler{}.NewMessages(response)
+	PublisherSignalHandler{signals: m.signals}.NewMessages(response)
 }
 
 func (m *MessengerSignalsHandler) HistoryRequestStarted(numBatches int) {
-	signal.SendHistoricMessagesRequestStarted(numBatches)
+	m.signals.SendHistoricMessagesRequestStarted(numBatches)
 }
 
 func (m *MessengerSignalsHandler) HistoryRequestCompleted() {
-	signal.SendHistoricMessagesRequestCompleted()
+	m.signals.SendHistoricMessagesRequestCompleted()
 }
 
 func (m *MessengerSignalsHandler) HistoryArchivesProtocolEnabled() {
-	signal.SendHistoryArchivesProtocolEnabled()
+	m.signals.SendHistoryArchivesProtocolEnabled()
 }
 
 func (m *MessengerSignalsHandler) HistoryArchivesProtocolDisabled() {
-	signal.SendHistoryArchivesProtocolDisabled()
+	m.signals.SendHistoryArchivesProtocolDisabled()
 }
 
 func (m *MessengerSignalsHandler) CreatingHistoryArchives(communityID string) {
-	signal.SendCreatingHistoryArchives(communityID)
+	m.signals.SendCreatingHistoryArchives(communityID)
 }
 
 func (m *MessengerSignalsHandler) NoHistoryArchivesCreated(communityID string, from int, to int) {
-	signal.SendNoHistoryArchivesCreated(communityID, from, to)
+	m.signals.SendNoHistoryArchivesCreated(communityID, from, to)
 }
 
 func (m *MessengerSignalsHandler) HistoryArchivesCreated(communityID string, from int, to int) {
-	signal.SendHistoryArchivesCreated(communityID, from, to)
+	m.signals.SendHistoryArchivesCreated(communityID, from, to)
 }
 
 func (m *MessengerSignalsHandler) HistoryArchivesSeeding(communityID string) {
-	signal.SendHistoryArchivesSeeding(communityID)
+	m.signals.SendHistoryArchivesSeeding(communityID)
 }
 
 func (m *MessengerSignalsHandler) HistoryArchivesUnseeded(communityID string) {
-	signal.SendHistoryArchivesUnseeded(communityID)
+	m.signals.SendHistoryArchivesUnseeded(communityID)
 }
 
 func (m *MessengerSignalsHandler) HistoryArchiveDownloaded(communityID string, from int, to int) {
-	signal.SendHistoryArchiveDownloaded(communityID, from, to)
+	m.signals.SendHistoryArchiveDownloaded(communityID, from, to)
 }
 
 func (m *MessengerSignalsHandler) DownloadingHistoryArchivesStarted(communityID string) {
-	signal.SendDownloadingHistoryArchivesStarted(communityID)
+	m.signals.SendDownloadingHistoryArchivesStarted(communityID)
 }
 
 func (m *MessengerSignalsHandler) ImportingHistoryArchiveMessages(communityID string) {
-	signal.SendImportingHistoryArchiveMessages(communityID)
+	m.signals.SendImportingHistoryArchiveMessages(communityID)
 }
 
 func (m *MessengerSignalsHandler) DownloadingHistoryArchivesFinished(communityID string) {
-	signal.SendDownloadingHistoryArchivesFinished(communityID)
+	m.signals.SendDownloadingHistoryArchivesFinished(communityID)
 }
 
 func (m *MessengerSignalsHandler) StatusUpdatesTimedOut(statusUpdates *[]protocol.UserStatus) {
-	signal.SendStatusUpdatesTimedOut(statusUpdates)
+	m.signals.SendStatusUpdatesTimedOut(statusUpdates)
 }
 
 func (m *MessengerSignalsHandler) DiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError) {
-	signal.SendDiscordCategoriesAndChannelsExtracted(categories, channels, oldestMessageTimestamp, errors)
+	m.signals.SendDiscordCategoriesAndChannelsExtracted(categories, channels, oldestMessageTimestamp, errors)
 }
 
 func (m *MessengerSignalsHandler) DiscordCommunityImportProgress(importProgress *discord.ImportProgress) {
-	signal.SendDiscordCommunityImportProgress(importProgress)
+	m.signals.SendDiscordCommunityImportProgress(importProgress)
 }
 
 func (m *MessengerSignalsHandler) DiscordChannelImportProgress(importProgress *discord.ImportProgress) {
-	signal.SendDiscordChannelImportProgress(importProgress)
+	m.signals.SendDiscordChannelImportProgress(importProgress)
 }
 
 func (m *MessengerSignalsHandler) DiscordCommunityImportFinished(id string) {
-	signal.SendDiscordCommunityImportFinished(id)
+	m.signals.SendDiscordCommunityImportFinished(id)
 }
 
 func (m *MessengerSignalsHandler) DiscordChannelImportFinished(communityID string, channelID string) {
-	signal.SendDiscordChannelImportFinished(communityID, channelID)
+	m.signals.SendDiscordChannelImportFinished(communityID, channelID)
 }
 
 func (m *MessengerSignalsHandler) DiscordCommunityImportCancelled(id string) {
-	signal.SendDiscordCommunityImportCancelled(id)
+	m.signals.SendDiscordCommunityImportCancelled(id)
 }
 
 func (m *MessengerSignalsHandler) DiscordCommunityImportCleanedUp(id string) {
-	signal.SendDiscordCommunityImportCleanedUp(id)
+	m.signals.SendDiscordCommunityImportCleanedUp(id)
 }
 
 func (m *MessengerSignalsHandler) DiscordChannelImportCancelled(id string) {
-	signal.SendDiscordChannelImportCancelled(id)
+	m.signals.SendDiscordChannelImportCancelled(id)
 }
 
 func (m *MessengerSignalsHandler) SendWakuFetchingBackupProgress(response *wakusync.WakuBackedUpDataResponse) {
-	signal.SendWakuFetchingBackupProgress(response)
+	m.signals.SendWakuFetchingBackupProgress(response)
 }
 
 func (m *MessengerSignalsHandler) SendWakuBackedUpProfile(response *wakusync.WakuBackedUpDataResponse) {
-	signal.SendWakuBackedUpProfile(response)
+	m.signals.SendWakuBackedUpProfile(response)
 }
 
 func (m *MessengerSignalsHandler) SendWakuBackedUpSettings(response *wakusync.WakuBackedUpDataResponse) {
-	signal.SendWakuBackedUpSettings(response)
+	m.signals.SendWakuBackedUpSettings(response)
 }
 
 func (m *MessengerSignalsHandler) SendWakuBackedUpKeypair(response *wakusync.WakuBackedUpDataResponse) {
-	signal.SendWakuBackedUpKeypair(response)
+	m.signals.SendWakuBackedUpKeypair(response)
 }
 
 func (m *MessengerSignalsHandler) SendWakuBackedUpWatchOnlyAccount(response *wakusync.WakuBackedUpDataResponse) {
-	signal.SendWakuBackedUpWatchOnlyAccount(response)
+	m.signals.SendWakuBackedUpWatchOnlyAccount(response)
 }
 
 func (m *MessengerSignalsHandler) SendCuratedCommunitiesUpdate(response *communities.KnownCommunitiesResponse) {
-	signal.SendCuratedCommunitiesUpdate(response)
+	m.signals.SendCuratedCommunitiesUpdate(response)
 }
@@ -93,9 +93,10 @@ type Service struct {
 	db                *Database
 	walletDB          *transfer.Database
 	accountsDB        *accounts.Database
+	signals           signal.Namespace
 }
 
-func NewService(appDB *sql.DB, walletDB *transfer.Database, chainID uint64) (*Service, error) {
+func NewService(appDB *sql.DB, walletDB *transfer.Database, chainID uint64, signals signal.Namespace) (*Service, error) {
 	db := NewDB(appDB, chainID)
 	accountsDB, err := accounts.NewDB(appDB)
 	if err != nil {
@@ -111,6 +112,7 @@ func NewService(appDB *sql.DB, walletDB *transfer.Database, chainID uint64) (*Se
 		accountsDB:        accountsDB,
 		transmitter:       trans,
 		walletTransmitter: walletTrans,
+		signals:           signals,
 	}, nil
 }
 
@@ -148,15 +150,16 @@ func (n *Notification) MarshalJSON() ([]byte, error) {
 	return json.Marshal(alias)
 }
 
-func PushMessages(ns []*Notification) {
+// PushMessages sends the notifications to the profile of the signals namespace
+func PushMessages(signals signal.Namespace, ns []*Notification) {
 	for _, n := range ns {
-		pushMessage(n)
+		pushMessage(signals, n)
 	}
 }
 
-func pushMessage(notification *Notification) {
+func pushMessage(signals signal.Namespace, notification *Notification) {
 	log.Debug("Pushing a new push notification")
-	signal.SendLocalNotifications(notification)
+	signals.SendLocalNotifications(notification)
 }
 
 // Start Worker which processes all incoming messages
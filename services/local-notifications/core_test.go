@@ -37,7 +37,7 @@ func TestServiceStartStop(t *testing.T) {
 	walletDb, walletStop := createWalletDb(t)
 	defer walletStop()
 
-	s, err := NewService(db, walletDb, 1777)
+	s, err := NewService(db, walletDb, 1777, signal.Namespace(""))
 	require.NoError(t, err)
 	require.NoError(t, s.Start())
 	require.Equal(t, true, s.IsStarted())
@@ -54,7 +54,7 @@ func TestWalletSubscription(t *testing.T) {
 	defer walletStop()
 
 	feed := &event.Feed{}
-	s, err := NewService(db, walletDb, 1777)
+	s, err := NewService(db, walletDb, 1777, signal.Namespace(""))
 	require.NoError(t, err)
 	require.NoError(t, s.Start())
 	require.Equal(t, true, s.IsStarted())
@@ -79,7 +79,7 @@ func TestTransactionNotification(t *testing.T) {
 	walletDb, walletStop := createWalletDb(t)
 	defer walletStop()
 
-	s, err := NewService(db, walletDb, 1777)
+	s, err := NewService(db, walletDb, 1777, signal.Namespace(""))
 	require.NoError(t, err)
 	require.NoError(t, s.Start())
 	require.Equal(t, true, s.IsStarted())
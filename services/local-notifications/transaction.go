@@ -121,7 +121,7 @@ func (s *Service) transactionsHandler(payload TransactionEvent) {
 
 				for _, transaction := range transfers {
 					n := s.buildTransactionNotification(transaction)
-					pushMessage(n)
+					pushMessage(s.signals, n)
 				}
 			}
 		}
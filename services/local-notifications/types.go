@@ -1,11 +1,13 @@
 package localnotifications
 
 const (
-	CategoryTransaction            PushCategory = "transaction"
-	CategoryMessage                PushCategory = "newMessage"
-	CategoryGroupInvite            PushCategory = "groupInvite"
-	CategoryCommunityRequestToJoin              = "communityRequestToJoin"
-	CategoryCommunityJoined                     = "communityJoined"
+	CategoryTransaction             PushCategory = "transaction"
+	CategoryMessage                 PushCategory = "newMessage"
+	CategoryGroupInvite             PushCategory = "groupInvite"
+	CategoryCommunityRequestToJoin               = "communityRequestToJoin"
+	CategoryCommunityJoined                      = "communityJoined"
+	CategoryCommunityScheduledEvent              = "communityScheduledEvent"
+	CategoryENSExpiry                            = "ensExpiry"
 
 	TypeTransaction NotificationType = "transaction"
 	TypeMessage     NotificationType = "message"
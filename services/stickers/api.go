@@ -41,6 +41,7 @@ type API struct {
 	contractMaker   *contracts.ContractMaker
 	accountsManager *account.GethManager
 	accountsDB      *accounts.Database
+	db              *Database
 	pendingTracker  *transactions.PendingTxTracker
 
 	keyStoreDir string
@@ -93,6 +94,7 @@ func NewAPI(ctx context.Context, acc *accounts.Database, rpcClient *rpc.Client,
 		},
 		accountsManager: accountsManager,
 		accountsDB:      acc,
+		db:              NewStickersDatabase(acc.DB()),
 		pendingTracker:  pendingTracker,
 		keyStoreDir:     keyStoreDir,
 		downloader:      downloader,
@@ -0,0 +1,244 @@
+package stickers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/status-im/status-go/images"
+)
+
+// A pack is sent with its images in a single message, the limits keep it to a few MB
+const (
+	MaxUserPackStickers     = 24
+	MaxUserStickerSize      = 128 * 1024
+	MaxUserStickerDimension = 512
+)
+
+var (
+	ErrUserPackNameEmpty    = errors.New("sticker pack name is empty")
+	ErrUserPackEmpty        = errors.New("sticker pack has no stickers")
+	ErrUserPackTooLarge     = errors.New("sticker pack has too many stickers")
+	ErrUserPackNotFound     = errors.New("sticker pack not found")
+	ErrUserStickerTooLarge  = errors.New("sticker image is too large")
+	ErrUserStickerNotImage  = errors.New("sticker is not a png, webp or gif image")
+	ErrUserStickerHashWrong = errors.New("sticker image doesn't match its hash")
+	ErrUserPackIDWrong      = errors.New("sticker pack ID doesn't match its content")
+)
+
+// UserStickerPack is a sticker pack created by a user and shared peer-to-peer instead of bought on
+// the sticker market. Its stickers are referenced by their content hash, like market ones.
+type UserStickerPack struct {
+	// ID is derived from the content of the pack
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Author    string    `json:"author"`
+	Thumbnail string    `json:"thumbnail"`
+	Stickers  []Sticker `json:"stickers"`
+	// SharedBy is the public key of who shared the pack with us, empty when we created it
+	SharedBy string `json:"sharedBy,omitempty"`
+	// ChatID is the chat the pack was shared in
+	ChatID string `json:"chatId,omitempty"`
+	Clock  uint64 `json:"clock"`
+}
+
+// UserStickerPackID returns the ID of a pack, so that the same pack shared several times is stored once
+func UserStickerPackID(name string, author string, stickerHashes []string) string {
+	data := []string{name, author}
+	data = append(data, stickerHashes...)
+	return hexutil.Encode(crypto.Keccak256([]byte(strings.Join(data, "\n"))))
+}
+
+func (p *UserStickerPack) StickerHashes() []string {
+	hashes := make([]string, 0, len(p.Stickers))
+	for _, sticker := range p.Stickers {
+		hashes = append(hashes, sticker.Hash)
+	}
+	return hashes
+}
+
+// ValidateUserSticker checks a sticker image can be part of a pack
+func ValidateUserSticker(payload []byte) error {
+	if len(payload) > MaxUserStickerSize {
+		return ErrUserStickerTooLarge
+	}
+
+	// Stickers keep their transparency, they are not converted to jpeg like other images
+	if !images.IsPng(payload) && !images.IsWebp(payload) && !images.IsGif(payload) {
+		return ErrUserStickerNotImage
+	}
+
+	width, height, err := images.GetImageDimensions(payload)
+	if err != nil {
+		return err
+	}
+	if width > MaxUserStickerDimension || height > MaxUserStickerDimension {
+		return ErrUserStickerTooLarge
+	}
+
+	return nil
+}
+
+// ValidateUserPack checks the pack metadata, stickers are checked with ValidateUserSticker
+func ValidateUserPack(name string, stickersCount int) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrUserPackNameEmpty
+	}
+	if stickersCount == 0 {
+		return ErrUserPackEmpty
+	}
+	if stickersCount > MaxUserPackStickers {
+		return ErrUserPackTooLarge
+	}
+	return nil
+}
+
+// CreateUserPack creates a sticker pack from images, the first one being its thumbnail
+func (api *API) CreateUserPack(name string, author string, imagePaths []string) (*UserStickerPack, error) {
+	err := ValidateUserPack(name, len(imagePaths))
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := make([][]byte, 0, len(imagePaths))
+	for _, path := range imagePaths {
+		payload, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ValidateUserSticker(payload)
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, payload)
+	}
+
+	pack := &UserStickerPack{
+		Name:   name,
+		Author: author,
+	}
+	for _, payload := range payloads {
+		hash, err := api.downloader.Add(payload)
+		if err != nil {
+			return nil, err
+		}
+		pack.Stickers = append(pack.Stickers, Sticker{Hash: hash})
+	}
+	pack.Thumbnail = pack.Stickers[0].Hash
+	pack.ID = UserStickerPackID(pack.Name, pack.Author, pack.StickerHashes())
+
+	err = api.db.SaveUserPack(pack)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.withURLs(pack), nil
+}
+
+// UserPacks returns the sticker packs we created or that were shared with us
+func (api *API) UserPacks() ([]*UserStickerPack, error) {
+	packs, err := api.db.GetUserPacks()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, pack := range packs {
+		packs[i] = api.withURLs(pack)
+	}
+
+	return packs, nil
+}
+
+func (api *API) RemoveUserPack(id string) error {
+	return api.db.DeleteUserPack(id)
+}
+
+func (api *API) withURLs(pack *UserStickerPack) *UserStickerPack {
+	for i, sticker := range pack.Stickers {
+		pack.Stickers[i].URL = api.hashToURL(sticker.Hash)
+	}
+	return pack
+}
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewStickersDatabase(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+func (db *Database) SaveUserPack(pack *UserStickerPack) error {
+	stickers, err := json.Marshal(pack.StickerHashes())
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO user_sticker_packs (id, name, author, thumbnail, stickers, shared_by, chat_id, clock)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		pack.ID, pack.Name, pack.Author, pack.Thumbnail, stickers, pack.SharedBy, pack.ChatID, pack.Clock)
+	return err
+}
+
+func (db *Database) GetUserPacks() ([]*UserStickerPack, error) {
+	rows, err := db.db.Query(`SELECT id, name, author, thumbnail, stickers, shared_by, chat_id, clock FROM user_sticker_packs ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var packs []*UserStickerPack
+	for rows.Next() {
+		pack, err := scanUserPack(rows)
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+
+	return packs, rows.Err()
+}
+
+// GetUserPack returns the pack with the given ID, nil when it doesn't exist
+func (db *Database) GetUserPack(id string) (*UserStickerPack, error) {
+	row := db.db.QueryRow(`SELECT id, name, author, thumbnail, stickers, shared_by, chat_id, clock FROM user_sticker_packs WHERE id = ?`, id)
+	pack, err := scanUserPack(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return pack, err
+}
+
+func (db *Database) DeleteUserPack(id string) error {
+	_, err := db.db.Exec(`DELETE FROM user_sticker_packs WHERE id = ?`, id)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUserPack(row scanner) (*UserStickerPack, error) {
+	pack := &UserStickerPack{}
+	var stickers []byte
+	err := row.Scan(&pack.ID, &pack.Name, &pack.Author, &pack.Thumbnail, &stickers, &pack.SharedBy, &pack.ChatID, &pack.Clock)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	err = json.Unmarshal(stickers, &hashes)
+	if err != nil {
+		return nil, err
+	}
+	for _, hash := range hashes {
+		pack.Stickers = append(pack.Stickers, Sticker{Hash: hash})
+	}
+
+	return pack, nil
+}
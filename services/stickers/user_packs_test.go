@@ -0,0 +1,98 @@
+package stickers
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/ipfs"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/server"
+	"github.com/status-im/status-go/t/helpers"
+)
+
+func writeTestSticker(t *testing.T, dir string, name string, size int) string {
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewNRGBA(image.Rect(0, 0, size, size))))
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	return path
+}
+
+func setupUserPacksAPI(t *testing.T) *API {
+	db, err := helpers.SetupTestMemorySQLDB(appdatabase.DbInitializer{})
+	require.NoError(t, err)
+	accountsDB, err := accounts.NewDB(db)
+	require.NoError(t, err)
+
+	downloader := ipfs.NewDownloader(t.TempDir())
+	t.Cleanup(downloader.Stop)
+	mediaServer, err := server.NewMediaServer(nil, downloader, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, mediaServer.Start())
+	t.Cleanup(func() { _ = mediaServer.Stop() })
+
+	return NewAPI(context.Background(), accountsDB, nil, nil, nil, "test-store-dir", downloader, mediaServer)
+}
+
+func TestUserPacks(t *testing.T) {
+	api := setupUserPacksAPI(t)
+	dir := t.TempDir()
+
+	paths := []string{
+		writeTestSticker(t, dir, "1.png", 64),
+		writeTestSticker(t, dir, "2.png", 128),
+	}
+
+	pack, err := api.CreateUserPack("pack", "author", paths)
+	require.NoError(t, err)
+	require.Len(t, pack.Stickers, 2)
+	require.Equal(t, pack.Stickers[0].Hash, pack.Thumbnail)
+	require.Equal(t, UserStickerPackID("pack", "author", pack.StickerHashes()), pack.ID)
+	require.NotEmpty(t, pack.Stickers[0].URL)
+
+	// Stickers are stored by their content hash
+	content, err := api.downloader.Get(pack.Stickers[1].Hash, false)
+	require.NoError(t, err)
+	expected, err := os.ReadFile(paths[1])
+	require.NoError(t, err)
+	require.Equal(t, expected, content)
+
+	packs, err := api.UserPacks()
+	require.NoError(t, err)
+	require.Len(t, packs, 1)
+	require.Equal(t, pack, packs[0])
+
+	require.NoError(t, api.RemoveUserPack(pack.ID))
+	packs, err = api.UserPacks()
+	require.NoError(t, err)
+	require.Len(t, packs, 0)
+}
+
+func TestCreateUserPackValidation(t *testing.T) {
+	api := setupUserPacksAPI(t)
+	dir := t.TempDir()
+	sticker := writeTestSticker(t, dir, "1.png", 64)
+
+	_, err := api.CreateUserPack(" ", "author", []string{sticker})
+	require.ErrorIs(t, err, ErrUserPackNameEmpty)
+
+	_, err = api.CreateUserPack("pack", "author", nil)
+	require.ErrorIs(t, err, ErrUserPackEmpty)
+
+	_, err = api.CreateUserPack("pack", "author", []string{writeTestSticker(t, dir, "big.png", MaxUserStickerDimension+1)})
+	require.ErrorIs(t, err, ErrUserStickerTooLarge)
+
+	notImage := filepath.Join(dir, "sticker.txt")
+	require.NoError(t, os.WriteFile(notImage, []byte("not an image"), 0600))
+	_, err = api.CreateUserPack("pack", "author", []string{notImage})
+	require.ErrorIs(t, err, ErrUserStickerNotImage)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/signal"
 )
 
 type API struct {
@@ -12,10 +13,10 @@ type API struct {
 	activeSubscriptions  *Subscriptions
 }
 
-func NewPublicAPI(rpcPrivateClientFunc func() *rpc.Client) *API {
+func NewPublicAPI(rpcPrivateClientFunc func() *rpc.Client, signals signal.Namespace) *API {
 	return &API{
 		rpcPrivateClientFunc: rpcPrivateClientFunc,
-		activeSubscriptions:  NewSubscriptions(100 * time.Millisecond),
+		activeSubscriptions:  NewSubscriptions(100*time.Millisecond, signals),
 	}
 }
 
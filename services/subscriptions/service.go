@@ -6,6 +6,7 @@ import (
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/signal"
 )
 
 // Make sure that Service implements gethnode.Lifecycle interface.
@@ -17,9 +18,9 @@ type Service struct {
 }
 
 // New returns a new Service.
-func New(rpcPrivateClientFunc func() *rpc.Client) *Service {
+func New(rpcPrivateClientFunc func() *rpc.Client, signals signal.Namespace) *Service {
 	return &Service{
-		api: NewPublicAPI(rpcPrivateClientFunc),
+		api: NewPublicAPI(rpcPrivateClientFunc, signals),
 	}
 }
 
@@ -4,16 +4,17 @@ import "github.com/status-im/status-go/signal"
 
 type filterSignal struct {
 	filterID string
+	signals  signal.Namespace
 }
 
-func newFilterSignal(filterID string) *filterSignal {
-	return &filterSignal{filterID}
+func newFilterSignal(filterID string, signals signal.Namespace) *filterSignal {
+	return &filterSignal{filterID, signals}
 }
 
 func (s *filterSignal) SendError(err error) {
-	signal.SendSubscriptionErrorEvent(s.filterID, err)
+	s.signals.SendSubscriptionErrorEvent(s.filterID, err)
 }
 
 func (s *filterSignal) SendData(data []interface{}) {
-	signal.SendSubscriptionDataEvent(s.filterID, data)
+	s.signals.SendSubscriptionDataEvent(s.filterID, data)
 }
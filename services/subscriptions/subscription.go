@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/status-im/status-go/signal"
 )
 
 type SubscriptionID string
@@ -18,11 +20,11 @@ type Subscription struct {
 	started bool
 }
 
-func NewSubscription(namespace string, filter filter) *Subscription {
+func NewSubscription(namespace string, filter filter, signals signal.Namespace) *Subscription {
 	subscriptionID := NewSubscriptionID(namespace, filter.getID())
 	return &Subscription{
 		id:     subscriptionID,
-		signal: newFilterSignal(string(subscriptionID)),
+		signal: newFilterSignal(string(subscriptionID), signals),
 		filter: filter,
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/signal"
 )
 
 type Subscriptions struct {
@@ -13,13 +15,15 @@ type Subscriptions struct {
 	subs        map[SubscriptionID]*Subscription
 	checkPeriod time.Duration
 	log         log.Logger
+	signals     signal.Namespace
 }
 
-func NewSubscriptions(period time.Duration) *Subscriptions {
+func NewSubscriptions(period time.Duration, signals signal.Namespace) *Subscriptions {
 	return &Subscriptions{
 		subs:        make(map[SubscriptionID]*Subscription),
 		checkPeriod: period,
 		log:         log.New("package", "status-go/services/subsriptions.Subscriptions"),
+		signals:     signals,
 	}
 }
 
@@ -27,7 +31,7 @@ func (s *Subscriptions) Create(namespace string, filter filter) (SubscriptionID,
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	newSub := NewSubscription(namespace, filter)
+	newSub := NewSubscription(namespace, filter, s.signals)
 
 	go func() {
 		err := newSub.Start(s.checkPeriod)
@@ -76,7 +76,7 @@ func (mf *mockFilter) setError(err error) {
 func TestSubscriptionGetData(t *testing.T) {
 	filter := newMockFilter(filterID)
 
-	subs := NewSubscriptions(time.Microsecond)
+	subs := NewSubscriptions(time.Microsecond, signal.Namespace(""))
 
 	subID, _ := subs.Create(filterNS, filter)
 
@@ -105,7 +105,7 @@ func TestSubscriptionGetData(t *testing.T) {
 func TestSubscriptionGetError(t *testing.T) {
 	filter := newMockFilter(filterID)
 
-	subs := NewSubscriptions(time.Microsecond)
+	subs := NewSubscriptions(time.Microsecond, signal.Namespace(""))
 
 	subID, _ := subs.Create(filterNS, filter)
 
@@ -135,7 +135,7 @@ func TestSubscriptionGetError(t *testing.T) {
 
 func TestSubscriptionRemove(t *testing.T) {
 	filter := newMockFilter(filterID)
-	subs := NewSubscriptions(time.Microsecond)
+	subs := NewSubscriptions(time.Microsecond, signal.Namespace(""))
 
 	subID, err := subs.Create(filterNS, filter)
 	require.NoError(t, err)
@@ -150,7 +150,7 @@ func TestSubscriptionRemoveError(t *testing.T) {
 	filter := newMockFilter(filterID)
 	filter.uninstallError = errors.New("uninstall-error-1")
 
-	subs := NewSubscriptions(time.Microsecond)
+	subs := NewSubscriptions(time.Microsecond, signal.Namespace(""))
 	subID, err := subs.Create(filterNS, filter)
 	require.NoError(t, err)
 	time.Sleep(time.Millisecond * 100) // create starts in a goroutine
@@ -164,7 +164,7 @@ func TestSubscriptionRemoveAll(t *testing.T) {
 	filter0 := newMockFilter(filterID)
 	filter1 := newMockFilter(filterID + "1")
 
-	subs := NewSubscriptions(time.Microsecond)
+	subs := NewSubscriptions(time.Microsecond, signal.Namespace(""))
 	_, err := subs.Create(filterNS, filter0)
 	require.NoError(t, err)
 	_, err = subs.Create(filterNS, filter1)
@@ -16,16 +16,18 @@ import (
 	"github.com/status-im/status-go/signal"
 )
 
-func NewAPI(ensService *ens.Service) *API {
+func NewAPI(ensService *ens.Service, signals signal.Namespace) *API {
 	return &API{
 		ensService: ensService,
 		httpClient: &http.Client{Timeout: time.Minute},
+		signals:    signals,
 	}
 }
 
 type API struct {
 	ensService *ens.Service
 	httpClient *http.Client
+	signals    signal.Namespace
 }
 
 func (api *API) Check(ctx context.Context, chainID uint64, ens string, currentVersion string) {
@@ -42,7 +44,7 @@ func (api *API) Check(ctx context.Context, chainID uint64, ens string, currentVe
 		uri, err := api.ensService.API().ResourceURL(ctx, chainID, ens)
 		if err != nil || uri.Host == "" {
 			log.Error("can't get obtain the updates content hash url", "ens", ens)
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
@@ -51,21 +53,21 @@ func (api *API) Check(ctx context.Context, chainID uint64, ens string, currentVe
 		response, err := api.httpClient.Get(versionURL)
 		if err != nil {
 			log.Error("can't get content", zap.String("any", versionURL))
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
 		defer response.Body.Close()
 		if response.StatusCode != http.StatusOK {
 			log.Error(fmt.Sprintf("version verification response status error: %v", response.StatusCode))
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
 		data, err := ioutil.ReadAll(response.Body)
 		if err != nil {
 			log.Error("version verification body err", "err", err)
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
@@ -73,7 +75,7 @@ func (api *API) Check(ctx context.Context, chainID uint64, ens string, currentVe
 		err = json.Unmarshal(data, &c)
 		if err != nil {
 			log.Error("invalid json", "err", err)
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
@@ -83,17 +85,17 @@ func (api *API) Check(ctx context.Context, chainID uint64, ens string, currentVe
 			latestStr = c["version"].(string)
 		default:
 			log.Error("invalid latest version", "val", c["version"])
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
 		latest, err := version.NewVersion(latestStr)
 		if err != nil {
 			log.Error("invalid latest version", "err", err)
-			signal.SendUpdateAvailable(false, "", "")
+			api.signals.SendUpdateAvailable(false, "", "")
 			return
 		}
 
-		signal.SendUpdateAvailable(latest.GreaterThan(current), latestStr, url)
+		api.signals.SendUpdateAvailable(latest.GreaterThan(current), latestStr, url)
 	}()
 }
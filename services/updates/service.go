@@ -4,15 +4,17 @@ import (
 	"github.com/ethereum/go-ethereum/p2p"
 	ethRpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/services/ens"
+	"github.com/status-im/status-go/signal"
 )
 
 // NewService initializes service instance.
-func NewService(ensService *ens.Service) *Service {
-	return &Service{ensService}
+func NewService(ensService *ens.Service, signals signal.Namespace) *Service {
+	return &Service{ensService, signals}
 }
 
 type Service struct {
 	ensService *ens.Service
+	signals    signal.Namespace
 }
 
 // Start a service.
@@ -31,7 +33,7 @@ func (s *Service) APIs() []ethRpc.API {
 		{
 			Namespace: "updates",
 			Version:   "0.1.0",
-			Service:   NewAPI(s.ensService),
+			Service:   NewAPI(s.ensService, s.signals),
 		},
 	}
 }
@@ -493,6 +493,7 @@ func getActivityEntries(ctx context.Context, deps FilterDependencies, addresses
 		FailedAS, CompleteAS, FinalizedAS, PendingAS,
 		includeAllTokenTypeAssets,
 		includeAllCollectibles,
+		filter.FilterOutSpam,
 		includeAllNetworks,
 		transactions.Pending,
 		deps.currentTimestamp(),
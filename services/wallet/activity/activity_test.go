@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/testutils"
 	"github.com/status-im/status-go/services/wallet/transfer"
 	"github.com/status-im/status-go/t/helpers"
@@ -1154,6 +1155,45 @@ func TestGetActivityEntriesFilterByCollectibles(t *testing.T) {
 	require.True(t, (*big.Int)(entries[1].tokenIn.TokenID).Cmp(transfer.TestCollectibles[1].TokenID) == 0)
 }
 
+func TestGetActivityEntriesFilterOutSpam(t *testing.T) {
+	deps, close := setupTestActivityDB(t)
+	defer close()
+
+	// Adds 4 extractable transactions 2 transactions (ETH/Goerli, ETH/Optimism), one MT USDC to DAI and another MT USDC to SNT
+	td, fromTds, toTds := fillTestData(t, deps.db)
+	// Add 4 transactions with collectibles
+	trs, fromTrs, toTrs := transfer.GenerateTestTransfers(t, deps.db, td.nextIndex, 4)
+	for i := range trs {
+		collectibleData := transfer.TestCollectibles[i]
+		trs[i].ChainID = collectibleData.ChainID
+		transfer.InsertTestTransferWithOptions(t, deps.db, trs[i].To, &trs[i], &transfer.TestTransferOptions{
+			TokenAddress: collectibleData.TokenAddress,
+			TokenID:      collectibleData.TokenID,
+		})
+	}
+
+	allAddresses := append(append(append(fromTds, toTds...), fromTrs...), toTrs...)
+
+	spamCollectible := transfer.TestCollectibles[0]
+	err := spam.NewDatabase(deps.db).SetUserStatus(uint64(spamCollectible.ChainID), spamCollectible.TokenAddress, spam.Spam)
+	require.NoError(t, err)
+
+	var filter Filter
+	entries, err := getActivityEntries(context.Background(), deps, allAddresses, true, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 9, len(entries))
+
+	filter.FilterOutSpam = true
+	entries, err = getActivityEntries(context.Background(), deps, allAddresses, true, []common.ChainID{}, filter, 0, 15)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(entries))
+	for _, entry := range entries {
+		if entry.tokenIn != nil {
+			require.NotEqual(t, spamCollectible.TokenAddress, entry.tokenIn.Address)
+		}
+	}
+}
+
 func TestGetActivityEntriesFilterByToAddresses(t *testing.T) {
 	deps, close := setupTestActivityDB(t)
 	defer close()
@@ -98,6 +98,9 @@ type Filter struct {
 	Collectibles          []Token `json:"collectibles"`
 	FilterOutAssets       bool    `json:"filterOutAssets"`
 	FilterOutCollectibles bool    `json:"filterOutCollectibles"`
+
+	// FilterOutSpam hides transfers of tokens and collectibles classified as spam
+	FilterOutSpam bool `json:"filterOutSpam"`
 }
 
 func (f *Filter) IsEmpty() bool {
@@ -109,7 +112,8 @@ func (f *Filter) IsEmpty() bool {
 		len(f.Assets) == 0 &&
 		len(f.Collectibles) == 0 &&
 		!f.FilterOutAssets &&
-		!f.FilterOutCollectibles
+		!f.FilterOutCollectibles &&
+		!f.FilterOutSpam
 }
 
 func GetRecipients(ctx context.Context, db *sql.DB, chainIDs []common.ChainID, addresses []eth.Address, offset int, limit int) (recipients []eth.Address, hasMore bool, err error) {
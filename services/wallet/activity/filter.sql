@@ -39,6 +39,7 @@ WITH filter_conditions AS (
 		? AS statusPending,
 		? AS includeAllTokenTypeAssets,
 		? AS includeAllCollectibles,
+		? AS filterOutSpam,
 		? AS includeAllNetworks,
 		? AS pendingStatus,
 		? AS nowTimestamp,
@@ -337,6 +338,18 @@ WHERE
 			)
 		)
 	)
+	AND (
+		NOT filterOutSpam
+		OR NOT EXISTS (
+			SELECT
+				1
+			FROM
+				spam_contracts_hidden
+			WHERE
+				spam_contracts_hidden.chain_id = transfers.network_id
+				AND spam_contracts_hidden.contract_address = transfers.token_address
+		)
+	)
 	AND (
 		includeAllNetworks
 		OR (transfers.network_id IN filter_networks)
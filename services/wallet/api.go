@@ -20,6 +20,7 @@ import (
 	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/rpc/network"
 	"github.com/status-im/status-go/services/typeddata"
@@ -32,6 +33,9 @@ import (
 	"github.com/status-im/status-go/services/wallet/requests"
 	"github.com/status-im/status-go/services/wallet/router"
 	"github.com/status-im/status-go/services/wallet/router/pathprocessor"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/services/wallet/smartaccount"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
@@ -49,6 +53,7 @@ func NewAPI(s *Service) *API {
 
 	router := router.NewRouter(rpcClient, transactor, tokenManager, s.GetMarketManager(), s.GetCollectiblesService(),
 		s.GetCollectiblesManager(), ensService, stickersService)
+	router.SetSignalsNamespace(s.SignalsNamespace())
 
 	transfer := pathprocessor.NewTransferProcessor(rpcClient, transactor)
 	router.AddPathProcessor(transfer)
@@ -83,6 +88,13 @@ func NewAPI(s *Service) *API {
 	buyStickers := pathprocessor.NewStickersBuyProcessor(rpcClient, transactor, stickersService)
 	router.AddPathProcessor(buyStickers)
 
+	// Transactions of smart accounts are sent as UserOperations by every processor, the ones of Safes are proposed
+	// to their owners
+	for _, processor := range router.GetPathProcessors() {
+		processor = pathprocessor.NewUserOpProcessor(processor, s.GetSmartAccountManager())
+		router.AddPathProcessor(pathprocessor.NewSafeProcessor(processor, s.GetSafeManager()))
+	}
+
 	return &API{s, s.reader, router}
 }
 
@@ -225,11 +237,19 @@ func (api *API) FetchDecodedTxData(ctx context.Context, data string) (*thirdpart
 func (api *API) GetBalanceHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, tokenSymbol string, currencySymbol string, timeInterval history.TimeInterval) ([]*history.ValuePoint, error) {
 	log.Debug("wallet.api.GetBalanceHistory", "chainIDs", chainIDs, "address", addresses, "tokenSymbol", tokenSymbol, "currencySymbol", currencySymbol, "timeInterval", timeInterval)
 
-	var fromTimestamp uint64
 	now := uint64(time.Now().UTC().Unix())
+	fromTimestamp, err := timeIntervalStart(timeInterval, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetBalanceHistoryRange(ctx, chainIDs, addresses, tokenSymbol, currencySymbol, fromTimestamp, now)
+}
+
+func timeIntervalStart(timeInterval history.TimeInterval, now uint64) (uint64, error) {
 	switch timeInterval {
 	case history.BalanceHistoryAllTime:
-		fromTimestamp = 0
+		return 0, nil
 	case history.BalanceHistory1Year:
 		fallthrough
 	case history.BalanceHistory6Months:
@@ -237,12 +257,10 @@ func (api *API) GetBalanceHistory(ctx context.Context, chainIDs []uint64, addres
 	case history.BalanceHistory1Month:
 		fallthrough
 	case history.BalanceHistory7Days:
-		fromTimestamp = now - history.TimeIntervalDurationSecs(timeInterval)
+		return now - history.TimeIntervalDurationSecs(timeInterval), nil
 	default:
-		return nil, fmt.Errorf("unknown time interval: %v", timeInterval)
+		return 0, fmt.Errorf("unknown time interval: %v", timeInterval)
 	}
-
-	return api.GetBalanceHistoryRange(ctx, chainIDs, addresses, tokenSymbol, currencySymbol, fromTimestamp, now)
 }
 
 // GetBalanceHistoryRange retrieves token balance history for token identity on multiple chains for a time range
@@ -259,6 +277,53 @@ func (api *API) GetTokenList(ctx context.Context) (*token.ListWrapper, error) {
 	return rst, nil
 }
 
+func (api *API) GetTokenLists(ctx context.Context) ([]*token.TokenList, error) {
+	log.Debug("call to get token lists")
+	return api.s.tokenManager.GetTokenLists(), nil
+}
+
+func (api *API) SubscribeTokenList(ctx context.Context, url string) (*token.TokenList, error) {
+	log.Debug("call to subscribe token list", "url", url)
+	return api.s.tokenManager.SubscribeTokenList(ctx, url)
+}
+
+func (api *API) UnsubscribeTokenList(ctx context.Context, url string) error {
+	log.Debug("call to unsubscribe token list", "url", url)
+	return api.s.tokenManager.UnsubscribeTokenList(url)
+}
+
+func (api *API) SetTokenListEnabled(ctx context.Context, url string, enabled bool) error {
+	log.Debug("call to set token list enabled", "url", url, "enabled", enabled)
+	return api.s.tokenManager.SetTokenListEnabled(url, enabled)
+}
+
+func (api *API) RefreshTokenLists(ctx context.Context) ([]*token.TokenList, error) {
+	log.Debug("call to refresh token lists")
+	return api.s.tokenManager.RefreshTokenLists(ctx), nil
+}
+
+func (api *API) GetSpamContracts(ctx context.Context) ([]*spam.ContractStatus, error) {
+	log.Debug("call to get spam contracts")
+	return api.s.spamManager.GetFlagged()
+}
+
+// SetSpamStatus lets the user mark a token or collectible contract as spam.Spam or spam.NotSpam,
+// spam.Unknown restores the heuristic classification
+func (api *API) SetSpamStatus(ctx context.Context, chainID wcommon.ChainID, address common.Address, status spam.Status) error {
+	log.Debug("call to set spam status", "chainID", chainID, "address", address, "status", status)
+	return api.s.spamManager.SetUserStatus(uint64(chainID), address, status)
+}
+
+func (api *API) ImportSpamBlockList(ctx context.Context, url string) (int, error) {
+	log.Debug("call to import spam block list", "url", url)
+	return api.s.spamManager.ImportBlockList(ctx, url)
+}
+
+func (api *API) ClearSpamBlockList(ctx context.Context) error {
+	log.Debug("call to clear spam block list")
+	return api.s.spamManager.ClearBlockList()
+}
+
 // @deprecated
 func (api *API) GetTokens(ctx context.Context, chainID uint64) ([]*token.Token, error) {
 	log.Debug("call to get tokens")
@@ -374,6 +439,110 @@ func (api *API) GetCollectibleOwnership(id thirdparty.CollectibleUniqueID) ([]th
 	return api.s.collectiblesManager.GetCollectibleOwnership(id)
 }
 
+// GetCollectiblesValue returns the value of the collectibles owned by the addresses, per chain and address,
+// in the native currency of the chain
+func (api *API) GetCollectiblesValue(ctx context.Context, chainIDs []wcommon.ChainID, addresses []common.Address) ([]collectibles.CollectiblesValue, error) {
+	log.Debug("wallet.api.GetCollectiblesValue", "chainIDs", chainIDs, "addresses", addresses)
+	return api.s.collectiblesManager.GetCollectiblesValue(ctx, chainIDs, addresses)
+}
+
+// GetCollectiblesValueHistory retrieves the value of the collectibles owned by the addresses on multiple chains
+func (api *API) GetCollectiblesValueHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, currencySymbol string, timeInterval history.TimeInterval) ([]*history.ValuePoint, error) {
+	log.Debug("wallet.api.GetCollectiblesValueHistory", "chainIDs", chainIDs, "addresses", addresses, "currencySymbol", currencySymbol, "timeInterval", timeInterval)
+
+	fromTimestamp, err := timeIntervalStart(timeInterval, uint64(time.Now().UTC().Unix()))
+	if err != nil {
+		return nil, err
+	}
+
+	return api.s.history.GetCollectiblesValueHistory(ctx, chainIDs, addresses, currencySymbol, fromTimestamp)
+}
+
+// AddSmartAccount adds a new ERC-4337 smart account owned by the owner wallet account. The account gets the same
+// address on every chain, chainID is the chain it is derived on and it's deployed with its first transaction.
+func (api *API) AddSmartAccount(ctx context.Context, chainID uint64, owner common.Address, name string) (*smartaccount.Account, error) {
+	log.Debug("wallet.api.AddSmartAccount", "chainID", chainID, "owner", owner, "name", name)
+
+	ownerAccount, err := api.s.accountsDB.GetAccountByAddress(types.Address(owner))
+	if err != nil {
+		return nil, err
+	}
+	if !ownerAccount.IsWalletAccountReadyForTransaction() {
+		return nil, smartaccount.ErrOwnerCantSign
+	}
+
+	return api.s.smartAccountManager.AddAccount(ctx, chainID, owner, name)
+}
+
+func (api *API) GetSmartAccounts(ctx context.Context) ([]*smartaccount.Account, error) {
+	log.Debug("wallet.api.GetSmartAccounts")
+	return api.s.smartAccountManager.GetAccounts()
+}
+
+func (api *API) RemoveSmartAccount(ctx context.Context, address common.Address) error {
+	log.Debug("wallet.api.RemoveSmartAccount", "address", address)
+	return api.s.smartAccountManager.DeleteAccount(address)
+}
+
+// GetUserOperationReceipt returns the receipt of a UserOperation sent from a smart account, nil if it's not included yet
+func (api *API) GetUserOperationReceipt(ctx context.Context, chainID uint64, userOpHash common.Hash) (*smartaccount.UserOperationReceipt, error) {
+	log.Debug("wallet.api.GetUserOperationReceipt", "chainID", chainID, "userOpHash", userOpHash)
+	return api.s.smartAccountManager.GetUserOperationReceipt(ctx, chainID, userOpHash)
+}
+
+// AddSafe adds the Safe multisig account deployed at address on the chain. Its owners and threshold are read on chain,
+// transactions sent from it are proposed to the owners.
+func (api *API) AddSafe(ctx context.Context, chainID uint64, address common.Address, name string) (*safe.Safe, error) {
+	log.Debug("wallet.api.AddSafe", "chainID", chainID, "address", address, "name", name)
+	return api.s.safeManager.AddSafe(ctx, chainID, address, name)
+}
+
+// RefreshSafe reads the owners, threshold and nonce of the Safe on chain again
+func (api *API) RefreshSafe(ctx context.Context, chainID uint64, address common.Address) (*safe.Safe, error) {
+	log.Debug("wallet.api.RefreshSafe", "chainID", chainID, "address", address)
+	return api.s.safeManager.RefreshSafe(ctx, chainID, address)
+}
+
+func (api *API) GetSafes(ctx context.Context) ([]*safe.Safe, error) {
+	log.Debug("wallet.api.GetSafes")
+	return api.s.safeManager.GetSafes()
+}
+
+func (api *API) RemoveSafe(ctx context.Context, chainID uint64, address common.Address) error {
+	log.Debug("wallet.api.RemoveSafe", "chainID", chainID, "address", address)
+	return api.s.safeManager.DeleteSafe(chainID, address)
+}
+
+// GetSafeProposals returns the transactions proposed for the Safe, executed ones included
+func (api *API) GetSafeProposals(ctx context.Context, chainID uint64, address common.Address) ([]*safe.Proposal, error) {
+	log.Debug("wallet.api.GetSafeProposals", "chainID", chainID, "address", address)
+	return api.s.safeManager.GetProposals(chainID, address)
+}
+
+// ConfirmSafeProposal signs the proposal with the owner wallet account
+func (api *API) ConfirmSafeProposal(ctx context.Context, safeTxHash common.Hash, owner common.Address, password string) (*safe.Proposal, error) {
+	log.Debug("wallet.api.ConfirmSafeProposal", "safeTxHash", safeTxHash, "owner", owner)
+
+	selectedAccount, err := api.getVerifiedWalletAccount(owner.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.s.safeManager.Confirm(safeTxHash, selectedAccount.AccountKey.PrivateKey)
+}
+
+// ExecuteSafeProposal sends the transaction of a confirmed proposal from the executor wallet account, which pays the gas
+func (api *API) ExecuteSafeProposal(ctx context.Context, safeTxHash common.Hash, executor common.Address, password string) (common.Hash, error) {
+	log.Debug("wallet.api.ExecuteSafeProposal", "safeTxHash", safeTxHash, "executor", executor)
+
+	selectedAccount, err := api.getVerifiedWalletAccount(executor.Hex(), password)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return api.s.safeManager.Execute(ctx, safeTxHash, selectedAccount)
+}
+
 func (api *API) RefetchOwnedCollectibles() error {
 	log.Debug("wallet.api.RefetchOwnedCollectibles")
 
@@ -739,7 +908,13 @@ func (api *API) CreateMultiTransaction(ctx context.Context, multiTransactionComm
 	}
 
 	if password != "" {
-		selectedAccount, err := api.getVerifiedWalletAccount(multiTransactionCommand.FromAddress.Hex(), password)
+		// Smart accounts and Safes are signed for by their owner
+		signer, err := api.accountSigner(multiTransactionCommand.FromAddress, data)
+		if err != nil {
+			return nil, err
+		}
+
+		selectedAccount, err := api.getVerifiedWalletAccount(signer.Hex(), password)
 		if err != nil {
 			return nil, err
 		}
@@ -871,6 +1046,43 @@ func (api *API) FetchChainIDForURL(ctx context.Context, rpcURL string) (*big.Int
 	return client.ChainID(ctx)
 }
 
+// accountSigner returns the owner of address if it's a smart account, the first owner held by the wallet if it's a
+// Safe on the chain of the transactions, address otherwise
+func (api *API) accountSigner(address common.Address, data []*pathprocessor.MultipathProcessorTxArgs) (common.Address, error) {
+	smartAccount, err := api.s.smartAccountManager.GetAccount(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if smartAccount != nil {
+		return smartAccount.Owner, nil
+	}
+
+	if len(data) == 0 {
+		return address, nil
+	}
+	s, err := api.s.safeManager.GetSafe(data[0].ChainID, address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if s == nil {
+		return address, nil
+	}
+
+	for _, owner := range s.Owners {
+		ownerAccount, err := api.s.accountsDB.GetAccountByAddress(types.Address(owner))
+		if err == accounts.ErrDbAccountNotFound {
+			continue
+		}
+		if err != nil {
+			return common.Address{}, err
+		}
+		if ownerAccount.IsWalletAccountReadyForTransaction() {
+			return owner, nil
+		}
+	}
+	return common.Address{}, safe.ErrNotSafeOwner
+}
+
 func (api *API) getVerifiedWalletAccount(address, password string) (*account.SelectedExtKey, error) {
 	exists, err := api.s.accountsDB.AddressExists(types.HexToAddress(address))
 	if err != nil {
@@ -0,0 +1,93 @@
+package collectibles
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+type CollectionPriceDataDB struct {
+	db *sql.DB
+}
+
+func NewCollectionPriceDataDB(sqlDb *sql.DB) *CollectionPriceDataDB {
+	return &CollectionPriceDataDB{
+		db: sqlDb,
+	}
+}
+
+const collectionPriceDataColumns = "chain_id, contract_address, provider, floor_price, last_sale_price"
+
+func (o *CollectionPriceDataDB) SetData(prices []thirdparty.CollectionPriceData, timestamp int64) (err error) {
+	tx, err := o.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	insertPrice, err := tx.Prepare(fmt.Sprintf(`INSERT OR REPLACE INTO collection_price_data_cache (%s, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)`, collectionPriceDataColumns))
+	if err != nil {
+		return err
+	}
+	defer insertPrice.Close()
+
+	for _, p := range prices {
+		_, err = insertPrice.Exec(
+			p.ID.ChainID,
+			p.ID.Address,
+			p.Provider,
+			p.FloorPrice,
+			p.LastSalePrice,
+			timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return
+}
+
+// GetData returns the prices of the collections updated at or after notBefore, keyed by ContractID.HashKey()
+func (o *CollectionPriceDataDB) GetData(ids []thirdparty.ContractID, notBefore int64) (map[string]thirdparty.CollectionPriceData, error) {
+	ret := make(map[string]thirdparty.CollectionPriceData)
+
+	getData, err := o.db.Prepare(fmt.Sprintf(`SELECT %s
+		FROM collection_price_data_cache
+		WHERE chain_id=? AND contract_address=? AND updated_at>=?`, collectionPriceDataColumns))
+	if err != nil {
+		return nil, err
+	}
+	defer getData.Close()
+
+	for _, id := range ids {
+		p := thirdparty.CollectionPriceData{}
+		err := getData.QueryRow(
+			id.ChainID,
+			id.Address,
+			notBefore,
+		).Scan(
+			&p.ID.ChainID,
+			&p.ID.Address,
+			&p.Provider,
+			&p.FloorPrice,
+			&p.LastSalePrice,
+		)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		ret[p.ID.HashKey()] = p
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,73 @@
+package collectibles
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupCollectionPriceDataDBTest(t *testing.T) (*CollectionPriceDataDB, func()) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	return NewCollectionPriceDataDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestCollectionPriceData(t *testing.T) {
+	db, cleanDB := setupCollectionPriceDataDBTest(t)
+	defer cleanDB()
+
+	ids := make([]thirdparty.ContractID, 0, 3)
+	prices := make([]thirdparty.CollectionPriceData, 0, 2)
+	for i := 0; i < 3; i++ {
+		id := thirdparty.ContractID{
+			ChainID: 1,
+			Address: common.BigToAddress(big.NewInt(int64(i))),
+		}
+		ids = append(ids, id)
+		if i < 2 {
+			prices = append(prices, thirdparty.CollectionPriceData{
+				ID:            id,
+				Provider:      "provider",
+				FloorPrice:    float64(i) + 0.5,
+				LastSalePrice: float64(i) + 0.75,
+			})
+		}
+	}
+
+	err := db.SetData(prices[:1], 100)
+	require.NoError(t, err)
+	err = db.SetData(prices[1:], 200)
+	require.NoError(t, err)
+
+	data, err := db.GetData(ids, 0)
+	require.NoError(t, err)
+	require.Len(t, data, 2)
+	for _, p := range prices {
+		require.Equal(t, p, data[p.ID.HashKey()])
+	}
+
+	// Prices updated before the given timestamp are left out
+	data, err = db.GetData(ids, 150)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.Equal(t, prices[1], data[prices[1].ID.HashKey()])
+
+	// Prices are replaced on update
+	prices[0].FloorPrice = 3
+	err = db.SetData(prices[:1], 300)
+	require.NoError(t, err)
+
+	data, err = db.GetData(ids, 250)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.Equal(t, prices[0], data[prices[0].ID.HashKey()])
+}
@@ -44,12 +44,21 @@ const (
 	OnlyCommunity
 )
 
+type FilterSpamType int
+
+const (
+	AllSpamStatuses FilterSpamType = iota
+	OnlyNonSpam
+	OnlySpam
+)
+
 type Filter struct {
 	CollectibleIDs            []thirdparty.CollectibleUniqueID `json:"collectible_ids"`
 	CommunityIDs              []string                         `json:"community_ids"`
 	CommunityPrivilegesLevels []token.PrivilegesLevel          `json:"community_privileges_levels"`
 
 	FilterCommunity FilterCommunityType `json:"filter_community"`
+	FilterSpam      FilterSpamType      `json:"filter_spam"`
 }
 
 func filterOwnedCollectibles(ctx context.Context, db *sql.DB, chainIDs []wcommon.ChainID, addresses []common.Address, filter Filter, offset int, limit int) ([]thirdparty.CollectibleUniqueID, error) {
@@ -67,6 +76,12 @@ func filterOwnedCollectibles(ctx context.Context, db *sql.DB, chainIDs []wcommon
 		ownership.contract_address = data.contract_address AND 
 		ownership.token_id = data.token_id`)
 
+	if filter.FilterSpam != AllSpamStatuses {
+		q = q.LeftJoin(`spam_contracts_hidden spam ON
+		ownership.chain_id = spam.chain_id AND
+		ownership.contract_address = spam.contract_address`)
+	}
+
 	qConditions := sq.And{}
 	qConditions = append(qConditions, sq.Eq{"ownership.chain_id": chainIDs})
 	qConditions = append(qConditions, sq.Eq{"ownership.owner_address": addresses})
@@ -93,6 +108,15 @@ func filterOwnedCollectibles(ctx context.Context, db *sql.DB, chainIDs []wcommon
 		qConditions = append(qConditions, sq.NotEq{"data.community_id": ""})
 	}
 
+	switch filter.FilterSpam {
+	case AllSpamStatuses:
+		// nothing to do
+	case OnlyNonSpam:
+		qConditions = append(qConditions, sq.Eq{"spam.contract_address": nil})
+	case OnlySpam:
+		qConditions = append(qConditions, sq.NotEq{"spam.contract_address": nil})
+	}
+
 	if len(filter.CommunityIDs) > 0 {
 		qConditions = append(qConditions, sq.Eq{"data.community_id": filter.CommunityIDs})
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/status-im/status-go/protocol/communities/token"
 	"github.com/status-im/status-go/services/wallet/bigint"
 	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/t/helpers"
 	"github.com/status-im/status-go/walletdatabase"
@@ -205,6 +206,37 @@ func TestFilterOwnedCollectibles(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedIDs, filterIDs)
 
+	// Test spam
+	tmpIDs, err = oDB.GetOwnedCollectibles(filterChains, filterAddresses, 0, nData)
+	require.NoError(t, err)
+
+	spamContractID := tmpIDs[0].ContractID
+	err = spam.NewDatabase(db).SetUserStatus(uint64(spamContractID.ChainID), spamContractID.Address, spam.Spam)
+	require.NoError(t, err)
+
+	var expectedSpamIDs []thirdparty.CollectibleUniqueID
+	expectedIDs = nil
+	for _, id := range tmpIDs {
+		if id.ContractID == spamContractID {
+			expectedSpamIDs = append(expectedSpamIDs, id)
+		} else {
+			expectedIDs = append(expectedIDs, id)
+		}
+	}
+
+	filter = allFilter()
+	filter.FilterSpam = OnlyNonSpam
+
+	filterIDs, err = filterOwnedCollectibles(ctx, db, filterChains, filterAddresses, filter, 0, nData)
+	require.NoError(t, err)
+	require.Equal(t, expectedIDs, filterIDs)
+
+	filter.FilterSpam = OnlySpam
+
+	filterIDs, err = filterOwnedCollectibles(ctx, db, filterChains, filterAddresses, filter, 0, nData)
+	require.NoError(t, err)
+	require.Equal(t, expectedSpamIDs, filterIDs)
+
 	// Test specific collectible IDs
 	tmpIDs, err = oDB.GetOwnedCollectibles(filterChains, filterAddresses, 0, nData)
 	require.NoError(t, err)
@@ -7,16 +7,16 @@ import (
 	"errors"
 	"math/big"
 	"net/http"
-	"strings"
 	"sync"
 	"time"
 
+	"golang.org/x/exp/maps"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/status-im/status-go/circuitbreaker"
-	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
 	"github.com/status-im/status-go/contracts/ierc1155"
 	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/rpc/chain"
@@ -26,22 +26,18 @@ import (
 	walletCommon "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/community"
 	"github.com/status-im/status-go/services/wallet/connection"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
 	"github.com/status-im/status-go/services/wallet/walletevent"
 )
 
 const requestTimeout = 5 * time.Second
+const collectionPriceTTL = 6 * time.Hour
 const signalUpdatedCollectiblesDataPageSize = 10
 
 const EventCollectiblesConnectionStatusChanged walletevent.EventType = "wallet-collectible-status-changed"
 
-// ERC721 does not support function "TokenURI" if call
-// returns error starting with one of these strings
-var noTokenURIErrorPrefixes = []string{
-	"execution reverted",
-	"abi: attempting to unmarshall",
-}
-
 var (
 	ErrAllProvidersFailedForChainID   = errors.New("all providers failed for chainID")
 	ErrNoProvidersAvailableForChainID = errors.New("no providers available for chainID")
@@ -60,8 +56,10 @@ type Manager struct {
 
 	collectiblesDataDB CollectibleDataStorage
 	collectionsDataDB  CollectionDataStorage
+	collectionPriceDB  *CollectionPriceDataDB
 	communityManager   *community.Manager
 	ownershipDB        *OwnershipDB
+	spamManager        *spam.Manager
 
 	mediaServer *server.MediaServer
 
@@ -77,6 +75,7 @@ func NewManager(
 	communityManager *community.Manager,
 	providers thirdparty.CollectibleProviders,
 	mediaServer *server.MediaServer,
+	spamManager *spam.Manager,
 	feed *event.Feed) *Manager {
 
 	var ownershipDB *OwnershipDB
@@ -104,8 +103,10 @@ func NewManager(
 		},
 		collectiblesDataDB: NewCollectibleDataDB(db),
 		collectionsDataDB:  NewCollectionDataDB(db),
+		collectionPriceDB:  NewCollectionPriceDataDB(db),
 		communityManager:   communityManager,
 		ownershipDB:        ownershipDB,
+		spamManager:        spamManager,
 		mediaServer:        mediaServer,
 		statuses:           statuses,
 		statusNotifier:     statusNotifier,
@@ -545,6 +546,153 @@ func (o *Manager) FetchCollectionsDataByContractID(ctx context.Context, ids []th
 	return mapToList(data), nil
 }
 
+// FetchCollectionsPriceDataByContractID returns the prices of the collections, fetching the ones missing or older than
+// collectionPriceTTL. When fetching fails the outdated prices are returned, if any.
+func (o *Manager) FetchCollectionsPriceDataByContractID(ctx context.Context, ids []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	now := time.Now()
+	cached, err := o.collectionPriceDB.GetData(ids, now.Add(-collectionPriceTTL).Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	missingIDs := make([]thirdparty.ContractID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := cached[id.HashKey()]; !ok {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	missingIDsPerChainID := thirdparty.GroupContractIDsByChainID(missingIDs)
+
+	group := async.NewAtomicGroup(ctx)
+	for chainID, idsToFetch := range missingIDsPerChainID {
+		group.Add(func(ctx context.Context) error {
+			defer o.checkConnectionStatus(chainID)
+
+			cmd := circuitbreaker.NewCommand(ctx, nil)
+			for _, provider := range o.providers.CollectionDataProviders {
+				if !provider.IsChainSupported(chainID) {
+					continue
+				}
+
+				provider := provider
+				cmd.Add(circuitbreaker.NewFunctor(func() ([]any, error) {
+					fetchedPrices, err := provider.FetchCollectionsPriceDataByContractID(ctx, idsToFetch)
+					return []any{fetchedPrices}, err
+				}, getCircuitName(provider, chainID)))
+			}
+
+			if cmd.IsEmpty() {
+				return nil
+			}
+
+			cmdRes := o.circuitBreaker.Execute(cmd)
+			if cmdRes.Error() != nil {
+				// Don't stop the other chains, outdated prices are still used
+				log.Error("FetchCollectionsPriceDataByContractID failed for", "chainID", chainID, "err", cmdRes.Error())
+				return nil
+			}
+
+			fetchedPrices := cmdRes.Result()[0].([]thirdparty.CollectionPriceData)
+			return o.collectionPriceDB.SetData(fetchedPrices, now.Unix())
+		})
+	}
+
+	group.Wait()
+
+	if group.Error() != nil {
+		return nil, group.Error()
+	}
+
+	data, err := o.collectionPriceDB.GetData(ids, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToList(data), nil
+}
+
+// GetCollectiblesValue returns the value of the collectibles owned by the addresses, per chain and address.
+// Collectibles are valued at the floor price of their collection, or at its last sale when there is no floor.
+// Collections marked as spam are left out.
+func (o *Manager) GetCollectiblesValue(ctx context.Context, chainIDs []walletCommon.ChainID, owners []common.Address) ([]CollectiblesValue, error) {
+	ret := make([]CollectiblesValue, 0, len(chainIDs)*len(owners))
+
+	for _, chainID := range chainIDs {
+		balancesPerOwner := make(map[common.Address]map[common.Address]*big.Int, len(owners))
+		contractAddresses := make(map[common.Address]bool)
+		for _, owner := range owners {
+			balances, err := o.ownershipDB.GetBalancesPerContractAddress(chainID, owner)
+			if err != nil {
+				return nil, err
+			}
+			balancesPerOwner[owner] = balances
+
+			for contractAddress := range balances {
+				contractAddresses[contractAddress] = true
+			}
+		}
+
+		if o.spamManager != nil && len(contractAddresses) > 0 {
+			statuses, err := o.spamManager.GetStatuses(uint64(chainID), maps.Keys(contractAddresses))
+			if err != nil {
+				return nil, err
+			}
+			for contractAddress, status := range statuses {
+				if status.Status == spam.Spam {
+					delete(contractAddresses, contractAddress)
+				}
+			}
+		}
+
+		ids := make([]thirdparty.ContractID, 0, len(contractAddresses))
+		for contractAddress := range contractAddresses {
+			ids = append(ids, thirdparty.ContractID{
+				ChainID: chainID,
+				Address: contractAddress,
+			})
+		}
+
+		prices, err := o.FetchCollectionsPriceDataByContractID(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		pricesPerContract := make(map[common.Address]float64, len(prices))
+		for _, p := range prices {
+			price := p.FloorPrice
+			if price == 0 {
+				price = p.LastSalePrice
+			}
+			if price > 0 {
+				pricesPerContract[p.ID.Address] = price
+			}
+		}
+
+		for _, owner := range owners {
+			value := CollectiblesValue{
+				ChainID: chainID,
+				Address: owner,
+			}
+			for contractAddress, balance := range balancesPerOwner[owner] {
+				if !contractAddresses[contractAddress] {
+					continue
+				}
+				price, ok := pricesPerContract[contractAddress]
+				if !ok {
+					value.Unpriced++
+					continue
+				}
+				count, _ := new(big.Float).SetInt(balance).Float64()
+				value.Value += price * count
+			}
+			ret = append(ret, value)
+		}
+	}
+
+	return ret, nil
+}
+
 func (o *Manager) GetCollectibleOwnership(id thirdparty.CollectibleUniqueID) ([]thirdparty.AccountBalance, error) {
 	return o.ownershipDB.GetOwnership(id)
 }
@@ -591,27 +739,7 @@ func (o *Manager) fetchTokenURI(ctx context.Context, id thirdparty.CollectibleUn
 	}
 
 	backend = getClientWithNoCircuitTripping(backend)
-	caller, err := collectibles.NewCollectiblesCaller(id.ContractID.Address, backend)
-
-	if err != nil {
-		return "", err
-	}
-
-	tokenURI, err := caller.TokenURI(&bind.CallOpts{
-		Context: ctx,
-	}, id.TokenID.Int)
-
-	if err != nil {
-		for _, errorPrefix := range noTokenURIErrorPrefixes {
-			if strings.Contains(err.Error(), errorPrefix) {
-				// Contract doesn't support "TokenURI" method
-				return "", nil
-			}
-		}
-		return "", err
-	}
-
-	return tokenURI, err
+	return tokenuri.Fetch(ctx, backend, walletCommon.ContractTypeERC721, id.ContractID.Address, id.TokenID.Int)
 }
 
 func isMetadataEmpty(asset thirdparty.CollectibleData) bool {
@@ -720,6 +848,8 @@ func (o *Manager) processFullCollectibleData(ctx context.Context, assets []third
 		return nil, err
 	}
 
+	o.classifySpam(fullyFetchedAssets)
+
 	if len(missingCollectionIDs) > 0 {
 		// Calling this ensures collection data is fetched and cached (if not already available)
 		_, err := o.FetchCollectionsDataByContractID(ctx, missingCollectionIDs)
@@ -731,6 +861,35 @@ func (o *Manager) processFullCollectibleData(ctx context.Context, assets []third
 	return processedIDs, nil
 }
 
+// classifySpam runs the spam heuristics on the contracts of collectibles, community ones excluded
+func (o *Manager) classifySpam(assets map[string]*thirdparty.FullCollectibleData) {
+	if o.spamManager == nil {
+		return
+	}
+
+	classified := make(map[string]bool)
+	for _, asset := range assets {
+		contractID := asset.CollectibleData.ID.ContractID
+		if asset.CollectibleData.CommunityID != "" || classified[contractID.HashKey()] {
+			continue
+		}
+		classified[contractID.HashKey()] = true
+
+		name := asset.CollectibleData.Name
+		if asset.CollectionData != nil {
+			name = asset.CollectionData.Name + " " + name
+		}
+		_, err := o.spamManager.Classify(spam.Asset{
+			ChainID: uint64(contractID.ChainID),
+			Address: contractID.Address,
+			Name:    name,
+		}, nil)
+		if err != nil {
+			log.Error("failed to classify collectible", "contractID", contractID.HashKey(), "err", err)
+		}
+	}
+}
+
 func (o *Manager) fillTokenURI(ctx context.Context, asset *thirdparty.FullCollectibleData) error {
 	id := asset.CollectibleData.ID
 
@@ -906,6 +1065,15 @@ func (o *Manager) getCacheFullCollectibleData(uniqueIDs []thirdparty.Collectible
 			CollectibleCommunityInfo: collectibleCommunityInfo,
 			Ownership:                ownership,
 		}
+		if o.spamManager != nil {
+			spamStatus, err := o.spamManager.GetStatus(uint64(id.ContractID.ChainID), id.ContractID.Address)
+			if err != nil {
+				return nil, err
+			}
+			if spamStatus != nil {
+				fullData.SpamStatus = spamStatus.Status
+			}
+		}
 		ret = append(ret, fullData)
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/status-go/circuitbreaker"
@@ -18,8 +19,11 @@ import (
 	"github.com/status-im/status-go/services/wallet/bigint"
 	mock_collectibles "github.com/status-im/status-go/services/wallet/collectibles/mock"
 	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	mock_thirdparty "github.com/status-im/status-go/services/wallet/thirdparty/mock"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
 )
 
 type CopyableMockChainClient struct {
@@ -97,7 +101,7 @@ func TestManager_FetchAllAssetsByOwner(t *testing.T) {
 	mockProvider2.EXPECT().IsConnected().Return(true).AnyTimes()
 	mockProvider2.EXPECT().ID().Return(providerID).AnyTimes()
 
-	manager := NewManager(nil, rpcClient, nil, mockProviders, nil, nil)
+	manager := NewManager(nil, rpcClient, nil, mockProviders, nil, nil, nil)
 	manager.statuses = &sync.Map{}
 	collectiblesDataDB := mock_collectibles.NewMockCollectibleDataStorage(mockCtrl)
 	collectiblesDataDB.EXPECT().SetData(gomock.Any(), gomock.Any()).Return(nil)
@@ -116,3 +120,74 @@ func TestManager_FetchAllAssetsByOwner(t *testing.T) {
 	assert.True(t, circuitbreaker.CircuitExists(circuitName))
 	assert.False(t, circuitbreaker.IsCircuitOpen(circuitName))
 }
+
+func TestManager_GetCollectiblesValue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.TODO()
+	chainID := walletCommon.ChainID(1)
+	owner := common.HexToAddress("0x1234567890abcdef")
+	pricedContract := common.HexToAddress("0x01")
+	unpricedContract := common.HexToAddress("0x02")
+	spamContract := common.HexToAddress("0x03")
+
+	balances := thirdparty.TokenBalancesPerContractAddress{
+		pricedContract: {
+			{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+			{TokenID: &bigint.BigInt{Int: big.NewInt(2)}, Balance: &bigint.BigInt{Int: big.NewInt(2)}},
+		},
+		unpricedContract: {
+			{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+		},
+		spamContract: {
+			{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+		},
+	}
+
+	spamManager := spam.NewManager(db, nil)
+	require.NoError(t, spamManager.SetUserStatus(uint64(chainID), spamContract, spam.Spam))
+
+	mockProvider := mock_thirdparty.NewMockCollectionDataProvider(mockCtrl)
+	mockProvider.EXPECT().IsChainSupported(chainID).Return(true).AnyTimes()
+	mockProvider.EXPECT().IsConnected().Return(true).AnyTimes()
+	mockProvider.EXPECT().ID().Return(fmt.Sprintf("circuit_%d", time.Now().Nanosecond())).AnyTimes()
+	mockProvider.EXPECT().FetchCollectionsPriceDataByContractID(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ids []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+			assert.Len(t, ids, 2)
+			return []thirdparty.CollectionPriceData{
+				{ID: thirdparty.ContractID{ChainID: chainID, Address: pricedContract}, FloorPrice: 0.5},
+				{ID: thirdparty.ContractID{ChainID: chainID, Address: unpricedContract}},
+			}, nil
+		}).Times(1)
+
+	mockProviders := thirdparty.CollectibleProviders{
+		CollectionDataProviders: []thirdparty.CollectionDataProvider{mockProvider},
+	}
+
+	manager := NewManager(db, nil, nil, mockProviders, nil, spamManager, nil)
+	_, _, _, err = manager.ownershipDB.Update(chainID, owner, balances, time.Now().Unix())
+	require.NoError(t, err)
+
+	expected := []CollectiblesValue{
+		{
+			ChainID:  chainID,
+			Address:  owner,
+			Value:    1.5,
+			Unpriced: 1,
+		},
+	}
+
+	values, err := manager.GetCollectiblesValue(ctx, []walletCommon.ChainID{chainID}, []common.Address{owner})
+	require.NoError(t, err)
+	assert.Equal(t, expected, values)
+
+	// Cached prices are used until they expire
+	values, err = manager.GetCollectiblesValue(ctx, []walletCommon.ChainID{chainID}, []common.Address{owner})
+	require.NoError(t, err)
+	assert.Equal(t, expected, values)
+}
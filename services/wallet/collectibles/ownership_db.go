@@ -596,6 +596,43 @@ func (o *OwnershipDB) GetOwnership(id thirdparty.CollectibleUniqueID) ([]thirdpa
 	return ret, nil
 }
 
+// GetBalancesPerContractAddress returns the number of collectibles of each collection owned by an address
+func (o *OwnershipDB) GetBalancesPerContractAddress(chainID w_common.ChainID, ownerAddress common.Address) (map[common.Address]*big.Int, error) {
+	stmt, err := o.db.Prepare(`SELECT contract_address, balance
+		FROM collectibles_ownership_cache
+		WHERE chain_id = ? AND owner_address = ?`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query(chainID, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make(map[common.Address]*big.Int)
+	for rows.Next() {
+		var contractAddress common.Address
+		balance := big.NewInt(0)
+		err = rows.Scan(
+			&contractAddress,
+			(*bigint.SQLBigIntBytes)(balance),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := ret[contractAddress]; !ok {
+			ret[contractAddress] = big.NewInt(0)
+		}
+		ret[contractAddress].Add(ret[contractAddress], balance)
+	}
+
+	return ret, rows.Err()
+}
+
 func (o *OwnershipDB) SetTransferID(ownerAddress common.Address, id thirdparty.CollectibleUniqueID, transferID common.Hash) (bool, error) {
 	query := `UPDATE collectibles_ownership_cache
 		SET transfer_id = ?
@@ -1,8 +1,11 @@
 package collectibles
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/status-im/status-go/protocol/communities/token"
 	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 )
 
@@ -18,6 +21,7 @@ type Collectible struct {
 	IsFirst         bool                           `json:"is_first,omitempty"`
 	LatestTxHash    string                         `json:"latest_tx_hash,omitempty"`
 	ReceivedAmount  float64                        `json:"received_amount,omitempty"`
+	SpamStatus      spam.Status                    `json:"spam_status,omitempty"`
 }
 
 type CollectibleData struct {
@@ -51,6 +55,15 @@ type CommunityData struct {
 	ImageURL        *string               `json:"image_url,omitempty"`
 }
 
+// Value of the collectibles of an account, in the native currency of the chain. Collections with no
+// known price don't add to the value and are counted as unpriced.
+type CollectiblesValue struct {
+	ChainID  w_common.ChainID `json:"chain_id"`
+	Address  common.Address   `json:"address"`
+	Value    float64          `json:"value"`
+	Unpriced int              `json:"unpriced"`
+}
+
 func idToCollectible(id thirdparty.CollectibleUniqueID) Collectible {
 	ret := Collectible{
 		DataType: CollectibleDataTypeUniqueID,
@@ -119,6 +132,7 @@ func fullCollectibleDataToHeader(c thirdparty.FullCollectibleData) Collectible {
 		ret.CommunityData = &communityData
 	}
 	ret.Ownership = c.Ownership
+	ret.SpamStatus = c.SpamStatus
 	return ret
 }
 
@@ -156,6 +170,7 @@ func fullCollectibleDataToDetails(c thirdparty.FullCollectibleData) Collectible
 		ret.CommunityData = &communityData
 	}
 	ret.Ownership = c.Ownership
+	ret.SpamStatus = c.SpamStatus
 	return ret
 }
 
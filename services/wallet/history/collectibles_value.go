@@ -0,0 +1,153 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/collectibles"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+)
+
+// Collection prices are cached for as long, there is no point in taking snapshots more often
+const collectiblesValueSnapshotInterval = 6 * time.Hour
+
+// CollectiblesValueSource values the collectibles owned by accounts, implemented by collectibles.Manager
+type CollectiblesValueSource interface {
+	GetCollectiblesValue(ctx context.Context, chainIDs []walletCommon.ChainID, owners []common.Address) ([]collectibles.CollectiblesValue, error)
+}
+
+func (s *Service) snapshotCollectiblesValueLoop(ctx context.Context) {
+	ticker := time.NewTicker(collectiblesValueSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		err := s.snapshotCollectiblesValue(ctx)
+		if err != nil {
+			log.Error("Error taking collectibles value snapshot", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// snapshotCollectiblesValue stores the current value of the collectibles of all wallet accounts.
+// All values of a snapshot share the same timestamp so they can be summed up.
+func (s *Service) snapshotCollectiblesValue(ctx context.Context) error {
+	addresses, err := s.accountsDB.GetWalletAddresses()
+	if err != nil {
+		return err
+	}
+
+	areTestNetworksEnabled, err := s.accountsDB.GetTestNetworksEnabled()
+	if err != nil {
+		return err
+	}
+
+	onlyEnabledNetworks := false
+	networks, err := s.networkManager.Get(onlyEnabledNetworks)
+	if err != nil {
+		return err
+	}
+
+	chainIDs := make([]walletCommon.ChainID, 0, len(networks))
+	for _, network := range networks {
+		if network.IsTest != areTestNetworksEnabled {
+			continue
+		}
+		chainIDs = append(chainIDs, walletCommon.ChainID(network.ChainID))
+	}
+
+	owners := make([]common.Address, 0, len(addresses))
+	for _, address := range addresses {
+		owners = append(owners, common.Address(address))
+	}
+
+	if len(chainIDs) == 0 || len(owners) == 0 {
+		return nil
+	}
+
+	values, err := s.collectiblesValue.GetCollectiblesValue(ctx, chainIDs, owners)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now().UTC().Unix()
+	for _, value := range values {
+		err = s.collectiblesValueDB.add(uint64(value.ChainID), value.Address, timestamp, value.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCollectiblesValueHistory returns the value of the collectibles of the addresses over time, converted to
+// currencySymbol with the exchange rate of the native currency of each chain for the day of the snapshot
+func (s *Service) GetCollectiblesValueHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, currencySymbol string, fromTimestamp uint64) ([]*ValuePoint, error) {
+	log.Debug("GetCollectiblesValueHistory", "chainIDs", chainIDs, "address", addresses, "currencySymbol", currencySymbol, "fromTimestamp", fromTimestamp)
+
+	if len(chainIDs) == 0 || len(addresses) == 0 {
+		return make([]*ValuePoint, 0), nil
+	}
+
+	entries, err := s.collectiblesValueDB.getNewerThan(chainIDs, addresses, int64(fromTimestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := time.Now().UTC()
+	currentDayStart := time.Date(currentTime.Year(), currentTime.Month(), currentTime.Day(), 0, 0, 0, 0, time.UTC)
+	fetchedRates := make(map[string]bool)
+
+	res := make([]*ValuePoint, 0)
+	for _, entry := range entries {
+		network := s.networkManager.Find(entry.chainID)
+		if network == nil {
+			log.Warn("Network not found", "chainID", entry.chainID)
+			continue
+		}
+		tokenSymbol := network.NativeCurrencySymbol
+
+		dayTime := time.Unix(entry.timestamp, 0).UTC()
+		if dayTime.After(currentDayStart) {
+			// No chance to have today, use the previous day value
+			dayTime = dayTime.AddDate(0, 0, -1)
+		}
+
+		dayValue, err := s.exchange.GetExchangeRateForDay(tokenSymbol, currencySymbol, dayTime)
+		if err != nil && !fetchedRates[tokenSymbol] {
+			fetchedRates[tokenSymbol] = true
+			err = s.exchange.FetchAndCacheMissingRates(tokenSymbol, currencySymbol)
+			if err != nil {
+				log.Error("Error fetching exchange rates", "tokenSymbol", tokenSymbol, "currencySymbol", currencySymbol, "err", err)
+				return nil, err
+			}
+			dayValue, err = s.exchange.GetExchangeRateForDay(tokenSymbol, currencySymbol, dayTime)
+		}
+		if err != nil {
+			log.Warn("Exchange rate missing for", "dayTime", dayTime, "err", err)
+			continue
+		}
+
+		// Entries are ordered by timestamp, values of the chains of a snapshot are summed up
+		value := entry.value * float64(dayValue)
+		if len(res) > 0 && res[len(res)-1].Timestamp == uint64(entry.timestamp) {
+			res[len(res)-1].Value += value
+		} else {
+			res = append(res, &ValuePoint{
+				Timestamp: uint64(entry.timestamp),
+				Value:     value,
+			})
+		}
+	}
+
+	return res, nil
+}
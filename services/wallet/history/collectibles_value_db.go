@@ -0,0 +1,68 @@
+package history
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type CollectiblesValueDB struct {
+	db *sql.DB
+}
+
+func NewCollectiblesValueDB(sqlDb *sql.DB) *CollectiblesValueDB {
+	return &CollectiblesValueDB{
+		db: sqlDb,
+	}
+}
+
+// collectiblesValueEntry is the value of the collectibles of a set of accounts on a chain at a given time,
+// in the native currency of the chain
+type collectiblesValueEntry struct {
+	chainID   uint64
+	timestamp int64
+	value     float64
+}
+
+func (c *CollectiblesValueDB) add(chainID uint64, address common.Address, timestamp int64, value float64) error {
+	_, err := c.db.Exec(`INSERT OR REPLACE INTO collectibles_value_history (chain_id, address, timestamp, value)
+		VALUES (?, ?, ?, ?)`, chainID, address, timestamp, value)
+	return err
+}
+
+// getNewerThan returns the values summed over the addresses, per chain and timestamp, ordered by timestamp
+func (c *CollectiblesValueDB) getNewerThan(chainIDs []uint64, addresses []common.Address, fromTimestamp int64) ([]*collectiblesValueEntry, error) {
+	query, args, err := sqlx.In(`SELECT chain_id, timestamp, SUM(value)
+		FROM collectibles_value_history
+		WHERE chain_id IN (?) AND address IN (?) AND timestamp >= ?
+		GROUP BY chain_id, timestamp
+		ORDER BY timestamp ASC`, chainIDs, addresses, fromTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*collectiblesValueEntry, 0)
+	for rows.Next() {
+		entry := &collectiblesValueEntry{}
+		err := rows.Scan(&entry.chainID, &entry.timestamp, &entry.value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+
+	return result, rows.Err()
+}
+
+func (c *CollectiblesValueDB) removeCollectiblesValueHistory(address common.Address) error {
+	_, err := c.db.Exec("DELETE FROM collectibles_value_history WHERE address = ?", address)
+	return err
+}
@@ -0,0 +1,145 @@
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/wallet/collectibles"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/transactions/fake"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+type testCollectiblesValueSource struct {
+	values map[common.Address]float64
+}
+
+func (s *testCollectiblesValueSource) GetCollectiblesValue(_ context.Context, chainIDs []walletCommon.ChainID, owners []common.Address) ([]collectibles.CollectiblesValue, error) {
+	ret := make([]collectibles.CollectiblesValue, 0)
+	for _, chainID := range chainIDs {
+		for _, owner := range owners {
+			ret = append(ret, collectibles.CollectiblesValue{
+				ChainID: chainID,
+				Address: owner,
+				Value:   s.values[owner],
+			})
+		}
+	}
+	return ret, nil
+}
+
+func setupCollectiblesValueTest(t *testing.T, source CollectiblesValueSource) (*Service, *accounts.Database) {
+	appDB, err := helpers.SetupTestMemorySQLDB(appdatabase.DbInitializer{})
+	require.NoError(t, err)
+
+	walletDB, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+
+	accountsDB, err := accounts.NewDB(appDB)
+	require.NoError(t, err)
+
+	chainID := uint64(1)
+	networks := []params.Network{
+		{
+			ChainID:              chainID,
+			NativeCurrencySymbol: "ETH",
+			Enabled:              true,
+		},
+	}
+	txServiceMockCtrl := gomock.NewController(t)
+	server, _ := fake.NewTestServer(txServiceMockCtrl)
+	client := gethrpc.DialInProc(server)
+	rpcClient, err := rpc.NewClient(client, chainID, params.UpstreamRPCConfig{}, networks, appDB, nil)
+	require.NoError(t, err)
+
+	service := NewService(walletDB, accountsDB, &event.Feed{}, &event.Feed{}, rpcClient, nil, nil, nil, source)
+
+	// Rates are cached for the current and previous years, 2000 USD per ETH
+	now := time.Now().UTC()
+	service.exchange.cache["ETH"] = map[currencyType]map[yearType][]float32{
+		"USD": {},
+	}
+	for _, year := range []int{now.Year() - 1, now.Year()} {
+		days := make([]float32, 367)
+		for i := range days {
+			days[i] = 2000
+		}
+		service.exchange.cache["ETH"]["USD"][year] = days
+	}
+
+	return service, accountsDB
+}
+
+func TestSnapshotCollectiblesValue(t *testing.T) {
+	address1 := common.Address{0x1}
+	address2 := common.Address{0x2}
+	source := &testCollectiblesValueSource{
+		values: map[common.Address]float64{
+			address1: 0.5,
+			address2: 1.5,
+		},
+	}
+	service, accountsDB := setupCollectiblesValueTest(t, source)
+
+	err := accountsDB.SaveOrUpdateAccounts([]*accounts.Account{
+		{Address: types.Address(address1), Chat: false, Wallet: true},
+		{Address: types.Address(address2), Chat: false, Wallet: true},
+	}, false)
+	require.NoError(t, err)
+
+	err = service.snapshotCollectiblesValue(context.Background())
+	require.NoError(t, err)
+
+	points, err := service.GetCollectiblesValueHistory(context.Background(), []uint64{1}, []common.Address{address1, address2}, "USD", 0)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	require.Equal(t, float64(4000), points[0].Value)
+
+	points, err = service.GetCollectiblesValueHistory(context.Background(), []uint64{1}, []common.Address{address2}, "USD", 0)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	require.Equal(t, float64(3000), points[0].Value)
+
+	// Older snapshots are left out
+	points, err = service.GetCollectiblesValueHistory(context.Background(), []uint64{1}, []common.Address{address1, address2}, "USD", points[0].Timestamp+1)
+	require.NoError(t, err)
+	require.Len(t, points, 0)
+}
+
+func TestGetCollectiblesValueHistory(t *testing.T) {
+	service, _ := setupCollectiblesValueTest(t, nil)
+	address := common.Address{0x1}
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Unix()
+	lastWeek := time.Now().UTC().AddDate(0, 0, -7).Unix()
+
+	require.NoError(t, service.collectiblesValueDB.add(1, address, lastWeek, 1))
+	require.NoError(t, service.collectiblesValueDB.add(1, address, yesterday, 2))
+	// Unknown networks are skipped
+	require.NoError(t, service.collectiblesValueDB.add(2, address, yesterday, 3))
+
+	points, err := service.GetCollectiblesValueHistory(context.Background(), []uint64{1, 2}, []common.Address{address}, "USD", 0)
+	require.NoError(t, err)
+	require.Equal(t, []*ValuePoint{
+		{Timestamp: uint64(lastWeek), Value: 2000},
+		{Timestamp: uint64(yesterday), Value: 4000},
+	}, points)
+
+	require.NoError(t, service.collectiblesValueDB.removeCollectiblesValueHistory(address))
+	points, err = service.GetCollectiblesValueHistory(context.Background(), []uint64{1, 2}, []common.Address{address}, "USD", 0)
+	require.NoError(t, err)
+	require.Len(t, points, 0)
+}
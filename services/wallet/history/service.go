@@ -64,20 +64,25 @@ type Service struct {
 	accWatcher      *accountsevent.Watcher
 	exchange        *Exchange
 	balanceCache    balance.CacheIface
+
+	collectiblesValue   CollectiblesValueSource
+	collectiblesValueDB *CollectiblesValueDB
 }
 
-func NewService(db *sql.DB, accountsDB *accounts.Database, accountFeed *event.Feed, eventFeed *event.Feed, rpcClient *statusrpc.Client, tokenManager *token.Manager, marketManager *market.Manager, balanceCache balance.CacheIface) *Service {
+func NewService(db *sql.DB, accountsDB *accounts.Database, accountFeed *event.Feed, eventFeed *event.Feed, rpcClient *statusrpc.Client, tokenManager *token.Manager, marketManager *market.Manager, balanceCache balance.CacheIface, collectiblesValue CollectiblesValueSource) *Service {
 	return &Service{
-		balance:        NewBalance(NewBalanceDB(db)),
-		db:             db,
-		accountsDB:     accountsDB,
-		accountFeed:    accountFeed,
-		eventFeed:      eventFeed,
-		rpcClient:      rpcClient,
-		networkManager: rpcClient.NetworkManager,
-		tokenManager:   tokenManager,
-		exchange:       NewExchange(marketManager),
-		balanceCache:   balanceCache,
+		balance:             NewBalance(NewBalanceDB(db)),
+		db:                  db,
+		accountsDB:          accountsDB,
+		accountFeed:         accountFeed,
+		eventFeed:           eventFeed,
+		rpcClient:           rpcClient,
+		networkManager:      rpcClient.NetworkManager,
+		tokenManager:        tokenManager,
+		exchange:            NewExchange(marketManager),
+		balanceCache:        balanceCache,
+		collectiblesValue:   collectiblesValue,
+		collectiblesValueDB: NewCollectiblesValueDB(db),
 	}
 }
 
@@ -109,6 +114,10 @@ func (s *Service) Start() {
 	go func() {
 		s.serviceContext, s.cancelFn = context.WithCancel(context.Background())
 
+		if s.collectiblesValue != nil {
+			go s.snapshotCollectiblesValueLoop(s.serviceContext)
+		}
+
 		err := s.updateBalanceHistory(s.serviceContext)
 		if s.serviceContext.Err() != nil {
 			s.triggerEvent(EventBalanceHistoryUpdateFinished, statustypes.Address{}, "Service canceled")
@@ -588,6 +597,11 @@ func (s *Service) onAccountsChanged(changedAddresses []common.Address, eventType
 			if err != nil {
 				log.Error("Error removing balance history", "address", address, "err", err)
 			}
+
+			err = s.collectiblesValueDB.removeCollectiblesValueHistory(address)
+			if err != nil {
+				log.Error("Error removing collectibles value history", "address", address, "err", err)
+			}
 		}
 	}
 }
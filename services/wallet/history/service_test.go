@@ -408,7 +408,7 @@ func Test_removeBalanceHistoryOnEventAccountRemoved(t *testing.T) {
 	rpcClient, _ := rpc.NewClient(client, chainID, params.UpstreamRPCConfig{}, nil, appDB, nil)
 	rpcClient.UpstreamChainID = chainID
 
-	service := NewService(walletDB, accountsDB, &accountFeed, &walletFeed, rpcClient, nil, nil, nil)
+	service := NewService(walletDB, accountsDB, &accountFeed, &walletFeed, rpcClient, nil, nil, nil, nil)
 
 	// Insert balances for address
 	database := service.balance.db
@@ -538,6 +538,10 @@ func (r *Reader) GetWalletToken(ctx context.Context, clients map[uint64]chain.Cl
 				}
 			}
 
+			if !tok.Verified && tok.CommunityData == nil && r.marketManager.IsConnected {
+				r.tokenManager.ClassifySpam(&result[address][index].Token, false, len(marketValuesPerCurrency) == 0)
+			}
+
 			if _, ok := tokenDetails[tok.Symbol]; !ok {
 				continue
 			}
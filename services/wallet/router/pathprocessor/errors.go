@@ -47,6 +47,12 @@ var (
 	ErrPriceTimeout                   = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-037"), Details: "price timeout"}
 	ErrNotEnoughLiquidity             = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-038"), Details: "not enough liquidity"}
 	ErrPriceImpactTooHigh             = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-039"), Details: "price impact too high"}
+	ErrSmartAccountKeycardSigning     = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-040"), Details: "smart accounts can't sign with keycard"}
+	ErrSmartAccountContractCreation   = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-041"), Details: "smart accounts can't create contracts"}
+	ErrSmartAccountNotOwner           = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-042"), Details: "account doesn't own the smart account"}
+	ErrSafeKeycardSigning             = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-043"), Details: "safe proposals can't be signed with keycard"}
+	ErrSafeContractCreation           = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-044"), Details: "safes can't create contracts"}
+	ErrSafeNotOwner                   = &errors.ErrorResponse{Code: errors.ErrorCode("WPP-045"), Details: "account is not an owner of the safe"}
 )
 
 func createErrorResponse(processorName string, err error) error {
@@ -45,6 +45,8 @@ func (t *MultipathProcessorTxArgs) From() types.Address {
 		return t.ERC721TransferTx.From
 	} else if t.ERC1155TransferTx != nil {
 		return t.ERC1155TransferTx.From
+	} else if t.SwapTx != nil {
+		return t.SwapTx.From
 	}
 
 	return types.HexToAddress("0x0")
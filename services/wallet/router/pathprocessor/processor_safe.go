@@ -0,0 +1,115 @@
+package pathprocessor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/safe"
+)
+
+// Safes proposes the transactions of Safe multisig accounts to their owners, implemented by safe.Manager
+type Safes interface {
+	// GetSafe returns nil if address is not a Safe on the chain
+	GetSafe(chainID uint64, address common.Address) (*safe.Safe, error)
+	GasOverhead(chainID uint64, address common.Address) (uint64, error)
+	Propose(ctx context.Context, chainID uint64, address common.Address, to common.Address, value *big.Int, data []byte,
+		key *ecdsa.PrivateKey, minNonce *big.Int) (*safe.Proposal, error)
+}
+
+// SafeProcessor wraps a PathProcessor so that its transactions become Safe proposals when they are sent from a
+// Safe, signed by one of its owners. They are executed once the other owners confirm them. Transactions of other
+// accounts go through the wrapped processor.
+type SafeProcessor struct {
+	PathProcessor
+	safes Safes
+}
+
+func NewSafeProcessor(processor PathProcessor, safes Safes) *SafeProcessor {
+	return &SafeProcessor{
+		PathProcessor: processor,
+		safes:         safes,
+	}
+}
+
+func (s *SafeProcessor) Clear() {
+	if clearable, ok := s.PathProcessor.(PathProcessorClearable); ok {
+		clearable.Clear()
+	}
+}
+
+func (s *SafeProcessor) EstimateGas(params ProcessorInputParams) (uint64, error) {
+	estimation, err := s.PathProcessor.EstimateGas(params)
+	if err != nil || params.TestsMode {
+		return estimation, err
+	}
+
+	safe, err := s.safes.GetSafe(params.FromChain.ChainID, params.FromAddr)
+	if err != nil {
+		return 0, createErrorResponse(s.Name(), err)
+	}
+	if safe == nil {
+		return estimation, nil
+	}
+
+	overhead, err := s.safes.GasOverhead(safe.ChainID, safe.Address)
+	if err != nil {
+		return 0, createErrorResponse(s.Name(), err)
+	}
+
+	return estimation + overhead, nil
+}
+
+// Send proposes the transaction to the owners of the Safe. The hash returned is the safeTxHash of the proposal
+// and the nonce is the nonce of the Safe.
+func (s *SafeProcessor) Send(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64, verifiedAccount *account.SelectedExtKey) (types.Hash, uint64, error) {
+	safe, err := s.safes.GetSafe(sendArgs.ChainID, common.Address(sendArgs.From()))
+	if err != nil {
+		return types.Hash{}, 0, createErrorResponse(s.Name(), err)
+	}
+	if safe == nil {
+		return s.PathProcessor.Send(sendArgs, lastUsedNonce, verifiedAccount)
+	}
+
+	if !safe.IsOwner(common.Address(verifiedAccount.Address)) {
+		return types.Hash{}, 0, ErrSafeNotOwner
+	}
+
+	// The transaction the wrapped processor would send becomes the call of the Safe
+	tx, _, err := s.PathProcessor.BuildTransaction(sendArgs, -1)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	if tx.To() == nil {
+		return types.Hash{}, 0, ErrSafeContractCreation
+	}
+
+	var minNonce *big.Int
+	if lastUsedNonce >= 0 {
+		minNonce = big.NewInt(lastUsedNonce + 1)
+	}
+
+	proposal, err := s.safes.Propose(context.Background(), sendArgs.ChainID, safe.Address, *tx.To(), tx.Value(), tx.Data(),
+		verifiedAccount.AccountKey.PrivateKey, minNonce)
+	if err != nil {
+		return types.Hash{}, 0, createErrorResponse(s.Name(), err)
+	}
+
+	return types.Hash(proposal.SafeTxHash), proposal.Transaction.Nonce, nil
+}
+
+func (s *SafeProcessor) BuildTransaction(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64) (*ethTypes.Transaction, uint64, error) {
+	safe, err := s.safes.GetSafe(sendArgs.ChainID, common.Address(sendArgs.From()))
+	if err != nil {
+		return nil, 0, createErrorResponse(s.Name(), err)
+	}
+	if safe != nil {
+		return nil, 0, ErrSafeKeycardSigning
+	}
+
+	return s.PathProcessor.BuildTransaction(sendArgs, lastUsedNonce)
+}
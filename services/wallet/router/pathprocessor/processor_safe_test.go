@@ -0,0 +1,109 @@
+package pathprocessor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/transactions"
+)
+
+type testSafes struct {
+	safes    map[common.Address]*safe.Safe
+	proposed *safe.Transaction
+	minNonce *big.Int
+}
+
+func (s *testSafes) GetSafe(chainID uint64, address common.Address) (*safe.Safe, error) {
+	return s.safes[address], nil
+}
+
+func (s *testSafes) GasOverhead(chainID uint64, address common.Address) (uint64, error) {
+	return 70000, nil
+}
+
+func (s *testSafes) Propose(ctx context.Context, chainID uint64, address common.Address, to common.Address, value *big.Int, data []byte,
+	key *ecdsa.PrivateKey, minNonce *big.Int) (*safe.Proposal, error) {
+	nonce := uint64(4)
+	if minNonce != nil {
+		nonce = minNonce.Uint64()
+	}
+	s.proposed = safe.NewTransaction(to, value, data, nonce)
+	s.minNonce = minNonce
+	return &safe.Proposal{SafeTxHash: common.Hash{0x03}, ChainID: chainID, Safe: address, Transaction: s.proposed}, nil
+}
+
+func TestSafeProcessor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	verifiedAccount := &account.SelectedExtKey{Address: owner, AccountKey: &types.Key{PrivateKey: key}}
+
+	safeAddress := common.HexToAddress("0x1234")
+	safes := &testSafes{safes: map[common.Address]*safe.Safe{
+		safeAddress: {ChainID: mainnet.ChainID, Address: safeAddress, Owners: []common.Address{common.Address(owner)}, Threshold: 2},
+	}}
+	inner := &testInnerProcessor{}
+	processor := NewSafeProcessor(inner, safes)
+	require.Equal(t, ProcessorTransferName, processor.Name())
+
+	newSendArgs := func(from types.Address) *MultipathProcessorTxArgs {
+		to := types.HexToAddress("0x5678")
+		value := (*hexutil.Big)(big.NewInt(1000))
+		return &MultipathProcessorTxArgs{
+			Name:       ProcessorTransferName,
+			ChainID:    mainnet.ChainID,
+			TransferTx: &transactions.SendTxArgs{From: from, To: &to, Value: value},
+		}
+	}
+
+	// Accounts that are not Safes go through the wrapped processor
+	estimation, err := processor.EstimateGas(ProcessorInputParams{FromChain: &mainnet, FromAddr: common.Address(owner)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), estimation)
+
+	hash, nonce, err := processor.Send(newSendArgs(owner), 2, verifiedAccount)
+	require.NoError(t, err)
+	require.True(t, inner.sent)
+	require.Equal(t, types.Hash{0x01}, hash)
+	require.Equal(t, uint64(3), nonce)
+
+	// Safes propose the transaction of the wrapped processor to their owners
+	estimation, err = processor.EstimateGas(ProcessorInputParams{FromChain: &mainnet, FromAddr: safeAddress})
+	require.NoError(t, err)
+	require.Equal(t, uint64(91000), estimation)
+
+	inner.sent = false
+	hash, nonce, err = processor.Send(newSendArgs(types.Address(safeAddress)), -1, verifiedAccount)
+	require.NoError(t, err)
+	require.False(t, inner.sent)
+	require.Equal(t, types.Hash{0x03}, hash)
+	require.Equal(t, uint64(4), nonce)
+	require.Nil(t, safes.minNonce)
+	require.Equal(t, common.HexToAddress("0x5678"), safes.proposed.To)
+	require.Equal(t, big.NewInt(1000), safes.proposed.Value.ToInt())
+
+	_, nonce, err = processor.Send(newSendArgs(types.Address(safeAddress)), 4, verifiedAccount)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), safes.minNonce)
+	require.Equal(t, uint64(5), nonce)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherAccount := &account.SelectedExtKey{Address: crypto.PubkeyToAddress(otherKey.PublicKey), AccountKey: &types.Key{PrivateKey: otherKey}}
+	_, _, err = processor.Send(newSendArgs(types.Address(safeAddress)), -1, otherAccount)
+	require.ErrorIs(t, err, ErrSafeNotOwner)
+
+	// Safe proposals can't be signed with keycard
+	_, _, err = processor.BuildTransaction(newSendArgs(types.Address(safeAddress)), -1)
+	require.ErrorIs(t, err, ErrSafeKeycardSigning)
+}
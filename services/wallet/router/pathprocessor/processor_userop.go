@@ -0,0 +1,112 @@
+package pathprocessor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/smartaccount"
+)
+
+// SmartAccounts sends the transactions of smart accounts as UserOperations, implemented by smartaccount.Manager
+type SmartAccounts interface {
+	// GetAccount returns nil if address is not a smart account
+	GetAccount(address common.Address) (*smartaccount.Account, error)
+	GasOverhead(ctx context.Context, chainID uint64, address common.Address) (uint64, error)
+	SendCalls(ctx context.Context, chainID uint64, address common.Address, calls []smartaccount.Call, key *ecdsa.PrivateKey, minNonce *big.Int) (common.Hash, *big.Int, error)
+}
+
+// UserOpProcessor wraps a PathProcessor so that its transactions are sent as UserOperations when they are sent
+// from a smart account, with the key of its owner. Transactions of other accounts go through the wrapped processor.
+type UserOpProcessor struct {
+	PathProcessor
+	smartAccounts SmartAccounts
+}
+
+func NewUserOpProcessor(processor PathProcessor, smartAccounts SmartAccounts) *UserOpProcessor {
+	return &UserOpProcessor{
+		PathProcessor: processor,
+		smartAccounts: smartAccounts,
+	}
+}
+
+func (s *UserOpProcessor) Clear() {
+	if clearable, ok := s.PathProcessor.(PathProcessorClearable); ok {
+		clearable.Clear()
+	}
+}
+
+func (s *UserOpProcessor) EstimateGas(params ProcessorInputParams) (uint64, error) {
+	estimation, err := s.PathProcessor.EstimateGas(params)
+	if err != nil || params.TestsMode {
+		return estimation, err
+	}
+
+	smartAccount, err := s.smartAccounts.GetAccount(params.FromAddr)
+	if err != nil {
+		return 0, createErrorResponse(s.Name(), err)
+	}
+	if smartAccount == nil {
+		return estimation, nil
+	}
+
+	overhead, err := s.smartAccounts.GasOverhead(context.Background(), params.FromChain.ChainID, smartAccount.Address)
+	if err != nil {
+		return 0, createErrorResponse(s.Name(), err)
+	}
+
+	return estimation + overhead, nil
+}
+
+func (s *UserOpProcessor) Send(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64, verifiedAccount *account.SelectedExtKey) (types.Hash, uint64, error) {
+	smartAccount, err := s.smartAccounts.GetAccount(common.Address(sendArgs.From()))
+	if err != nil {
+		return types.Hash{}, 0, createErrorResponse(s.Name(), err)
+	}
+	if smartAccount == nil {
+		return s.PathProcessor.Send(sendArgs, lastUsedNonce, verifiedAccount)
+	}
+
+	if common.Address(verifiedAccount.Address) != smartAccount.Owner {
+		return types.Hash{}, 0, ErrSmartAccountNotOwner
+	}
+
+	// The transaction the wrapped processor would send becomes the call of the smart account
+	tx, _, err := s.PathProcessor.BuildTransaction(sendArgs, -1)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	if tx.To() == nil {
+		return types.Hash{}, 0, ErrSmartAccountContractCreation
+	}
+
+	var minNonce *big.Int
+	if lastUsedNonce >= 0 {
+		minNonce = big.NewInt(lastUsedNonce + 1)
+	}
+
+	calls := []smartaccount.Call{{To: *tx.To(), Value: tx.Value(), Data: tx.Data()}}
+	hash, nonce, err := s.smartAccounts.SendCalls(context.Background(), sendArgs.ChainID, smartAccount.Address, calls,
+		verifiedAccount.AccountKey.PrivateKey, minNonce)
+	if err != nil {
+		return types.Hash{}, 0, createErrorResponse(s.Name(), err)
+	}
+
+	return types.Hash(hash), nonce.Uint64(), nil
+}
+
+func (s *UserOpProcessor) BuildTransaction(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64) (*ethTypes.Transaction, uint64, error) {
+	smartAccount, err := s.smartAccounts.GetAccount(common.Address(sendArgs.From()))
+	if err != nil {
+		return nil, 0, createErrorResponse(s.Name(), err)
+	}
+	if smartAccount != nil {
+		return nil, 0, ErrSmartAccountKeycardSigning
+	}
+
+	return s.PathProcessor.BuildTransaction(sendArgs, lastUsedNonce)
+}
@@ -0,0 +1,134 @@
+package pathprocessor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/smartaccount"
+	"github.com/status-im/status-go/transactions"
+)
+
+type testInnerProcessor struct {
+	PathProcessor
+	sent bool
+}
+
+func (p *testInnerProcessor) Name() string {
+	return ProcessorTransferName
+}
+
+func (p *testInnerProcessor) EstimateGas(params ProcessorInputParams) (uint64, error) {
+	return 21000, nil
+}
+
+func (p *testInnerProcessor) Send(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64, verifiedAccount *account.SelectedExtKey) (types.Hash, uint64, error) {
+	p.sent = true
+	return types.Hash{0x01}, uint64(lastUsedNonce + 1), nil
+}
+
+func (p *testInnerProcessor) BuildTransaction(sendArgs *MultipathProcessorTxArgs, lastUsedNonce int64) (*ethTypes.Transaction, uint64, error) {
+	tx := sendArgs.TransferTx
+	to := common.Address(*tx.To)
+	return ethTypes.NewTx(&ethTypes.DynamicFeeTx{To: &to, Value: tx.Value.ToInt(), Data: tx.GetInput()}), 0, nil
+}
+
+type testSmartAccounts struct {
+	accounts map[common.Address]*smartaccount.Account
+	calls    []smartaccount.Call
+	minNonce *big.Int
+}
+
+func (s *testSmartAccounts) GetAccount(address common.Address) (*smartaccount.Account, error) {
+	return s.accounts[address], nil
+}
+
+func (s *testSmartAccounts) GasOverhead(ctx context.Context, chainID uint64, address common.Address) (uint64, error) {
+	return 100000, nil
+}
+
+func (s *testSmartAccounts) SendCalls(ctx context.Context, chainID uint64, address common.Address, calls []smartaccount.Call, key *ecdsa.PrivateKey, minNonce *big.Int) (common.Hash, *big.Int, error) {
+	s.calls = calls
+	s.minNonce = minNonce
+	nonce := big.NewInt(7)
+	if minNonce != nil {
+		nonce = minNonce
+	}
+	return common.Hash{0x02}, nonce, nil
+}
+
+func TestUserOpProcessor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	verifiedAccount := &account.SelectedExtKey{Address: owner, AccountKey: &types.Key{PrivateKey: key}}
+
+	smartAccountAddress := common.HexToAddress("0x1234")
+	smartAccounts := &testSmartAccounts{accounts: map[common.Address]*smartaccount.Account{
+		smartAccountAddress: {Address: smartAccountAddress, Owner: common.Address(owner)},
+	}}
+	inner := &testInnerProcessor{}
+	processor := NewUserOpProcessor(inner, smartAccounts)
+	require.Equal(t, ProcessorTransferName, processor.Name())
+
+	newSendArgs := func(from types.Address) *MultipathProcessorTxArgs {
+		to := types.HexToAddress("0x5678")
+		value := (*hexutil.Big)(big.NewInt(1000))
+		return &MultipathProcessorTxArgs{
+			Name:       ProcessorTransferName,
+			ChainID:    mainnet.ChainID,
+			TransferTx: &transactions.SendTxArgs{From: from, To: &to, Value: value},
+		}
+	}
+
+	// Accounts that are not smart accounts go through the wrapped processor
+	estimation, err := processor.EstimateGas(ProcessorInputParams{FromChain: &mainnet, FromAddr: common.Address(owner)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), estimation)
+
+	hash, nonce, err := processor.Send(newSendArgs(owner), 2, verifiedAccount)
+	require.NoError(t, err)
+	require.True(t, inner.sent)
+	require.Equal(t, types.Hash{0x01}, hash)
+	require.Equal(t, uint64(3), nonce)
+
+	// Smart accounts send the transaction of the wrapped processor as a call
+	estimation, err = processor.EstimateGas(ProcessorInputParams{FromChain: &mainnet, FromAddr: smartAccountAddress})
+	require.NoError(t, err)
+	require.Equal(t, uint64(121000), estimation)
+
+	inner.sent = false
+	hash, nonce, err = processor.Send(newSendArgs(types.Address(smartAccountAddress)), -1, verifiedAccount)
+	require.NoError(t, err)
+	require.False(t, inner.sent)
+	require.Equal(t, types.Hash{0x02}, hash)
+	require.Equal(t, uint64(7), nonce)
+	require.Nil(t, smartAccounts.minNonce)
+	require.Len(t, smartAccounts.calls, 1)
+	require.Equal(t, common.HexToAddress("0x5678"), smartAccounts.calls[0].To)
+	require.Equal(t, big.NewInt(1000), smartAccounts.calls[0].Value)
+
+	_, nonce, err = processor.Send(newSendArgs(types.Address(smartAccountAddress)), 7, verifiedAccount)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(8), smartAccounts.minNonce)
+	require.Equal(t, uint64(8), nonce)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherAccount := &account.SelectedExtKey{Address: crypto.PubkeyToAddress(otherKey.PublicKey), AccountKey: &types.Key{PrivateKey: otherKey}}
+	_, _, err = processor.Send(newSendArgs(types.Address(smartAccountAddress)), -1, otherAccount)
+	require.ErrorIs(t, err, ErrSmartAccountNotOwner)
+
+	// Smart accounts can't sign with keycard
+	_, _, err = processor.BuildTransaction(newSendArgs(types.Address(smartAccountAddress)), -1)
+	require.ErrorIs(t, err, ErrSmartAccountKeycardSigning)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/router/pathprocessor"
 	"github.com/status-im/status-go/services/wallet/token"
 	walletToken "github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/transactions"
 )
 
@@ -292,6 +293,11 @@ func NewRouter(rpcClient *rpc.Client, transactor *transactions.Transactor, token
 	}
 }
 
+// SetSignalsNamespace sets the namespace of the signals sent by the router, that is the profile it runs for
+func (r *Router) SetSignalsNamespace(signals signal.Namespace) {
+	r.signals = signals
+}
+
 func (r *Router) AddPathProcessor(processor pathprocessor.PathProcessor) {
 	r.pathProcessors[processor.Name()] = processor
 }
@@ -329,6 +335,7 @@ type Router struct {
 	feesManager         *FeeManager
 	pathProcessors      map[string]pathprocessor.PathProcessor
 	scheduler           *async.Scheduler
+	signals             signal.Namespace
 }
 
 func (r *Router) requireApproval(ctx context.Context, sendType SendType, approvalContractAddress *common.Address, params pathprocessor.ProcessorInputParams) (
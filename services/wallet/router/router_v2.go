@@ -465,7 +465,7 @@ func (r *Router) SuggestedRoutesV2Async(input *RouteInputParams) {
 			routesResponse.NativeChainTokenPrice = &suggestedRoutes.NativeChainTokenPrice
 		}
 
-		signal.SendWalletEvent(signal.SuggestedRoutes, routesResponse)
+		r.signals.SendWalletEvent(signal.SuggestedRoutes, routesResponse)
 	})
 }
 
@@ -0,0 +1,314 @@
+package safe
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewDB(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+// SaveSafe adds the safe or updates it, owners included
+func (d *Database) SaveSafe(safe *Safe) (err error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO safes (chain_id, address, name, version, threshold, nonce, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`, safe.ChainID, safe.Address, safe.Name, safe.Version, safe.Threshold, safe.Nonce, safe.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM safe_owners WHERE chain_id = ? AND safe_address = ?`, safe.ChainID, safe.Address)
+	if err != nil {
+		return err
+	}
+
+	for i, owner := range safe.Owners {
+		_, err = tx.Exec(`INSERT INTO safe_owners (chain_id, safe_address, owner, position) VALUES (?, ?, ?, ?)`,
+			safe.ChainID, safe.Address, owner, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteSafe deletes the safe with its proposals
+func (d *Database) DeleteSafe(chainID uint64, address common.Address) (err error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM safe_signatures WHERE safe_tx_hash IN
+		(SELECT safe_tx_hash FROM safe_proposals WHERE chain_id = ? AND safe_address = ?)`, chainID, address)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range []string{"safe_proposals", "safe_owners"} {
+		_, err = tx.Exec(`DELETE FROM `+table+` WHERE chain_id = ? AND safe_address = ?`, chainID, address)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM safes WHERE chain_id = ? AND address = ?`, chainID, address)
+	return err
+}
+
+// GetSafe returns nil if the address is not a safe on the chain
+func (d *Database) GetSafe(chainID uint64, address common.Address) (*Safe, error) {
+	safes, err := d.getSafes(`WHERE chain_id = ? AND address = ?`, chainID, address)
+	if err != nil || len(safes) == 0 {
+		return nil, err
+	}
+	return safes[0], nil
+}
+
+func (d *Database) GetSafes() ([]*Safe, error) {
+	return d.getSafes(``)
+}
+
+func (d *Database) getSafes(where string, args ...interface{}) ([]*Safe, error) {
+	rows, err := d.db.Query(`SELECT chain_id, address, name, version, threshold, nonce, updated_at FROM safes `+where+`
+		ORDER BY chain_id, address`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*Safe, 0)
+	for rows.Next() {
+		safe := &Safe{}
+		err := rows.Scan(&safe.ChainID, &safe.Address, &safe.Name, &safe.Version, &safe.Threshold, &safe.Nonce, &safe.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, safe)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, safe := range result {
+		safe.Owners, err = d.getOwners(safe.ChainID, safe.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (d *Database) getOwners(chainID uint64, address common.Address) ([]common.Address, error) {
+	rows, err := d.db.Query(`SELECT owner FROM safe_owners WHERE chain_id = ? AND safe_address = ? ORDER BY position`, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	owners := make([]common.Address, 0)
+	for rows.Next() {
+		var owner common.Address
+		if err := rows.Scan(&owner); err != nil {
+			return nil, err
+		}
+		owners = append(owners, owner)
+	}
+
+	return owners, rows.Err()
+}
+
+// SaveProposal adds the proposal if it's new, and the signatures it doesn't have yet
+func (d *Database) SaveProposal(proposal *Proposal) (err error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	t := proposal.Transaction
+	_, err = tx.Exec(`INSERT OR IGNORE INTO safe_proposals (safe_tx_hash, chain_id, safe_address, to_address, value, data, operation,
+		safe_tx_gas, base_gas, gas_price, gas_token, refund_receiver, nonce, proposer, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		proposal.SafeTxHash, proposal.ChainID, proposal.Safe, t.To, bigToHex(t.Value), []byte(t.Data), t.Operation,
+		bigToHex(t.SafeTxGas), bigToHex(t.BaseGas), bigToHex(t.GasPrice), t.GasToken, t.RefundReceiver, t.Nonce,
+		proposal.Proposer, proposal.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	for owner, signature := range proposal.Signatures {
+		_, err = tx.Exec(`INSERT OR IGNORE INTO safe_signatures (safe_tx_hash, owner, signature) VALUES (?, ?, ?)`,
+			proposal.SafeTxHash, owner, []byte(signature))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Database) SetProposalExecuted(safeTxHash common.Hash, txHash common.Hash) error {
+	_, err := d.db.Exec(`UPDATE safe_proposals SET executed_tx_hash = ? WHERE safe_tx_hash = ?`, txHash, safeTxHash)
+	return err
+}
+
+// GetProposal returns nil if there is no proposal with the hash
+func (d *Database) GetProposal(safeTxHash common.Hash) (*Proposal, error) {
+	proposals, err := d.getProposals(`WHERE safe_tx_hash = ?`, safeTxHash)
+	if err != nil || len(proposals) == 0 {
+		return nil, err
+	}
+	return proposals[0], nil
+}
+
+// GetProposals returns the proposals of the safe ordered by nonce
+func (d *Database) GetProposals(chainID uint64, safe common.Address) ([]*Proposal, error) {
+	return d.getProposals(`WHERE chain_id = ? AND safe_address = ?`, chainID, safe)
+}
+
+func (d *Database) getProposals(where string, args ...interface{}) ([]*Proposal, error) {
+	rows, err := d.db.Query(`SELECT safe_tx_hash, chain_id, safe_address, to_address, value, data, operation, safe_tx_gas, base_gas,
+		gas_price, gas_token, refund_receiver, nonce, proposer, created_at, executed_tx_hash
+		FROM safe_proposals `+where+` ORDER BY nonce, created_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*Proposal, 0)
+	for rows.Next() {
+		p := &Proposal{Transaction: &Transaction{}}
+		t := p.Transaction
+		var value, safeTxGas, baseGas, gasPrice string
+		var data []byte
+		var executedTxHash []byte
+		err := rows.Scan(&p.SafeTxHash, &p.ChainID, &p.Safe, &t.To, &value, &data, &t.Operation, &safeTxGas, &baseGas,
+			&gasPrice, &t.GasToken, &t.RefundReceiver, &t.Nonce, &p.Proposer, &p.CreatedAt, &executedTxHash)
+		if err != nil {
+			return nil, err
+		}
+
+		t.Data = data
+		for _, v := range []struct {
+			dst **hexutil.Big
+			src string
+		}{{&t.Value, value}, {&t.SafeTxGas, safeTxGas}, {&t.BaseGas, baseGas}, {&t.GasPrice, gasPrice}} {
+			*v.dst, err = hexToBig(v.src)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(executedTxHash) > 0 {
+			hash := common.BytesToHash(executedTxHash)
+			p.ExecutedTxHash = &hash
+		}
+
+		result = append(result, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, p := range result {
+		p.Signatures, err = d.getSignatures(p.SafeTxHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (d *Database) getSignatures(safeTxHash common.Hash) (map[common.Address]hexutil.Bytes, error) {
+	rows, err := d.db.Query(`SELECT owner, signature FROM safe_signatures WHERE safe_tx_hash = ?`, safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	signatures := make(map[common.Address]hexutil.Bytes)
+	for rows.Next() {
+		var owner common.Address
+		var signature []byte
+		if err := rows.Scan(&owner, &signature); err != nil {
+			return nil, err
+		}
+		signatures[owner] = signature
+	}
+
+	return signatures, rows.Err()
+}
+
+// ImportProposal validates a proposal shared by another owner against the safe and merges its signatures
+// with the ones already known. It returns the merged proposal.
+func (d *Database) ImportProposal(proposal *Proposal) (*Proposal, error) {
+	safe, err := d.GetSafe(proposal.ChainID, proposal.Safe)
+	if err != nil {
+		return nil, err
+	}
+	if safe == nil {
+		return nil, ErrSafeNotFound
+	}
+
+	err = ValidateProposal(safe, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.SaveProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.GetProposal(proposal.SafeTxHash)
+}
+
+func bigToHex(value *hexutil.Big) string {
+	if value == nil {
+		return "0x0"
+	}
+	return value.String()
+}
+
+func hexToBig(value string) (*hexutil.Big, error) {
+	v, err := hexutil.DecodeBig(value)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).Set(v)), nil
+}
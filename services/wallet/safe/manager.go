@@ -0,0 +1,348 @@
+package safe
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/contracts/safe"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// Gas used by the Safe on top of the call of a transaction: signature checks excluded
+	safeTransactionGasOverhead = 50000
+	// Gas used to check each owner signature
+	signatureGas = 10000
+)
+
+// Backend is the subset of the chain client used to read Safes
+type Backend interface {
+	bind.ContractCaller
+}
+
+type BackendGetter func(chainID uint64) (Backend, error)
+
+// Manager keeps the Safes of the wallet and their pending transactions. Owners of the wallet propose and confirm
+// transactions with EIP-712 signatures, any owner executes them once the threshold of the Safe is reached.
+type Manager struct {
+	db         *Database
+	backends   BackendGetter
+	transactor transactions.TransactorIface
+	safeABI    abi.ABI
+}
+
+func NewManager(db *sql.DB, backends BackendGetter, transactor transactions.TransactorIface) *Manager {
+	safeABI, err := abi.JSON(strings.NewReader(safe.ISafeABI))
+	if err != nil {
+		log.Crit("Failed to parse Safe ABI", "err", err)
+	}
+
+	return &Manager{
+		db:         NewDB(db),
+		backends:   backends,
+		transactor: transactor,
+		safeABI:    safeABI,
+	}
+}
+
+// readSafe reads the owners, threshold and nonce of the Safe on chain
+func (m *Manager) readSafe(ctx context.Context, chainID uint64, address common.Address) (*Safe, error) {
+	backend, err := m.backends(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := safe.NewISafeCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	owners, err := caller.GetOwners(opts)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := caller.GetThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := caller.Nonce(opts)
+	if err != nil {
+		return nil, err
+	}
+	version, err := caller.VERSION(opts)
+	if err != nil {
+		return nil, err
+	}
+	if !supportedVersion(version) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+	}
+
+	return &Safe{
+		ChainID:   chainID,
+		Address:   address,
+		Version:   version,
+		Owners:    owners,
+		Threshold: threshold.Uint64(),
+		Nonce:     nonce.Uint64(),
+		UpdatedAt: time.Now().Unix(),
+	}, nil
+}
+
+// AddSafe adds the Safe deployed at address on the chain
+func (m *Manager) AddSafe(ctx context.Context, chainID uint64, address common.Address, name string) (*Safe, error) {
+	existing, err := m.db.GetSafe(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrSafeExists
+	}
+
+	s, err := m.readSafe(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	s.Name = name
+
+	return s, m.db.SaveSafe(s)
+}
+
+// RefreshSafe reads the owners, threshold and nonce of a Safe of the wallet again
+func (m *Manager) RefreshSafe(ctx context.Context, chainID uint64, address common.Address) (*Safe, error) {
+	existing, err := m.db.GetSafe(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrSafeNotFound
+	}
+
+	s, err := m.readSafe(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	s.Name = existing.Name
+
+	return s, m.db.SaveSafe(s)
+}
+
+func (m *Manager) GetSafe(chainID uint64, address common.Address) (*Safe, error) {
+	return m.db.GetSafe(chainID, address)
+}
+
+func (m *Manager) GetSafes() ([]*Safe, error) {
+	return m.db.GetSafes()
+}
+
+func (m *Manager) DeleteSafe(chainID uint64, address common.Address) error {
+	return m.db.DeleteSafe(chainID, address)
+}
+
+func (m *Manager) GetProposal(safeTxHash common.Hash) (*Proposal, error) {
+	return m.db.GetProposal(safeTxHash)
+}
+
+func (m *Manager) GetProposals(chainID uint64, address common.Address) ([]*Proposal, error) {
+	return m.db.GetProposals(chainID, address)
+}
+
+// ImportProposal adds a proposal shared by another owner, see Database.ImportProposal
+func (m *Manager) ImportProposal(proposal *Proposal) (*Proposal, error) {
+	return m.db.ImportProposal(proposal)
+}
+
+// GasOverhead returns the gas the Safe uses on top of the call of a transaction when it's executed
+func (m *Manager) GasOverhead(chainID uint64, address common.Address) (uint64, error) {
+	s, err := m.db.GetSafe(chainID, address)
+	if err != nil {
+		return 0, err
+	}
+	if s == nil {
+		return 0, ErrSafeNotFound
+	}
+
+	return safeTransactionGasOverhead + signatureGas*s.Threshold, nil
+}
+
+// nextNonce returns the nonce of a new proposal: after the nonce of the Safe, the pending proposals and minNonce
+func (m *Manager) nextNonce(s *Safe, minNonce *big.Int) (uint64, error) {
+	nonce := s.Nonce
+
+	proposals, err := m.db.GetProposals(s.ChainID, s.Address)
+	if err != nil {
+		return 0, err
+	}
+	for _, proposal := range proposals {
+		if proposal.ExecutedTxHash == nil && proposal.Transaction.Nonce >= nonce {
+			nonce = proposal.Transaction.Nonce + 1
+		}
+	}
+
+	if minNonce != nil && minNonce.Uint64() > nonce {
+		nonce = minNonce.Uint64()
+	}
+
+	return nonce, nil
+}
+
+// Propose creates a transaction of the Safe calling to, signed by the owner with key. It returns the proposal
+// for the other owners to confirm.
+func (m *Manager) Propose(ctx context.Context, chainID uint64, address common.Address, to common.Address, value *big.Int,
+	data []byte, key *ecdsa.PrivateKey, minNonce *big.Int) (*Proposal, error) {
+	s, err := m.RefreshSafe(ctx, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer := crypto.PubkeyToAddress(key.PublicKey)
+	if !s.IsOwner(proposer) {
+		return nil, ErrNotSafeOwner
+	}
+
+	nonce, err := m.nextNonce(s, minNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := NewTransaction(to, value, data, nonce)
+	hash, err := tx.Hash(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := tx.Sign(chainID, address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &Proposal{
+		SafeTxHash:  hash,
+		ChainID:     chainID,
+		Safe:        address,
+		Transaction: tx,
+		Proposer:    proposer,
+		Signatures:  map[common.Address]hexutil.Bytes{proposer: signature},
+		CreatedAt:   time.Now().Unix(),
+	}
+
+	return proposal, m.db.SaveProposal(proposal)
+}
+
+// Confirm adds the signature of the owner with key to the proposal
+func (m *Manager) Confirm(safeTxHash common.Hash, key *ecdsa.PrivateKey) (*Proposal, error) {
+	proposal, err := m.db.GetProposal(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, ErrProposalNotFound
+	}
+	if proposal.ExecutedTxHash != nil {
+		return nil, ErrProposalExecuted
+	}
+
+	s, err := m.db.GetSafe(proposal.ChainID, proposal.Safe)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, ErrSafeNotFound
+	}
+
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	if !s.IsOwner(owner) {
+		return nil, ErrNotSafeOwner
+	}
+
+	signature, err := proposal.Transaction.Sign(proposal.ChainID, proposal.Safe, key)
+	if err != nil {
+		return nil, err
+	}
+	proposal.Signatures[owner] = signature
+
+	return proposal, m.db.SaveProposal(proposal)
+}
+
+// packSignatures concatenates the signatures of the current owners, sorted by owner as the Safe expects
+func packSignatures(s *Safe, signatures map[common.Address]hexutil.Bytes) []byte {
+	owners := make([]common.Address, 0, len(signatures))
+	for owner := range signatures {
+		if s.IsOwner(owner) {
+			owners = append(owners, owner)
+		}
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].Bytes(), owners[j].Bytes()) < 0
+	})
+
+	packed := make([]byte, 0, len(owners)*crypto.SignatureLength)
+	for _, owner := range owners {
+		packed = append(packed, signatures[owner]...)
+	}
+	return packed
+}
+
+// Execute sends the transaction of the proposal from the executor once enough owners confirmed it. The executor
+// pays the gas and doesn't need to be an owner.
+func (m *Manager) Execute(ctx context.Context, safeTxHash common.Hash, executor *account.SelectedExtKey) (common.Hash, error) {
+	proposal, err := m.db.GetProposal(safeTxHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if proposal == nil {
+		return common.Hash{}, ErrProposalNotFound
+	}
+	if proposal.ExecutedTxHash != nil {
+		return common.Hash{}, ErrProposalExecuted
+	}
+
+	s, err := m.RefreshSafe(ctx, proposal.ChainID, proposal.Safe)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if proposal.Transaction.Nonce != s.Nonce {
+		return common.Hash{}, ErrProposalNotNext
+	}
+
+	signatures := packSignatures(s, proposal.Signatures)
+	if uint64(len(signatures)/crypto.SignatureLength) < s.Threshold {
+		return common.Hash{}, ErrThresholdNotReached
+	}
+
+	t := proposal.Transaction
+	data, err := m.safeABI.Pack("execTransaction", t.To, t.Value.ToInt(), []byte(t.Data), t.Operation, t.SafeTxGas.ToInt(),
+		t.BaseGas.ToInt(), t.GasPrice.ToInt(), t.GasToken, t.RefundReceiver, signatures)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	to := types.Address(proposal.Safe)
+	hash, _, err := m.transactor.SendTransactionWithChainID(proposal.ChainID, transactions.SendTxArgs{
+		From:  executor.Address,
+		To:    &to,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Data:  data,
+	}, -1, executor)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash := common.Hash(hash)
+	return txHash, m.db.SetProposalExecuted(safeTxHash, txHash)
+}
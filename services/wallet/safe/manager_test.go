@@ -0,0 +1,265 @@
+package safe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/contracts/safe"
+	"github.com/status-im/status-go/eth-node/types"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/transactions"
+	"github.com/status-im/status-go/transactions/mock_transactor"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+// testBackend answers the calls reading the Safe the manager makes
+type testBackend struct {
+	t         *testing.T
+	safeABI   abi.ABI
+	owners    []common.Address
+	threshold int64
+	nonce     int64
+	version   string
+}
+
+func (b *testBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (b *testBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := b.safeABI.MethodById(call.Data[:4])
+	require.NoError(b.t, err)
+	switch method.Name {
+	case "getOwners":
+		return method.Outputs.Pack(b.owners)
+	case "getThreshold":
+		return method.Outputs.Pack(big.NewInt(b.threshold))
+	case "nonce":
+		return method.Outputs.Pack(big.NewInt(b.nonce))
+	case "VERSION":
+		return method.Outputs.Pack(b.version)
+	}
+	b.t.Fatalf("unexpected call to %s", method.Name)
+	return nil, nil
+}
+
+func generateOwner(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func setupTestManager(t *testing.T, owners []common.Address, threshold int64) (*Manager, *testBackend, *mock_transactor.MockTransactorIface) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	safeABI, err := abi.JSON(strings.NewReader(safe.ISafeABI))
+	require.NoError(t, err)
+	backend := &testBackend{t: t, safeABI: safeABI, owners: owners, threshold: threshold, version: "1.3.0"}
+
+	ctrl := gomock.NewController(t)
+	transactor := mock_transactor.NewMockTransactorIface(ctrl)
+
+	manager := NewManager(db, func(chainID uint64) (Backend, error) {
+		return backend, nil
+	}, transactor)
+
+	return manager, backend, transactor
+}
+
+func TestManager_AddSafe(t *testing.T) {
+	_, owner := generateOwner(t)
+	manager, backend, _ := setupTestManager(t, []common.Address{owner}, 1)
+	address := common.HexToAddress("0x1234")
+
+	s, err := manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "treasury")
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{owner}, s.Owners)
+	require.Equal(t, uint64(1), s.Threshold)
+	require.Equal(t, "1.3.0", s.Version)
+
+	_, err = manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "treasury")
+	require.ErrorIs(t, err, ErrSafeExists)
+
+	// Owners and threshold changed on chain are read again, the name is kept
+	_, other := generateOwner(t)
+	backend.owners = []common.Address{other, owner}
+	backend.threshold = 2
+	backend.nonce = 5
+	s, err = manager.RefreshSafe(context.Background(), walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Equal(t, "treasury", s.Name)
+
+	stored, err := manager.GetSafe(walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{other, owner}, stored.Owners)
+	require.Equal(t, uint64(2), stored.Threshold)
+	require.Equal(t, uint64(5), stored.Nonce)
+
+	overhead, err := manager.GasOverhead(walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(safeTransactionGasOverhead+2*signatureGas), overhead)
+
+	require.NoError(t, manager.DeleteSafe(walletCommon.EthereumMainnet, address))
+	safes, err := manager.GetSafes()
+	require.NoError(t, err)
+	require.Empty(t, safes)
+
+	_, err = manager.RefreshSafe(context.Background(), walletCommon.EthereumMainnet, address)
+	require.ErrorIs(t, err, ErrSafeNotFound)
+}
+
+func TestManager_AddSafeUnsupportedVersion(t *testing.T) {
+	_, owner := generateOwner(t)
+	manager, backend, _ := setupTestManager(t, []common.Address{owner}, 1)
+	address := common.HexToAddress("0x1234")
+
+	backend.version = "1.2.0"
+	_, err := manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "legacy")
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	safes, err := manager.GetSafes()
+	require.NoError(t, err)
+	require.Empty(t, safes)
+
+	backend.version = "1.3.0+L2"
+	_, err = manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "l2")
+	require.NoError(t, err)
+}
+
+func TestManager_ProposeConfirmExecute(t *testing.T) {
+	firstKey, first := generateOwner(t)
+	secondKey, second := generateOwner(t)
+	outsiderKey, _ := generateOwner(t)
+	manager, backend, transactor := setupTestManager(t, []common.Address{first, second}, 2)
+	address := common.HexToAddress("0x1234")
+	recipient := common.HexToAddress("0x5678")
+
+	_, err := manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "treasury")
+	require.NoError(t, err)
+
+	_, err = manager.Propose(context.Background(), walletCommon.EthereumMainnet, address, recipient, big.NewInt(1000), nil, outsiderKey, nil)
+	require.ErrorIs(t, err, ErrNotSafeOwner)
+
+	proposal, err := manager.Propose(context.Background(), walletCommon.EthereumMainnet, address, recipient, big.NewInt(1000), nil, firstKey, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), proposal.Transaction.Nonce)
+	require.Len(t, proposal.Signatures, 1)
+	require.NoError(t, ValidateProposal(&Safe{ChainID: walletCommon.EthereumMainnet, Address: address, Owners: []common.Address{first, second}}, proposal))
+
+	// Proposals queue after the pending ones
+	queued, err := manager.Propose(context.Background(), walletCommon.EthereumMainnet, address, recipient, big.NewInt(2000), nil, firstKey, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), queued.Transaction.Nonce)
+
+	executor := &account.SelectedExtKey{Address: types.Address(first), AccountKey: &types.Key{PrivateKey: firstKey}}
+	_, err = manager.Execute(context.Background(), proposal.SafeTxHash, executor)
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+
+	_, err = manager.Confirm(proposal.SafeTxHash, outsiderKey)
+	require.ErrorIs(t, err, ErrNotSafeOwner)
+
+	proposal, err = manager.Confirm(proposal.SafeTxHash, secondKey)
+	require.NoError(t, err)
+	require.Len(t, proposal.Signatures, 2)
+
+	_, err = manager.Execute(context.Background(), queued.SafeTxHash, executor)
+	require.ErrorIs(t, err, ErrProposalNotNext)
+
+	txHash := types.Hash{0x01}
+	transactor.EXPECT().SendTransactionWithChainID(walletCommon.EthereumMainnet, gomock.Any(), int64(-1), executor).DoAndReturn(
+		func(chainID uint64, sendArgs transactions.SendTxArgs, lastUsedNonce int64, verifiedAccount *account.SelectedExtKey) (types.Hash, uint64, error) {
+			require.Equal(t, types.Address(first), sendArgs.From)
+			require.Equal(t, types.Address(address), *sendArgs.To)
+
+			method, err := manager.safeABI.MethodById(sendArgs.Data[:4])
+			require.NoError(t, err)
+			require.Equal(t, "execTransaction", method.Name)
+			args, err := method.Inputs.Unpack(sendArgs.Data[4:])
+			require.NoError(t, err)
+			require.Equal(t, recipient, args[0])
+			require.Equal(t, big.NewInt(1000), args[1])
+
+			// Signatures are sorted by owner
+			signatures := args[9].([]byte)
+			require.Len(t, signatures, 2*crypto.SignatureLength)
+			lower, higher := first, second
+			if second.Hex() < first.Hex() {
+				lower, higher = second, first
+			}
+			require.Equal(t, []byte(proposal.Signatures[lower]), signatures[:crypto.SignatureLength])
+			require.Equal(t, []byte(proposal.Signatures[higher]), signatures[crypto.SignatureLength:])
+			return txHash, 0, nil
+		})
+
+	hash, err := manager.Execute(context.Background(), proposal.SafeTxHash, executor)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash(txHash), hash)
+
+	executed, err := manager.GetProposal(proposal.SafeTxHash)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash(txHash), *executed.ExecutedTxHash)
+
+	_, err = manager.Confirm(proposal.SafeTxHash, secondKey)
+	require.ErrorIs(t, err, ErrProposalExecuted)
+
+	// Once the Safe nonce moves, the next proposal follows the queued one
+	backend.nonce = 1
+	next, err := manager.Propose(context.Background(), walletCommon.EthereumMainnet, address, recipient, big.NewInt(3000), nil, secondKey, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next.Transaction.Nonce)
+
+	proposals, err := manager.GetProposals(walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Len(t, proposals, 3)
+}
+
+func TestManager_ImportProposal(t *testing.T) {
+	firstKey, first := generateOwner(t)
+	secondKey, second := generateOwner(t)
+	address := common.HexToAddress("0x1234")
+
+	// The proposal is made by one owner and imported by the other, each with its own wallet
+	proposerManager, _, _ := setupTestManager(t, []common.Address{first, second}, 2)
+	_, err := proposerManager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "treasury")
+	require.NoError(t, err)
+	proposal, err := proposerManager.Propose(context.Background(), walletCommon.EthereumMainnet, address, common.HexToAddress("0x5678"), big.NewInt(1000), nil, firstKey, nil)
+	require.NoError(t, err)
+
+	manager, _, _ := setupTestManager(t, []common.Address{first, second}, 2)
+	_, err = manager.ImportProposal(proposal)
+	require.ErrorIs(t, err, ErrSafeNotFound)
+
+	_, err = manager.AddSafe(context.Background(), walletCommon.EthereumMainnet, address, "treasury")
+	require.NoError(t, err)
+	imported, err := manager.ImportProposal(proposal)
+	require.NoError(t, err)
+	require.Equal(t, proposal.SafeTxHash, imported.SafeTxHash)
+	require.Equal(t, proposal.Transaction, imported.Transaction)
+
+	// Confirmations shared back are merged with the known ones
+	confirmed, err := manager.Confirm(proposal.SafeTxHash, secondKey)
+	require.NoError(t, err)
+	merged, err := proposerManager.ImportProposal(confirmed)
+	require.NoError(t, err)
+	require.Len(t, merged.Signatures, 2)
+
+	tampered := *confirmed
+	tampered.Transaction = NewTransaction(common.HexToAddress("0x5678"), big.NewInt(9999), nil, 0)
+	_, err = manager.ImportProposal(&tampered)
+	require.ErrorIs(t, err, ErrProposalHashWrong)
+}
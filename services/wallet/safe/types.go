@@ -0,0 +1,237 @@
+package safe
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	signercore "github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/status-im/status-go/services/typeddata"
+)
+
+var (
+	ErrSafeExists           = errors.New("safe already exists")
+	ErrSafeNotFound         = errors.New("safe not found")
+	ErrProposalNotFound     = errors.New("safe proposal not found")
+	ErrProposalHashWrong    = errors.New("safe proposal doesn't match its hash")
+	ErrNotSafeOwner         = errors.New("signer is not an owner of the safe")
+	ErrInvalidSignature     = errors.New("invalid safe signature")
+	ErrThresholdNotReached  = errors.New("safe proposal doesn't have enough confirmations")
+	ErrProposalNotNext      = errors.New("safe proposal nonce is not the next nonce of the safe")
+	ErrProposalExecuted     = errors.New("safe proposal is already executed")
+	ErrDelegateCallProposal = errors.New("delegate call proposals are not supported")
+	// Safes before 1.3.0 sign transactions with an EIP-712 domain without chainId
+	ErrUnsupportedVersion = errors.New("safe versions older than 1.3.0 are not supported")
+)
+
+const (
+	OperationCall         uint8 = 0
+	OperationDelegateCall uint8 = 1
+)
+
+// Safe is a Safe multisig account, its owners and threshold are read on chain
+type Safe struct {
+	ChainID   uint64           `json:"chainId"`
+	Address   common.Address   `json:"address"`
+	Name      string           `json:"name"`
+	Version   string           `json:"version"`
+	Owners    []common.Address `json:"owners"`
+	Threshold uint64           `json:"threshold"`
+	Nonce     uint64           `json:"nonce"`
+	UpdatedAt int64            `json:"updatedAt"`
+}
+
+// supportedVersion tells whether the Safe version, e.g. 1.3.0 or 1.3.0+L2, is 1.3.0 or newer
+func supportedVersion(version string) bool {
+	version, _, _ = strings.Cut(version, "+")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+	return major > 1 || (major == 1 && minor >= 3)
+}
+
+func (s *Safe) IsOwner(address common.Address) bool {
+	for _, owner := range s.Owners {
+		if owner == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Transaction is the SafeTx signed by the owners and executed by the Safe
+type Transaction struct {
+	To             common.Address `json:"to"`
+	Value          *hexutil.Big   `json:"value"`
+	Data           hexutil.Bytes  `json:"data"`
+	Operation      uint8          `json:"operation"`
+	SafeTxGas      *hexutil.Big   `json:"safeTxGas"`
+	BaseGas        *hexutil.Big   `json:"baseGas"`
+	GasPrice       *hexutil.Big   `json:"gasPrice"`
+	GasToken       common.Address `json:"gasToken"`
+	RefundReceiver common.Address `json:"refundReceiver"`
+	Nonce          uint64         `json:"nonce"`
+}
+
+// NewTransaction returns a call of the Safe, with the gas paid by the owner executing it
+func NewTransaction(to common.Address, value *big.Int, data []byte, nonce uint64) *Transaction {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return &Transaction{
+		To:        to,
+		Value:     (*hexutil.Big)(value),
+		Data:      data,
+		Operation: OperationCall,
+		SafeTxGas: (*hexutil.Big)(big.NewInt(0)),
+		BaseGas:   (*hexutil.Big)(big.NewInt(0)),
+		GasPrice:  (*hexutil.Big)(big.NewInt(0)),
+		Nonce:     nonce,
+	}
+}
+
+func toHexOrDecimal(value *hexutil.Big) *math.HexOrDecimal256 {
+	if value == nil {
+		return math.NewHexOrDecimal256(0)
+	}
+	return (*math.HexOrDecimal256)(value.ToInt())
+}
+
+// TypedData returns the EIP-712 typed data the owners sign to confirm the transaction
+func (tx *Transaction) TypedData(chainID uint64, safe common.Address) signercore.TypedData {
+	return signercore.TypedData{
+		Types: signercore.Types{
+			"EIP712Domain": {
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: signercore.TypedDataDomain{
+			ChainId:           math.NewHexOrDecimal256(int64(chainID)),
+			VerifyingContract: safe.Hex(),
+		},
+		Message: signercore.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          toHexOrDecimal(tx.Value),
+			"data":           []byte(tx.Data),
+			"operation":      math.NewHexOrDecimal256(int64(tx.Operation)),
+			"safeTxGas":      toHexOrDecimal(tx.SafeTxGas),
+			"baseGas":        toHexOrDecimal(tx.BaseGas),
+			"gasPrice":       toHexOrDecimal(tx.GasPrice),
+			"gasToken":       tx.GasToken.Hex(),
+			"refundReceiver": tx.RefundReceiver.Hex(),
+			"nonce":          math.NewHexOrDecimal256(int64(tx.Nonce)),
+		},
+	}
+}
+
+// Hash returns the safeTxHash, the hash computed by Safe.getTransactionHash
+func (tx *Transaction) Hash(chainID uint64, safe common.Address) (common.Hash, error) {
+	chain := new(big.Int).SetUint64(chainID)
+	return typeddata.HashTypedDataV4(tx.TypedData(chainID, safe), chain)
+}
+
+// Sign returns the signature of an owner confirming the transaction
+func (tx *Transaction) Sign(chainID uint64, safe common.Address, key *ecdsa.PrivateKey) (hexutil.Bytes, error) {
+	chain := new(big.Int).SetUint64(chainID)
+	return typeddata.SignTypedDataV4(tx.TypedData(chainID, safe), key, chain)
+}
+
+// recoverSigner returns the owner who made an EIP-712 signature of safeTxHash
+func recoverSigner(safeTxHash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength || signature[crypto.RecoveryIDOffset] < 27 {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+
+	publicKey, err := crypto.SigToPub(safeTxHash[:], sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// Proposal is a Safe transaction waiting for the confirmations of the owners
+type Proposal struct {
+	SafeTxHash     common.Hash                      `json:"safeTxHash"`
+	ChainID        uint64                           `json:"chainId"`
+	Safe           common.Address                   `json:"safe"`
+	Transaction    *Transaction                     `json:"transaction"`
+	Proposer       common.Address                   `json:"proposer"`
+	Signatures     map[common.Address]hexutil.Bytes `json:"signatures"`
+	CreatedAt      int64                            `json:"createdAt"`
+	ExecutedTxHash *common.Hash                     `json:"executedTxHash,omitempty"`
+}
+
+// ValidateProposal checks the proposal matches its hash and is signed by owners of the safe only,
+// the proposer included
+func ValidateProposal(safe *Safe, proposal *Proposal) error {
+	if proposal.ChainID != safe.ChainID || proposal.Safe != safe.Address {
+		return ErrProposalHashWrong
+	}
+
+	if proposal.Transaction.Operation != OperationCall {
+		return ErrDelegateCallProposal
+	}
+
+	hash, err := proposal.Transaction.Hash(proposal.ChainID, proposal.Safe)
+	if err != nil {
+		return err
+	}
+	if hash != proposal.SafeTxHash {
+		return ErrProposalHashWrong
+	}
+
+	if _, ok := proposal.Signatures[proposal.Proposer]; !ok {
+		return ErrInvalidSignature
+	}
+
+	for owner, signature := range proposal.Signatures {
+		signer, err := recoverSigner(hash, signature)
+		if err != nil {
+			return err
+		}
+		if signer != owner {
+			return ErrInvalidSignature
+		}
+		if !safe.IsOwner(owner) {
+			return ErrNotSafeOwner
+		}
+	}
+
+	return nil
+}
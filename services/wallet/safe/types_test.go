@@ -0,0 +1,122 @@
+package safe
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testSafeTxHash computes the safeTxHash the way Safe.getTransactionHash does
+func testSafeTxHash(t *testing.T, chainID uint64, safe common.Address, tx *Transaction) common.Hash {
+	uint256, _ := abi.NewType("uint256", "", nil)
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+
+	domainTypeHash := crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	domain, err := abi.Arguments{{Type: bytes32}, {Type: uint256}, {Type: address}}.Pack(
+		domainTypeHash, new(big.Int).SetUint64(chainID), safe)
+	require.NoError(t, err)
+
+	safeTxTypeHash := crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas," +
+		"uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+	safeTx, err := abi.Arguments{{Type: bytes32}, {Type: address}, {Type: uint256}, {Type: bytes32}, {Type: uint8Type},
+		{Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: address}, {Type: address}, {Type: uint256}}.Pack(
+		safeTxTypeHash, tx.To, tx.Value.ToInt(), crypto.Keccak256Hash(tx.Data), tx.Operation, tx.SafeTxGas.ToInt(),
+		tx.BaseGas.ToInt(), tx.GasPrice.ToInt(), tx.GasToken, tx.RefundReceiver, new(big.Int).SetUint64(tx.Nonce))
+	require.NoError(t, err)
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, crypto.Keccak256(domain), crypto.Keccak256(safeTx))
+}
+
+func TestTransactionHash(t *testing.T) {
+	safe := common.HexToAddress("0x1234")
+	tx := NewTransaction(common.HexToAddress("0x5678"), big.NewInt(1000), hexutil.MustDecode("0xa9059cbb"), 3)
+
+	hash, err := tx.Hash(1, safe)
+	require.NoError(t, err)
+	require.Equal(t, testSafeTxHash(t, 1, safe, tx), hash)
+
+	otherChainHash, err := tx.Hash(10, safe)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherChainHash)
+
+	tx.Nonce = 4
+	otherNonceHash, err := tx.Hash(1, safe)
+	require.NoError(t, err)
+	require.Equal(t, testSafeTxHash(t, 1, safe, tx), otherNonceHash)
+	require.NotEqual(t, hash, otherNonceHash)
+}
+
+func TestValidateProposal(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other := crypto.PubkeyToAddress(otherKey.PublicKey)
+
+	safe := &Safe{ChainID: 1, Address: common.HexToAddress("0x1234"), Owners: []common.Address{owner}, Threshold: 1}
+	tx := NewTransaction(common.HexToAddress("0x5678"), big.NewInt(1000), nil, 0)
+	hash, err := tx.Hash(safe.ChainID, safe.Address)
+	require.NoError(t, err)
+	signature, err := tx.Sign(safe.ChainID, safe.Address, key)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, signature[crypto.RecoveryIDOffset], byte(27))
+
+	newProposal := func() *Proposal {
+		return &Proposal{
+			SafeTxHash:  hash,
+			ChainID:     safe.ChainID,
+			Safe:        safe.Address,
+			Transaction: tx,
+			Proposer:    owner,
+			Signatures:  map[common.Address]hexutil.Bytes{owner: signature},
+		}
+	}
+	require.NoError(t, ValidateProposal(safe, newProposal()))
+
+	// The transaction must match the hash the owners signed
+	proposal := newProposal()
+	proposal.Transaction = NewTransaction(common.HexToAddress("0x5678"), big.NewInt(2000), nil, 0)
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrProposalHashWrong)
+
+	proposal = newProposal()
+	proposal.ChainID = 10
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrProposalHashWrong)
+
+	// Signatures must recover to the owner they are given for
+	proposal = newProposal()
+	proposal.Signatures[other] = signature
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrInvalidSignature)
+
+	proposal = newProposal()
+	proposal.Signatures[other], err = tx.Sign(safe.ChainID, safe.Address, otherKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrNotSafeOwner)
+
+	proposal = newProposal()
+	proposal.Proposer = other
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrInvalidSignature)
+
+	delegateCall := NewTransaction(common.HexToAddress("0x5678"), nil, nil, 0)
+	delegateCall.Operation = OperationDelegateCall
+	proposal = newProposal()
+	proposal.Transaction = delegateCall
+	require.ErrorIs(t, ValidateProposal(safe, proposal), ErrDelegateCallProposal)
+}
+
+func TestSupportedVersion(t *testing.T) {
+	for _, version := range []string{"1.3.0", "1.3.0+L2", "1.4.1", "2.0.0"} {
+		require.True(t, supportedVersion(version), version)
+	}
+	for _, version := range []string{"1.2.0", "1.1.1", "1.0.0", "0.1.0", "", "invalid"} {
+		require.False(t, supportedVersion(version), version)
+	}
+}
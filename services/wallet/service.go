@@ -30,15 +30,20 @@ import (
 	"github.com/status-im/status-go/services/wallet/history"
 	"github.com/status-im/status-go/services/wallet/market"
 	"github.com/status-im/status-go/services/wallet/onramp"
+	"github.com/status-im/status-go/services/wallet/safe"
+	"github.com/status-im/status-go/services/wallet/smartaccount"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/alchemy"
 	"github.com/status-im/status-go/services/wallet/thirdparty/coingecko"
 	"github.com/status-im/status-go/services/wallet/thirdparty/cryptocompare"
+	"github.com/status-im/status-go/services/wallet/thirdparty/onchain"
 	"github.com/status-im/status-go/services/wallet/thirdparty/opensea"
 	"github.com/status-im/status-go/services/wallet/thirdparty/rarible"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
 	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/transactions"
 )
 
@@ -100,8 +105,10 @@ func NewService(
 	})
 
 	communityManager := community.NewManager(db, mediaServer, feed)
+	spamManager := spam.NewManager(db, feed)
 	balanceCacher := balance.NewCacherWithTTL(5 * time.Minute)
 	tokenManager := token.NewTokenManager(db, rpcClient, communityManager, rpcClient.NetworkManager, appDB, mediaServer, feed, accountFeed, accountsDB, token.NewPersistence(db))
+	tokenManager.SetSpamManager(spamManager)
 	tokenManager.Start()
 
 	cryptoOnRampProviders := []onramp.Provider{
@@ -127,7 +134,6 @@ func NewService(
 	})
 	marketManager := market.NewManager([]thirdparty.MarketDataProvider{cryptoCompare, coingecko, cryptoCompareProxy}, feed)
 	reader := NewReader(tokenManager, marketManager, token.NewPersistence(db), feed)
-	history := history.NewService(db, accountsDB, accountFeed, feed, rpcClient, tokenManager, marketManager, balanceCacher.Cache())
 	currency := currency.NewService(db, feed, tokenManager, marketManager)
 
 	openseaHTTPClient := opensea.NewHTTPClient()
@@ -135,34 +141,50 @@ func NewService(
 	raribleClient := rarible.NewClient(config.WalletConfig.RaribleMainnetAPIKey, config.WalletConfig.RaribleTestnetAPIKey)
 	alchemyClient := alchemy.NewClient(config.WalletConfig.AlchemyAPIKeys)
 
+	onchainClient := onchain.NewClient(func(chainID uint64) (onchain.Backend, error) {
+		return rpcClient.EthClient(chainID)
+	}, transfer.NewBlockRangeSequentialDAO(db))
+
 	// Collectible providers in priority order (i.e. provider N+1 will be tried only if provider N fails)
 	contractOwnershipProviders := []thirdparty.CollectibleContractOwnershipProvider{
 		raribleClient,
 		alchemyClient,
 	}
 
+	// The on-chain client needs no indexer, it is used as a last resort
 	accountOwnershipProviders := []thirdparty.CollectibleAccountOwnershipProvider{
 		raribleClient,
 		alchemyClient,
 		openseaV2Client,
+		onchainClient,
 	}
 
 	collectibleDataProviders := []thirdparty.CollectibleDataProvider{
 		raribleClient,
 		alchemyClient,
 		openseaV2Client,
+		onchainClient,
 	}
 
 	collectionDataProviders := []thirdparty.CollectionDataProvider{
 		raribleClient,
 		alchemyClient,
 		openseaV2Client,
+		onchainClient,
 	}
 
 	collectibleSearchProviders := []thirdparty.CollectibleSearchProvider{
 		raribleClient,
 	}
 
+	if config.WalletConfig.OnChainCollectiblesOnly {
+		contractOwnershipProviders = []thirdparty.CollectibleContractOwnershipProvider{}
+		accountOwnershipProviders = []thirdparty.CollectibleAccountOwnershipProvider{onchainClient}
+		collectibleDataProviders = []thirdparty.CollectibleDataProvider{onchainClient}
+		collectionDataProviders = []thirdparty.CollectionDataProvider{onchainClient}
+		collectibleSearchProviders = []thirdparty.CollectibleSearchProvider{}
+	}
+
 	collectibleProviders := thirdparty.CollectibleProviders{
 		ContractOwnershipProviders: contractOwnershipProviders,
 		AccountOwnershipProviders:  accountOwnershipProviders,
@@ -177,12 +199,39 @@ func NewService(
 		communityManager,
 		collectibleProviders,
 		mediaServer,
+		spamManager,
 		feed,
 	)
+	history := history.NewService(db, accountsDB, accountFeed, feed, rpcClient, tokenManager, marketManager, balanceCacher.Cache(), collectiblesManager)
 	collectibles := collectibles.NewService(db, feed, accountsDB, accountFeed, settingsFeed, communityManager, rpcClient.NetworkManager, collectiblesManager)
 
 	activity := activity.NewService(db, accountsDB, tokenManager, collectiblesManager, feed, pendingTxManager)
 
+	bundlers := make(map[uint64]smartaccount.Bundler)
+	for chainID, url := range config.WalletConfig.BundlerURLs {
+		bundler, err := smartaccount.NewBundlerClient(url)
+		if err != nil {
+			log.Error("Failed to create bundler client", "chainID", chainID, "err", err)
+			continue
+		}
+		bundlers[chainID] = bundler
+	}
+	paymasters := make(map[uint64]smartaccount.Paymaster)
+	for chainID, url := range config.WalletConfig.PaymasterURLs {
+		paymaster, err := smartaccount.NewPaymasterClient(url)
+		if err != nil {
+			log.Error("Failed to create paymaster client", "chainID", chainID, "err", err)
+			continue
+		}
+		paymasters[chainID] = paymaster
+	}
+	smartAccountManager := smartaccount.NewManager(db, func(chainID uint64) (smartaccount.Backend, error) {
+		return rpcClient.EthClient(chainID)
+	}, bundlers, paymasters)
+	safeManager := safe.NewManager(db, func(chainID uint64) (safe.Backend, error) {
+		return rpcClient.EthClient(chainID)
+	}, transactor)
+
 	featureFlags := &protocolCommon.FeatureFlags{}
 	if config.WalletConfig.EnableCelerBridge {
 		featureFlags.EnableCelerBridge = true
@@ -194,6 +243,7 @@ func NewService(
 		rpcClient:             rpcClient,
 		tokenManager:          tokenManager,
 		communityManager:      communityManager,
+		spamManager:           spamManager,
 		savedAddressesManager: savedAddressesManager,
 		transactionManager:    transactionManager,
 		pendingTxManager:      pendingTxManager,
@@ -201,6 +251,8 @@ func NewService(
 		cryptoOnRampManager:   cryptoOnRampManager,
 		collectiblesManager:   collectiblesManager,
 		collectibles:          collectibles,
+		smartAccountManager:   smartAccountManager,
+		safeManager:           safeManager,
 		gethManager:           gethManager,
 		marketManager:         marketManager,
 		transactor:            transactor,
@@ -228,6 +280,7 @@ type Service struct {
 	savedAddressesManager *SavedAddressesManager
 	tokenManager          *token.Manager
 	communityManager      *community.Manager
+	spamManager           *spam.Manager
 	transactionManager    *transfer.TransactionManager
 	pendingTxManager      *transactions.PendingTxTracker
 	cryptoOnRampManager   *onramp.Manager
@@ -236,6 +289,8 @@ type Service struct {
 	started               bool
 	collectiblesManager   *collectibles.Manager
 	collectibles          *collectibles.Service
+	smartAccountManager   *smartaccount.Manager
+	safeManager           *safe.Manager
 	gethManager           *account.GethManager
 	transactor            *transactions.Transactor
 	ens                   *ens.Service
@@ -264,6 +319,17 @@ func (s *Service) Start() error {
 	return err
 }
 
+// SetSignalsNamespace tags the wallet signals with the key UID of the logged in profile
+func (s *Service) SetSignalsNamespace(signals signal.Namespace) {
+	s.signals.SetNamespace(signals)
+	s.transactionManager.SetSignalsNamespace(signals)
+}
+
+// SignalsNamespace returns the namespace of the wallet signals
+func (s *Service) SignalsNamespace() signal.Namespace {
+	return s.signals.Namespace()
+}
+
 // Set external Collectibles community info provider
 func (s *Service) SetWalletCommunityInfoProvider(provider thirdparty.CommunityInfoProvider) {
 	s.communityManager.SetCommunityInfoProvider(provider)
@@ -342,6 +408,14 @@ func (s *Service) GetCollectiblesManager() *collectibles.Manager {
 	return s.collectiblesManager
 }
 
+func (s *Service) GetSmartAccountManager() *smartaccount.Manager {
+	return s.smartAccountManager
+}
+
+func (s *Service) GetSafeManager() *safe.Manager {
+	return s.safeManager
+}
+
 func (s *Service) GetEnsService() *ens.Service {
 	return s.ens
 }
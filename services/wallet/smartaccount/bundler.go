@@ -0,0 +1,82 @@
+package smartaccount
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// Bundler submits UserOperations to the EntryPoint, as specified by the ERC-4337 RPC methods
+type Bundler interface {
+	SupportedEntryPoints(ctx context.Context) ([]common.Address, error)
+	EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error)
+	SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error)
+	// GetUserOperationReceipt returns nil if the UserOperation is not included yet
+	GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*UserOperationReceipt, error)
+}
+
+// Paymaster pays the gas of UserOperations it agrees to sponsor
+type Paymaster interface {
+	SponsorUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*Sponsorship, error)
+}
+
+type BundlerClient struct {
+	client *gethrpc.Client
+}
+
+func NewBundlerClient(url string) (*BundlerClient, error) {
+	client, err := gethrpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return &BundlerClient{client: client}, nil
+}
+
+func (b *BundlerClient) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	var result []common.Address
+	err := b.client.CallContext(ctx, &result, "eth_supportedEntryPoints")
+	return result, err
+}
+
+func (b *BundlerClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	var result GasEstimate
+	err := b.client.CallContext(ctx, &result, "eth_estimateUserOperationGas", op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (b *BundlerClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	var result common.Hash
+	err := b.client.CallContext(ctx, &result, "eth_sendUserOperation", op, entryPoint)
+	return result, err
+}
+
+func (b *BundlerClient) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*UserOperationReceipt, error) {
+	var result *UserOperationReceipt
+	err := b.client.CallContext(ctx, &result, "eth_getUserOperationReceipt", hash)
+	return result, err
+}
+
+type PaymasterClient struct {
+	client *gethrpc.Client
+}
+
+func NewPaymasterClient(url string) (*PaymasterClient, error) {
+	client, err := gethrpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymasterClient{client: client}, nil
+}
+
+func (p *PaymasterClient) SponsorUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*Sponsorship, error) {
+	var result Sponsorship
+	err := p.client.CallContext(ctx, &result, "pm_sponsorUserOperation", op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,68 @@
+package smartaccount
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewDB(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+const accountColumns = "address, owner, salt, factory, name, created_at"
+
+func (d *Database) AddAccount(account *Account) error {
+	_, err := d.db.Exec(`INSERT INTO smart_accounts (`+accountColumns+`) VALUES (?, ?, ?, ?, ?, ?)`,
+		account.Address, account.Owner, (*bigint.SQLBigIntBytes)(account.Salt.Int), account.Factory, account.Name, account.CreatedAt)
+	return err
+}
+
+func (d *Database) DeleteAccount(address common.Address) error {
+	_, err := d.db.Exec(`DELETE FROM smart_accounts WHERE address = ?`, address)
+	return err
+}
+
+// GetAccount returns nil if address is not a smart account
+func (d *Database) GetAccount(address common.Address) (*Account, error) {
+	accounts, err := d.getAccounts(`SELECT `+accountColumns+` FROM smart_accounts WHERE address = ?`, address)
+	if err != nil || len(accounts) == 0 {
+		return nil, err
+	}
+	return accounts[0], nil
+}
+
+func (d *Database) GetAccounts() ([]*Account, error) {
+	return d.getAccounts(`SELECT ` + accountColumns + ` FROM smart_accounts ORDER BY created_at ASC`)
+}
+
+func (d *Database) GetAccountsByOwner(owner common.Address) ([]*Account, error) {
+	return d.getAccounts(`SELECT `+accountColumns+` FROM smart_accounts WHERE owner = ? ORDER BY created_at ASC`, owner)
+}
+
+func (d *Database) getAccounts(query string, args ...interface{}) ([]*Account, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*Account, 0)
+	for rows.Next() {
+		account := &Account{Salt: &bigint.BigInt{Int: new(big.Int)}}
+		err := rows.Scan(&account.Address, &account.Owner, (*bigint.SQLBigIntBytes)(account.Salt.Int), &account.Factory, &account.Name, &account.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, account)
+	}
+
+	return result, rows.Err()
+}
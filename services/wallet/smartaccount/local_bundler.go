@@ -0,0 +1,103 @@
+package smartaccount
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LocalBundler is an in-memory Bundler accepting every UserOperation signed by the owner of its sender.
+// It doesn't execute anything and is meant to stand in for a bundler in tests.
+type LocalBundler struct {
+	chainID    uint64
+	entryPoint common.Address
+	Estimate   GasEstimate
+
+	mu      sync.Mutex
+	owners  map[common.Address]common.Address
+	ops     map[common.Hash]*UserOperation
+	ordered []*UserOperation
+}
+
+func NewLocalBundler(chainID uint64, entryPoint common.Address) *LocalBundler {
+	return &LocalBundler{
+		chainID:    chainID,
+		entryPoint: entryPoint,
+		Estimate: GasEstimate{
+			PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+			VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		},
+		owners: make(map[common.Address]common.Address),
+		ops:    make(map[common.Hash]*UserOperation),
+	}
+}
+
+// SetOwner sets the owner signatures of the sender's UserOperations are checked against
+func (b *LocalBundler) SetOwner(sender common.Address, owner common.Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.owners[sender] = owner
+}
+
+// UserOperations returns the UserOperations sent so far, in the order they were sent
+func (b *LocalBundler) UserOperations() []*UserOperation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*UserOperation(nil), b.ordered...)
+}
+
+func (b *LocalBundler) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	return []common.Address{b.entryPoint}, nil
+}
+
+func (b *LocalBundler) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	estimate := b.Estimate
+	return &estimate, nil
+}
+
+func (b *LocalBundler) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error) {
+	signer, err := op.Signer(entryPoint, b.chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if owner, ok := b.owners[op.Sender]; !ok || owner != signer {
+		return common.Hash{}, ErrInvalidSignature
+	}
+
+	hash, err := op.Hash(entryPoint, b.chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	b.ops[hash] = op
+	b.ordered = append(b.ordered, op)
+
+	return hash, nil
+}
+
+func (b *LocalBundler) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*UserOperationReceipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	op, ok := b.ops[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	receipt := &UserOperationReceipt{
+		UserOpHash:    hash,
+		Sender:        op.Sender,
+		Nonce:         op.Nonce,
+		Success:       true,
+		ActualGasCost: (*hexutil.Big)(big.NewInt(0)),
+	}
+	receipt.Receipt.TransactionHash = hash
+	return receipt, nil
+}
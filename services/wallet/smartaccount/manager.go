@@ -0,0 +1,362 @@
+package smartaccount
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/erc4337"
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+const (
+	// Gas used by the EntryPoint on top of the calls of a UserOperation: account validation, pre-verification and
+	// the paying of the bundler
+	userOperationGasOverhead = 100000
+	// Gas used by the factory to deploy the account with its first UserOperation
+	accountDeploymentGas = 300000
+)
+
+// Backend is the subset of the chain client used to build UserOperations
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+type BackendGetter func(chainID uint64) (Backend, error)
+
+// Manager keeps the smart accounts of the wallet and sends their calls as UserOperations, through the
+// bundler of the chain. Gas is paid by the paymaster of the chain if there is one, by the account otherwise.
+type Manager struct {
+	db         *Database
+	backends   BackendGetter
+	bundlers   map[uint64]Bundler
+	paymasters map[uint64]Paymaster
+	accountABI abi.ABI
+	factoryABI abi.ABI
+}
+
+func NewManager(db *sql.DB, backends BackendGetter, bundlers map[uint64]Bundler, paymasters map[uint64]Paymaster) *Manager {
+	accountABI, err := abi.JSON(strings.NewReader(erc4337.ISimpleAccountABI))
+	if err != nil {
+		log.Crit("Failed to parse smart account ABI", "err", err)
+	}
+	factoryABI, err := abi.JSON(strings.NewReader(erc4337.ISimpleAccountFactoryABI))
+	if err != nil {
+		log.Crit("Failed to parse smart account factory ABI", "err", err)
+	}
+
+	if bundlers == nil {
+		bundlers = make(map[uint64]Bundler)
+	}
+	if paymasters == nil {
+		paymasters = make(map[uint64]Paymaster)
+	}
+
+	return &Manager{
+		db:         NewDB(db),
+		backends:   backends,
+		bundlers:   bundlers,
+		paymasters: paymasters,
+		accountABI: accountABI,
+		factoryABI: factoryABI,
+	}
+}
+
+// DeriveAddress returns the address of the smart account of owner for salt, whether it is deployed or not
+func (m *Manager) DeriveAddress(ctx context.Context, chainID uint64, owner common.Address, salt *big.Int) (common.Address, error) {
+	factoryAddress, err := erc4337.FactoryAddress(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	backend, err := m.backends(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	factory, err := erc4337.NewISimpleAccountFactoryCaller(factoryAddress, backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return factory.GetAddress(&bind.CallOpts{Context: ctx}, owner, salt)
+}
+
+// AddAccount adds a new smart account owned by owner. The factory deploys accounts at the same address on every
+// chain, chainID is the chain the address is derived on.
+func (m *Manager) AddAccount(ctx context.Context, chainID uint64, owner common.Address, name string) (*Account, error) {
+	ownedAccounts, err := m.db.GetAccountsByOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := big.NewInt(0)
+	for _, account := range ownedAccounts {
+		if account.Salt.Cmp(salt) >= 0 {
+			salt = new(big.Int).Add(account.Salt.Int, big.NewInt(1))
+		}
+	}
+
+	address, err := m.DeriveAddress(ctx, chainID, owner, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := m.db.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrAccountExists
+	}
+
+	factoryAddress, err := erc4337.FactoryAddress(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		Address:   address,
+		Owner:     owner,
+		Salt:      &bigint.BigInt{Int: salt},
+		Factory:   factoryAddress,
+		Name:      name,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	err = m.db.AddAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// GetAccount returns nil if address is not a smart account
+func (m *Manager) GetAccount(address common.Address) (*Account, error) {
+	return m.db.GetAccount(address)
+}
+
+func (m *Manager) GetAccounts() ([]*Account, error) {
+	return m.db.GetAccounts()
+}
+
+func (m *Manager) DeleteAccount(address common.Address) error {
+	return m.db.DeleteAccount(address)
+}
+
+// GasOverhead returns the gas a UserOperation sent by the account uses on top of its calls
+func (m *Manager) GasOverhead(ctx context.Context, chainID uint64, address common.Address) (uint64, error) {
+	backend, err := m.backends(chainID)
+	if err != nil {
+		return 0, err
+	}
+
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(code) == 0 {
+		return userOperationGasOverhead + accountDeploymentGas, nil
+	}
+	return userOperationGasOverhead, nil
+}
+
+func (m *Manager) packCalls(calls []Call) ([]byte, error) {
+	if len(calls) == 1 {
+		value := calls[0].Value
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		return m.accountABI.Pack("execute", calls[0].To, value, calls[0].Data)
+	}
+
+	// executeBatch doesn't transfer any value
+	dest := make([]common.Address, 0, len(calls))
+	data := make([][]byte, 0, len(calls))
+	for _, call := range calls {
+		if call.Value != nil && call.Value.Sign() > 0 {
+			return nil, ErrBatchWithValue
+		}
+		dest = append(dest, call.To)
+		data = append(data, call.Data)
+	}
+	return m.accountABI.Pack("executeBatch", dest, data)
+}
+
+// BuildUserOperation builds the unsigned UserOperation making the account run calls. The nonce is at least minNonce,
+// which allows to queue several UserOperations before the first one is included. The account is deployed by the
+// UserOperation if it isn't yet.
+func (m *Manager) BuildUserOperation(ctx context.Context, chainID uint64, account *Account, calls []Call, minNonce *big.Int) (*UserOperation, error) {
+	if len(calls) == 0 {
+		return nil, ErrNoCalls
+	}
+
+	bundler, ok := m.bundlers[chainID]
+	if !ok {
+		return nil, ErrNoBundler
+	}
+
+	entryPointAddress, err := erc4337.EntryPointAddress(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := m.backends(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	callData, err := m.packCalls(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &UserOperation{
+		Sender:   account.Address,
+		CallData: callData,
+	}
+
+	code, err := backend.CodeAt(ctx, account.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		createAccount, err := m.factoryABI.Pack("createAccount", account.Owner, account.Salt.Int)
+		if err != nil {
+			return nil, err
+		}
+		op.InitCode = append(account.Factory.Bytes(), createAccount...)
+	}
+
+	entryPoint, err := erc4337.NewIEntryPointCaller(entryPointAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := entryPoint.GetNonce(&bind.CallOpts{Context: ctx}, account.Address, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	if minNonce != nil && nonce.Cmp(minNonce) < 0 {
+		nonce = minNonce
+	}
+	op.Nonce = (*hexutil.Big)(nonce)
+
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxFee := new(big.Int).Set(tip)
+	if header.BaseFee != nil {
+		maxFee.Add(maxFee, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	}
+	op.MaxFeePerGas = (*hexutil.Big)(maxFee)
+	op.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+
+	// Gas is estimated with a signature of the right format, the real one depends on the gas limits
+	op.Signature = dummySignature
+
+	paymaster, sponsored := m.paymasters[chainID]
+	if sponsored {
+		// The estimation has to include the verification of the paymaster
+		sponsorship, err := paymaster.SponsorUserOperation(ctx, op, entryPointAddress)
+		if err != nil {
+			return nil, err
+		}
+		op.PaymasterAndData = sponsorship.PaymasterAndData
+	}
+
+	estimate, err := bundler.EstimateUserOperationGas(ctx, op, entryPointAddress)
+	if err != nil {
+		return nil, err
+	}
+	op.PreVerificationGas = estimate.PreVerificationGas
+	op.VerificationGasLimit = estimate.VerificationGasLimit
+	op.CallGasLimit = estimate.CallGasLimit
+
+	if sponsored {
+		// The paymaster signs the gas limits, it has to sponsor the final UserOperation
+		sponsorship, err := paymaster.SponsorUserOperation(ctx, op, entryPointAddress)
+		if err != nil {
+			return nil, err
+		}
+		op.PaymasterAndData = sponsorship.PaymasterAndData
+		if sponsorship.PreVerificationGas != nil {
+			op.PreVerificationGas = sponsorship.PreVerificationGas
+		}
+		if sponsorship.VerificationGasLimit != nil {
+			op.VerificationGasLimit = sponsorship.VerificationGasLimit
+		}
+		if sponsorship.CallGasLimit != nil {
+			op.CallGasLimit = sponsorship.CallGasLimit
+		}
+	}
+
+	op.Signature = nil
+	return op, nil
+}
+
+// SendCalls makes the smart account at address run calls, signed with the key of its owner.
+// It returns the hash of the UserOperation and its nonce.
+func (m *Manager) SendCalls(ctx context.Context, chainID uint64, address common.Address, calls []Call, key *ecdsa.PrivateKey, minNonce *big.Int) (common.Hash, *big.Int, error) {
+	account, err := m.db.GetAccount(address)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	if account == nil {
+		return common.Hash{}, nil, ErrAccountNotFound
+	}
+
+	if crypto.PubkeyToAddress(key.PublicKey) != account.Owner {
+		return common.Hash{}, nil, ErrNotAccountOwner
+	}
+
+	op, err := m.BuildUserOperation(ctx, chainID, account, calls, minNonce)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	entryPointAddress, err := erc4337.EntryPointAddress(chainID)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	err = op.Sign(entryPointAddress, chainID, key)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	hash, err := m.bundlers[chainID].SendUserOperation(ctx, op, entryPointAddress)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	return hash, op.Nonce.ToInt(), nil
+}
+
+// GetUserOperationReceipt returns nil if the UserOperation is not included yet
+func (m *Manager) GetUserOperationReceipt(ctx context.Context, chainID uint64, hash common.Hash) (*UserOperationReceipt, error) {
+	bundler, ok := m.bundlers[chainID]
+	if !ok {
+		return nil, ErrNoBundler
+	}
+	return bundler.GetUserOperationReceipt(ctx, hash)
+}
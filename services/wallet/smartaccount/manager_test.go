@@ -0,0 +1,268 @@
+package smartaccount
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/contracts/erc4337"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+var (
+	entryPointAddress, _ = erc4337.EntryPointAddress(walletCommon.EthereumMainnet)
+	factoryAddress, _    = erc4337.FactoryAddress(walletCommon.EthereumMainnet)
+)
+
+// testBackend answers the factory and EntryPoint calls the manager makes
+type testBackend struct {
+	t          *testing.T
+	factoryABI abi.ABI
+	entryABI   abi.ABI
+	nonces     map[common.Address]*big.Int
+	deployed   map[common.Address]bool
+}
+
+func newTestBackend(t *testing.T) *testBackend {
+	factoryABI, err := abi.JSON(strings.NewReader(erc4337.ISimpleAccountFactoryABI))
+	require.NoError(t, err)
+	entryABI, err := abi.JSON(strings.NewReader(erc4337.IEntryPointABI))
+	require.NoError(t, err)
+
+	return &testBackend{
+		t:          t,
+		factoryABI: factoryABI,
+		entryABI:   entryABI,
+		nonces:     make(map[common.Address]*big.Int),
+		deployed:   make(map[common.Address]bool),
+	}
+}
+
+func testAccountAddress(owner common.Address, salt *big.Int) common.Address {
+	return crypto.CreateAddress(owner, salt.Uint64())
+}
+
+func (b *testBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if b.deployed[contract] {
+		return []byte{0x60}, nil
+	}
+	return nil, nil
+}
+
+func (b *testBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch *call.To {
+	case factoryAddress:
+		method, err := b.factoryABI.MethodById(call.Data[:4])
+		require.NoError(b.t, err)
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		require.NoError(b.t, err)
+		return method.Outputs.Pack(testAccountAddress(args[0].(common.Address), args[1].(*big.Int)))
+	case entryPointAddress:
+		method, err := b.entryABI.MethodById(call.Data[:4])
+		require.NoError(b.t, err)
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		require.NoError(b.t, err)
+		nonce, ok := b.nonces[args[0].(common.Address)]
+		if !ok {
+			nonce = big.NewInt(0)
+		}
+		return method.Outputs.Pack(nonce)
+	}
+	b.t.Fatalf("unexpected call to %s", call.To)
+	return nil, nil
+}
+
+func (b *testBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: big.NewInt(10000000000)}, nil
+}
+
+func (b *testBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1000000000), nil
+}
+
+type testPaymaster struct {
+	calls int
+}
+
+func (p *testPaymaster) SponsorUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (*Sponsorship, error) {
+	p.calls++
+	return &Sponsorship{
+		PaymasterAndData: append(common.HexToAddress("0xaa").Bytes(), byte(p.calls)),
+		CallGasLimit:     (*hexutil.Big)(big.NewInt(200000)),
+	}, nil
+}
+
+func setupTestManager(t *testing.T, paymasters map[uint64]Paymaster) (*Manager, *testBackend, *LocalBundler) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	backend := newTestBackend(t)
+	bundler := NewLocalBundler(walletCommon.EthereumMainnet, entryPointAddress)
+	manager := NewManager(db, func(chainID uint64) (Backend, error) {
+		return backend, nil
+	}, map[uint64]Bundler{walletCommon.EthereumMainnet: bundler}, paymasters)
+
+	return manager, backend, bundler
+}
+
+func TestManager_AddAccount(t *testing.T) {
+	manager, _, _ := setupTestManager(t, nil)
+	owner := common.HexToAddress("0x1234")
+
+	first, err := manager.AddAccount(context.Background(), walletCommon.EthereumMainnet, owner, "first")
+	require.NoError(t, err)
+	require.Equal(t, testAccountAddress(owner, big.NewInt(0)), first.Address)
+	require.Equal(t, factoryAddress, first.Factory)
+
+	second, err := manager.AddAccount(context.Background(), walletCommon.EthereumMainnet, owner, "second")
+	require.NoError(t, err)
+	require.Equal(t, testAccountAddress(owner, big.NewInt(1)), second.Address)
+
+	account, err := manager.GetAccount(second.Address)
+	require.NoError(t, err)
+	require.Equal(t, second, account)
+
+	accounts, err := manager.GetAccounts()
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	// Salts of removed accounts are not reused before the latest one
+	require.NoError(t, manager.DeleteAccount(first.Address))
+	third, err := manager.AddAccount(context.Background(), walletCommon.EthereumMainnet, owner, "third")
+	require.NoError(t, err)
+	require.Equal(t, testAccountAddress(owner, big.NewInt(2)), third.Address)
+
+	account, err = manager.GetAccount(first.Address)
+	require.NoError(t, err)
+	require.Nil(t, account)
+
+	_, err = manager.AddAccount(context.Background(), 12345, owner, "unsupported")
+	require.ErrorIs(t, err, erc4337.ErrorNotAvailableOnChainID)
+}
+
+func TestManager_SendCalls(t *testing.T) {
+	manager, backend, bundler := setupTestManager(t, nil)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	account, err := manager.AddAccount(context.Background(), walletCommon.EthereumMainnet, owner, "account")
+	require.NoError(t, err)
+	bundler.SetOwner(account.Address, owner)
+
+	recipient := common.HexToAddress("0x5678")
+	calls := []Call{{To: recipient, Value: big.NewInt(1000)}}
+
+	// The first UserOperation deploys the account
+	hash, nonce, err := manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, calls, key, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), nonce.Int64())
+
+	ops := bundler.UserOperations()
+	require.Len(t, ops, 1)
+	require.Equal(t, account.Address, ops[0].Sender)
+	require.Equal(t, factoryAddress.Bytes(), []byte(ops[0].InitCode[:common.AddressLength]))
+	require.Equal(t, bundler.Estimate.CallGasLimit, ops[0].CallGasLimit)
+	require.Equal(t, big.NewInt(21000000000), ops[0].MaxFeePerGas.ToInt())
+	require.Empty(t, ops[0].PaymasterAndData)
+
+	method, err := manager.accountABI.MethodById(ops[0].CallData[:4])
+	require.NoError(t, err)
+	require.Equal(t, "execute", method.Name)
+	args, err := method.Inputs.Unpack(ops[0].CallData[4:])
+	require.NoError(t, err)
+	require.Equal(t, recipient, args[0])
+	require.Equal(t, big.NewInt(1000), args[1])
+
+	receipt, err := manager.GetUserOperationReceipt(context.Background(), walletCommon.EthereumMainnet, hash)
+	require.NoError(t, err)
+	require.True(t, receipt.Success)
+	require.Equal(t, account.Address, receipt.Sender)
+
+	// Once deployed, queued UserOperations use the nonce following the last sent one
+	backend.deployed[account.Address] = true
+	_, nonce, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, calls, key, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), nonce.Int64())
+
+	ops = bundler.UserOperations()
+	require.Len(t, ops, 2)
+	require.Empty(t, ops[1].InitCode)
+
+	// Calls without value are batched
+	batch := []Call{{To: recipient, Data: []byte{0x01}}, {To: recipient, Data: []byte{0x02}}}
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, batch, key, nil)
+	require.NoError(t, err)
+	ops = bundler.UserOperations()
+	method, err = manager.accountABI.MethodById(ops[2].CallData[:4])
+	require.NoError(t, err)
+	require.Equal(t, "executeBatch", method.Name)
+
+	batch[0].Value = big.NewInt(1)
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, batch, key, nil)
+	require.ErrorIs(t, err, ErrBatchWithValue)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, calls, otherKey, nil)
+	require.ErrorIs(t, err, ErrNotAccountOwner)
+
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.OptimismMainnet, account.Address, calls, key, nil)
+	require.ErrorIs(t, err, ErrNoBundler)
+
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, recipient, calls, key, nil)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+}
+
+func TestManager_SendCallsSponsored(t *testing.T) {
+	paymaster := &testPaymaster{}
+	manager, _, bundler := setupTestManager(t, map[uint64]Paymaster{walletCommon.EthereumMainnet: paymaster})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	account, err := manager.AddAccount(context.Background(), walletCommon.EthereumMainnet, owner, "account")
+	require.NoError(t, err)
+	bundler.SetOwner(account.Address, owner)
+
+	calls := []Call{{To: common.HexToAddress("0x5678"), Value: big.NewInt(1000)}}
+	_, _, err = manager.SendCalls(context.Background(), walletCommon.EthereumMainnet, account.Address, calls, key, nil)
+	require.NoError(t, err)
+
+	// The paymaster sponsors the estimation and then the final UserOperation
+	require.Equal(t, 2, paymaster.calls)
+	ops := bundler.UserOperations()
+	require.Len(t, ops, 1)
+	require.Equal(t, append(common.HexToAddress("0xaa").Bytes(), 2), []byte(ops[0].PaymasterAndData))
+	require.Equal(t, big.NewInt(200000), ops[0].CallGasLimit.ToInt())
+	require.Equal(t, bundler.Estimate.VerificationGasLimit, ops[0].VerificationGasLimit)
+}
+
+func TestManager_GasOverhead(t *testing.T) {
+	manager, backend, _ := setupTestManager(t, nil)
+	address := common.HexToAddress("0x1234")
+
+	overhead, err := manager.GasOverhead(context.Background(), walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(userOperationGasOverhead+accountDeploymentGas), overhead)
+
+	backend.deployed[address] = true
+	overhead, err = manager.GasOverhead(context.Background(), walletCommon.EthereumMainnet, address)
+	require.NoError(t, err)
+	require.Equal(t, uint64(userOperationGasOverhead), overhead)
+}
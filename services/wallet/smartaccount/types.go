@@ -0,0 +1,192 @@
+package smartaccount
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+var (
+	ErrNoBundler          = errors.New("no bundler for chainID")
+	ErrNoCalls            = errors.New("no calls to send")
+	ErrBatchWithValue     = errors.New("calls sending value can't be batched")
+	ErrAccountExists      = errors.New("smart account already exists")
+	ErrAccountNotFound    = errors.New("smart account not found")
+	ErrInvalidSignature   = errors.New("user operation is not signed by the account owner")
+	ErrNotAccountOwner    = errors.New("key doesn't own the smart account")
+	ErrOwnerCantSign      = errors.New("smart account owner must be a wallet account ready for sending")
+	ErrInvalidSignatureV  = errors.New("invalid signature recovery id")
+	ErrInvalidSignatureSz = errors.New("invalid signature length")
+)
+
+// Account is an ERC-4337 smart account owned by a keypair account. Its address is derived from the owner
+// and the salt before the account is deployed, deployment happens with its first UserOperation.
+type Account struct {
+	Address   common.Address `json:"address"`
+	Owner     common.Address `json:"owner"`
+	Salt      *bigint.BigInt `json:"salt"`
+	Factory   common.Address `json:"factory"`
+	Name      string         `json:"name"`
+	CreatedAt int64          `json:"createdAt"`
+}
+
+// Call is a call made by a smart account
+type Call struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// UserOperation as defined by the EntryPoint v0.6, encoded the way bundlers expect it
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// GasEstimate is the gas needed by a UserOperation, as estimated by a bundler
+type GasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// Sponsorship is what a paymaster returns when it agrees to pay for a UserOperation.
+// The gas limits are covered by the paymaster signature and replace the estimated ones when set.
+type Sponsorship struct {
+	PaymasterAndData     hexutil.Bytes `json:"paymasterAndData"`
+	PreVerificationGas   *hexutil.Big  `json:"preVerificationGas,omitempty"`
+	VerificationGasLimit *hexutil.Big  `json:"verificationGasLimit,omitempty"`
+	CallGasLimit         *hexutil.Big  `json:"callGasLimit,omitempty"`
+}
+
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	Success       bool           `json:"success"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	Receipt       struct {
+		TransactionHash common.Hash `json:"transactionHash"`
+	} `json:"receipt"`
+}
+
+// dummySignature has the format of a signature so that the account validation doesn't revert
+// while the gas of a UserOperation is estimated
+var dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+var (
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+
+	packedUserOpArgs = abi.Arguments{
+		{Type: addressType},
+		{Type: uint256Type},
+		{Type: bytes32Type},
+		{Type: bytes32Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: bytes32Type},
+	}
+	userOpHashArgs = abi.Arguments{
+		{Type: bytes32Type},
+		{Type: addressType},
+		{Type: uint256Type},
+	}
+)
+
+func toBig(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+	return value.ToInt()
+}
+
+// Hash returns the hash signed by the owner of the account, the one computed by EntryPoint.getUserOpHash
+func (op *UserOperation) Hash(entryPoint common.Address, chainID uint64) (common.Hash, error) {
+	packed, err := packedUserOpArgs.Pack(
+		op.Sender,
+		toBig(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		toBig(op.CallGasLimit),
+		toBig(op.VerificationGasLimit),
+		toBig(op.PreVerificationGas),
+		toBig(op.MaxFeePerGas),
+		toBig(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	encoded, err := userOpHashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, new(big.Int).SetUint64(chainID))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// Sign signs the UserOperation the way the account checks it, as an Ethereum signed message of its hash
+func (op *UserOperation) Sign(entryPoint common.Address, chainID uint64, key *ecdsa.PrivateKey) error {
+	hash, err := op.Hash(entryPoint, chainID)
+	if err != nil {
+		return err
+	}
+
+	signature, err := crypto.Sign(accounts.TextHash(hash[:]), key)
+	if err != nil {
+		return err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	op.Signature = signature
+	return nil
+}
+
+// Signer returns the address that signed the UserOperation
+func (op *UserOperation) Signer(entryPoint common.Address, chainID uint64) (common.Address, error) {
+	if len(op.Signature) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignatureSz
+	}
+
+	hash, err := op.Hash(entryPoint, chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	copy(signature, op.Signature)
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		return common.Address{}, ErrInvalidSignatureV
+	}
+	signature[crypto.RecoveryIDOffset] -= 27
+
+	publicKey, err := crypto.SigToPub(accounts.TextHash(hash[:]), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
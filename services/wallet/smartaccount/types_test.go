@@ -0,0 +1,78 @@
+package smartaccount
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func testUserOperation() *UserOperation {
+	return &UserOperation{
+		Sender:               common.HexToAddress("0x1234"),
+		Nonce:                (*hexutil.Big)(big.NewInt(3)),
+		InitCode:             hexutil.Bytes{},
+		CallData:             hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2000000000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1000000000)),
+		PaymasterAndData:     hexutil.Bytes{},
+	}
+}
+
+func TestUserOperationHash(t *testing.T) {
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	op := testUserOperation()
+
+	hash, err := op.Hash(entryPoint, 1)
+	require.NoError(t, err)
+
+	// The hash covers the chain, the EntryPoint and every field but the signature
+	otherChainHash, err := op.Hash(entryPoint, 10)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherChainHash)
+
+	otherEntryPointHash, err := op.Hash(common.HexToAddress("0x01"), 1)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherEntryPointHash)
+
+	op.Signature = dummySignature
+	signedHash, err := op.Hash(entryPoint, 1)
+	require.NoError(t, err)
+	require.Equal(t, hash, signedHash)
+
+	op.Nonce = (*hexutil.Big)(big.NewInt(4))
+	otherNonceHash, err := op.Hash(entryPoint, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherNonceHash)
+}
+
+func TestUserOperationSign(t *testing.T) {
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	op := testUserOperation()
+	require.NoError(t, op.Sign(entryPoint, 1, key))
+	require.Len(t, op.Signature, crypto.SignatureLength)
+	require.GreaterOrEqual(t, op.Signature[crypto.RecoveryIDOffset], byte(27))
+
+	signer, err := op.Signer(entryPoint, 1)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	// A signature for another chain doesn't recover the owner
+	signer, err = op.Signer(entryPoint, 10)
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	op.Signature = op.Signature[:10]
+	_, err = op.Signer(entryPoint, 1)
+	require.ErrorIs(t, err, ErrInvalidSignatureSz)
+}
@@ -0,0 +1,166 @@
+package spam
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Status is the spam classification of a token or collectible contract
+type Status int
+
+const (
+	Unknown Status = iota
+	NotSpam
+	Suspicious
+	Spam
+)
+
+// Reason explains why a contract was classified as spam
+type Reason string
+
+const (
+	ReasonURLInName          Reason = "url-in-name"
+	ReasonLureText           Reason = "lure-text"
+	ReasonLookalikeSymbol    Reason = "lookalike-symbol"
+	ReasonUnsolicitedAirdrop Reason = "unsolicited-airdrop"
+	ReasonNoLiquidity        Reason = "no-liquidity"
+	ReasonBlockList          Reason = "block-list"
+	ReasonUserOverride       Reason = "user-override"
+)
+
+// Weight of each heuristic, a contract is suspicious from suspiciousScore and spam from spamScore
+var reasonScores = map[Reason]int{
+	ReasonURLInName:          3,
+	ReasonLookalikeSymbol:    3,
+	ReasonLureText:           2,
+	ReasonUnsolicitedAirdrop: 2,
+	ReasonNoLiquidity:        1,
+}
+
+const (
+	suspiciousScore = 2
+	spamScore       = 3
+)
+
+var (
+	urlPattern = regexp.MustCompile(`(?i)(https?://|www\.|t\.me/|[a-z0-9-]+\.(com|io|org|net|xyz|app|gift|site|top|finance|fi|claims?|live|pro|info|link|click|vip|cc|to|me|us|gg)\b)`)
+
+	lureWords = []string{"claim", "reward", "airdrop", "voucher", "visit", "redeem", "giveaway", "eligible", "bonus"}
+)
+
+// confusables maps letters commonly used to imitate latin ones in symbols
+var confusables = map[rune]rune{
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P',
+	'С': 'C', 'Т': 'T', 'У': 'Y', 'Х': 'X', 'Ѕ': 'S', 'І': 'I', 'Ј': 'J', 'Ԁ': 'D',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M',
+	'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	'Ⅰ': 'I', 'Ⅴ': 'V', 'Ⅹ': 'X', 'Ⅽ': 'C', 'Ⅾ': 'D', 'Ⅿ': 'M',
+}
+
+// Asset is what the heuristics know of a token or collectible contract
+type Asset struct {
+	ChainID uint64
+	Address common.Address
+	Name    string
+	Symbol  string
+	// Unsolicited is set when the asset was sent to the user in a transaction they didn't initiate
+	// which also transferred it to many other accounts
+	Unsolicited bool
+	// NoLiquidity is set when no market data is available for the asset
+	NoLiquidity bool
+}
+
+// ListedToken is a token of the curated token lists, its symbol can't be used by other contracts of the same chain
+type ListedToken struct {
+	ChainID uint64
+	Address common.Address
+	Symbol  string
+}
+
+// Verdict is the result of the heuristics for an asset
+type Verdict struct {
+	Status  Status   `json:"status"`
+	Reasons []Reason `json:"reasons"`
+}
+
+// normalizeSymbol folds lookalike letters, case and separators so that imitations of a symbol compare equal
+func normalizeSymbol(symbol string) string {
+	var b strings.Builder
+	for _, r := range symbol {
+		r = unicode.ToUpper(r)
+		if latin, ok := confusables[r]; ok {
+			r = latin
+		}
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func hasLureText(text string) bool {
+	text = strings.ToLower(text)
+	for _, word := range lureWords {
+		if strings.Contains(text, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLookalike(asset Asset, listed []ListedToken) bool {
+	symbol := normalizeSymbol(asset.Symbol)
+	if len(symbol) < 2 {
+		return false
+	}
+	for _, token := range listed {
+		if token.ChainID != asset.ChainID || token.Address == asset.Address {
+			continue
+		}
+		if normalizeSymbol(token.Symbol) == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify runs the spam heuristics on an asset that isn't part of the listed tokens
+func Classify(asset Asset, listed []ListedToken) Verdict {
+	reasons := make([]Reason, 0)
+	text := asset.Name + " " + asset.Symbol
+
+	if urlPattern.MatchString(text) {
+		reasons = append(reasons, ReasonURLInName)
+	}
+	if hasLureText(text) {
+		reasons = append(reasons, ReasonLureText)
+	}
+	if isLookalike(asset, listed) {
+		reasons = append(reasons, ReasonLookalikeSymbol)
+	}
+	if asset.Unsolicited {
+		reasons = append(reasons, ReasonUnsolicitedAirdrop)
+	}
+	if asset.NoLiquidity {
+		reasons = append(reasons, ReasonNoLiquidity)
+	}
+
+	score := 0
+	for _, reason := range reasons {
+		score += reasonScores[reason]
+	}
+
+	status := NotSpam
+	if score >= spamScore {
+		status = Spam
+	} else if score >= suspiciousScore {
+		status = Suspicious
+	}
+	return Verdict{
+		Status:  status,
+		Reasons: reasons,
+	}
+}
@@ -0,0 +1,83 @@
+package spam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestClassify(t *testing.T) {
+	usdt := ListedToken{
+		ChainID: 1,
+		Address: common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+		Symbol:  "USDT",
+	}
+	listed := []ListedToken{usdt}
+	other := common.HexToAddress("0x1234")
+
+	testCases := []struct {
+		name    string
+		asset   Asset
+		status  Status
+		reasons []Reason
+	}{
+		{
+			name:    "regular token",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Some Token", Symbol: "SOME"},
+			status:  NotSpam,
+			reasons: []Reason{},
+		},
+		{
+			name:    "listed token",
+			asset:   Asset{ChainID: 1, Address: usdt.Address, Name: "Tether USD", Symbol: "USDT"},
+			status:  NotSpam,
+			reasons: []Reason{},
+		},
+		{
+			name:    "url in name",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Visit uni-rewards.gift", Symbol: "UNI"},
+			status:  Spam,
+			reasons: []Reason{ReasonURLInName, ReasonLureText},
+		},
+		{
+			name:    "lookalike symbol",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Tether USD", Symbol: "UЅDТ"},
+			status:  Spam,
+			reasons: []Reason{ReasonLookalikeSymbol},
+		},
+		{
+			name:    "same symbol on another chain",
+			asset:   Asset{ChainID: 10, Address: other, Name: "Tether USD", Symbol: "USDT"},
+			status:  NotSpam,
+			reasons: []Reason{},
+		},
+		{
+			name:    "lure text",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Claim your tokens", Symbol: "CLAIM"},
+			status:  Suspicious,
+			reasons: []Reason{ReasonLureText},
+		},
+		{
+			name:    "unsolicited airdrop without liquidity",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Moon", Symbol: "MOON", Unsolicited: true, NoLiquidity: true},
+			status:  Spam,
+			reasons: []Reason{ReasonUnsolicitedAirdrop, ReasonNoLiquidity},
+		},
+		{
+			name:    "no liquidity",
+			asset:   Asset{ChainID: 1, Address: other, Name: "Moon", Symbol: "MOON", NoLiquidity: true},
+			status:  NotSpam,
+			reasons: []Reason{ReasonNoLiquidity},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verdict := Classify(tc.asset, listed)
+			require.Equal(t, tc.status, verdict.Status)
+			require.Equal(t, tc.reasons, verdict.Reasons)
+		})
+	}
+}
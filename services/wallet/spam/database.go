@@ -0,0 +1,192 @@
+package spam
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Database struct {
+	db *sql.DB
+}
+
+func NewDatabase(db *sql.DB) *Database {
+	return &Database{
+		db: db,
+	}
+}
+
+// ContractStatus is the spam classification of a contract. Status is the effective one,
+// the user status takes precedence over the block list, which takes precedence over the heuristics.
+type ContractStatus struct {
+	ChainID         uint64         `json:"chainId"`
+	Address         common.Address `json:"address"`
+	Status          Status         `json:"status"`
+	Reasons         []Reason       `json:"reasons"`
+	HeuristicStatus Status         `json:"heuristicStatus"`
+	UserStatus      Status         `json:"userStatus"`
+	Blocked         bool           `json:"blocked"`
+}
+
+func (c *ContractStatus) resolve() {
+	switch {
+	case c.UserStatus != Unknown:
+		c.Status = c.UserStatus
+		c.Reasons = []Reason{ReasonUserOverride}
+	case c.Blocked:
+		c.Status = Spam
+		c.Reasons = append([]Reason{ReasonBlockList}, c.Reasons...)
+	default:
+		c.Status = c.HeuristicStatus
+	}
+}
+
+// BlockListEntry is a contract of the block list
+type BlockListEntry struct {
+	ChainID uint64         `json:"chainId"`
+	Address common.Address `json:"address"`
+}
+
+const selectContractStatusColumns = "chain_id, contract_address, heuristic_status, reasons, user_status, blocked"
+
+func joinReasons(reasons []Reason) string {
+	values := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		values = append(values, string(reason))
+	}
+	return strings.Join(values, ",")
+}
+
+func splitReasons(value string) []Reason {
+	reasons := make([]Reason, 0)
+	if value == "" {
+		return reasons
+	}
+	for _, reason := range strings.Split(value, ",") {
+		reasons = append(reasons, Reason(reason))
+	}
+	return reasons
+}
+
+func rowsToContractStatuses(rows *sql.Rows) ([]*ContractStatus, error) {
+	statuses := make([]*ContractStatus, 0)
+	for rows.Next() {
+		status := &ContractStatus{}
+		var reasons string
+		err := rows.Scan(&status.ChainID, &status.Address, &status.HeuristicStatus, &reasons, &status.UserStatus, &status.Blocked)
+		if err != nil {
+			return nil, err
+		}
+		status.Reasons = splitReasons(reasons)
+		status.resolve()
+		statuses = append(statuses, status)
+	}
+	return statuses, rows.Err()
+}
+
+// GetStatus returns the classification of a contract, nil when it was never classified
+func (d *Database) GetStatus(chainID uint64, address common.Address) (*ContractStatus, error) {
+	rows, err := d.db.Query(`SELECT `+selectContractStatusColumns+` FROM spam_contracts WHERE chain_id = ? AND contract_address = ?`, chainID, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses, err := rowsToContractStatuses(rows)
+	if err != nil || len(statuses) == 0 {
+		return nil, err
+	}
+	return statuses[0], nil
+}
+
+// GetStatuses returns the classification of the contracts of a chain, by address
+func (d *Database) GetStatuses(chainID uint64, addresses []common.Address) (map[common.Address]*ContractStatus, error) {
+	ret := make(map[common.Address]*ContractStatus)
+	if len(addresses) == 0 {
+		return ret, nil
+	}
+
+	args := make([]interface{}, 0, len(addresses)+1)
+	args = append(args, chainID)
+	for _, address := range addresses {
+		args = append(args, address)
+	}
+	placeholders := strings.Repeat(",?", len(addresses))[1:]
+
+	rows, err := d.db.Query(`SELECT `+selectContractStatusColumns+` FROM spam_contracts WHERE chain_id = ? AND contract_address IN (`+placeholders+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses, err := rowsToContractStatuses(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		ret[status.Address] = status
+	}
+	return ret, nil
+}
+
+// GetFlagged returns the contracts classified as suspicious or spam, and the ones overridden by the user
+func (d *Database) GetFlagged() ([]*ContractStatus, error) {
+	rows, err := d.db.Query(`SELECT `+selectContractStatusColumns+` FROM spam_contracts
+		WHERE heuristic_status >= ? OR user_status != ? OR blocked ORDER BY chain_id, contract_address`, Suspicious, Unknown)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return rowsToContractStatuses(rows)
+}
+
+// SaveVerdict stores the result of the heuristics for a contract, user overrides and the block list are kept
+func (d *Database) SaveVerdict(chainID uint64, address common.Address, verdict Verdict) error {
+	_, err := d.db.Exec(`INSERT INTO spam_contracts (chain_id, contract_address, heuristic_status, reasons) VALUES (?, ?, ?, ?)
+		ON CONFLICT(chain_id, contract_address) DO UPDATE SET heuristic_status = excluded.heuristic_status, reasons = excluded.reasons`,
+		chainID, address, verdict.Status, joinReasons(verdict.Reasons))
+	return err
+}
+
+// SetUserStatus overrides the classification of a contract, Unknown removes the override
+func (d *Database) SetUserStatus(chainID uint64, address common.Address, status Status) error {
+	_, err := d.db.Exec(`INSERT INTO spam_contracts (chain_id, contract_address, user_status) VALUES (?, ?, ?)
+		ON CONFLICT(chain_id, contract_address) DO UPDATE SET user_status = excluded.user_status`,
+		chainID, address, status)
+	return err
+}
+
+// ReplaceBlockList replaces the contracts of the block list
+func (d *Database) ReplaceBlockList(entries []BlockListEntry) (err error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	if _, err = tx.Exec(`UPDATE spam_contracts SET blocked = FALSE WHERE blocked`); err != nil {
+		return err
+	}
+
+	block, err := tx.Prepare(`INSERT INTO spam_contracts (chain_id, contract_address, blocked) VALUES (?, ?, TRUE)
+		ON CONFLICT(chain_id, contract_address) DO UPDATE SET blocked = TRUE`)
+	if err != nil {
+		return err
+	}
+	defer block.Close()
+
+	for _, entry := range entries {
+		if _, err = block.Exec(entry.ChainID, entry.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
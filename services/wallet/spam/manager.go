@@ -0,0 +1,173 @@
+package spam
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+// These events are used to notify the UI of state changes
+const (
+	EventSpamStatusChanged walletevent.EventType = "wallet-spam-status-changed"
+)
+
+const (
+	blockListFetchTimeout = 30 * time.Second
+	maxBlockListSize      = 10 * 1024 * 1024
+)
+
+var ErrInvalidUserStatus = errors.New("user status must be unknown, not spam or spam")
+
+// Manager classifies token and collectible contracts as spam. The heuristics only run on contracts
+// that aren't part of the curated lists, their verdict can be overridden by the user or a block list.
+type Manager struct {
+	db   *Database
+	feed *event.Feed
+}
+
+func NewManager(db *sql.DB, feed *event.Feed) *Manager {
+	return &Manager{
+		db:   NewDatabase(db),
+		feed: feed,
+	}
+}
+
+// Classify runs the heuristics on an asset and returns its resulting classification.
+// A contract is never downgraded by the heuristics, as they may run with less information later on.
+func (m *Manager) Classify(asset Asset, listed []ListedToken) (*ContractStatus, error) {
+	status, err := m.db.GetStatus(asset.ChainID, asset.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	verdict := Classify(asset, listed)
+	if status == nil || verdict.Status >= status.HeuristicStatus {
+		if err := m.db.SaveVerdict(asset.ChainID, asset.Address, verdict); err != nil {
+			return nil, err
+		}
+		if verdict.Status >= Suspicious {
+			log.Debug("asset classified as spam", "chainID", asset.ChainID, "address", asset.Address, "status", verdict.Status, "reasons", verdict.Reasons)
+		}
+		if status == nil {
+			status = &ContractStatus{
+				ChainID: asset.ChainID,
+				Address: asset.Address,
+			}
+		}
+		status.HeuristicStatus = verdict.Status
+		status.Reasons = verdict.Reasons
+		status.resolve()
+	}
+	return status, nil
+}
+
+// GetStatus returns the classification of a contract, nil when it was never classified
+func (m *Manager) GetStatus(chainID uint64, address common.Address) (*ContractStatus, error) {
+	return m.db.GetStatus(chainID, address)
+}
+
+// GetStatuses returns the classification of the contracts of a chain, by address
+func (m *Manager) GetStatuses(chainID uint64, addresses []common.Address) (map[common.Address]*ContractStatus, error) {
+	return m.db.GetStatuses(chainID, addresses)
+}
+
+// GetFlagged returns the contracts classified as suspicious or spam, and the ones overridden by the user
+func (m *Manager) GetFlagged() ([]*ContractStatus, error) {
+	return m.db.GetFlagged()
+}
+
+// SetUserStatus lets the user mark a contract as spam or not spam, Unknown restores the heuristic classification
+func (m *Manager) SetUserStatus(chainID uint64, address common.Address, status Status) error {
+	if status != Unknown && status != NotSpam && status != Spam {
+		return ErrInvalidUserStatus
+	}
+	if err := m.db.SetUserStatus(chainID, address, status); err != nil {
+		return err
+	}
+
+	contractStatus, err := m.db.GetStatus(chainID, address)
+	if err != nil {
+		return err
+	}
+	m.sendStatusChanged(chainID, []*ContractStatus{contractStatus})
+	return nil
+}
+
+// ImportBlockList replaces the block list with the one at url, a JSON array of {chainId, address} entries
+func (m *Manager) ImportBlockList(ctx context.Context, url string) (int, error) {
+	entries, err := fetchBlockList(ctx, url)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.db.ReplaceBlockList(entries); err != nil {
+		return 0, err
+	}
+	m.sendStatusChanged(0, nil)
+	return len(entries), nil
+}
+
+// ClearBlockList removes the contracts of the block list
+func (m *Manager) ClearBlockList() error {
+	if err := m.db.ReplaceBlockList(nil); err != nil {
+		return err
+	}
+	m.sendStatusChanged(0, nil)
+	return nil
+}
+
+func fetchBlockList(ctx context.Context, url string) ([]BlockListEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, blockListFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code fetching block list: %d", resp.StatusCode)
+	}
+
+	var entries []BlockListEntry
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBlockListSize)).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (m *Manager) sendStatusChanged(chainID uint64, statuses []*ContractStatus) {
+	if m.feed == nil {
+		return
+	}
+
+	message := ""
+	if statuses != nil {
+		encoded, err := json.Marshal(statuses)
+		if err != nil {
+			log.Error("failed to encode spam statuses", "err", err)
+			return
+		}
+		message = string(encoded)
+	}
+
+	m.feed.Send(walletevent.Event{
+		Type:    EventSpamStatusChanged,
+		ChainID: chainID,
+		Message: message,
+	})
+}
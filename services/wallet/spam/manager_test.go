@@ -0,0 +1,98 @@
+package spam
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+func setupTestManager(t *testing.T) (*Manager, func()) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+
+	return NewManager(db, nil), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestManagerClassify(t *testing.T) {
+	m, stop := setupTestManager(t)
+	defer stop()
+
+	address := common.HexToAddress("0x1234")
+	airdrop := Asset{ChainID: 1, Address: address, Name: "Claim", Symbol: "CLAIM", Unsolicited: true}
+
+	status, err := m.Classify(airdrop, nil)
+	require.NoError(t, err)
+	require.Equal(t, Spam, status.Status)
+
+	// Less information doesn't downgrade the classification
+	airdrop.Unsolicited = false
+	status, err = m.Classify(airdrop, nil)
+	require.NoError(t, err)
+	require.Equal(t, Spam, status.Status)
+	require.Equal(t, []Reason{ReasonLureText, ReasonUnsolicitedAirdrop}, status.Reasons)
+
+	flagged, err := m.GetFlagged()
+	require.NoError(t, err)
+	require.Len(t, flagged, 1)
+
+	// The user overrides the heuristics
+	require.NoError(t, m.SetUserStatus(1, address, NotSpam))
+	status, err = m.Classify(airdrop, nil)
+	require.NoError(t, err)
+	require.Equal(t, NotSpam, status.Status)
+	require.Equal(t, []Reason{ReasonUserOverride}, status.Reasons)
+
+	require.ErrorIs(t, m.SetUserStatus(1, address, Suspicious), ErrInvalidUserStatus)
+
+	require.NoError(t, m.SetUserStatus(1, address, Unknown))
+	status, err = m.GetStatus(1, address)
+	require.NoError(t, err)
+	require.Equal(t, Spam, status.Status)
+
+	status, err = m.GetStatus(1, common.HexToAddress("0x5678"))
+	require.NoError(t, err)
+	require.Nil(t, status)
+}
+
+func TestManagerBlockList(t *testing.T) {
+	m, stop := setupTestManager(t)
+	defer stop()
+
+	blocked := common.HexToAddress("0x1234")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"chainId": 1, "address": "0x0000000000000000000000000000000000001234"}]`))
+	}))
+	defer server.Close()
+
+	count, err := m.ImportBlockList(context.Background(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	statuses, err := m.GetStatuses(1, []common.Address{blocked, common.HexToAddress("0x5678")})
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, Spam, statuses[blocked].Status)
+	require.Equal(t, []Reason{ReasonBlockList}, statuses[blocked].Reasons)
+
+	// The user overrides the block list
+	require.NoError(t, m.SetUserStatus(1, blocked, NotSpam))
+	status, err := m.GetStatus(1, blocked)
+	require.NoError(t, err)
+	require.Equal(t, NotSpam, status.Status)
+	require.NoError(t, m.SetUserStatus(1, blocked, Unknown))
+
+	require.NoError(t, m.ClearBlockList())
+	status, err = m.GetStatus(1, blocked)
+	require.NoError(t, err)
+	require.Equal(t, Unknown, status.Status)
+}
@@ -466,3 +466,62 @@ func (o *Client) FetchCollectionsDataByContractID(ctx context.Context, contractI
 
 	return ret, nil
 }
+
+func (o *Client) FetchCollectionsPriceDataByContractID(ctx context.Context, contractIDs []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	ret := make([]thirdparty.CollectionPriceData, 0, len(contractIDs))
+
+	for _, contractID := range contractIDs {
+		baseURL, err := getNFTBaseURL(contractID.ChainID, o.apiKeys[uint64(contractID.ChainID)])
+		if err != nil {
+			return nil, err
+		}
+
+		queryParams := url.Values{
+			"contractAddress": {contractID.Address.String()},
+		}
+
+		var floorPrices FloorPrices
+		err = o.fetchJSON(ctx, fmt.Sprintf("%s/getFloorPrice?%s", baseURL, queryParams.Encode()), &floorPrices)
+		if err != nil {
+			return nil, err
+		}
+
+		queryParams["order"] = []string{"desc"}
+		queryParams["limit"] = []string{"1"}
+
+		var lastSales NFTSalesContainer
+		err = o.fetchJSON(ctx, fmt.Sprintf("%s/getNFTSales?%s", baseURL, queryParams.Encode()), &lastSales)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, floorPrices.toCommon(contractID, &lastSales))
+	}
+
+	return ret, nil
+}
+
+func (o *Client) fetchJSON(ctx context.Context, url string, result interface{}) error {
+	resp, err := o.doQuery(ctx, url)
+	if err != nil {
+		if ctx.Err() == nil {
+			o.connectionStatus.SetIsConnected(false)
+		}
+		return err
+	}
+	o.connectionStatus.SetIsConnected(true)
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// if Json is not returned there must be an error
+	if !json.Valid(body) {
+		return fmt.Errorf("invalid json: %s", string(body))
+	}
+
+	return json.Unmarshal(body, result)
+}
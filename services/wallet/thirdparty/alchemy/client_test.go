@@ -155,3 +155,27 @@ func TestUnmarshallOwnedCollectibles(t *testing.T) {
 
 	assert.Equal(t, expectedCollectiblesData, collectiblesData)
 }
+
+func TestUnmarshallCollectionPriceData(t *testing.T) {
+	floorPriceJSON := `{"openSea": {"floorPrice": 0.0251, "priceCurrency": "ETH", "collectionUrl": "https://opensea.io/collection/cryptokitties", "retrievedAt": "2023-12-01T10:00:00.000Z"}, "looksRare": {"floorPrice": 0.024, "priceCurrency": "ETH", "collectionUrl": "https://looksrare.org/collections/0x06012c8cf97bead5deae237070f9587f8e7a266d", "retrievedAt": "2023-12-01T10:00:00.000Z"}}`
+	salesJSON := `{"nftSales": [{"marketplace": "seaport", "contractAddress": "0x06012c8cf97bead5deae237070f9587f8e7a266d", "tokenId": "1", "quantity": "1", "sellerFee": {"amount": "27500000000000000", "tokenAddress": "0x0000000000000000000000000000000000000000", "symbol": "ETH", "decimals": 18}, "protocolFee": {"amount": "0", "symbol": "ETH", "decimals": 18}, "royaltyFee": {"amount": "2500000000000000", "tokenAddress": "0x0000000000000000000000000000000000000000", "symbol": "ETH", "decimals": 18}}], "pageKey": "key"}`
+
+	id := thirdparty.ContractID{
+		ChainID: 1,
+		Address: common.HexToAddress("0x06012c8cf97bead5deae237070f9587f8e7a266d"),
+	}
+
+	floorPrices := FloorPrices{}
+	err := json.Unmarshal([]byte(floorPriceJSON), &floorPrices)
+	assert.NoError(t, err)
+
+	sales := NFTSalesContainer{}
+	err = json.Unmarshal([]byte(salesJSON), &sales)
+	assert.NoError(t, err)
+
+	priceData := floorPrices.toCommon(id, &sales)
+	assert.Equal(t, id, priceData.ID)
+	assert.Equal(t, AlchemyID, priceData.Provider)
+	assert.Equal(t, 0.024, priceData.FloorPrice)
+	assert.InDelta(t, 0.03, priceData.LastSalePrice, 1e-9)
+}
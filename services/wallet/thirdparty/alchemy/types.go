@@ -260,3 +260,73 @@ func alchemyToCollectionsData(chainID walletCommon.ChainID, l []Contract) []thir
 	}
 	return ret
 }
+
+type FloorPrice struct {
+	FloorPrice    float64 `json:"floorPrice"`
+	PriceCurrency string  `json:"priceCurrency"`
+}
+
+type FloorPrices struct {
+	OpenSea   FloorPrice `json:"openSea"`
+	LooksRare FloorPrice `json:"looksRare"`
+}
+
+type SaleFee struct {
+	Amount   *bigint.BigInt `json:"amount"`
+	Symbol   string         `json:"symbol"`
+	Decimals int            `json:"decimals"`
+}
+
+type NFTSale struct {
+	SellerFee   SaleFee `json:"sellerFee"`
+	ProtocolFee SaleFee `json:"protocolFee"`
+	RoyaltyFee  SaleFee `json:"royaltyFee"`
+}
+
+type NFTSalesContainer struct {
+	NFTSales []NFTSale `json:"nftSales"`
+}
+
+func (c *FloorPrices) floorPrice() float64 {
+	// Use the lowest floor price among the marketplaces
+	ret := 0.0
+	for _, price := range []FloorPrice{c.OpenSea, c.LooksRare} {
+		if price.FloorPrice <= 0 || !thirdparty.IsNativePriceSymbol(price.PriceCurrency) {
+			continue
+		}
+		if ret == 0 || price.FloorPrice < ret {
+			ret = price.FloorPrice
+		}
+	}
+	return ret
+}
+
+// The price paid by the buyer is the sum of what the seller received and the fees
+func (s *NFTSale) price() float64 {
+	ret := 0.0
+	for _, fee := range []SaleFee{s.SellerFee, s.ProtocolFee, s.RoyaltyFee} {
+		if fee.Amount == nil || !thirdparty.IsNativePriceSymbol(fee.Symbol) {
+			continue
+		}
+		ret += thirdparty.AmountToPrice(fee.Amount.Int, fee.Decimals)
+	}
+	return ret
+}
+
+func (c *NFTSalesContainer) lastSalePrice() float64 {
+	for _, sale := range c.NFTSales {
+		if price := sale.price(); price > 0 {
+			return price
+		}
+	}
+	return 0
+}
+
+func (c *FloorPrices) toCommon(id thirdparty.ContractID, lastSales *NFTSalesContainer) thirdparty.CollectionPriceData {
+	return thirdparty.CollectionPriceData{
+		ID:            id,
+		Provider:      AlchemyID,
+		FloorPrice:    c.floorPrice(),
+		LastSalePrice: lastSales.lastSalePrice(),
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/status-go/protocol/communities/token"
 	"github.com/status-im/status-go/services/wallet/bigint"
 	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/spam"
 )
 
 var (
@@ -155,6 +158,34 @@ type CollectionData struct {
 	Socials      *CollectionSocials         `json:"socials"`
 }
 
+// Collection price info, prices are expressed in the native currency of the chain
+// and are zero when unknown
+type CollectionPriceData struct {
+	ID            ContractID `json:"id"`
+	Provider      string     `json:"provider"`
+	FloorPrice    float64    `json:"floor_price"`
+	LastSalePrice float64    `json:"last_sale_price"`
+}
+
+// IsNativePriceSymbol tells whether a marketplace price is in the native currency. Collectible providers
+// only support chains with ETH as native currency, and prices in wrapped ETH are taken as native ones.
+func IsNativePriceSymbol(symbol string) bool {
+	switch strings.ToUpper(symbol) {
+	case "ETH", "WETH":
+		return true
+	}
+	return false
+}
+
+// AmountToPrice converts an amount in the smallest unit of a currency into a price
+func AmountToPrice(amount *big.Int, decimals int) float64 {
+	if amount == nil {
+		return 0
+	}
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetFloat64(math.Pow10(decimals))).Float64()
+	return price
+}
+
 type CollectionSocials struct {
 	Website       string `json:"website"`
 	TwitterHandle string `json:"twitter_handle"`
@@ -202,6 +233,7 @@ type FullCollectibleData struct {
 	CollectibleCommunityInfo *CollectibleCommunityInfo
 	Ownership                []AccountBalance // This is a list of all the owners of the collectible
 	AccountBalance           *bigint.BigInt   // This is the balance of the collectible for the requested account
+	SpamStatus               spam.Status
 }
 
 type CollectiblesContainer[T any] struct {
@@ -286,6 +318,7 @@ type CollectibleDataProvider interface {
 type CollectionDataProvider interface {
 	CollectibleProvider
 	FetchCollectionsDataByContractID(ctx context.Context, ids []ContractID) ([]CollectionData, error)
+	FetchCollectionsPriceDataByContractID(ctx context.Context, ids []ContractID) ([]CollectionPriceData, error)
 }
 
 type CollectibleSearchProvider interface {
@@ -388,6 +388,21 @@ func (mr *MockCollectionDataProviderMockRecorder) FetchCollectionsDataByContract
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCollectionsDataByContractID", reflect.TypeOf((*MockCollectionDataProvider)(nil).FetchCollectionsDataByContractID), ctx, ids)
 }
 
+// FetchCollectionsPriceDataByContractID mocks base method.
+func (m *MockCollectionDataProvider) FetchCollectionsPriceDataByContractID(ctx context.Context, ids []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchCollectionsPriceDataByContractID", ctx, ids)
+	ret0, _ := ret[0].([]thirdparty.CollectionPriceData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchCollectionsPriceDataByContractID indicates an expected call of FetchCollectionsPriceDataByContractID.
+func (mr *MockCollectionDataProviderMockRecorder) FetchCollectionsPriceDataByContractID(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCollectionsPriceDataByContractID", reflect.TypeOf((*MockCollectionDataProvider)(nil).FetchCollectionsPriceDataByContractID), ctx, ids)
+}
+
 // ID mocks base method.
 func (m *MockCollectionDataProvider) ID() string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,291 @@
+package onchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/connection"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
+)
+
+const metadataRequestTimeout = 10 * time.Second
+
+var ErrBlockRangeUnknown = errors.New("transfers of the account were not scanned yet")
+
+// Backend is the subset of the chain client used to read logs and call contracts
+type Backend interface {
+	bind.ContractCaller
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// BlockRanges gives the block range of an account already scanned for token transfers
+type BlockRanges interface {
+	GetTokenBlockRange(chainID uint64, account common.Address) (from, to *big.Int, err error)
+}
+
+type BackendGetter func(chainID uint64) (Backend, error)
+
+// Client reconstructs the collectibles owned by an account from the ERC-721 and ERC-1155 Transfer logs
+// of the chain and reads their metadata from the token URI, without relying on any indexer
+type Client struct {
+	thirdparty.CollectibleAccountOwnershipProvider
+	getBackend       BackendGetter
+	blockRanges      BlockRanges
+	httpClient       *http.Client
+	connectionStatus *connection.Status
+
+	ownershipLock sync.Mutex
+	ownership     map[string]*ownershipState
+
+	collectionNamesLock sync.RWMutex
+	collectionNames     map[string]string
+}
+
+func NewClient(getBackend BackendGetter, blockRanges BlockRanges) *Client {
+	return &Client{
+		getBackend:       getBackend,
+		blockRanges:      blockRanges,
+		httpClient:       &http.Client{Timeout: metadataRequestTimeout},
+		connectionStatus: connection.NewStatus(),
+		ownership:        make(map[string]*ownershipState),
+		collectionNames:  make(map[string]string),
+	}
+}
+
+func (o *Client) ID() string {
+	return OnChainID
+}
+
+func (o *Client) IsChainSupported(chainID walletCommon.ChainID) bool {
+	_, err := o.getBackend(uint64(chainID))
+	return err == nil
+}
+
+func (o *Client) IsConnected() bool {
+	return o.connectionStatus.IsConnected()
+}
+
+func ownershipKey(chainID walletCommon.ChainID, owner common.Address) string {
+	return fmt.Sprintf("%d+%s", chainID, owner.Hex())
+}
+
+// getOwnedTokens brings the ownership of the account up to date with the scanned block range
+func (o *Client) getOwnedTokens(ctx context.Context, backend Backend, chainID walletCommon.ChainID, owner common.Address, contractAddresses []common.Address) ([]*ownedToken, error) {
+	fromBlock, toBlock, err := o.blockRanges.GetTokenBlockRange(uint64(chainID), owner)
+	if err != nil {
+		return nil, err
+	}
+	if fromBlock == nil {
+		return nil, ErrBlockRangeUnknown
+	}
+
+	o.ownershipLock.Lock()
+	defer o.ownershipLock.Unlock()
+
+	key := ownershipKey(chainID, owner)
+	state, ok := o.ownership[key]
+	// Older blocks were scanned since, ownership has to be reconstructed from scratch
+	if !ok || fromBlock.Cmp(state.fromBlock) < 0 {
+		state = newOwnershipState(fromBlock)
+	}
+
+	err = state.update(ctx, backend, owner, toBlock)
+	if err != nil {
+		if ctx.Err() == nil {
+			o.connectionStatus.SetIsConnected(false)
+		}
+		return nil, err
+	}
+	o.connectionStatus.SetIsConnected(true)
+	o.ownership[key] = state
+
+	return state.sortedTokens(contractAddresses), nil
+}
+
+func (o *Client) FetchAllAssetsByOwner(ctx context.Context, chainID walletCommon.ChainID, owner common.Address, cursor string, limit int) (*thirdparty.FullCollectibleDataContainer, error) {
+	return o.fetchOwnedAssets(ctx, chainID, owner, nil, cursor, limit)
+}
+
+func (o *Client) FetchAllAssetsByOwnerAndContractAddress(ctx context.Context, chainID walletCommon.ChainID, owner common.Address, contractAddresses []common.Address, cursor string, limit int) (*thirdparty.FullCollectibleDataContainer, error) {
+	return o.fetchOwnedAssets(ctx, chainID, owner, contractAddresses, cursor, limit)
+}
+
+func (o *Client) fetchOwnedAssets(ctx context.Context, chainID walletCommon.ChainID, owner common.Address, contractAddresses []common.Address, cursor string, limit int) (*thirdparty.FullCollectibleDataContainer, error) {
+	backend, err := o.getBackend(uint64(chainID))
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := o.getOwnedTokens(ctx, backend, chainID, owner, contractAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cursor is the offset of the page in the sorted owned tokens
+	offset := 0
+	if cursor != "" {
+		offset, err = strconv.Atoi(cursor)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid cursor: %s", cursor)
+		}
+	}
+	if offset > len(tokens) {
+		offset = len(tokens)
+	}
+	end := len(tokens)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+
+	assets := &thirdparty.FullCollectibleDataContainer{
+		Items:          make([]thirdparty.FullCollectibleData, 0, end-offset),
+		PreviousCursor: cursor,
+		Provider:       o.ID(),
+	}
+	if end < len(tokens) {
+		assets.NextCursor = strconv.Itoa(end)
+	}
+
+	for _, token := range tokens[offset:end] {
+		id := thirdparty.CollectibleUniqueID{
+			ContractID: thirdparty.ContractID{
+				ChainID: chainID,
+				Address: token.contractAddress,
+			},
+			TokenID: &bigint.BigInt{Int: new(big.Int).Set(token.tokenID)},
+		}
+		tokenURI, err := tokenuri.Fetch(ctx, backend, token.contractType, id.ContractID.Address, id.TokenID.Int)
+		if err != nil {
+			log.Warn("failed to fetch token URI", "id", id.HashKey(), "err", err)
+		}
+		item := o.buildAsset(ctx, backend, id, token.contractType, tokenURI)
+		item.AccountBalance = &bigint.BigInt{Int: new(big.Int).Set(token.balance)}
+		assets.Items = append(assets.Items, item)
+	}
+
+	return assets, nil
+}
+
+// buildAsset builds the collectible data from its token URI, a collectible whose metadata can't be
+// fetched is still returned so that its ownership is known
+func (o *Client) buildAsset(ctx context.Context, backend Backend, id thirdparty.CollectibleUniqueID, contractType walletCommon.ContractType, tokenURI string) thirdparty.FullCollectibleData {
+	collectible := thirdparty.CollectibleData{
+		ID:           id,
+		ContractType: contractType,
+		Provider:     o.ID(),
+		TokenURI:     tokenURI,
+	}
+
+	if tokenURI != "" {
+		metadata, err := o.fetchMetadata(ctx, tokenURI)
+		if err != nil {
+			log.Warn("failed to fetch collectible metadata", "id", id.HashKey(), "tokenURI", tokenURI, "err", err)
+		} else {
+			collectible.Name = metadata.Name
+			collectible.Description = metadata.Description
+			collectible.Permalink = metadata.ExternalURL
+			collectible.ImageURL = tokenuri.Resolve(metadata.imageURL())
+			collectible.AnimationURL = tokenuri.Resolve(metadata.AnimationURL)
+			collectible.BackgroundColor = metadata.BackgroundColor
+			collectible.Traits = metadata.traits()
+		}
+	}
+
+	return thirdparty.FullCollectibleData{
+		CollectibleData: collectible,
+		CollectionData:  o.getCollectionData(ctx, backend, id.ContractID, contractType),
+	}
+}
+
+func (o *Client) getCollectionData(ctx context.Context, backend Backend, contractID thirdparty.ContractID, contractType walletCommon.ContractType) *thirdparty.CollectionData {
+	key := contractID.HashKey()
+
+	o.collectionNamesLock.RLock()
+	name, ok := o.collectionNames[key]
+	o.collectionNamesLock.RUnlock()
+
+	if !ok {
+		var err error
+		name, err = fetchCollectionName(ctx, backend, contractID.Address)
+		if err != nil {
+			log.Warn("failed to fetch collection name", "contractID", key, "err", err)
+		} else {
+			o.collectionNamesLock.Lock()
+			o.collectionNames[key] = name
+			o.collectionNamesLock.Unlock()
+		}
+	}
+
+	return &thirdparty.CollectionData{
+		ID:           contractID,
+		ContractType: contractType,
+		Provider:     o.ID(),
+		Name:         name,
+		Traits:       make(map[string]thirdparty.CollectionTrait),
+	}
+}
+
+func (o *Client) FetchAssetsByCollectibleUniqueID(ctx context.Context, uniqueIDs []thirdparty.CollectibleUniqueID) ([]thirdparty.FullCollectibleData, error) {
+	ret := make([]thirdparty.FullCollectibleData, 0, len(uniqueIDs))
+
+	for chainID, ids := range thirdparty.GroupCollectibleUIDsByChainID(uniqueIDs) {
+		backend, err := o.getBackend(uint64(chainID))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			// The contract type isn't known, tokenURI is tried before the ERC-1155 uri
+			contractType := walletCommon.ContractTypeERC721
+			tokenURI, err := tokenuri.Fetch(ctx, backend, contractType, id.ContractID.Address, id.TokenID.Int)
+			if err == nil && tokenURI == "" {
+				contractType = walletCommon.ContractTypeERC1155
+				tokenURI, err = tokenuri.Fetch(ctx, backend, contractType, id.ContractID.Address, id.TokenID.Int)
+			}
+			if err != nil {
+				log.Warn("failed to fetch token URI", "id", id.HashKey(), "err", err)
+			}
+			ret = append(ret, o.buildAsset(ctx, backend, id, contractType, tokenURI))
+		}
+	}
+
+	return ret, nil
+}
+
+func (o *Client) FetchCollectionSocials(ctx context.Context, contractID thirdparty.ContractID) (*thirdparty.CollectionSocials, error) {
+	return nil, thirdparty.ErrEndpointNotSupported
+}
+
+func (o *Client) FetchCollectionsPriceDataByContractID(ctx context.Context, contractIDs []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	// Prices come from marketplaces, there is nothing to read on chain
+	return nil, thirdparty.ErrEndpointNotSupported
+}
+
+func (o *Client) FetchCollectionsDataByContractID(ctx context.Context, contractIDs []thirdparty.ContractID) ([]thirdparty.CollectionData, error) {
+	ret := make([]thirdparty.CollectionData, 0, len(contractIDs))
+
+	for _, contractID := range contractIDs {
+		backend, err := o.getBackend(uint64(contractID.ChainID))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *o.getCollectionData(ctx, backend, contractID, walletCommon.ContractTypeUnknown))
+	}
+
+	return ret, nil
+}
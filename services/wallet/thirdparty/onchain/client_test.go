@@ -0,0 +1,184 @@
+package onchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
+)
+
+const simulatedChainID = 1337
+
+type simulatedBlockRanges struct {
+	backend *backends.SimulatedBackend
+}
+
+func (s *simulatedBlockRanges) GetTokenBlockRange(chainID uint64, account common.Address) (from, to *big.Int, err error) {
+	header, err := s.backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return big.NewInt(0), header.Number, nil
+}
+
+func setupSimulatedCollectibles(t *testing.T, baseTokenURI string) (*Client, common.Address, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	other := common.HexToAddress("0x1234")
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		owner: {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	}, 30_000_000)
+	t.Cleanup(func() { backend.Close() })
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+
+	// Any master token address allows the deployer to mint when there is no owner token
+	contractAddress, _, contract, err := collectibles.DeployCollectibles(auth, backend, "Simulated", "SIM", big.NewInt(100), false, true, baseTokenURI, common.Address{}, other)
+	require.NoError(t, err)
+	backend.Commit()
+
+	_, err = contract.MintTo(auth, []common.Address{owner, owner, other, owner})
+	require.NoError(t, err)
+	backend.Commit()
+
+	_, err = contract.TransferFrom(auth, owner, other, big.NewInt(1))
+	require.NoError(t, err)
+	backend.Commit()
+
+	client := NewClient(func(chainID uint64) (Backend, error) {
+		if chainID != simulatedChainID {
+			return nil, fmt.Errorf("unsupported chainID %d", chainID)
+		}
+		return backend, nil
+	}, &simulatedBlockRanges{backend: backend})
+
+	return client, owner, contractAddress
+}
+
+func TestFetchAllAssetsByOwner(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"Token %s","description":"desc","image":"ipfs://ipfs/QmImage%s","attributes":[{"trait_type":"Level","value":5}]}`, r.URL.Path[1:], r.URL.Path[1:])
+	}))
+	defer srv.Close()
+
+	client, owner, contractAddress := setupSimulatedCollectibles(t, srv.URL+"/")
+	chainID := walletCommon.ChainID(simulatedChainID)
+
+	require.True(t, client.IsChainSupported(chainID))
+	require.False(t, client.IsChainSupported(walletCommon.ChainID(walletCommon.EthereumMainnet)))
+
+	assets, err := client.FetchAllAssetsByOwner(context.Background(), chainID, owner, "", 0)
+	require.NoError(t, err)
+	require.True(t, client.IsConnected())
+	require.Equal(t, OnChainID, assets.Provider)
+	require.Empty(t, assets.NextCursor)
+	require.Len(t, assets.Items, 2)
+
+	// Token 1 was transferred out, token 2 was minted to another account
+	for i, tokenID := range []int64{0, 3} {
+		item := assets.Items[i]
+		require.Equal(t, contractAddress, item.CollectibleData.ID.ContractID.Address)
+		require.Equal(t, big.NewInt(tokenID), item.CollectibleData.ID.TokenID.Int)
+		require.Equal(t, walletCommon.ContractTypeERC721, item.CollectibleData.ContractType)
+		require.Equal(t, big.NewInt(1), item.AccountBalance.Int)
+		require.Equal(t, fmt.Sprintf("%s/%d", srv.URL, tokenID), item.CollectibleData.TokenURI)
+		require.Equal(t, fmt.Sprintf("Token %d", tokenID), item.CollectibleData.Name)
+		require.Equal(t, fmt.Sprintf("%sQmImage%d", tokenuri.DefaultIpfsGatewayURL, tokenID), item.CollectibleData.ImageURL)
+		require.Equal(t, "5", item.CollectibleData.Traits[0].Value)
+		require.Equal(t, "Simulated", item.CollectionData.Name)
+	}
+
+	// Paginated
+	assets, err = client.FetchAllAssetsByOwner(context.Background(), chainID, owner, "", 1)
+	require.NoError(t, err)
+	require.Len(t, assets.Items, 1)
+	require.Equal(t, "1", assets.NextCursor)
+	assets, err = client.FetchAllAssetsByOwner(context.Background(), chainID, owner, assets.NextCursor, 1)
+	require.NoError(t, err)
+	require.Len(t, assets.Items, 1)
+	require.Equal(t, big.NewInt(3), assets.Items[0].CollectibleData.ID.TokenID.Int)
+	require.Empty(t, assets.NextCursor)
+
+	// Filtered by contract
+	assets, err = client.FetchAllAssetsByOwnerAndContractAddress(context.Background(), chainID, owner, []common.Address{common.HexToAddress("0x5678")}, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, assets.Items)
+}
+
+func TestERC1155Ownership(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	contractAddress := common.HexToAddress("0x3")
+
+	word := func(value int64) []byte {
+		return common.BigToHash(big.NewInt(value)).Bytes()
+	}
+	addressTopic := func(address common.Address) common.Hash {
+		return common.BytesToHash(address.Bytes())
+	}
+	singleLog := func(from, to common.Address, id, value int64) *types.Log {
+		return &types.Log{
+			Address: contractAddress,
+			Topics:  []common.Hash{erc1155TransferSingleEventSignatureHash, addressTopic(other), addressTopic(from), addressTopic(to)},
+			Data:    append(word(id), word(value)...),
+		}
+	}
+
+	state := newOwnershipState(big.NewInt(0))
+	state.applyLog(owner, singleLog(common.Address{}, owner, 7, 10))
+	state.applyLog(owner, singleLog(common.Address{}, owner, 8, 1))
+	state.applyLog(owner, singleLog(owner, other, 7, 4))
+	state.applyLog(owner, singleLog(owner, other, 8, 1))
+
+	// TransferBatch of ids [7, 9] with values [1, 2]
+	batchData := make([]byte, 0)
+	for _, value := range []int64{64, 160, 2, 7, 9, 2, 1, 2} {
+		batchData = append(batchData, word(value)...)
+	}
+	state.applyLog(owner, &types.Log{
+		Address: contractAddress,
+		Topics:  []common.Hash{erc1155TransferBatchEventSignatureHash, addressTopic(other), addressTopic(other), addressTopic(owner)},
+		Data:    batchData,
+	})
+
+	tokens := state.sortedTokens(nil)
+	require.Len(t, tokens, 2)
+	require.Equal(t, big.NewInt(7), tokens[0].tokenID)
+	require.Equal(t, big.NewInt(7), tokens[0].balance)
+	require.Equal(t, big.NewInt(9), tokens[1].tokenID)
+	require.Equal(t, big.NewInt(2), tokens[1].balance)
+	require.Equal(t, walletCommon.ContractTypeERC1155, tokens[0].contractType)
+}
+
+func TestFetchMetadataDataURI(t *testing.T) {
+	client := NewClient(nil, nil)
+
+	metadata, err := client.fetchMetadata(context.Background(), "data:application/json;base64,eyJuYW1lIjoiQmFzZTY0In0=")
+	require.NoError(t, err)
+	require.Equal(t, "Base64", metadata.Name)
+
+	metadata, err = client.fetchMetadata(context.Background(), `data:application/json;utf8,{"name":"Plain%20text"}`)
+	require.NoError(t, err)
+	require.Equal(t, "Plain text", metadata.Name)
+
+	_, err = client.fetchMetadata(context.Background(), "ftp://example.com/1")
+	require.ErrorIs(t, err, ErrUnsupportedURIScheme)
+}
@@ -0,0 +1,77 @@
+package onchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
+	"github.com/status-im/status-go/services/wallet/tokenuri"
+)
+
+const maxMetadataSize = 1024 * 1024
+
+var ErrUnsupportedURIScheme = errors.New("unsupported URI scheme")
+
+func fetchCollectionName(ctx context.Context, backend Backend, contractAddress common.Address) (string, error) {
+	caller, err := collectibles.NewCollectiblesCaller(contractAddress, backend)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := caller.Name(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		if tokenuri.IsNoTokenURIError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return name, nil
+}
+
+func (o *Client) fetchMetadataContent(ctx context.Context, uri string) ([]byte, error) {
+	if tokenuri.IsDataURI(uri) {
+		return tokenuri.DecodeDataURI(uri)
+	}
+
+	resolved := tokenuri.Resolve(uri)
+	if !tokenuri.IsHTTP(resolved) {
+		return nil, ErrUnsupportedURIScheme
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resolved, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code fetching metadata: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+}
+
+func (o *Client) fetchMetadata(ctx context.Context, tokenURI string) (*Metadata, error) {
+	content, err := o.fetchMetadataContent(ctx, tokenURI)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &Metadata{}
+	if err := json.Unmarshal(content, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
@@ -0,0 +1,214 @@
+package onchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+)
+
+const (
+	logsChunkSize    = 50000
+	minLogsChunkSize = 500
+)
+
+var (
+	transferEventSignatureHash              = walletCommon.GetEventSignatureHash(walletCommon.Erc20_721TransferEventSignature)
+	erc1155TransferSingleEventSignatureHash = walletCommon.GetEventSignatureHash(walletCommon.Erc1155TransferSingleEventSignature)
+	erc1155TransferBatchEventSignatureHash  = walletCommon.GetEventSignatureHash(walletCommon.Erc1155TransferBatchEventSignature)
+)
+
+type ownedToken struct {
+	contractType    walletCommon.ContractType
+	contractAddress common.Address
+	tokenID         *big.Int
+	balance         *big.Int
+}
+
+func tokenKey(contractAddress common.Address, tokenID *big.Int) string {
+	return contractAddress.Hex() + "+" + tokenID.String()
+}
+
+// ownershipState is the ownership of an account reconstructed from its transfer logs up to toBlock
+type ownershipState struct {
+	fromBlock *big.Int
+	toBlock   *big.Int
+	tokens    map[string]*ownedToken
+}
+
+func newOwnershipState(fromBlock *big.Int) *ownershipState {
+	return &ownershipState{
+		fromBlock: fromBlock,
+		toBlock:   new(big.Int).Sub(fromBlock, big.NewInt(1)),
+		tokens:    make(map[string]*ownedToken),
+	}
+}
+
+func (s *ownershipState) add(contractType walletCommon.ContractType, contractAddress common.Address, tokenID, amount *big.Int) {
+	key := tokenKey(contractAddress, tokenID)
+	token, ok := s.tokens[key]
+	if !ok {
+		token = &ownedToken{
+			contractType:    contractType,
+			contractAddress: contractAddress,
+			tokenID:         tokenID,
+			balance:         new(big.Int),
+		}
+		s.tokens[key] = token
+	}
+
+	token.balance.Add(token.balance, amount)
+	if token.balance.Sign() <= 0 {
+		delete(s.tokens, key)
+	}
+}
+
+func (s *ownershipState) applyLog(owner common.Address, ethlog *types.Log) {
+	switch eventType := walletCommon.GetEventType(ethlog); eventType {
+	case walletCommon.Erc721TransferEventType:
+		from, to, tokenID := walletCommon.ParseErc721TransferLog(ethlog)
+		if to == owner {
+			// Balance is always 1, even for self transfers
+			s.tokens[tokenKey(ethlog.Address, tokenID)] = &ownedToken{
+				contractType:    walletCommon.ContractTypeERC721,
+				contractAddress: ethlog.Address,
+				tokenID:         tokenID,
+				balance:         big.NewInt(1),
+			}
+		} else if from == owner {
+			delete(s.tokens, tokenKey(ethlog.Address, tokenID))
+		}
+	case walletCommon.Erc1155TransferSingleEventType, walletCommon.Erc1155TransferBatchEventType:
+		_, from, to, ids, amounts, err := walletCommon.ParseErc1155TransferLog(ethlog, eventType)
+		if err != nil || len(ids) != len(amounts) {
+			log.Warn("invalid erc1155 transfer log", "txHash", ethlog.TxHash, "index", ethlog.Index, "err", err)
+			return
+		}
+		for i := range ids {
+			if from == owner {
+				s.add(walletCommon.ContractTypeERC1155, ethlog.Address, ids[i], new(big.Int).Neg(amounts[i]))
+			}
+			if to == owner {
+				s.add(walletCommon.ContractTypeERC1155, ethlog.Address, ids[i], amounts[i])
+			}
+		}
+	}
+}
+
+// sortedTokens returns the owned tokens ordered by contract address and token ID, so that pages are stable
+func (s *ownershipState) sortedTokens(contractAddresses []common.Address) []*ownedToken {
+	filter := make(map[common.Address]bool, len(contractAddresses))
+	for _, address := range contractAddresses {
+		filter[address] = true
+	}
+
+	ret := make([]*ownedToken, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		if len(filter) > 0 && !filter[token.contractAddress] {
+			continue
+		}
+		ret = append(ret, token)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if c := bytes.Compare(ret[i].contractAddress.Bytes(), ret[j].contractAddress.Bytes()); c != 0 {
+			return c < 0
+		}
+		return ret[i].tokenID.Cmp(ret[j].tokenID) < 0
+	})
+	return ret
+}
+
+// transferQueries returns the filters matching the ERC-721 and ERC-1155 transfers from and to owner
+func transferQueries(owner common.Address, fromBlock, toBlock *big.Int) []ethereum.FilterQuery {
+	ownerTopic := common.BytesToHash(owner.Bytes())
+	erc1155Events := []common.Hash{erc1155TransferSingleEventSignatureHash, erc1155TransferBatchEventSignatureHash}
+
+	topics := [][][]common.Hash{
+		{{transferEventSignatureHash}, {ownerTopic}},
+		{{transferEventSignatureHash}, {}, {ownerTopic}},
+		{erc1155Events, {}, {ownerTopic}},
+		{erc1155Events, {}, {}, {ownerTopic}},
+	}
+
+	queries := make([]ethereum.FilterQuery, 0, len(topics))
+	for _, t := range topics {
+		queries = append(queries, ethereum.FilterQuery{
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+			Topics:    t,
+		})
+	}
+	return queries
+}
+
+// fetchTransferLogs returns the transfer logs of owner in the range, sorted by position in the chain.
+// Logs matching several queries (e.g. self transfers) are only returned once.
+func fetchTransferLogs(ctx context.Context, backend Backend, owner common.Address, fromBlock, toBlock *big.Int) ([]types.Log, error) {
+	seen := make(map[string]bool)
+	ret := make([]types.Log, 0)
+
+	for _, query := range transferQueries(owner, fromBlock, toBlock) {
+		logs, err := backend.FilterLogs(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			key := walletCommon.GetLogSubTxID(l).Hex()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			ret = append(ret, l)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].BlockNumber != ret[j].BlockNumber {
+			return ret[i].BlockNumber < ret[j].BlockNumber
+		}
+		return ret[i].Index < ret[j].Index
+	})
+	return ret, nil
+}
+
+// update applies the transfer logs of owner up to toBlock. The range is split in chunks which
+// are halved when the node refuses a query, usually because it would return too many logs.
+func (s *ownershipState) update(ctx context.Context, backend Backend, owner common.Address, toBlock *big.Int) error {
+	chunkSize := big.NewInt(logsChunkSize)
+	one := big.NewInt(1)
+
+	for s.toBlock.Cmp(toBlock) < 0 {
+		from := new(big.Int).Add(s.toBlock, one)
+		to := new(big.Int).Add(s.toBlock, chunkSize)
+		if to.Cmp(toBlock) > 0 {
+			to.Set(toBlock)
+		}
+
+		logs, err := fetchTransferLogs(ctx, backend, owner, from, to)
+		if err != nil {
+			if ctx.Err() != nil || chunkSize.Int64() <= minLogsChunkSize {
+				return err
+			}
+			chunkSize.Rsh(chunkSize, 1)
+			log.Debug("failed to fetch transfer logs, retrying with smaller range", "owner", owner, "from", from, "to", to, "err", err)
+			continue
+		}
+
+		for i := range logs {
+			s.applyLog(owner, &logs[i])
+		}
+		s.toBlock = to
+	}
+	return nil
+}
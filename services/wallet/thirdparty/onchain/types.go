@@ -0,0 +1,69 @@
+package onchain
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+const OnChainID = "onchain"
+
+type AttributeValue string
+
+func (st *AttributeValue) UnmarshalJSON(b []byte) error {
+	var item interface{}
+	if err := json.Unmarshal(b, &item); err != nil {
+		return err
+	}
+
+	switch v := item.(type) {
+	case float64:
+		*st = AttributeValue(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		*st = AttributeValue(strconv.FormatBool(v))
+	case string:
+		*st = AttributeValue(v)
+	}
+	return nil
+}
+
+type Attribute struct {
+	TraitType   string         `json:"trait_type"`
+	Value       AttributeValue `json:"value"`
+	DisplayType string         `json:"display_type"`
+	MaxValue    AttributeValue `json:"max_value"`
+}
+
+// Metadata is the JSON document a token URI points to, as described by ERC-721 and ERC-1155
+// and extended by the de facto OpenSea format
+type Metadata struct {
+	Name            string      `json:"name"`
+	Description     string      `json:"description"`
+	Image           string      `json:"image"`
+	ImageURL        string      `json:"image_url"`
+	AnimationURL    string      `json:"animation_url"`
+	ExternalURL     string      `json:"external_url"`
+	BackgroundColor string      `json:"background_color"`
+	Attributes      []Attribute `json:"attributes"`
+}
+
+func (m *Metadata) imageURL() string {
+	if m.Image != "" {
+		return m.Image
+	}
+	return m.ImageURL
+}
+
+func (m *Metadata) traits() []thirdparty.CollectibleTrait {
+	ret := make([]thirdparty.CollectibleTrait, 0, len(m.Attributes))
+	for _, attribute := range m.Attributes {
+		ret = append(ret, thirdparty.CollectibleTrait{
+			TraitType:   attribute.TraitType,
+			Value:       string(attribute.Value),
+			DisplayType: attribute.DisplayType,
+			MaxValue:    string(attribute.MaxValue),
+		})
+	}
+	return ret
+}
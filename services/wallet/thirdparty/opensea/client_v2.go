@@ -325,3 +325,62 @@ func (o *ClientV2) FetchCollectionsDataByContractID(ctx context.Context, contrac
 
 	return ret, nil
 }
+
+func (o *ClientV2) FetchCollectionsPriceDataByContractID(ctx context.Context, contractIDs []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	ret := make([]thirdparty.CollectionPriceData, 0, len(contractIDs))
+
+	for _, id := range contractIDs {
+		contractData, err := o.fetchContractDataByContractID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if contractData == nil || contractData.Collection == "" {
+			continue
+		}
+
+		stats := CollectionStats{}
+		err = o.fetchJSON(ctx, id.ChainID, fmt.Sprintf("collections/%s/stats", contractData.Collection), &stats)
+		if err != nil {
+			return nil, err
+		}
+
+		lastSale := EventsContainer{}
+		err = o.fetchJSON(ctx, id.ChainID, fmt.Sprintf("events/collection/%s?event_type=sale&limit=1", contractData.Collection), &lastSale)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, stats.toCommon(id, &lastSale))
+	}
+
+	return ret, nil
+}
+
+func (o *ClientV2) fetchJSON(ctx context.Context, chainID walletCommon.ChainID, path string, result interface{}) error {
+	url, err := o.urlGetter(chainID, path)
+	if err != nil {
+		return err
+	}
+
+	body, err := o.client.doGetRequest(ctx, url, o.apiKey)
+	if err != nil {
+		if ctx.Err() == nil {
+			o.connectionStatus.SetIsConnected(false)
+		}
+		return err
+	}
+	o.connectionStatus.SetIsConnected(true)
+
+	// Empty body on bad requests, no data is available (workaround implemented in http_client.go)
+	if body == nil {
+		return nil
+	}
+
+	// if Json is not returned there must be an error
+	if !json.Valid(body) {
+		return fmt.Errorf("invalid json: %s", string(body))
+	}
+
+	return json.Unmarshal(body, result)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/status-im/status-go/services/wallet/bigint"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -46,3 +47,36 @@ func TestUnmarshallDetailedAsset(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNFT, nftContainer.NFT)
 }
+
+func TestUnmarshallCollectionPriceData(t *testing.T) {
+	statsJSON := `{"total": {"volume": 1234.5, "sales": 100, "average_price": 12.3, "num_owners": 50, "market_cap": 500.2, "floor_price": 0.42, "floor_price_symbol": "ETH"}, "intervals": []}`
+	eventsJSON := `{"asset_events": [{"event_type": "sale", "order_hash": "0x1", "chain": "ethereum", "payment": {"quantity": "510000000000000000", "token_address": "0x0000000000000000000000000000000000000000", "decimals": 18, "symbol": "ETH"}, "quantity": 1}], "next": "cursor"}`
+
+	id := thirdparty.ContractID{
+		ChainID: 1,
+		Address: common.HexToAddress("0x9a95631794a42d30c47f214fbe02a72585df35e1"),
+	}
+
+	stats := CollectionStats{}
+	err := json.Unmarshal([]byte(statsJSON), &stats)
+	assert.NoError(t, err)
+
+	events := EventsContainer{}
+	err = json.Unmarshal([]byte(eventsJSON), &events)
+	assert.NoError(t, err)
+
+	expectedPriceData := thirdparty.CollectionPriceData{
+		ID:            id,
+		Provider:      OpenseaV2ID,
+		FloorPrice:    0.42,
+		LastSalePrice: 0.51,
+	}
+	assert.Equal(t, expectedPriceData, stats.toCommon(id, &events))
+
+	// Prices in other currencies are ignored
+	stats.Total.FloorPriceSymbol = "USDC"
+	events.AssetEvents[0].Payment.Symbol = "USDC"
+	expectedPriceData.FloorPrice = 0
+	expectedPriceData.LastSalePrice = 0
+	assert.Equal(t, expectedPriceData, stats.toCommon(id, &events))
+}
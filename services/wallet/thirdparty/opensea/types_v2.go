@@ -149,6 +149,32 @@ type CollectionData struct {
 	TwitterHandle string         `json:"twitter_username"`
 }
 
+type CollectionStats struct {
+	Total CollectionStatsTotal `json:"total"`
+}
+
+type CollectionStatsTotal struct {
+	FloorPrice       float64 `json:"floor_price"`
+	FloorPriceSymbol string  `json:"floor_price_symbol"`
+}
+
+type EventsContainer struct {
+	AssetEvents []AssetEvent `json:"asset_events"`
+	NextCursor  string       `json:"next"`
+}
+
+type AssetEvent struct {
+	EventType string        `json:"event_type"`
+	Payment   *EventPayment `json:"payment"`
+}
+
+type EventPayment struct {
+	Quantity     *bigint.BigInt `json:"quantity"`
+	TokenAddress common.Address `json:"token_address"`
+	Decimals     int            `json:"decimals"`
+	Symbol       string         `json:"symbol"`
+}
+
 func (c *NFT) id(chainID walletCommon.ChainID) thirdparty.CollectibleUniqueID {
 	return thirdparty.CollectibleUniqueID{
 		ContractID: thirdparty.ContractID{
@@ -247,3 +273,29 @@ func (c *CollectionData) toCommon(id thirdparty.ContractID, tokenStandard string
 	}
 	return ret
 }
+
+func (c *CollectionStats) floorPrice() float64 {
+	if !thirdparty.IsNativePriceSymbol(c.Total.FloorPriceSymbol) {
+		return 0
+	}
+	return c.Total.FloorPrice
+}
+
+func (c *EventsContainer) lastSalePrice() float64 {
+	for _, event := range c.AssetEvents {
+		if event.Payment == nil || event.Payment.Quantity == nil || !thirdparty.IsNativePriceSymbol(event.Payment.Symbol) {
+			continue
+		}
+		return thirdparty.AmountToPrice(event.Payment.Quantity.Int, event.Payment.Decimals)
+	}
+	return 0
+}
+
+func (c *CollectionStats) toCommon(id thirdparty.ContractID, lastSale *EventsContainer) thirdparty.CollectionPriceData {
+	return thirdparty.CollectionPriceData{
+		ID:            id,
+		Provider:      OpenseaV2ID,
+		FloorPrice:    c.floorPrice(),
+		LastSalePrice: lastSale.lastSalePrice(),
+	}
+}
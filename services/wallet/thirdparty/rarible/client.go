@@ -439,6 +439,62 @@ func (o *Client) FetchCollectionsDataByContractID(ctx context.Context, contractI
 	return ret, nil
 }
 
+func (o *Client) FetchCollectionsPriceDataByContractID(ctx context.Context, contractIDs []thirdparty.ContractID) ([]thirdparty.CollectionPriceData, error) {
+	ret := make([]thirdparty.CollectionPriceData, 0, len(contractIDs))
+
+	for _, contractID := range contractIDs {
+		baseURL, err := getBaseURL(contractID.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		collection := fmt.Sprintf("%s:%s", chainIDToChainString(contractID.ChainID), contractID.Address.String())
+
+		var stats CollectionStats
+		url := fmt.Sprintf("%s/v0.1/data/collections/%s/stats?currency=NATIVE", baseURL, collection)
+		err = o.fetchJSON(ctx, contractID.ChainID, url, &stats)
+		if err != nil {
+			return nil, err
+		}
+
+		var lastSales ActivitiesContainer
+		url = fmt.Sprintf("%s/v0.1/activities/byCollection?type=SELL&collection=%s&size=1&sort=LATEST_FIRST", baseURL, collection)
+		err = o.fetchJSON(ctx, contractID.ChainID, url, &lastSales)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, stats.toCommon(contractID, &lastSales))
+	}
+
+	return ret, nil
+}
+
+func (o *Client) fetchJSON(ctx context.Context, chainID walletCommon.ChainID, url string, result interface{}) error {
+	resp, err := o.doQuery(ctx, url, o.getAPIKey(chainID))
+	if err != nil {
+		if ctx.Err() == nil {
+			o.connectionStatus.SetIsConnected(false)
+		}
+		return err
+	}
+	o.connectionStatus.SetIsConnected(true)
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// if Json is not returned there must be an error
+	if !json.Valid(body) {
+		return fmt.Errorf("invalid json: %s", string(body))
+	}
+
+	return json.Unmarshal(body, result)
+}
+
 func (o *Client) searchCollectibles(ctx context.Context, chainID walletCommon.ChainID, collections []common.Address, fullText CollectibleFilterFullText, sort CollectibleFilterContainerSort, cursor string, limit int) (*thirdparty.FullCollectibleDataContainer, error) {
 	baseURL, err := getItemBaseURL(chainID)
 	if err != nil {
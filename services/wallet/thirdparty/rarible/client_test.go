@@ -123,3 +123,34 @@ func TestUnmarshallOwnedCollectibles(t *testing.T) {
 
 	assert.Equal(t, expectedCollectiblesData, collectiblesData)
 }
+
+func TestUnmarshallCollectionPriceData(t *testing.T) {
+	statsJSON := `{"floorPrice": 0.0345, "volume": 12.5, "owners": 42, "items": 1000}`
+	activitiesJSON := `{"continuation": "cursor", "activities": [{"@type": "SELL", "id": "ETHEREUM:1", "date": "2023-12-01T10:00:00Z", "price": 0.05, "priceUsd": 105.2, "payment": {"type": {"@type": "ETH", "blockchain": "ETHEREUM"}, "value": "0.05"}}]}`
+
+	id := thirdparty.ContractID{
+		ChainID: 1,
+		Address: common.HexToAddress("0xb66a603f4cfe17e3d27b87a8bfcad319856518b8"),
+	}
+
+	var stats CollectionStats
+	err := json.Unmarshal([]byte(statsJSON), &stats)
+	assert.NoError(t, err)
+
+	var activities ActivitiesContainer
+	err = json.Unmarshal([]byte(activitiesJSON), &activities)
+	assert.NoError(t, err)
+
+	expectedPriceData := thirdparty.CollectionPriceData{
+		ID:            id,
+		Provider:      RaribleID,
+		FloorPrice:    0.0345,
+		LastSalePrice: 0.05,
+	}
+	assert.Equal(t, expectedPriceData, stats.toCommon(id, &activities))
+
+	// Sales paid with tokens are ignored
+	activities.Activities[0].Payment.Type.Type = "ERC20"
+	expectedPriceData.LastSalePrice = 0
+	assert.Equal(t, expectedPriceData, stats.toCommon(id, &activities))
+}
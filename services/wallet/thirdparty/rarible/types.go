@@ -215,6 +215,28 @@ type Collection struct {
 	Metadata     CollectionMetadata `json:"meta"`
 }
 
+type CollectionStats struct {
+	FloorPrice json.Number `json:"floorPrice"`
+}
+
+type ActivitiesContainer struct {
+	Activities []Activity `json:"activities"`
+}
+
+type Activity struct {
+	Type    string          `json:"@type"`
+	Price   json.Number     `json:"price"`
+	Payment ActivityPayment `json:"payment"`
+}
+
+type ActivityPayment struct {
+	Type ActivityPaymentType `json:"type"`
+}
+
+type ActivityPaymentType struct {
+	Type string `json:"@type"`
+}
+
 type CollectionMetadata struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
@@ -323,6 +345,30 @@ func (c *Collection) toCommon(id thirdparty.ContractID) thirdparty.CollectionDat
 	return ret
 }
 
+func (c *CollectionStats) toCommon(id thirdparty.ContractID, lastSales *ActivitiesContainer) thirdparty.CollectionPriceData {
+	ret := thirdparty.CollectionPriceData{
+		ID:       id,
+		Provider: RaribleID,
+	}
+
+	// Stats are requested in the native currency
+	if floorPrice, err := c.FloorPrice.Float64(); err == nil {
+		ret.FloorPrice = floorPrice
+	}
+
+	for _, activity := range lastSales.Activities {
+		if activity.Type != "SELL" || !thirdparty.IsNativePriceSymbol(activity.Payment.Type.Type) {
+			continue
+		}
+		if price, err := activity.Price.Float64(); err == nil {
+			ret.LastSalePrice = price
+			break
+		}
+	}
+
+	return ret
+}
+
 func contentTypeValue(contentType string, includeOriginal bool) int {
 	ret := -1
 
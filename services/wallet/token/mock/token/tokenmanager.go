@@ -40,6 +40,18 @@ func (m *MockManagerInterface) EXPECT() *MockManagerInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ClassifySpam mocks base method.
+func (m *MockManagerInterface) ClassifySpam(token *token.Token, unsolicited, noLiquidity bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClassifySpam", token, unsolicited, noLiquidity)
+}
+
+// ClassifySpam indicates an expected call of ClassifySpam.
+func (mr *MockManagerInterfaceMockRecorder) ClassifySpam(token, unsolicited, noLiquidity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClassifySpam", reflect.TypeOf((*MockManagerInterface)(nil).ClassifySpam), token, unsolicited, noLiquidity)
+}
+
 // FetchBalancesForChain mocks base method.
 func (m *MockManagerInterface) FetchBalancesForChain(parent context.Context, client chain.ClientInterface, accounts, tokens []common.Address, atBlock *big.Int) (map[common.Address]map[common.Address]*hexutil.Big, error) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,7 @@ import (
 	"github.com/status-im/status-go/services/utils"
 	"github.com/status-im/status-go/services/wallet/bigint"
 	"github.com/status-im/status-go/services/wallet/community"
+	"github.com/status-im/status-go/services/wallet/spam"
 	"github.com/status-im/status-go/services/wallet/token/balancefetcher"
 	"github.com/status-im/status-go/services/wallet/walletevent"
 )
@@ -57,6 +58,7 @@ type Token struct {
 	CommunityData *community.Data `json:"community_data,omitempty"`
 	Verified      bool            `json:"verified"`
 	TokenListID   string          `json:"tokenListId"`
+	SpamStatus    spam.Status     `json:"spamStatus,omitempty"`
 }
 
 type ReceivedToken struct {
@@ -91,6 +93,7 @@ type ManagerInterface interface {
 	LookupToken(chainID *uint64, tokenSymbol string) (token *Token, isNative bool)
 	GetTokenHistoricalBalance(account common.Address, chainID uint64, symbol string, timestamp int64) (*big.Int, error)
 	GetTokensByChainIDs(chainIDs []uint64) ([]*Token, error)
+	ClassifySpam(token *Token, unsolicited, noLiquidity bool)
 }
 
 // Manager is used for accessing token store. It changes the token store based on overridden tokens
@@ -109,10 +112,15 @@ type Manager struct {
 	accountWatcher       *accountsevent.Watcher
 	accountsDB           *accounts.Database
 	tokenBalancesStorage TokenBalancesStorage
+	spamManager          *spam.Manager
 
 	tokens []*Token
 
 	tokenLock sync.RWMutex
+
+	remoteStores     []*remoteStore // Token lists subscribed to by URL, guarded by tokenListsLock
+	tokenListsLock   sync.RWMutex
+	tokenListsCancel context.CancelFunc
 }
 
 func mergeTokens(sliceLists [][]*Token) []*Token {
@@ -141,7 +149,8 @@ func prepareTokens(networkManager network.ManagerInterface, stores []store) []*T
 	for _, store := range stores {
 		validTokens := make([]*Token, 0)
 		for _, token := range store.GetTokens() {
-			token.Verified = true
+			// Only the compiled-in lists are curated
+			token.Verified = !isRemoteStore(store)
 
 			for _, network := range networks {
 				if network.ChainID == token.ChainID {
@@ -172,7 +181,7 @@ func NewTokenManager(
 	stores := []store{newUniswapStore(), newDefaultStore()}
 	tokens := prepareTokens(networkManager, stores)
 
-	return &Manager{
+	manager := &Manager{
 		BalanceFetcher:       balancefetcher.NewDefaultBalanceFetcher(maker),
 		db:                   db,
 		RPCClient:            RPCClient,
@@ -188,10 +197,19 @@ func NewTokenManager(
 		accountsDB:           accountsDB,
 		tokenBalancesStorage: tokenBalancesStorage,
 	}
+
+	if err := manager.loadTokenLists(); err != nil {
+		log.Error("failed to load token lists", "err", err)
+	} else if len(manager.remoteStores) > 0 {
+		manager.updateTokens()
+	}
+
+	return manager
 }
 
 func (tm *Manager) Start() {
 	tm.startAccountsWatcher()
+	tm.startTokenListsRefresh()
 }
 
 func (tm *Manager) startAccountsWatcher() {
@@ -205,6 +223,7 @@ func (tm *Manager) startAccountsWatcher() {
 
 func (tm *Manager) Stop() {
 	tm.stopAccountsWatcher()
+	tm.stopTokenListsRefresh()
 }
 
 func (tm *Manager) stopAccountsWatcher() {
@@ -351,9 +370,72 @@ func (tm *Manager) FindOrCreateTokenByAddress(ctx context.Context, chainID uint6
 	}
 
 	tm.discoverTokenCommunityID(ctx, token, address)
+	tm.ClassifySpam(token, false, false)
 	return token
 }
 
+// SetSpamManager enables the spam classification of tokens that aren't part of the token lists
+func (tm *Manager) SetSpamManager(spamManager *spam.Manager) {
+	tm.spamManager = spamManager
+}
+
+// ClassifySpam runs the spam heuristics on a token that isn't part of the token lists nor of a community.
+// unsolicited tells that the token was airdropped to the user, noLiquidity that it has no market data.
+func (tm *Manager) ClassifySpam(token *Token, unsolicited, noLiquidity bool) {
+	if tm.spamManager == nil || token == nil || token.Verified || token.CommunityData != nil {
+		return
+	}
+
+	listed := make([]spam.ListedToken, 0)
+	for _, t := range tm.getTokens() {
+		if t.Verified && t.ChainID == token.ChainID {
+			listed = append(listed, spam.ListedToken{
+				ChainID: t.ChainID,
+				Address: t.Address,
+				Symbol:  t.Symbol,
+			})
+		}
+	}
+
+	status, err := tm.spamManager.Classify(spam.Asset{
+		ChainID:     token.ChainID,
+		Address:     token.Address,
+		Name:        token.Name,
+		Symbol:      token.Symbol,
+		Unsolicited: unsolicited,
+		NoLiquidity: noLiquidity,
+	}, listed)
+	if err != nil {
+		log.Error("failed to classify token", "chainID", token.ChainID, "address", token.Address, "err", err)
+		return
+	}
+	token.SpamStatus = status.Status
+}
+
+// fillSpamStatuses flags the tokens classified as spam or suspicious
+func (tm *Manager) fillSpamStatuses(tokens []*Token) {
+	if tm.spamManager == nil {
+		return
+	}
+
+	addressesByChain := make(map[uint64][]common.Address)
+	for _, token := range tokens {
+		addressesByChain[token.ChainID] = append(addressesByChain[token.ChainID], token.Address)
+	}
+	for chainID, addresses := range addressesByChain {
+		statuses, err := tm.spamManager.GetStatuses(chainID, addresses)
+		if err != nil {
+			log.Error("failed to get spam statuses", "chainID", chainID, "err", err)
+			continue
+		}
+		for _, token := range tokens {
+			if status, ok := statuses[token.Address]; ok && token.ChainID == chainID {
+				token.SpamStatus = status.Status
+			}
+		}
+	}
+}
+
 func (tm *Manager) MarkAsPreviouslyOwnedToken(token *Token, owner common.Address) (bool, error) {
 	log.Info("Marking token as previously owned", "token", token, "owner", owner)
 	if token == nil {
@@ -561,8 +643,10 @@ func (tm *Manager) GetList() *ListWrapper {
 	}
 
 	updatedAt := time.Now().Unix()
-	for _, store := range tm.stores {
-		updatedAt = store.GetUpdatedAt()
+	for _, store := range tm.getStores() {
+		if !isRemoteStore(store) {
+			updatedAt = store.GetUpdatedAt()
+		}
 		data = append(data, &List{
 			Name:    store.GetName(),
 			Tokens:  store.GetTokens(),
@@ -654,6 +738,8 @@ func (tm *Manager) getTokensFromDB(query string, args ...any) ([]*Token, error)
 		rst = append(rst, token)
 	}
 
+	tm.fillSpamStatuses(rst)
+
 	return rst, nil
 }
 
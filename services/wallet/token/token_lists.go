@@ -0,0 +1,557 @@
+package token
+
+import (
+	"context"
+	_ "embed" // for the token list schema
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventTokenListsUpdated is sent when token lists are subscribed, toggled or refreshed
+	EventTokenListsUpdated walletevent.EventType = "wallet-token-lists-updated"
+
+	tokenListsRefreshInterval = 24 * time.Hour
+	tokenListsCheckInterval   = time.Hour
+	tokenListFetchTimeout     = 30 * time.Second
+	maxTokenListSize          = 20 * 1024 * 1024
+)
+
+// tokenListSchema is the JSON schema of the Uniswap token-list format, see https://uniswap.org/tokenlist.schema.json
+//
+//go:embed tokenlist.schema.json
+var tokenListSchema string
+
+var (
+	ErrTokenListNotFound          = errors.New("token list not found")
+	ErrTokenListAlreadySubscribed = errors.New("token list already subscribed")
+	ErrTokenListDowngrade         = errors.New("token list version is older than the cached one")
+	ErrInvalidTokenListURL        = errors.New("token list URL must be an http or https URL")
+)
+
+var tokenListSchemaLoader = gojsonschema.NewStringLoader(tokenListSchema)
+
+// TokenListVersion is the semantic version of a token list. The major version is bumped
+// when tokens are removed, the minor one when tokens are added and the patch one for any other change.
+type TokenListVersion struct {
+	Major uint `json:"major"`
+	Minor uint `json:"minor"`
+	Patch uint `json:"patch"`
+}
+
+func (v TokenListVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Less returns whether v precedes other
+func (v TokenListVersion) Less(other TokenListVersion) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// tokenListDocument is a token list in the Uniswap token-list format
+type tokenListDocument struct {
+	Name      string           `json:"name"`
+	Timestamp time.Time        `json:"timestamp"`
+	Version   TokenListVersion `json:"version"`
+	LogoURI   string           `json:"logoURI"`
+	Tokens    []struct {
+		ChainID  uint64 `json:"chainId"`
+		Address  string `json:"address"`
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Decimals uint   `json:"decimals"`
+		LogoURI  string `json:"logoURI"`
+	} `json:"tokens"`
+}
+
+// parseTokenList validates a token list against the token-list schema and decodes it
+func parseTokenList(content []byte) (*tokenListDocument, error) {
+	result, err := gojsonschema.Validate(tokenListSchemaLoader, gojsonschema.NewBytesLoader(content))
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid() {
+		return nil, fmt.Errorf("token list does not match schema: %v", result.Errors()[0])
+	}
+
+	var document tokenListDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// TokenList is a token list subscribed to by URL
+type TokenList struct {
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	LogoURI     string `json:"logoUri"`
+	Enabled     bool   `json:"enabled"`
+	TokensCount int    `json:"tokensCount"`
+	UpdatedAt   int64  `json:"updatedAt"`
+	FetchedAt   int64  `json:"fetchedAt"`
+	LastError   string `json:"lastError,omitempty"`
+}
+
+// remoteStore is the store of a token list subscribed to by URL.
+// Its tokens are those of the last valid version of the list fetched.
+type remoteStore struct {
+	url       string
+	enabled   bool
+	fetchedAt int64
+	lastError string
+	document  *tokenListDocument
+	tokens    []*Token
+}
+
+func newRemoteStore(url string, enabled bool, content []byte) (*remoteStore, error) {
+	store := &remoteStore{
+		url:     url,
+		enabled: enabled,
+	}
+	if len(content) == 0 {
+		return store, nil
+	}
+	document, err := parseTokenList(content)
+	if err != nil {
+		return nil, err
+	}
+	store.setDocument(document)
+	return store, nil
+}
+
+func (s *remoteStore) setDocument(document *tokenListDocument) {
+	tokens := make([]*Token, 0, len(document.Tokens))
+	for _, t := range document.Tokens {
+		tokens = append(tokens, &Token{
+			Address:     common.HexToAddress(t.Address),
+			Name:        t.Name,
+			Symbol:      t.Symbol,
+			Decimals:    t.Decimals,
+			ChainID:     t.ChainID,
+			PegSymbol:   GetTokenPegSymbol(t.Symbol),
+			Image:       t.LogoURI,
+			TokenListID: s.url,
+		})
+	}
+	s.document = document
+	s.tokens = tokens
+}
+
+func (s *remoteStore) GetTokens() []*Token {
+	return s.tokens
+}
+
+func (s *remoteStore) GetName() string {
+	if s.document == nil {
+		return ""
+	}
+	return s.document.Name
+}
+
+func (s *remoteStore) GetVersion() string {
+	if s.document == nil {
+		return ""
+	}
+	return s.document.Version.String()
+}
+
+func (s *remoteStore) GetUpdatedAt() int64 {
+	if s.document == nil {
+		return 0
+	}
+	return s.document.Timestamp.Unix()
+}
+
+func (s *remoteStore) GetSource() string {
+	return s.url
+}
+
+func (s *remoteStore) toTokenList() *TokenList {
+	list := &TokenList{
+		URL:         s.url,
+		Name:        s.GetName(),
+		Version:     s.GetVersion(),
+		Enabled:     s.enabled,
+		TokensCount: len(s.tokens),
+		UpdatedAt:   s.GetUpdatedAt(),
+		FetchedAt:   s.fetchedAt,
+		LastError:   s.lastError,
+	}
+	if s.document != nil {
+		list.LogoURI = s.document.LogoURI
+	}
+	return list
+}
+
+func validateTokenListURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidTokenListURL
+	}
+	return nil
+}
+
+func fetchTokenList(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenListFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code fetching token list: %d", resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenListSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxTokenListSize {
+		return nil, errors.New("token list is too large")
+	}
+	return content, nil
+}
+
+// loadTokenLists loads the subscribed token lists cached in the database
+func (tm *Manager) loadTokenLists() error {
+	rows, err := tm.db.Query("SELECT url, enabled, fetched_at, last_error, content FROM token_lists ORDER BY added_at, url")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	stores := make([]*remoteStore, 0)
+	for rows.Next() {
+		var (
+			url, lastError string
+			enabled        bool
+			fetchedAt      int64
+			content        []byte
+		)
+		if err := rows.Scan(&url, &enabled, &fetchedAt, &lastError, &content); err != nil {
+			return err
+		}
+		store, err := newRemoteStore(url, enabled, content)
+		if err != nil {
+			log.Error("cached token list is invalid", "url", url, "err", err)
+			store = &remoteStore{url: url, enabled: enabled}
+		}
+		store.fetchedAt = fetchedAt
+		store.lastError = lastError
+		stores = append(stores, store)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tm.tokenListsLock.Lock()
+	tm.remoteStores = stores
+	tm.tokenListsLock.Unlock()
+	return nil
+}
+
+func (tm *Manager) findRemoteStore(url string) *remoteStore {
+	for _, store := range tm.remoteStores {
+		if store.url == url {
+			return store
+		}
+	}
+	return nil
+}
+
+// getStores returns the stores tokens are taken from, in order of precedence:
+// the compiled-in ones first, then the enabled token lists in the order they were subscribed
+func (tm *Manager) getStores() []store {
+	tm.tokenListsLock.RLock()
+	defer tm.tokenListsLock.RUnlock()
+
+	stores := make([]store, 0, len(tm.stores)+len(tm.remoteStores))
+	stores = append(stores, tm.stores...)
+	for _, store := range tm.remoteStores {
+		if store.enabled && store.document != nil {
+			stores = append(stores, store)
+		}
+	}
+	return stores
+}
+
+// updateTokens merges the tokens of the stores again after token lists changed
+func (tm *Manager) updateTokens() {
+	tokens := prepareTokens(tm.networkManager, tm.getStores())
+	if tokens == nil {
+		return
+	}
+	tm.SetTokens(tokens)
+	tm.sendTokenListsUpdated()
+}
+
+func (tm *Manager) sendTokenListsUpdated() {
+	if tm.walletFeed == nil {
+		return
+	}
+	tm.walletFeed.Send(walletevent.Event{
+		Type: EventTokenListsUpdated,
+	})
+}
+
+// GetTokenLists returns the token lists subscribed to
+func (tm *Manager) GetTokenLists() []*TokenList {
+	tm.tokenListsLock.RLock()
+	defer tm.tokenListsLock.RUnlock()
+
+	lists := make([]*TokenList, 0, len(tm.remoteStores))
+	for _, store := range tm.remoteStores {
+		lists = append(lists, store.toTokenList())
+	}
+	return lists
+}
+
+// SubscribeTokenList fetches and validates the token list at url, then adds its tokens to the catalogue.
+// Tokens of the compiled-in lists and of lists subscribed earlier take precedence.
+func (tm *Manager) SubscribeTokenList(ctx context.Context, url string) (*TokenList, error) {
+	if err := validateTokenListURL(url); err != nil {
+		return nil, err
+	}
+
+	tm.tokenListsLock.RLock()
+	subscribed := tm.findRemoteStore(url) != nil
+	tm.tokenListsLock.RUnlock()
+	if subscribed {
+		return nil, ErrTokenListAlreadySubscribed
+	}
+
+	content, err := fetchTokenList(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	store, err := newRemoteStore(url, true, content)
+	if err != nil {
+		return nil, err
+	}
+	store.fetchedAt = time.Now().Unix()
+
+	tm.tokenListsLock.Lock()
+	if tm.findRemoteStore(url) != nil {
+		tm.tokenListsLock.Unlock()
+		return nil, ErrTokenListAlreadySubscribed
+	}
+	_, err = tm.db.Exec("INSERT INTO token_lists (url, enabled, added_at, fetched_at, content) VALUES (?, ?, ?, ?, ?)",
+		url, true, time.Now().UnixNano(), store.fetchedAt, content)
+	if err != nil {
+		tm.tokenListsLock.Unlock()
+		return nil, err
+	}
+	tm.remoteStores = append(tm.remoteStores, store)
+	list := store.toTokenList()
+	tm.tokenListsLock.Unlock()
+
+	tm.updateTokens()
+	return list, nil
+}
+
+// UnsubscribeTokenList removes a token list and its tokens from the catalogue
+func (tm *Manager) UnsubscribeTokenList(url string) error {
+	tm.tokenListsLock.Lock()
+	stores := make([]*remoteStore, 0, len(tm.remoteStores))
+	for _, store := range tm.remoteStores {
+		if store.url != url {
+			stores = append(stores, store)
+		}
+	}
+	if len(stores) == len(tm.remoteStores) {
+		tm.tokenListsLock.Unlock()
+		return ErrTokenListNotFound
+	}
+	if _, err := tm.db.Exec("DELETE FROM token_lists WHERE url = ?", url); err != nil {
+		tm.tokenListsLock.Unlock()
+		return err
+	}
+	tm.remoteStores = stores
+	tm.tokenListsLock.Unlock()
+
+	tm.updateTokens()
+	return nil
+}
+
+// SetTokenListEnabled adds or removes the tokens of a subscribed token list from the catalogue
+func (tm *Manager) SetTokenListEnabled(url string, enabled bool) error {
+	tm.tokenListsLock.Lock()
+	store := tm.findRemoteStore(url)
+	if store == nil {
+		tm.tokenListsLock.Unlock()
+		return ErrTokenListNotFound
+	}
+	if store.enabled == enabled {
+		tm.tokenListsLock.Unlock()
+		return nil
+	}
+	if _, err := tm.db.Exec("UPDATE token_lists SET enabled = ? WHERE url = ?", enabled, url); err != nil {
+		tm.tokenListsLock.Unlock()
+		return err
+	}
+	store.enabled = enabled
+	tm.tokenListsLock.Unlock()
+
+	tm.updateTokens()
+	return nil
+}
+
+// RefreshTokenLists fetches all the subscribed token lists again. Lists that fail to be fetched
+// or validated keep their cached version, the failure is reported in their last error.
+func (tm *Manager) RefreshTokenLists(ctx context.Context) []*TokenList {
+	tm.refreshTokenLists(ctx, func(*remoteStore) bool { return true })
+	return tm.GetTokenLists()
+}
+
+// refreshStaleTokenLists fetches the token lists that weren't fetched for longer than the refresh interval
+func (tm *Manager) refreshStaleTokenLists(ctx context.Context) {
+	staleBefore := time.Now().Add(-tokenListsRefreshInterval).Unix()
+	tm.refreshTokenLists(ctx, func(store *remoteStore) bool {
+		return store.fetchedAt < staleBefore
+	})
+}
+
+func (tm *Manager) refreshTokenLists(ctx context.Context, shouldRefresh func(*remoteStore) bool) {
+	tm.tokenListsLock.RLock()
+	urls := make([]string, 0, len(tm.remoteStores))
+	for _, store := range tm.remoteStores {
+		if shouldRefresh(store) {
+			urls = append(urls, store.url)
+		}
+	}
+	tm.tokenListsLock.RUnlock()
+
+	if len(urls) == 0 {
+		return
+	}
+
+	changed := false
+	for _, url := range urls {
+		if ctx.Err() != nil {
+			break
+		}
+		updated, err := tm.refreshTokenList(ctx, url)
+		if err != nil {
+			log.Error("failed to refresh token list", "url", url, "err", err)
+		}
+		changed = changed || updated
+	}
+
+	if changed {
+		tm.updateTokens()
+	} else {
+		tm.sendTokenListsUpdated()
+	}
+}
+
+// refreshTokenList fetches a token list and returns whether its tokens changed
+func (tm *Manager) refreshTokenList(ctx context.Context, url string) (bool, error) {
+	var document *tokenListDocument
+	content, err := fetchTokenList(ctx, url)
+	if err == nil {
+		document, err = parseTokenList(content)
+	}
+
+	tm.tokenListsLock.Lock()
+	defer tm.tokenListsLock.Unlock()
+
+	store := tm.findRemoteStore(url)
+	if store == nil {
+		// Unsubscribed meanwhile
+		return false, nil
+	}
+	if err == nil && store.document != nil && document.Version.Less(store.document.Version) {
+		err = ErrTokenListDowngrade
+	}
+
+	fetchedAt := time.Now().Unix()
+	if err != nil {
+		if _, dbErr := tm.db.Exec("UPDATE token_lists SET fetched_at = ?, last_error = ? WHERE url = ?", fetchedAt, err.Error(), url); dbErr != nil {
+			return false, dbErr
+		}
+		store.fetchedAt = fetchedAt
+		store.lastError = err.Error()
+		return false, err
+	}
+
+	if store.document != nil && document.Version == store.document.Version {
+		_, err = tm.db.Exec("UPDATE token_lists SET fetched_at = ?, last_error = '' WHERE url = ?", fetchedAt, url)
+	} else {
+		_, err = tm.db.Exec("UPDATE token_lists SET fetched_at = ?, last_error = '', content = ? WHERE url = ?", fetchedAt, content, url)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	store.fetchedAt = fetchedAt
+	store.lastError = ""
+	if store.document != nil && document.Version == store.document.Version {
+		return false, nil
+	}
+	store.setDocument(document)
+	return store.enabled, nil
+}
+
+func (tm *Manager) startTokenListsRefresh() {
+	if tm.tokenListsCancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tm.tokenListsCancel = cancel
+	go func() {
+		ticker := time.NewTicker(tokenListsCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			tm.refreshStaleTokenLists(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (tm *Manager) stopTokenListsRefresh() {
+	if tm.tokenListsCancel != nil {
+		tm.tokenListsCancel()
+		tm.tokenListsCancel = nil
+	}
+}
+
+// isRemoteStore tells whether tokens come from a token list subscribed to by URL rather than a compiled-in one
+func isRemoteStore(s store) bool {
+	_, ok := s.(*remoteStore)
+	return ok
+}
@@ -0,0 +1,149 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/params"
+	mock_network "github.com/status-im/status-go/rpc/network/mock"
+)
+
+const testTokenList = `{
+	"name": "Test List",
+	"timestamp": "2024-10-18T08:00:00.000Z",
+	"version": {"major": 1, "minor": %d, "patch": 0},
+	"tokens": [
+		{
+			"chainId": 1,
+			"address": "0x0000000000000000000000000000000000000aaa",
+			"name": "Alpha",
+			"symbol": "ALPHA",
+			"decimals": 18,
+			"logoURI": "https://example.com/alpha.png"
+		},
+		{
+			"chainId": 999,
+			"address": "0x0000000000000000000000000000000000000bbb",
+			"name": "Beta",
+			"symbol": "BETA",
+			"decimals": 6
+		}
+	]
+}`
+
+type testTokenListServer struct {
+	mu      sync.Mutex
+	content string
+}
+
+func (s *testTokenListServer) setMinorVersion(minor int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.content = fmt.Sprintf(testTokenList, minor)
+}
+
+func (s *testTokenListServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, _ = w.Write([]byte(s.content))
+}
+
+func findTestToken(manager *Manager, address common.Address) *Token {
+	for _, token := range manager.getTokens() {
+		if token.Address == address {
+			return token
+		}
+	}
+	return nil
+}
+
+func TestTokenLists(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	networkManager := mock_network.NewMockManagerInterface(ctrl)
+	networks := []*params.Network{{ChainID: 1}}
+	networkManager.EXPECT().GetAll().Return(networks, nil).AnyTimes()
+	networkManager.EXPECT().Get(gomock.Any()).Return(networks, nil).AnyTimes()
+	manager.networkManager = networkManager
+
+	listServer := &testTokenListServer{}
+	listServer.setMinorVersion(1)
+	server := httptest.NewServer(listServer)
+	defer server.Close()
+	listURL := server.URL + "/tokens.json"
+	alpha := common.HexToAddress("0x0000000000000000000000000000000000000aaa")
+	beta := common.HexToAddress("0x0000000000000000000000000000000000000bbb")
+
+	list, err := manager.SubscribeTokenList(context.Background(), listURL)
+	require.NoError(t, err)
+	require.Equal(t, "Test List", list.Name)
+	require.Equal(t, "1.1.0", list.Version)
+	require.Equal(t, 2, list.TokensCount)
+	require.True(t, list.Enabled)
+
+	_, err = manager.SubscribeTokenList(context.Background(), listURL)
+	require.ErrorIs(t, err, ErrTokenListAlreadySubscribed)
+	_, err = manager.SubscribeTokenList(context.Background(), "ftp://example.com/tokens.json")
+	require.ErrorIs(t, err, ErrInvalidTokenListURL)
+
+	// Tokens are attributed to the list and not verified, the ones of unknown networks are left out
+	token := findTestToken(manager, alpha)
+	require.NotNil(t, token)
+	require.Equal(t, listURL, token.TokenListID)
+	require.Equal(t, "https://example.com/alpha.png", token.Image)
+	require.False(t, token.Verified)
+	require.Nil(t, findTestToken(manager, beta))
+
+	lists := manager.GetList().Data
+	require.Equal(t, listURL, lists[len(lists)-1].Source)
+
+	require.NoError(t, manager.SetTokenListEnabled(listURL, false))
+	require.Nil(t, findTestToken(manager, alpha))
+	require.NoError(t, manager.SetTokenListEnabled(listURL, true))
+	require.NotNil(t, findTestToken(manager, alpha))
+
+	// Lists are cached
+	listServer.setMinorVersion(2)
+	require.NoError(t, manager.loadTokenLists())
+	require.Equal(t, "1.1.0", manager.GetTokenLists()[0].Version)
+
+	refreshed := manager.RefreshTokenLists(context.Background())
+	require.Len(t, refreshed, 1)
+	require.Equal(t, "1.2.0", refreshed[0].Version)
+	require.Empty(t, refreshed[0].LastError)
+
+	// Older versions are rejected, the cached one is kept
+	listServer.setMinorVersion(0)
+	refreshed = manager.RefreshTokenLists(context.Background())
+	require.Equal(t, "1.2.0", refreshed[0].Version)
+	require.Equal(t, ErrTokenListDowngrade.Error(), refreshed[0].LastError)
+
+	// So are lists that don't match the schema
+	listServer.mu.Lock()
+	listServer.content = `{"name": "Test List", "tokens": []}`
+	listServer.mu.Unlock()
+	refreshed = manager.RefreshTokenLists(context.Background())
+	require.Equal(t, "1.2.0", refreshed[0].Version)
+	require.Contains(t, refreshed[0].LastError, "does not match schema")
+	require.NotNil(t, findTestToken(manager, alpha))
+
+	require.NoError(t, manager.UnsubscribeTokenList(listURL))
+	require.Nil(t, findTestToken(manager, alpha))
+	require.Empty(t, manager.GetTokenLists())
+	require.ErrorIs(t, manager.UnsubscribeTokenList(listURL), ErrTokenListNotFound)
+
+	require.NoError(t, manager.loadTokenLists())
+	require.Empty(t, manager.GetTokenLists())
+}
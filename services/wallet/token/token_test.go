@@ -24,6 +24,7 @@ import (
 	"github.com/status-im/status-go/services/accounts/accountsevent"
 	"github.com/status-im/status-go/services/wallet/bigint"
 	"github.com/status-im/status-go/services/wallet/community"
+	"github.com/status-im/status-go/services/wallet/spam"
 
 	"github.com/status-im/status-go/t/helpers"
 	"github.com/status-im/status-go/t/utils"
@@ -427,3 +428,37 @@ func Test_tokensListsValidity(t *testing.T) {
 		}
 	}
 }
+
+func TestClassifySpam(t *testing.T) {
+	manager, stop := setupTestTokenDB(t)
+	defer stop()
+
+	listed := &Token{
+		Address:  common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+		Symbol:   "USDT",
+		Name:     "Tether USD",
+		ChainID:  1,
+		Verified: true,
+	}
+	manager.SetTokens([]*Token{listed})
+	manager.SetSpamManager(spam.NewManager(manager.db, nil))
+
+	lookalike := &Token{
+		Address: common.HexToAddress("0x1234"),
+		Symbol:  "USDT",
+		Name:    "Tether USD",
+		ChainID: 1,
+	}
+	manager.ClassifySpam(lookalike, false, false)
+	require.Equal(t, spam.Spam, lookalike.SpamStatus)
+
+	manager.ClassifySpam(listed, true, true)
+	require.Equal(t, spam.Unknown, listed.SpamStatus)
+
+	// Spam tokens are flagged in the tokens stored
+	require.NoError(t, manager.UpsertCustom(*lookalike))
+	customs, err := manager.GetCustoms(false)
+	require.NoError(t, err)
+	require.Len(t, customs, 1)
+	require.Equal(t, spam.Spam, customs[0].SpamStatus)
+}
@@ -0,0 +1,142 @@
+package tokenuri
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
+	"github.com/status-im/status-go/params"
+	walletCommon "github.com/status-im/status-go/services/wallet/common"
+)
+
+const (
+	DefaultIpfsGatewayURL = "https://ipfs.io/ipfs/"
+	ArweaveGatewayURL     = "https://arweave.net/"
+)
+
+// ERC-1155 metadata extension, not part of the ierc1155 bindings
+const erc1155MetadataURIABI = `[{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"}],"name":"uri","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}]`
+
+// Contract does not support the metadata extension if call
+// returns error starting with one of these strings
+var noTokenURIErrorPrefixes = []string{
+	"execution reverted",
+	"abi: attempting to unmarshall",
+}
+
+// IsNoTokenURIError tells whether a contract call failed because the contract doesn't implement the method
+func IsNoTokenURIError(err error) bool {
+	for _, errorPrefix := range noTokenURIErrorPrefixes {
+		if strings.Contains(err.Error(), errorPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetch calls tokenURI for ERC-721 contracts and uri for ERC-1155 ones, an empty
+// URI is returned when the contract doesn't implement the metadata extension
+func Fetch(ctx context.Context, caller bind.ContractCaller, contractType walletCommon.ContractType, contractAddress common.Address, tokenID *big.Int) (string, error) {
+	var tokenURI string
+	var err error
+
+	switch contractType {
+	case walletCommon.ContractTypeERC1155:
+		tokenURI, err = ERC1155URI(ctx, caller, contractAddress, tokenID)
+	default:
+		tokenURI, err = ERC721TokenURI(ctx, caller, contractAddress, tokenID)
+	}
+
+	if err != nil {
+		if IsNoTokenURIError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return tokenURI, nil
+}
+
+// ERC721TokenURI calls tokenURI of an ERC-721 contract
+func ERC721TokenURI(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, tokenID *big.Int) (string, error) {
+	contract, err := collectibles.NewCollectiblesCaller(contractAddress, caller)
+	if err != nil {
+		return "", err
+	}
+	return contract.TokenURI(&bind.CallOpts{Context: ctx}, tokenID)
+}
+
+// ERC1155URI calls uri of an ERC-1155 contract and substitutes the token ID in the returned URI
+func ERC1155URI(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, tokenID *big.Int) (string, error) {
+	parsed, err := abi.JSON(strings.NewReader(erc1155MetadataURIABI))
+	if err != nil {
+		return "", err
+	}
+
+	contract := bind.NewBoundContract(contractAddress, parsed, caller, nil, nil)
+	var out []interface{}
+	err = contract.Call(&bind.CallOpts{Context: ctx}, &out, "uri", tokenID)
+	if err != nil {
+		return "", err
+	}
+	tokenURI := *abi.ConvertType(out[0], new(string)).(*string)
+
+	// ERC-1155 clients must replace {id} with the lowercase, zero padded hex token ID
+	return strings.ReplaceAll(tokenURI, "{id}", fmt.Sprintf("%064x", tokenID)), nil
+}
+
+func IpfsGatewayURL() string {
+	if params.IpfsGatewayURL != "" {
+		return params.IpfsGatewayURL
+	}
+	return DefaultIpfsGatewayURL
+}
+
+// Resolve maps decentralized storage URIs to their HTTP gateway, other URIs are returned unchanged
+func Resolve(uri string) string {
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(uri, "ipfs://")
+		path = strings.TrimPrefix(path, "ipfs/")
+		return IpfsGatewayURL() + path
+	case strings.HasPrefix(uri, "ar://"):
+		return ArweaveGatewayURL + strings.TrimPrefix(uri, "ar://")
+	}
+	return uri
+}
+
+// IsDataURI tells whether the URI embeds its content
+func IsDataURI(uri string) bool {
+	return strings.HasPrefix(uri, "data:")
+}
+
+// IsHTTP tells whether the URI can be fetched over HTTP
+func IsHTTP(uri string) bool {
+	return strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://")
+}
+
+// DecodeDataURI returns the payload of an RFC 2397 data URI
+func DecodeDataURI(uri string) ([]byte, error) {
+	header, payload, found := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !found {
+		return nil, errors.New("malformed data URI")
+	}
+
+	if strings.HasSuffix(header, ";base64") {
+		return base64.StdEncoding.DecodeString(payload)
+	}
+
+	decoded, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(decoded), nil
+}
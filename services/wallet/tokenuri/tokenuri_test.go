@@ -0,0 +1,33 @@
+package tokenuri
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	require.Equal(t, DefaultIpfsGatewayURL+"QmHash/1.json", Resolve("ipfs://QmHash/1.json"))
+	require.Equal(t, DefaultIpfsGatewayURL+"QmHash/1.json", Resolve("ipfs://ipfs/QmHash/1.json"))
+	require.Equal(t, ArweaveGatewayURL+"txid", Resolve("ar://txid"))
+	require.Equal(t, "https://example.com/1", Resolve("https://example.com/1"))
+}
+
+func TestDecodeDataURI(t *testing.T) {
+	content, err := DecodeDataURI("data:application/json;base64,eyJuYW1lIjoiQmFzZTY0In0=")
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"Base64"}`, string(content))
+
+	content, err = DecodeDataURI(`data:application/json;utf8,{"name":"Plain%20text"}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"Plain text"}`, string(content))
+
+	_, err = DecodeDataURI("data:application/json")
+	require.Error(t, err)
+}
+
+func TestIsNoTokenURIError(t *testing.T) {
+	require.True(t, IsNoTokenURIError(errors.New("execution reverted: no token")))
+	require.False(t, IsNoTokenURIError(errors.New("connection refused")))
+}
@@ -21,6 +21,10 @@ type BlockRangeSequentialDAO struct {
 	db *sql.DB
 }
 
+func NewBlockRangeSequentialDAO(db *sql.DB) *BlockRangeSequentialDAO {
+	return &BlockRangeSequentialDAO{db: db}
+}
+
 type BlockRange struct {
 	Start      *big.Int // Block of first transfer
 	FirstKnown *big.Int // Oldest scanned block
@@ -105,6 +109,24 @@ func (b *BlockRangeSequentialDAO) getBlockRange(chainID uint64, address common.A
 	return blockRange, exists, nil
 }
 
+// GetTokenBlockRange returns the block range scanned for token transfers of an account,
+// from is nil when the account wasn't scanned yet
+func (b *BlockRangeSequentialDAO) GetTokenBlockRange(chainID uint64, account common.Address) (from, to *big.Int, err error) {
+	blockRange, exists, err := b.getBlockRange(chainID, account)
+	if err != nil || !exists {
+		return nil, nil, err
+	}
+
+	from = blockRange.tokens.Start
+	if from == nil {
+		from = blockRange.tokens.FirstKnown
+	}
+	if from == nil || blockRange.tokens.LastKnown == nil {
+		return nil, nil, nil
+	}
+	return from, blockRange.tokens.LastKnown, nil
+}
+
 func (b *BlockRangeSequentialDAO) getBlockRanges(chainID uint64, addresses []common.Address) (blockRanges map[common.Address]*ethTokensBlockRanges, err error) {
 	blockRanges = make(map[common.Address]*ethTokensBlockRanges)
 	addressesPlaceholder := ""
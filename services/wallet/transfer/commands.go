@@ -451,6 +451,9 @@ func (c *transfersCommand) processUnknownErc20CommunityTransactions(ctx context.
 			// Find token in db or if this is a community token, find its metadata
 			token := c.tokenManager.FindOrCreateTokenByAddress(ctx, tx.NetworkID, *tx.Transaction.To())
 			if token != nil {
+				if isAirdrop(tx) {
+					c.tokenManager.ClassifySpam(token, true, false)
+				}
 				isFirst := false
 				if token.Verified || token.CommunityData != nil {
 					isFirst, _ = c.tokenManager.MarkAsPreviouslyOwnedToken(token, tx.Address)
@@ -463,6 +466,27 @@ func (c *transfersCommand) processUnknownErc20CommunityTransactions(ctx context.
 	}
 }
 
+// airdropRecipientsThreshold is the number of token transfers from a single transaction
+// above which receiving the token is considered part of a mass airdrop
+const airdropRecipientsThreshold = 5
+
+// isAirdrop tells whether a token transfer was received in a transaction, not sent by the account,
+// that also transferred tokens to many other accounts
+func isAirdrop(tx Transfer) bool {
+	if tx.From == tx.Address || tx.Receipt == nil {
+		return false
+	}
+
+	transferSignature := w_common.GetEventSignatureHash(w_common.Erc20_721TransferEventSignature)
+	transfers := 0
+	for _, receiptLog := range tx.Receipt.Logs {
+		if len(receiptLog.Topics) > 0 && receiptLog.Topics[0] == transferSignature {
+			transfers++
+		}
+	}
+	return transfers > airdropRecipientsThreshold
+}
+
 func (c *transfersCommand) processMultiTransactions(ctx context.Context, allTransfers []Transfer) error {
 	txByTxHash := subTransactionListToTransactionsByTxHash(allTransfers)
 
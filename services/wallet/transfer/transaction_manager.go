@@ -17,6 +17,7 @@ import (
 	"github.com/status-im/status-go/params"
 	wallet_common "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/router/pathprocessor"
+	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/transactions"
 )
 
@@ -41,6 +42,7 @@ type TransactionManager struct {
 	accountsDB     accounts.AccountsStorage
 	pendingTracker *transactions.PendingTxTracker
 	eventFeed      *event.Feed
+	signals        signal.Namespace
 
 	multiTransactionForKeycardSigning *MultiTransaction
 	multipathTransactionsData         []*pathprocessor.MultipathProcessorTxArgs
@@ -74,6 +76,11 @@ func NewTransactionManager(
 	}
 }
 
+// SetSignalsNamespace sets the namespace of the signals sent by the manager, that is the profile it runs for
+func (tm *TransactionManager) SetSignalsNamespace(signals signal.Namespace) {
+	tm.signals = signals
+}
+
 var (
 	emptyHash = common.Hash{}
 )
@@ -83,7 +83,7 @@ func (tm *TransactionManager) SendTransactionForSigningToKeycard(ctx context.Con
 		return err
 	}
 
-	signal.SendWalletEvent(signal.SignTransactions, hashes)
+	tm.signals.SendWalletEvent(signal.SignTransactions, hashes)
 
 	return nil
 }
@@ -19,6 +19,23 @@ type SignalsTransmitter struct {
 
 	wg   sync.WaitGroup
 	quit chan struct{}
+
+	mu      sync.RWMutex
+	signals signal.Namespace
+}
+
+// SetNamespace sets the namespace of the signals transmitted, that is the profile they are sent for.
+func (tmr *SignalsTransmitter) SetNamespace(signals signal.Namespace) {
+	tmr.mu.Lock()
+	defer tmr.mu.Unlock()
+	tmr.signals = signals
+}
+
+// Namespace returns the namespace of the signals transmitted
+func (tmr *SignalsTransmitter) Namespace() signal.Namespace {
+	tmr.mu.RLock()
+	defer tmr.mu.RUnlock()
+	return tmr.signals
 }
 
 // Start runs loop in background.
@@ -48,7 +65,7 @@ func (tmr *SignalsTransmitter) Start() error {
 				return
 			case event := <-events:
 				if !event.Type.IsInternal() {
-					signal.SendWalletEvent(signal.Wallet, event)
+					tmr.Namespace().SendWalletEvent(signal.Wallet, event)
 				}
 			}
 		}
@@ -80,64 +80,64 @@ type DownloadingHistoryArchivesFinishedSignal struct {
 	CommunityID string `json:"communityId"`
 }
 
-func SendHistoryArchivesProtocolEnabled() {
-	send(EventHistoryArchivesProtocolEnabled, nil)
+func (ns Namespace) SendHistoryArchivesProtocolEnabled() {
+	ns.send(EventHistoryArchivesProtocolEnabled, nil)
 }
 
-func SendHistoryArchivesProtocolDisabled() {
-	send(EventHistoryArchivesProtocolDisabled, nil)
+func (ns Namespace) SendHistoryArchivesProtocolDisabled() {
+	ns.send(EventHistoryArchivesProtocolDisabled, nil)
 }
 
-func SendCreatingHistoryArchives(communityID string) {
-	send(EventCreatingHistoryArchives, CreatingHistoryArchivesSignal{CommunityID: communityID})
+func (ns Namespace) SendCreatingHistoryArchives(communityID string) {
+	ns.send(EventCreatingHistoryArchives, CreatingHistoryArchivesSignal{CommunityID: communityID})
 }
 
-func SendNoHistoryArchivesCreated(communityID string, from int, to int) {
-	send(EventNoHistoryArchivesCreated, NoHistoryArchivesCreatedSignal{
+func (ns Namespace) SendNoHistoryArchivesCreated(communityID string, from int, to int) {
+	ns.send(EventNoHistoryArchivesCreated, NoHistoryArchivesCreatedSignal{
 		CommunityID: communityID,
 		From:        from,
 		To:          to,
 	})
 }
 
-func SendHistoryArchivesCreated(communityID string, from int, to int) {
-	send(EventHistoryArchivesCreated, HistoryArchivesCreatedSignal{
+func (ns Namespace) SendHistoryArchivesCreated(communityID string, from int, to int) {
+	ns.send(EventHistoryArchivesCreated, HistoryArchivesCreatedSignal{
 		CommunityID: communityID,
 		From:        from,
 		To:          to,
 	})
 }
 
-func SendHistoryArchivesSeeding(communityID string) {
-	send(EventHistoryArchivesSeeding, HistoryArchivesSeedingSignal{CommunityID: communityID})
+func (ns Namespace) SendHistoryArchivesSeeding(communityID string) {
+	ns.send(EventHistoryArchivesSeeding, HistoryArchivesSeedingSignal{CommunityID: communityID})
 }
 
-func SendHistoryArchivesUnseeded(communityID string) {
-	send(EventHistoryArchivesUnseeded, HistoryArchivesUnseededSignal{CommunityID: communityID})
+func (ns Namespace) SendHistoryArchivesUnseeded(communityID string) {
+	ns.send(EventHistoryArchivesUnseeded, HistoryArchivesUnseededSignal{CommunityID: communityID})
 }
 
-func SendHistoryArchiveDownloaded(communityID string, from int, to int) {
-	send(EventHistoryArchiveDownloaded, HistoryArchiveDownloadedSignal{
+func (ns Namespace) SendHistoryArchiveDownloaded(communityID string, from int, to int) {
+	ns.send(EventHistoryArchiveDownloaded, HistoryArchiveDownloadedSignal{
 		CommunityID: communityID,
 		From:        from,
 		To:          to,
 	})
 }
 
-func SendDownloadingHistoryArchivesStarted(communityID string) {
-	send(EventDownloadingHistoryArchivesStarted, DownloadingHistoryArchivesStartedSignal{
+func (ns Namespace) SendDownloadingHistoryArchivesStarted(communityID string) {
+	ns.send(EventDownloadingHistoryArchivesStarted, DownloadingHistoryArchivesStartedSignal{
 		CommunityID: communityID,
 	})
 }
 
-func SendImportingHistoryArchiveMessages(communityID string) {
-	send(EventImportingHistoryArchiveMessages, ImportingHistoryArchiveMessagesSignal{
+func (ns Namespace) SendImportingHistoryArchiveMessages(communityID string) {
+	ns.send(EventImportingHistoryArchiveMessages, ImportingHistoryArchiveMessagesSignal{
 		CommunityID: communityID,
 	})
 }
 
-func SendDownloadingHistoryArchivesFinished(communityID string) {
-	send(EventDownloadingHistoryArchivesFinished, DownloadingHistoryArchivesFinishedSignal{
+func (ns Namespace) SendDownloadingHistoryArchivesFinished(communityID string) {
+	ns.send(EventDownloadingHistoryArchivesFinished, DownloadingHistoryArchivesFinishedSignal{
 		CommunityID: communityID,
 	})
 }
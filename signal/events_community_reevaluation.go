@@ -17,10 +17,10 @@ type CommunityMemberReevaluationSignal struct {
 	Status      ReevaluationStatus `json:"status"`
 }
 
-func SendCommunityMemberReevaluationStarted(communityID string) {
-	send(MemberReevaluationStatus, CommunityMemberReevaluationSignal{CommunityID: communityID, Status: InProgress})
+func (ns Namespace) SendCommunityMemberReevaluationStarted(communityID string) {
+	ns.send(MemberReevaluationStatus, CommunityMemberReevaluationSignal{CommunityID: communityID, Status: InProgress})
 }
 
-func SendCommunityMemberReevaluationEnded(communityID string) {
-	send(MemberReevaluationStatus, CommunityMemberReevaluationSignal{CommunityID: communityID, Status: Done})
+func (ns Namespace) SendCommunityMemberReevaluationEnded(communityID string) {
+	ns.send(MemberReevaluationStatus, CommunityMemberReevaluationSignal{CommunityID: communityID, Status: Done})
 }
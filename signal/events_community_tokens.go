@@ -27,9 +27,9 @@ type CommunityTokenTransactionSignal struct {
 	ErrorString     string                `json:"errorString"`              // information about failed operation
 }
 
-func SendCommunityTokenTransactionStatusSignal(transactionType string, success bool, hash common.Hash,
+func (ns Namespace) SendCommunityTokenTransactionStatusSignal(transactionType string, success bool, hash common.Hash,
 	communityToken *token.CommunityToken, ownerToken *token.CommunityToken, masterToken *token.CommunityToken, errorString string) {
-	send(EventCommunityTokenTransactionStatusChanged, CommunityTokenTransactionSignal{
+	ns.send(EventCommunityTokenTransactionStatusChanged, CommunityTokenTransactionSignal{
 		TransactionType: transactionType,
 		Success:         success,
 		Hash:            hash,
@@ -45,8 +45,8 @@ type CommunityTokenActionSignal struct {
 	ActionType     protobuf.CommunityTokenAction_ActionType `json:"actionType"`     // type od action made by the other owner/master
 }
 
-func SendCommunityTokenActionSignal(communityToken *token.CommunityToken, actionType protobuf.CommunityTokenAction_ActionType) {
-	send(EventCommunityTokenAction, CommunityTokenActionSignal{
+func (ns Namespace) SendCommunityTokenActionSignal(communityToken *token.CommunityToken, actionType protobuf.CommunityTokenAction_ActionType) {
+	ns.send(EventCommunityTokenAction, CommunityTokenActionSignal{
 		CommunityToken: communityToken,
 		ActionType:     actionType,
 	})
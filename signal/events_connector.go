@@ -41,15 +41,15 @@ type ConnectorDAppChainIdSwitchedSignal struct {
 	ChainId string `json:"chainId"`
 }
 
-func SendConnectorSendRequestAccounts(dApp ConnectorDApp, requestID string) {
-	send(EventConnectorSendRequestAccounts, ConnectorSendRequestAccountsSignal{
+func (ns Namespace) SendConnectorSendRequestAccounts(dApp ConnectorDApp, requestID string) {
+	ns.send(EventConnectorSendRequestAccounts, ConnectorSendRequestAccountsSignal{
 		ConnectorDApp: dApp,
 		RequestID:     requestID,
 	})
 }
 
-func SendConnectorSendTransaction(dApp ConnectorDApp, chainID uint64, txArgs string, requestID string) {
-	send(EventConnectorSendTransaction, ConnectorSendTransactionSignal{
+func (ns Namespace) SendConnectorSendTransaction(dApp ConnectorDApp, chainID uint64, txArgs string, requestID string) {
+	ns.send(EventConnectorSendTransaction, ConnectorSendTransactionSignal{
 		ConnectorDApp: dApp,
 		RequestID:     requestID,
 		ChainID:       chainID,
@@ -57,8 +57,8 @@ func SendConnectorSendTransaction(dApp ConnectorDApp, chainID uint64, txArgs str
 	})
 }
 
-func SendConnectorPersonalSign(dApp ConnectorDApp, requestID, challenge, address string) {
-	send(EventConnectorPersonalSign, ConnectorPersonalSignSignal{
+func (ns Namespace) SendConnectorPersonalSign(dApp ConnectorDApp, requestID, challenge, address string) {
+	ns.send(EventConnectorPersonalSign, ConnectorPersonalSignSignal{
 		ConnectorDApp: dApp,
 		RequestID:     requestID,
 		Challenge:     challenge,
@@ -66,14 +66,14 @@ func SendConnectorPersonalSign(dApp ConnectorDApp, requestID, challenge, address
 	})
 }
 
-func SendConnectorDAppPermissionGranted(dApp ConnectorDApp) {
-	send(EventConnectorDAppPermissionGranted, dApp)
+func (ns Namespace) SendConnectorDAppPermissionGranted(dApp ConnectorDApp) {
+	ns.send(EventConnectorDAppPermissionGranted, dApp)
 }
 
-func SendConnectorDAppPermissionRevoked(dApp ConnectorDApp) {
-	send(EventConnectorDAppPermissionRevoked, dApp)
+func (ns Namespace) SendConnectorDAppPermissionRevoked(dApp ConnectorDApp) {
+	ns.send(EventConnectorDAppPermissionRevoked, dApp)
 }
 
-func SendConnectorDAppChainIdSwitched(payload ConnectorDAppChainIdSwitchedSignal) {
-	send(EventConnectorDAppChainIdSwitched, payload)
+func (ns Namespace) SendConnectorDAppChainIdSwitched(payload ConnectorDAppChainIdSwitchedSignal) {
+	ns.send(EventConnectorDAppChainIdSwitched, payload)
 }
@@ -8,11 +8,11 @@ const (
 )
 
 // Send db.reencryption.started signal.
-func SendReEncryptionStarted() {
-	send(ReEncryptionStarted, nil)
+func (ns Namespace) SendReEncryptionStarted() {
+	ns.send(ReEncryptionStarted, nil)
 }
 
 // Send db.reencryption.finished signal.
-func SendReEncryptionFinished() {
-	send(ReEncryptionFinished, nil)
+func (ns Namespace) SendReEncryptionFinished() {
+	ns.send(ReEncryptionFinished, nil)
 }
@@ -74,8 +74,8 @@ type DiscordChannelImportCancelledSignal struct {
 	ChannelID string `json:"channelId"`
 }
 
-func SendDiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError) {
-	send(EventDiscordCategoriesAndChannelsExtracted, DiscordCategoriesAndChannelsExtractedSignal{
+func (ns Namespace) SendDiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError) {
+	ns.send(EventDiscordCategoriesAndChannelsExtracted, DiscordCategoriesAndChannelsExtractedSignal{
 		Categories:             categories,
 		Channels:               channels,
 		OldestMessageTimestamp: oldestMessageTimestamp,
@@ -83,45 +83,45 @@ func SendDiscordCategoriesAndChannelsExtracted(categories []*discord.Category, c
 	})
 }
 
-func SendDiscordCommunityImportProgress(importProgress *discord.ImportProgress) {
-	send(EventDiscordCommunityImportProgress, DiscordCommunityImportProgressSignal{
+func (ns Namespace) SendDiscordCommunityImportProgress(importProgress *discord.ImportProgress) {
+	ns.send(EventDiscordCommunityImportProgress, DiscordCommunityImportProgressSignal{
 		ImportProgress: importProgress,
 	})
 }
 
-func SendDiscordChannelImportProgress(importProgress *discord.ImportProgress) {
-	send(EventDiscordChannelImportProgress, DiscordChannelImportProgressSignal{
+func (ns Namespace) SendDiscordChannelImportProgress(importProgress *discord.ImportProgress) {
+	ns.send(EventDiscordChannelImportProgress, DiscordChannelImportProgressSignal{
 		ImportProgress: importProgress,
 	})
 }
 
-func SendDiscordCommunityImportFinished(communityID string) {
-	send(EventDiscordCommunityImportFinished, DiscordCommunityImportFinishedSignal{
+func (ns Namespace) SendDiscordCommunityImportFinished(communityID string) {
+	ns.send(EventDiscordCommunityImportFinished, DiscordCommunityImportFinishedSignal{
 		CommunityID: communityID,
 	})
 }
 
-func SendDiscordChannelImportFinished(communityID string, channelID string) {
-	send(EventDiscordChannelImportFinished, DiscordChannelImportFinishedSignal{
+func (ns Namespace) SendDiscordChannelImportFinished(communityID string, channelID string) {
+	ns.send(EventDiscordChannelImportFinished, DiscordChannelImportFinishedSignal{
 		CommunityID: communityID,
 		ChannelID:   channelID,
 	})
 }
 
-func SendDiscordCommunityImportCancelled(communityID string) {
-	send(EventDiscordCommunityImportCancelled, DiscordCommunityImportCancelledSignal{
+func (ns Namespace) SendDiscordCommunityImportCancelled(communityID string) {
+	ns.send(EventDiscordCommunityImportCancelled, DiscordCommunityImportCancelledSignal{
 		CommunityID: communityID,
 	})
 }
 
-func SendDiscordCommunityImportCleanedUp(communityID string) {
-	send(EventDiscordCommunityImportCleanedUp, DiscordCommunityImportCleanedUpSignal{
+func (ns Namespace) SendDiscordCommunityImportCleanedUp(communityID string) {
+	ns.send(EventDiscordCommunityImportCleanedUp, DiscordCommunityImportCleanedUpSignal{
 		CommunityID: communityID,
 	})
 }
 
-func SendDiscordChannelImportCancelled(channelID string) {
-	send(EventDiscordChannelImportCancelled, DiscordChannelImportCancelledSignal{
+func (ns Namespace) SendDiscordChannelImportCancelled(channelID string) {
+	ns.send(EventDiscordChannelImportCancelled, DiscordChannelImportCancelledSignal{
 		ChannelID: channelID,
 	})
 }
@@ -12,16 +12,16 @@ const (
 )
 
 // SendDiscoveryStarted sends discovery.started signal.
-func SendDiscoveryStarted() {
-	send(EventDiscoveryStarted, nil)
+func (ns Namespace) SendDiscoveryStarted() {
+	ns.send(EventDiscoveryStarted, nil)
 }
 
 // SendDiscoveryStopped sends discovery.stopped signal.
-func SendDiscoveryStopped() {
-	send(EventDiscoveryStopped, nil)
+func (ns Namespace) SendDiscoveryStopped() {
+	ns.send(EventDiscoveryStopped, nil)
 }
 
 // SendDiscoverySummary sends discovery.summary signal.
-func SendDiscoverySummary(summary interface{}) {
-	send(EventDiscoverySummary, summary)
+func (ns Namespace) SendDiscoverySummary(summary interface{}) {
+	ns.send(EventDiscoverySummary, summary)
 }
@@ -38,8 +38,8 @@ type CommunityInfoFoundSignal struct {
 }
 
 // SendMessageDelivered notifies about delivered message
-func SendMessageDelivered(chatID string, messageID string) {
-	send(EventMesssageDelivered, MessageDeliveredSignal{ChatID: chatID, MessageID: messageID})
+func (ns Namespace) SendMessageDelivered(chatID string, messageID string) {
+	ns.send(EventMesssageDelivered, MessageDeliveredSignal{ChatID: chatID, MessageID: messageID})
 }
 
 // SendMediaServerStarted notifies about restarts of the media server
@@ -48,14 +48,14 @@ func SendMediaServerStarted(port int) {
 }
 
 // SendMessageDelivered notifies about delivered message
-func SendCommunityInfoFound(community interface{}) {
-	send(EventCommunityInfoFound, community)
+func (ns Namespace) SendCommunityInfoFound(community interface{}) {
+	ns.send(EventCommunityInfoFound, community)
 }
 
-func SendStatusUpdatesTimedOut(statusUpdates interface{}) {
-	send(EventStatusUpdatesTimedOut, statusUpdates)
+func (ns Namespace) SendStatusUpdatesTimedOut(statusUpdates interface{}) {
+	ns.send(EventStatusUpdatesTimedOut, statusUpdates)
 }
 
-func SendCuratedCommunitiesUpdate(curatedCommunitiesUpdate interface{}) {
-	send(EventCuratedCommunitiesUpdate, curatedCommunitiesUpdate)
+func (ns Namespace) SendCuratedCommunitiesUpdate(curatedCommunitiesUpdate interface{}) {
+	ns.send(EventCuratedCommunitiesUpdate, curatedCommunitiesUpdate)
 }
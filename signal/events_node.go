@@ -43,8 +43,8 @@ type NodeLoginEvent struct {
 
 // SendNodeCrashed emits a signal when status node has crashed, and
 // provides error description.
-func SendNodeCrashed(err error) {
-	send(EventNodeCrashed,
+func (ns Namespace) SendNodeCrashed(err error) {
+	ns.send(EventNodeCrashed,
 		NodeCrashEvent{
 			Error: err.Error(),
 		})
@@ -52,30 +52,30 @@ func SendNodeCrashed(err error) {
 
 // SendNodeStarted emits a signal when status node has just started (but not
 // finished startup yet).
-func SendNodeStarted() {
-	send(EventNodeStarted, nil)
+func (ns Namespace) SendNodeStarted() {
+	ns.send(EventNodeStarted, nil)
 }
 
 // SendNodeReady emits a signal when status node has started and successfully
 // completed startup.
-func SendNodeReady() {
-	send(EventNodeReady, nil)
+func (ns Namespace) SendNodeReady() {
+	ns.send(EventNodeReady, nil)
 }
 
 // SendNodeStopped emits a signal when underlying node has stopped.
-func SendNodeStopped() {
-	send(EventNodeStopped, nil)
+func (ns Namespace) SendNodeStopped() {
+	ns.send(EventNodeStopped, nil)
 }
 
 // SendChainDataRemoved emits a signal when node's chain data has been removed.
-func SendChainDataRemoved() {
-	send(EventChainDataRemoved, nil)
+func (ns Namespace) SendChainDataRemoved() {
+	ns.send(EventChainDataRemoved, nil)
 }
 
-func SendLoggedIn(account *multiaccounts.Account, settings *settings.Settings, ensUsernames json.RawMessage, err error) {
+func (ns Namespace) SendLoggedIn(account *multiaccounts.Account, settings *settings.Settings, ensUsernames json.RawMessage, err error) {
 	event := NodeLoginEvent{Settings: settings, Account: account, EnsUsernames: ensUsernames}
 	if err != nil {
 		event.Error = err.Error()
 	}
-	send(EventLoggedIn, event)
+	ns.send(EventLoggedIn, event)
 }
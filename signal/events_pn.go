@@ -5,6 +5,6 @@ const (
 )
 
 // SendLocalNotifications sends event with a local notification.
-func SendLocalNotifications(event interface{}) {
-	send(notificationEvent, event)
+func (ns Namespace) SendLocalNotifications(event interface{}) {
+	ns.send(notificationEvent, event)
 }
@@ -122,19 +122,19 @@ type Filter struct {
 }
 
 // SendEnvelopeSent triggered when envelope delivered at least to 1 peer.
-func SendEnvelopeSent(identifiers [][]byte) {
+func (ns Namespace) SendEnvelopeSent(identifiers [][]byte) {
 	var hexIdentifiers []hexutil.Bytes
 	for _, i := range identifiers {
 		hexIdentifiers = append(hexIdentifiers, i)
 	}
 
-	send(EventEnvelopeSent, EnvelopeSignal{
+	ns.send(EventEnvelopeSent, EnvelopeSignal{
 		IDs: hexIdentifiers,
 	})
 }
 
 // SendEnvelopeExpired triggered when envelope delivered at least to 1 peer.
-func SendEnvelopeExpired(identifiers [][]byte, err error) {
+func (ns Namespace) SendEnvelopeExpired(identifiers [][]byte, err error) {
 	var message string
 	if err != nil {
 		message = err.Error()
@@ -144,27 +144,27 @@ func SendEnvelopeExpired(identifiers [][]byte, err error) {
 		hexIdentifiers = append(hexIdentifiers, i)
 	}
 
-	send(EventEnvelopeExpired, EnvelopeSignal{IDs: hexIdentifiers, Message: message})
+	ns.send(EventEnvelopeExpired, EnvelopeSignal{IDs: hexIdentifiers, Message: message})
 }
 
-func SendHistoricMessagesRequestStarted(numBatches int) {
-	send(EventHistoryRequestStarted, HistoryMessagesSignal{NumBatches: numBatches})
+func (ns Namespace) SendHistoricMessagesRequestStarted(numBatches int) {
+	ns.send(EventHistoryRequestStarted, HistoryMessagesSignal{NumBatches: numBatches})
 }
 
-func SendHistoricMessagesRequestFailed(requestID []byte, peerID peer.ID, err error) {
-	send(EventHistoryRequestFailed, HistoryMessagesSignal{RequestID: hex.EncodeToString(requestID), PeerID: peerID.String(), ErrorMsg: err.Error()})
+func (ns Namespace) SendHistoricMessagesRequestFailed(requestID []byte, peerID peer.ID, err error) {
+	ns.send(EventHistoryRequestFailed, HistoryMessagesSignal{RequestID: hex.EncodeToString(requestID), PeerID: peerID.String(), ErrorMsg: err.Error()})
 }
 
-func SendHistoricMessagesRequestCompleted() {
-	send(EventHistoryRequestCompleted, HistoryMessagesSignal{})
+func (ns Namespace) SendHistoricMessagesRequestCompleted() {
+	ns.send(EventHistoryRequestCompleted, HistoryMessagesSignal{})
 }
 
-func SendUpdateAvailable(available bool, latestVersion string, url string) {
-	send(EventUpdateAvailable, UpdateAvailableSignal{Available: available, Version: latestVersion, URL: url})
+func (ns Namespace) SendUpdateAvailable(available bool, latestVersion string, url string) {
+	ns.send(EventUpdateAvailable, UpdateAvailableSignal{Available: available, Version: latestVersion, URL: url})
 }
 
 // SendMailServerRequestCompleted triggered when mail server response has been received
-func SendMailServerRequestCompleted(requestID types.Hash, lastEnvelopeHash types.Hash, cursor []byte, err error) {
+func (ns Namespace) SendMailServerRequestCompleted(requestID types.Hash, lastEnvelopeHash types.Hash, cursor []byte, err error) {
 	errorMsg := ""
 	if err != nil {
 		errorMsg = err.Error()
@@ -175,12 +175,12 @@ func SendMailServerRequestCompleted(requestID types.Hash, lastEnvelopeHash types
 		Cursor:           hex.EncodeToString(cursor),
 		ErrorMsg:         errorMsg,
 	}
-	send(EventMailServerRequestCompleted, sig)
+	ns.send(EventMailServerRequestCompleted, sig)
 }
 
 // SendMailServerRequestExpired triggered when mail server request expires
-func SendMailServerRequestExpired(hash types.Hash) {
-	send(EventMailServerRequestExpired, EnvelopeSignal{Hash: hash})
+func (ns Namespace) SendMailServerRequestExpired(hash types.Hash) {
+	ns.send(EventMailServerRequestExpired, EnvelopeSignal{Hash: hash})
 }
 
 // EnodeDiscoveredSignal includes enode address and topic
@@ -196,42 +196,42 @@ type BackupPerformedSignal struct {
 
 // SendEnodeDiscovered tiggered when an enode is discovered.
 // finds a new enode.
-func SendEnodeDiscovered(enode, topic string) {
-	send(EventEnodeDiscovered, EnodeDiscoveredSignal{
+func (ns Namespace) SendEnodeDiscovered(enode, topic string) {
+	ns.send(EventEnodeDiscovered, EnodeDiscoveredSignal{
 		Enode: enode,
 		Topic: topic,
 	})
 }
 
-func SendBackupPerformed(lastBackup uint64) {
-	send(EventBackupPerformed, BackupPerformedSignal{lastBackup})
+func (ns Namespace) SendBackupPerformed(lastBackup uint64) {
+	ns.send(EventBackupPerformed, BackupPerformedSignal{lastBackup})
 }
-func SendDecryptMessageFailed(sender string) {
-	send(EventDecryptMessageFailed, DecryptMessageFailedSignal{sender})
+func (ns Namespace) SendDecryptMessageFailed(sender string) {
+	ns.send(EventDecryptMessageFailed, DecryptMessageFailedSignal{sender})
 }
 
-func SendBundleAdded(identity string, installationID string) {
-	send(EventBundleAdded, BundleAddedSignal{Identity: identity, InstallationID: installationID})
+func (ns Namespace) SendBundleAdded(identity string, installationID string) {
+	ns.send(EventBundleAdded, BundleAddedSignal{Identity: identity, InstallationID: installationID})
 }
 
-func SendNewMessages(obj json.Marshaler) {
-	send(EventNewMessages, obj)
+func (ns Namespace) SendNewMessages(obj json.Marshaler) {
+	ns.send(EventNewMessages, obj)
 }
 
-func SendMailserverAvailable(nodeAddress, id string) {
-	send(EventMailserverAvailable, MailserverSignal{
+func (ns Namespace) SendMailserverAvailable(nodeAddress, id string) {
+	ns.send(EventMailserverAvailable, MailserverSignal{
 		Address: nodeAddress,
 		ID:      id,
 	})
 }
 
-func SendMailserverChanged(nodeAddress, id string) {
-	send(EventMailserverChanged, MailserverSignal{
+func (ns Namespace) SendMailserverChanged(nodeAddress, id string) {
+	ns.send(EventMailserverChanged, MailserverSignal{
 		Address: nodeAddress,
 		ID:      id,
 	})
 }
 
-func SendMailserverNotWorking() {
-	send(EventMailserverNotWorking, MailserverSignal{})
+func (ns Namespace) SendMailserverNotWorking() {
+	ns.send(EventMailserverNotWorking, MailserverSignal{})
 }
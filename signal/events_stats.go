@@ -6,6 +6,6 @@ const (
 )
 
 // SendStats sends stats signal.
-func SendStats(stats interface{}) {
-	send(EventStats, stats)
+func (ns Namespace) SendStats(stats interface{}) {
+	ns.send(EventStats, stats)
 }
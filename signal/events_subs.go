@@ -18,16 +18,16 @@ type SubscriptionErrorEvent struct {
 }
 
 // SendSubscriptionDataEvent
-func SendSubscriptionDataEvent(filterID string, data []interface{}) {
-	send(EventSubscriptionsData, SubscriptionDataEvent{
+func (ns Namespace) SendSubscriptionDataEvent(filterID string, data []interface{}) {
+	ns.send(EventSubscriptionsData, SubscriptionDataEvent{
 		FilterID: filterID,
 		Data:     data,
 	})
 }
 
 // SendSubscriptionErrorEvent
-func SendSubscriptionErrorEvent(filterID string, err error) {
-	send(EventSubscriptionsError, SubscriptionErrorEvent{
+func (ns Namespace) SendSubscriptionErrorEvent(filterID string, err error) {
+	ns.send(EventSubscriptionsError, SubscriptionErrorEvent{
 		ErrorMessage: err.Error(),
 	})
 }
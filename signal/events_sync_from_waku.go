@@ -19,22 +19,22 @@ const (
 	EventWakuBackedUpWatchOnlyAccount = "waku.backedup.watch-only-account" // #nosec G101
 )
 
-func SendWakuFetchingBackupProgress(obj json.Marshaler) {
-	send(EventWakuFetchingBackupProgress, obj)
+func (ns Namespace) SendWakuFetchingBackupProgress(obj json.Marshaler) {
+	ns.send(EventWakuFetchingBackupProgress, obj)
 }
 
-func SendWakuBackedUpProfile(obj json.Marshaler) {
-	send(EventWakuBackedUpProfile, obj)
+func (ns Namespace) SendWakuBackedUpProfile(obj json.Marshaler) {
+	ns.send(EventWakuBackedUpProfile, obj)
 }
 
-func SendWakuBackedUpSettings(obj json.Marshaler) {
-	send(EventWakuBackedUpSettings, obj)
+func (ns Namespace) SendWakuBackedUpSettings(obj json.Marshaler) {
+	ns.send(EventWakuBackedUpSettings, obj)
 }
 
-func SendWakuBackedUpKeypair(obj json.Marshaler) {
-	send(EventWakuBackedUpKeypair, obj)
+func (ns Namespace) SendWakuBackedUpKeypair(obj json.Marshaler) {
+	ns.send(EventWakuBackedUpKeypair, obj)
 }
 
-func SendWakuBackedUpWatchOnlyAccount(obj json.Marshaler) {
-	send(EventWakuBackedUpWatchOnlyAccount, obj)
+func (ns Namespace) SendWakuBackedUpWatchOnlyAccount(obj json.Marshaler) {
+	ns.send(EventWakuBackedUpWatchOnlyAccount, obj)
 }
@@ -9,6 +9,6 @@ const (
 )
 
 // SendPeerStats sends discovery.summary signal.
-func SendPeerStats(peerStats types.ConnStatus) {
-	send(EventPeerStats, peerStats)
+func (ns Namespace) SendPeerStats(peerStats types.ConnStatus) {
+	ns.send(EventPeerStats, peerStats)
 }
@@ -9,6 +9,6 @@ const (
 )
 
 // SendWalletEvent sends event from services/wallet/events.
-func SendWalletEvent(signalType SignalType, event interface{}) {
-	send(string(signalType), event)
+func (ns Namespace) SendWalletEvent(signalType SignalType, event interface{}) {
+	ns.send(string(signalType), event)
 }
@@ -0,0 +1,23 @@
+package signal
+
+// Namespace tags the signals sent on behalf of a logged in profile with its key UID,
+// so that clients running several profiles side by side can tell them apart.
+// Signals sent through the zero Namespace are not tagged, as well as the ones that aren't
+// bound to a profile, like the local pairing and media server signals.
+type Namespace string
+
+// NewNamespace returns the namespace of the signals of a profile
+func NewNamespace(keyUID string) Namespace {
+	return Namespace(keyUID)
+}
+
+// KeyUID returns the key UID of the profile the signals are sent for
+func (ns Namespace) KeyUID() string {
+	return string(ns)
+}
+
+func (ns Namespace) send(typ string, event interface{}) {
+	signal := NewEnvelope(typ, event)
+	signal.KeyUID = string(ns)
+	sendEnvelope(signal)
+}
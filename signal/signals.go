@@ -30,6 +30,8 @@ var logger = log.New("package", "status-go/signal")
 type Envelope struct {
 	Type  string      `json:"type"`
 	Event interface{} `json:"event"`
+	// KeyUID is the key UID of the profile the signal is sent for, see Namespace
+	KeyUID string `json:"keyUid,omitempty"`
 }
 
 // NewEnvelope creates new envlope of given type and event payload.
@@ -42,7 +44,10 @@ func NewEnvelope(typ string, event interface{}) *Envelope {
 
 // send sends application signal (in JSON) upwards to application (via default notification handler)
 func send(typ string, event interface{}) {
-	signal := NewEnvelope(typ, event)
+	sendEnvelope(NewEnvelope(typ, event))
+}
+
+func sendEnvelope(signal *Envelope) {
 	data, err := json.Marshal(&signal)
 	if err != nil {
 		logger.Error("Marshalling signal envelope", "error", err)
@@ -18,3 +18,19 @@ func TestNodeCrashEventJSONMarshalling(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedJSON, string(marshalled))
 }
+
+func TestNamespaceTagsSignals(t *testing.T) {
+	var received []string
+	SetMobileSignalHandler(func(data []byte) {
+		received = append(received, string(data))
+	})
+	defer SetMobileSignalHandler(nil)
+
+	NewNamespace("0x01").SendNodeReady()
+	Namespace("").SendNodeReady()
+
+	require.Equal(t, []string{
+		`{"type":"node.ready","event":null,"keyUid":"0x01"}`,
+		`{"type":"node.ready","event":null}`,
+	}, received)
+}
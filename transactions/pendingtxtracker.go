@@ -405,6 +405,10 @@ const (
 	DeployOwnerToken          PendingTrxType = "DeployOwnerToken"
 	SetSignerPublicKey        PendingTrxType = "SetSignerPublicKey"
 	WalletConnectTransfer     PendingTrxType = "WalletConnectTransfer"
+	SetENSTextRecords         PendingTrxType = "SetENSTextRecords"
+	CreateENSSubname          PendingTrxType = "CreateENSSubname"
+	TransferENSName           PendingTrxType = "TransferENSName"
+	RenewENS                  PendingTrxType = "RenewENS"
 )
 
 type PendingTransaction struct {
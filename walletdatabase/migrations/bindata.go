@@ -32,6 +32,11 @@
 // 1716912885_add_wallet_connect_dapps.up.sql (750B)
 // 1721136888_recreate_indices_balance_history_remove_dups.up.sql (923B)
 // 1721306883_add_connector_dapps.up.sql (360B)
+// 1729241520_add_token_lists.up.sql (420B)
+// 1729258260_add_spam_contracts.up.sql (931B)
+// 1729500000_add_collectibles_value.up.sql (905B)
+// 1729600000_add_smart_accounts.up.sql (498B)
+// 1729700000_add_safes.up.sql (1.731kB)
 // doc.go (94B)
 
 package migrations
@@ -42,7 +47,6 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -52,7 +56,7 @@ import (
 func bindataRead(data []byte, name string) ([]byte, error) {
 	gz, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 
 	var buf bytes.Buffer
@@ -60,7 +64,7 @@ func bindataRead(data []byte, name string) ([]byte, error) {
 	clErr := gz.Close()
 
 	if err != nil {
-		return nil, fmt.Errorf("read %q: %v", name, err)
+		return nil, fmt.Errorf("read %q: %w", name, err)
 	}
 	if clErr != nil {
 		return nil, err
@@ -741,6 +745,106 @@ func _1721306883_add_connector_dappsUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1729241520_add_token_listsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xd0\xc1\x4b\xc3\x30\x14\x06\xf0\x7b\xff\x8a\xef\x36\x05\x37\xbc\x7b\x6a\x35\x93\x62\x6c\xa5\x4b\x61\x3b\x8d\xb4\x79\xa5\xc1\x98\x48\x92\x55\xfc\xef\xa5\xcd\x36\x41\xbc\x05\xbe\x5f\xbe\xbc\xbc\xf5\x1a\xc2\xbd\x93\x85\xd1\x21\x06\x68\x8b\x38\x12\x5a\xab\xc3\x97\xfc\x44\x9c\xa3\xf5\x1c\x61\x70\xfe\x43\x46\x84\x53\x17\x7a\xaf\x3b\x52\x88\x0e\xdd\x37\xda\x86\x6f\xb2\xb9\x65\x24\xf4\xce\x46\xb2\x11\x6e\x58\x5a\x8c\x0c\x11\x93\x34\x5a\x61\x22\x1f\xb4\xb3\x18\x28\xf6\x23\x29\xe8\x80\x5e\x2e\xa7\xe0\x10\x47\x19\xcf\xef\x4b\x4f\x90\x93\xd4\x46\x76\x86\xe0\x86\xc1\x68\x4b\x9b\xec\xb1\x61\xb9\x60\x10\x79\xc1\x19\xca\x2d\xaa\x5a\x80\xed\xcb\x9d\xd8\xa5\x11\x8f\xe9\xf6\x4d\x06\x00\x27\x6f\x20\xd8\x5e\x2c\xaa\x6a\x39\xc7\x5b\x53\xbe\xe6\xcd\x01\x2f\xec\x70\xb7\x10\xb2\x73\xbf\x42\x51\xd7\x9c\xe5\xd5\xaf\x7c\x62\xdb\xbc\xe5\x02\xa2\x69\x59\xa2\x52\x29\x52\x47\x19\x51\x56\x82\x3d\xb3\xe6\x6a\x53\x7c\xfe\xd0\x7f\xe0\x5a\x76\x9f\xe8\xbc\x8e\x23\x79\xef\xfc\x9f\xf1\x2e\x6e\xb5\x4a\xf0\xb2\xc6\x82\xd7\x45\x76\xfb\x90\xfd\x0c\x00\xd6\x32\xfc\xea\xa4\x01\x00\x00")
+
+func _1729241520_add_token_listsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729241520_add_token_listsUpSql,
+		"1729241520_add_token_lists.up.sql",
+	)
+}
+
+func _1729241520_add_token_listsUpSql() (*asset, error) {
+	bytes, err := _1729241520_add_token_listsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729241520_add_token_lists.up.sql", size: 420, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0xb1, 0xdc, 0x8c, 0x68, 0xc7, 0x81, 0x30, 0x1b, 0x11, 0x61, 0x94, 0xdf, 0x81, 0xfe, 0x3, 0xef, 0xf7, 0x51, 0x6b, 0x22, 0xf0, 0x49, 0x46, 0xc0, 0x8, 0x74, 0xe3, 0x5c, 0xf3, 0x65, 0xb8}}
+	return a, nil
+}
+
+var __1729258260_add_spam_contractsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x4f\x8f\xda\x3c\x18\xc4\xef\xf9\x14\x73\xdb\x45\x02\xb4\xd2\x7b\x5c\xbd\x87\x00\x06\xa2\x66\x93\xca\x31\xcb\xee\x09\x19\xe7\xa1\xb1\x08\x31\xb2\x4d\x57\xfd\xf6\x55\x4c\xf8\x5f\x55\x55\x2e\xd1\xe3\x9f\x67\xf4\xcc\x24\x83\x01\xdc\x5e\xee\x56\xca\x34\xde\x4a\xe5\x1d\xb6\x44\x7b\x07\x5f\x51\x38\x80\xaa\xa5\x73\x7a\xa3\x95\xf4\xda\x34\x30\x1b\x78\xb3\xa5\x06\xb2\x29\xa1\x4c\x5d\x93\xf2\x7a\x5d\x13\xce\x02\xc3\x68\x30\x80\xa8\x08\x15\x1d\xac\x76\x5e\x2b\x38\x2f\xfd\xc1\x41\x3b\x28\xb3\xdb\x1f\x3c\x95\x58\xff\x0a\x16\x5f\xb2\xae\xc9\xf7\xc3\xfb\xc1\x91\x3d\xa1\xe6\x27\x59\xab\x4b\x72\xd0\xbe\xb5\x6a\x35\xd7\xb5\x51\x5b\x2a\x2f\x4e\xad\x1a\x61\x63\xcd\x2e\xdc\x0f\xe7\xa8\xb5\xf3\xd0\xbb\xbd\xb1\x57\x36\xad\xf4\x30\x1a\x73\x16\x0b\x06\x11\x8f\x52\x86\x64\x8a\x2c\x17\x60\x1f\x49\x21\x8a\xfb\x0c\x9e\x23\x00\x50\x95\xd4\xcd\x4a\x97\x58\x64\x45\x32\xcb\xd8\x04\xa3\x64\x96\x64\x22\x5c\xcc\x16\x69\xda\x3f\x62\xdd\xb5\x95\x2c\x4b\x4b\xce\xe1\x3d\xe6\xe3\x79\xcc\xef\xb0\x73\x1c\xab\x6e\xc7\x24\x13\x6c\xc6\x2e\x18\x26\x6c\x1a\x2f\x52\x81\x97\xa3\xae\x25\xe9\x4c\xe3\x20\xd8\x87\x78\x84\x9e\x9e\x8e\x54\xbb\xda\xbf\x2a\x9e\x12\x1c\xe5\x79\xca\xe2\xec\x91\x9b\xc6\x69\xc1\x8e\xec\x77\x9e\xbc\xc5\xfc\x13\xdf\xd8\x27\x9e\x4f\x49\xf4\x1f\x96\xed\x45\x3d\x2c\x13\x31\xcf\x17\x02\x3c\x5f\x26\x93\xd7\xa8\xed\x6a\xdc\x61\x0e\x95\x2e\x4b\x6a\xf0\x55\x51\x83\x8d\xae\x3d\x59\xdd\xfc\x80\x39\xf8\x90\x79\xbf\x2b\x9c\x1c\x76\xd2\xab\x2a\x0c\x87\x45\x98\x9d\xfa\x7a\x4f\xd8\xf2\xaf\x75\xad\x3a\x8f\xb8\x88\x0a\x96\xb2\xb1\xb8\x69\xef\xcf\x25\x45\x53\x9e\xbf\x85\x93\x5b\xad\x68\x39\x67\x9c\x3d\x24\xfb\x3f\xfe\x0b\xb3\x9c\x77\xdf\xc6\x23\xf0\x72\x9e\xc7\xd9\xe4\x8a\xba\x0a\xfe\x66\x96\xf3\xcb\x1f\x72\x6f\xd3\x3e\xbd\x08\x00\x7a\xaf\xd1\xef\x01\x00\xf8\x09\x2f\x7a\xa3\x03\x00\x00")
+
+func _1729258260_add_spam_contractsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729258260_add_spam_contractsUpSql,
+		"1729258260_add_spam_contracts.up.sql",
+	)
+}
+
+func _1729258260_add_spam_contractsUpSql() (*asset, error) {
+	bytes, err := _1729258260_add_spam_contractsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729258260_add_spam_contracts.up.sql", size: 931, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1c, 0xe1, 0xd3, 0x94, 0x19, 0xc, 0x5b, 0x24, 0x80, 0x64, 0xef, 0xd8, 0x61, 0x43, 0xad, 0xe9, 0xe2, 0x5a, 0x52, 0xf6, 0x30, 0xe2, 0xca, 0xef, 0xc9, 0xfc, 0xe, 0x24, 0x0, 0xd7, 0xf0, 0x8}}
+	return a, nil
+}
+
+var __1729500000_add_collectibles_valueUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\xde\xb1\x05\x92\x62\xf7\x9d\xdc\x46\x4d\x8d\x79\xce\xe0\x28\xeb\x7a\x32\x38\x99\x81\x85\xa9\x92\x21\x31\x01\xfa\xf7\x83\xed\xb6\xcb\xb2\x2c\x48\xaf\xe4\xe3\x23\xdf\x7b\x9c\xcf\x61\x82\x73\x6c\xc4\x06\xdf\xf4\xd1\x1a\x6e\x5a\x12\x6a\x0c\x99\x8e\xf1\x8b\xb9\x4f\x90\x8e\xe1\x48\x38\x09\xb6\x2e\x84\x08\xf2\x2d\x1c\x25\x41\x22\xc7\x18\xa7\x12\xc2\xf6\x80\x2a\xcd\xb2\xf9\x1c\xd6\x8f\xb3\x9e\xc4\xee\x19\x66\x17\x23\x7b\xf3\x32\x20\x87\xb2\xe9\xc8\xfa\x1b\x28\x2f\xd1\x72\x02\x45\x46\xe4\x2d\x8b\xe9\xb8\x45\xf0\x86\x11\x5c\xcb\x11\xd2\xd1\xc4\xa3\x75\x79\x93\xdd\xd5\x2a\xd7\x0a\x3a\xbf\x2d\x15\x8a\x7b\x54\x2b\x0d\xf5\xa3\x58\xeb\xf5\x59\x25\x57\x19\x80\x69\x65\x63\x5b\x6c\xaa\x75\xb1\xac\xd4\x02\xb7\xc5\xb2\xa8\xf4\xc8\x52\x6d\xca\x72\x36\xc1\x82\x97\x48\x46\x1a\x6a\xdb\xc8\x29\xe1\x7b\x5e\xdf\x3d\xe4\xf5\x11\xac\x8f\x61\x6f\x87\x0b\x4f\xb7\x47\xaf\x26\x4f\x51\xab\xbc\x7c\x6f\x63\xa1\xee\xf3\x4d\xa9\xf1\x69\x02\x0e\x56\x36\x83\x95\x97\x80\x77\x7d\x4b\xc2\x6d\x43\x82\xa2\xd2\x6a\xa9\x8e\xd7\x7e\xab\x8b\xaf\x79\xfd\x84\x2f\xea\x09\x57\x6f\x82\x67\xff\x68\xba\xce\xae\xf1\x58\xe8\x87\xd5\x46\xa3\x5e\x3d\x16\x8b\xcf\x59\x76\xf0\x0d\x3f\x1d\xa7\x66\x4f\x6e\xc7\x4d\x67\x93\x84\xf8\xf2\xfa\x0c\xc9\x53\x9f\xba\x20\xe9\x2d\xc6\x11\xf4\x9e\xe9\xc1\xf8\x50\x23\x0f\x32\x26\xec\xbc\x5c\xfa\x10\x97\x04\x7c\xe2\xb8\x0f\xe5\x7b\x3e\x56\xb1\xcf\x9c\x84\x9e\xfb\xff\x18\x3c\x09\xfe\x2b\xa4\xb3\xce\xbf\x6e\x9b\xfd\x21\x3e\xe1\xfd\xef\x01\x00\xe7\x96\x3e\xd5\x89\x03\x00\x00")
+
+func _1729500000_add_collectibles_valueUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729500000_add_collectibles_valueUpSql,
+		"1729500000_add_collectibles_value.up.sql",
+	)
+}
+
+func _1729500000_add_collectibles_valueUpSql() (*asset, error) {
+	bytes, err := _1729500000_add_collectibles_valueUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729500000_add_collectibles_value.up.sql", size: 905, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0xa, 0xec, 0xe4, 0x8e, 0x97, 0x82, 0xb9, 0xc2, 0x3f, 0x23, 0x85, 0x4c, 0x14, 0x55, 0x1c, 0x4b, 0x62, 0x8f, 0x27, 0x40, 0x5a, 0x6b, 0x72, 0x1a, 0x66, 0xfe, 0xca, 0xeb, 0x8d, 0x85, 0x63}}
+	return a, nil
+}
+
+var __1729600000_add_smart_accountsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xc1\x6e\xea\x30\x14\x44\xf7\xf9\x8a\xd9\xf1\x90\x5e\xd8\x50\xa9\x0b\x56\x29\x98\x62\x35\x4d\x2a\xe3\x14\x58\x45\x6e\x72\x11\x11\xc1\x8e\x6c\x23\x9a\xbf\xaf\x48\x5a\xaa\x82\x58\x9f\xe3\xb9\x9e\x09\x43\xb8\x83\xb2\x3e\x57\x45\x61\x8e\xda\x3b\xec\x89\x1a\x07\xbf\x23\x30\x31\x0d\x1f\xc6\xe3\xc7\xde\xc0\xc5\x30\x27\x4d\x25\x3e\x5a\xec\xa9\x6d\x54\x65\x2f\x64\x14\x84\x21\xe4\x8e\xa0\xca\xd2\x92\x73\xa8\x1c\xba\x37\x64\xb7\xaa\xf0\x47\x55\xe3\xa8\x7d\x55\x77\xe9\xdb\xca\x3a\x8f\xcc\x91\x4d\x1b\xb2\xca\x57\x46\xa3\xa4\xa6\x36\x6d\x7f\xfd\x3b\x74\x14\x4c\x05\x8b\x24\x83\x8c\x9e\x62\x06\x3e\x47\x92\x4a\xb0\x35\x5f\xca\xe5\xf5\xd7\xff\x05\x00\x2e\xc7\xdf\x23\x31\x5d\x44\xa2\xf3\x93\x2c\x8e\xf1\x26\xf8\x6b\x24\x36\x78\x61\x9b\xff\x9d\x79\x2e\x62\x6f\xbc\x9e\x39\x55\xfb\x3b\xe8\xdc\xc5\xd8\xf6\x0e\xd5\xea\x40\x37\x08\x33\x36\x8f\xb2\x58\x62\x30\xe8\xe3\x0b\x4b\xca\x53\x99\x2b\x0f\x9e\x48\xf6\xcc\x7e\xdd\x60\x88\x15\x97\x8b\x34\x93\x10\xe9\x8a\xcf\x26\xc1\xcf\x04\x3c\x99\xb1\xf5\xd5\x04\x55\xf9\x99\xff\x9d\x21\xef\x6b\xa5\xc9\xcd\x3c\xe6\xa4\xc9\x0e\x27\xc1\xd7\x00\x93\xa1\x94\x82\xf5\x01\x00\x00")
+
+func _1729600000_add_smart_accountsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729600000_add_smart_accountsUpSql,
+		"1729600000_add_smart_accounts.up.sql",
+	)
+}
+
+func _1729600000_add_smart_accountsUpSql() (*asset, error) {
+	bytes, err := _1729600000_add_smart_accountsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729600000_add_smart_accounts.up.sql", size: 498, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x63, 0xee, 0xb4, 0x9, 0x3f, 0x94, 0x2b, 0x7c, 0x86, 0xf6, 0x47, 0xf8, 0x92, 0x3a, 0x2a, 0x6e, 0x12, 0x85, 0x4a, 0x7e, 0xa4, 0xc1, 0x90, 0xc0, 0xc9, 0x3f, 0xe1, 0x21, 0x6c, 0x81, 0x3d, 0x98}}
+	return a, nil
+}
+
+var __1729700000_add_safesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x72\xda\x30\x10\xbd\xf3\x15\x7b\x0b\xcc\x98\x43\x7b\xe9\xa1\x27\x13\x1c\xa2\x29\x35\x19\x63\x9a\xe4\xe4\xd9\x4a\x0b\xd6\xc4\x48\x1e\x49\x06\x3e\xbf\x83\x6d\x8a\x83\x63\xbb\x99\xe9\xd1\x7e\x4f\xbb\x7a\x4f\x6f\x77\x3a\x05\x8b\x5b\xb2\xf0\x46\x94\x5b\x70\x29\xc1\x1a\xb7\x04\xfb\x22\x73\xd2\xca\x1d\x20\xe7\xba\x50\xce\x82\xde\x96\xe8\x11\xb3\x8c\x9c\x07\x47\xe9\xd2\xf3\x0f\x69\xc0\xa5\x86\x6c\xaa\x33\x01\xa8\x04\x28\xad\x38\x01\x5a\xc8\xd0\x3a\x30\x84\x02\xb4\x02\x9e\xa2\x54\xa3\xfb\x28\xf0\xe3\x00\x62\x7f\xb6\x0c\x80\x3d\x40\xb8\x8a\x21\x78\x61\xeb\x78\x5d\xdf\x62\x3c\x02\x80\x8a\x9c\x48\x01\x9b\x70\xcd\x16\x61\x30\x87\x19\x5b\xb0\x30\x2e\xf9\xe1\x66\xb9\xf4\x4a\x1a\x0a\x61\xc8\x5a\xf8\xe5\x47\xf7\x8f\x7e\x74\x83\x2a\xdc\x53\x0b\x82\x79\xf0\xe0\x6f\x96\x31\xdc\xdd\x55\x35\x0e\x64\xac\xd4\x6a\x98\x78\x15\xc9\xc2\x38\x58\x04\xad\x76\xa5\xec\x8f\xb1\x22\x17\xe8\x48\x24\xe8\x3a\x0e\x3f\x45\xec\xa7\x1f\xbd\xc2\x8f\xe0\x15\xc6\x17\xf5\xde\x45\xe0\x64\x34\x81\x67\x16\x3f\xae\x36\x31\x44\xab\x67\x36\xff\x3e\x1a\x72\x32\xd1\x47\x45\xe6\x93\x7e\x96\x07\xfb\x4d\x2d\xcb\x76\x60\xb9\xb6\xd2\x9d\xbd\xfc\x94\xc6\x66\x53\xaf\xaa\xff\x91\xde\x3a\xa7\x49\x6e\x74\xae\x2d\x66\xad\xc0\x3a\x83\xca\x22\x3f\x5f\xc0\x42\xc5\x22\x01\x4e\x9f\x33\x5a\x95\xb5\x1e\x1c\x30\x2b\xc8\x02\x1a\x82\x94\x4e\x40\x8a\x6b\x41\x62\xd0\xcc\x6b\xd3\xf1\xd5\x28\x77\x4a\x52\xb4\x69\xcb\x8c\xa6\x50\xef\x3f\xdb\xef\xf4\x00\xa1\x14\xd8\x81\x09\x74\x08\xb3\xe5\x6a\x56\x3f\x65\x4e\x06\x7b\xde\xeb\x22\x72\x87\x5d\xcd\x7e\xa3\xa5\x1e\x78\x87\x36\xc9\x8d\xe4\xd4\x83\x3b\xfd\x46\xed\xe1\xab\x70\x43\xdb\x42\x89\xc4\x10\x27\x79\xe8\x4c\x5d\xdf\xdc\xd5\x31\xe8\x3a\xca\x0d\xf5\x8f\x25\x9d\x88\x17\x67\xc6\xcd\x5b\x77\xcf\x23\x0b\xe7\xc1\xcb\x4d\x84\xa4\x38\x25\xef\x63\x54\x7e\xc2\x2a\x6c\xa5\xab\x6b\x2c\x4a\x91\x93\xc6\x18\x58\xb9\x53\xe8\x0a\xf3\x6e\x71\x07\xec\x69\xfa\xed\xcb\x57\x68\x80\xf5\xd2\xae\xb7\x01\xd7\x6a\x2b\xcd\x5e\xaa\x1d\x60\x6d\x0e\x66\x83\xe9\x6f\x94\xfb\x87\xf8\x0f\xef\x89\xbf\xf5\xca\x30\xf6\x6d\x89\x66\xa3\xee\xdd\xf0\x67\x00\x02\x64\xec\x7a\xc3\x06\x00\x00")
+
+func _1729700000_add_safesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1729700000_add_safesUpSql,
+		"1729700000_add_safes.up.sql",
+	)
+}
+
+func _1729700000_add_safesUpSql() (*asset, error) {
+	bytes, err := _1729700000_add_safesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1729700000_add_safes.up.sql", size: 1731, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xce, 0x63, 0x77, 0x65, 0x7d, 0x7a, 0xe2, 0xdc, 0xf3, 0x50, 0xaa, 0xe, 0xf0, 0x36, 0x8d, 0xa2, 0xa7, 0xff, 0x61, 0x9b, 0xa6, 0xe, 0x39, 0xd9, 0x62, 0xb1, 0x62, 0x60, 0xe9, 0x7, 0xad, 0xd1}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x41\x0e\x02\x31\x08\x05\xd0\x7d\x4f\xf1\x2f\x00\xe8\xca\xc4\xc4\xc3\xa0\x43\x08\x19\x5b\xc6\x96\xfb\xc7\x4d\xdf\xfe\x5d\xfa\x39\xd5\x0d\xeb\xf7\x6d\x4d\xc4\xf3\xe9\x36\x6c\x6a\x19\x3c\xe9\x1d\xe3\xd0\x52\x50\xcf\xa3\xa2\xdb\xeb\xfe\xb8\x6d\xa0\xeb\x74\xf4\xf0\xa9\x15\x39\x16\x28\xc1\x2c\x7b\xb0\x27\x58\xda\x3f\x00\x00\xff\xff\x57\xd4\xd5\x90\x5e\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -852,82 +956,60 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"1691753758_initial.up.sql": _1691753758_initialUpSql,
-
-	"1692701329_add_collectibles_and_collections_data_cache.up.sql": _1692701329_add_collectibles_and_collections_data_cacheUpSql,
-
-	"1692701339_add_scope_to_pending.up.sql": _1692701339_add_scope_to_pendingUpSql,
-
-	"1694540071_add_collectibles_ownership_update_timestamp.up.sql": _1694540071_add_collectibles_ownership_update_timestampUpSql,
-
-	"1694692748_add_raw_balance_to_token_balances.up.sql": _1694692748_add_raw_balance_to_token_balancesUpSql,
-
+	"1691753758_initial.up.sql":                                                     _1691753758_initialUpSql,
+	"1692701329_add_collectibles_and_collections_data_cache.up.sql":                 _1692701329_add_collectibles_and_collections_data_cacheUpSql,
+	"1692701339_add_scope_to_pending.up.sql":                                        _1692701339_add_scope_to_pendingUpSql,
+	"1694540071_add_collectibles_ownership_update_timestamp.up.sql":                 _1694540071_add_collectibles_ownership_update_timestampUpSql,
+	"1694692748_add_raw_balance_to_token_balances.up.sql":                           _1694692748_add_raw_balance_to_token_balancesUpSql,
 	"1695133989_add_community_id_to_collectibles_and_collections_data_cache.up.sql": _1695133989_add_community_id_to_collectibles_and_collections_data_cacheUpSql,
-
-	"1695932536_balance_history_v2.up.sql": _1695932536_balance_history_v2UpSql,
-
-	"1696853635_input_data.up.sql": _1696853635_input_dataUpSql,
-
-	"1698117918_add_community_id_to_tokens.up.sql": _1698117918_add_community_id_to_tokensUpSql,
-
-	"1698257443_add_community_metadata_to_wallet_db.up.sql": _1698257443_add_community_metadata_to_wallet_dbUpSql,
-
-	"1699987075_add_timestamp_and_state_to_community_data_cache.up.sql": _1699987075_add_timestamp_and_state_to_community_data_cacheUpSql,
-
-	"1700414564_add_wallet_connect_pairings_table.up.sql": _1700414564_add_wallet_connect_pairings_tableUpSql,
-
-	"1701101493_add_token_blocks_range.up.sql": _1701101493_add_token_blocks_rangeUpSql,
-
-	"1702467441_wallet_connect_sessions_instead_of_pairings.up.sql": _1702467441_wallet_connect_sessions_instead_of_pairingsUpSql,
-
-	"1702577524_add_community_collections_and_collectibles_images_cache.up.sql": _1702577524_add_community_collections_and_collectibles_images_cacheUpSql,
-
-	"1702867707_add_balance_to_collectibles_ownership_cache.up.sql": _1702867707_add_balance_to_collectibles_ownership_cacheUpSql,
-
-	"1703686612_add_color_to_saved_addresses.up.sql": _1703686612_add_color_to_saved_addressesUpSql,
-
+	"1695932536_balance_history_v2.up.sql":                                          _1695932536_balance_history_v2UpSql,
+	"1696853635_input_data.up.sql":                                                  _1696853635_input_dataUpSql,
+	"1698117918_add_community_id_to_tokens.up.sql":                                  _1698117918_add_community_id_to_tokensUpSql,
+	"1698257443_add_community_metadata_to_wallet_db.up.sql":                         _1698257443_add_community_metadata_to_wallet_dbUpSql,
+	"1699987075_add_timestamp_and_state_to_community_data_cache.up.sql":             _1699987075_add_timestamp_and_state_to_community_data_cacheUpSql,
+	"1700414564_add_wallet_connect_pairings_table.up.sql":                           _1700414564_add_wallet_connect_pairings_tableUpSql,
+	"1701101493_add_token_blocks_range.up.sql":                                      _1701101493_add_token_blocks_rangeUpSql,
+	"1702467441_wallet_connect_sessions_instead_of_pairings.up.sql":                 _1702467441_wallet_connect_sessions_instead_of_pairingsUpSql,
+	"1702577524_add_community_collections_and_collectibles_images_cache.up.sql":     _1702577524_add_community_collections_and_collectibles_images_cacheUpSql,
+	"1702867707_add_balance_to_collectibles_ownership_cache.up.sql":                 _1702867707_add_balance_to_collectibles_ownership_cacheUpSql,
+	"1703686612_add_color_to_saved_addresses.up.sql":                                _1703686612_add_color_to_saved_addressesUpSql,
 	"1704701942_remove_favourite_and_change_primary_key_for_saved_addresses.up.sql": _1704701942_remove_favourite_and_change_primary_key_for_saved_addressesUpSql,
-
-	"1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cache.up.sql": _1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cacheUpSql,
-
-	"1705664490_add_balance_check_fields_blocks_ranges_sequential.up.sql": _1705664490_add_balance_check_fields_blocks_ranges_sequentialUpSql,
-
-	"1706531789_remove_gasfee-only-eth-transfers.up.sql": _1706531789_remove_gasfeeOnlyEthTransfersUpSql,
-
-	"1707160323_add_contract_type_table.up.sql": _1707160323_add_contract_type_tableUpSql,
-
-	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql": _1708089811_add_nullable_fiesl_blocks_rangesUpSql,
-
-	"1710189541_add_nonce_to_pending_transactions.up.sql": _1710189541_add_nonce_to_pending_transactionsUpSql,
-
-	"1712567001_add_soulbound_collectible_cache.up.sql": _1712567001_add_soulbound_collectible_cacheUpSql,
-
-	"1714670633_add_id_to_multi_transaction_table.up.sql": _1714670633_add_id_to_multi_transaction_tableUpSql,
-
-	"1715637927_add_collection_socials.up.sql": _1715637927_add_collection_socialsUpSql,
-
-	"1715839555_rename_chain_prefixes.up.sql": _1715839555_rename_chain_prefixesUpSql,
-
-	"1716313614_add_rpc_limits_table.up.sql": _1716313614_add_rpc_limits_tableUpSql,
-
-	"1716912885_add_wallet_connect_dapps.up.sql": _1716912885_add_wallet_connect_dappsUpSql,
-
-	"1721136888_recreate_indices_balance_history_remove_dups.up.sql": _1721136888_recreate_indices_balance_history_remove_dupsUpSql,
-
-	"1721306883_add_connector_dapps.up.sql": _1721306883_add_connector_dappsUpSql,
-
-	"doc.go": docGo,
-}
+	"1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cache.up.sql":   _1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cacheUpSql,
+	"1705664490_add_balance_check_fields_blocks_ranges_sequential.up.sql":           _1705664490_add_balance_check_fields_blocks_ranges_sequentialUpSql,
+	"1706531789_remove_gasfee-only-eth-transfers.up.sql":                            _1706531789_remove_gasfeeOnlyEthTransfersUpSql,
+	"1707160323_add_contract_type_table.up.sql":                                     _1707160323_add_contract_type_tableUpSql,
+	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql":                            _1708089811_add_nullable_fiesl_blocks_rangesUpSql,
+	"1710189541_add_nonce_to_pending_transactions.up.sql":                           _1710189541_add_nonce_to_pending_transactionsUpSql,
+	"1712567001_add_soulbound_collectible_cache.up.sql":                             _1712567001_add_soulbound_collectible_cacheUpSql,
+	"1714670633_add_id_to_multi_transaction_table.up.sql":                           _1714670633_add_id_to_multi_transaction_tableUpSql,
+	"1715637927_add_collection_socials.up.sql":                                      _1715637927_add_collection_socialsUpSql,
+	"1715839555_rename_chain_prefixes.up.sql":                                       _1715839555_rename_chain_prefixesUpSql,
+	"1716313614_add_rpc_limits_table.up.sql":                                        _1716313614_add_rpc_limits_tableUpSql,
+	"1716912885_add_wallet_connect_dapps.up.sql":                                    _1716912885_add_wallet_connect_dappsUpSql,
+	"1721136888_recreate_indices_balance_history_remove_dups.up.sql":                _1721136888_recreate_indices_balance_history_remove_dupsUpSql,
+	"1721306883_add_connector_dapps.up.sql":                                         _1721306883_add_connector_dappsUpSql,
+	"1729241520_add_token_lists.up.sql":                                             _1729241520_add_token_listsUpSql,
+	"1729258260_add_spam_contracts.up.sql":                                          _1729258260_add_spam_contractsUpSql,
+	"1729500000_add_collectibles_value.up.sql":                                      _1729500000_add_collectibles_valueUpSql,
+	"1729600000_add_smart_accounts.up.sql":                                          _1729600000_add_smart_accountsUpSql,
+	"1729700000_add_safes.up.sql":                                                   _1729700000_add_safesUpSql,
+	"doc.go":                                                                        docGo,
+}
+
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"},
 // AssetDir("data/img") would return []string{"a.png", "b.png"},
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
@@ -960,39 +1042,44 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"1691753758_initial.up.sql":                                                     &bintree{_1691753758_initialUpSql, map[string]*bintree{}},
-	"1692701329_add_collectibles_and_collections_data_cache.up.sql":                 &bintree{_1692701329_add_collectibles_and_collections_data_cacheUpSql, map[string]*bintree{}},
-	"1692701339_add_scope_to_pending.up.sql":                                        &bintree{_1692701339_add_scope_to_pendingUpSql, map[string]*bintree{}},
-	"1694540071_add_collectibles_ownership_update_timestamp.up.sql":                 &bintree{_1694540071_add_collectibles_ownership_update_timestampUpSql, map[string]*bintree{}},
-	"1694692748_add_raw_balance_to_token_balances.up.sql":                           &bintree{_1694692748_add_raw_balance_to_token_balancesUpSql, map[string]*bintree{}},
-	"1695133989_add_community_id_to_collectibles_and_collections_data_cache.up.sql": &bintree{_1695133989_add_community_id_to_collectibles_and_collections_data_cacheUpSql, map[string]*bintree{}},
-	"1695932536_balance_history_v2.up.sql":                                          &bintree{_1695932536_balance_history_v2UpSql, map[string]*bintree{}},
-	"1696853635_input_data.up.sql":                                                  &bintree{_1696853635_input_dataUpSql, map[string]*bintree{}},
-	"1698117918_add_community_id_to_tokens.up.sql":                                  &bintree{_1698117918_add_community_id_to_tokensUpSql, map[string]*bintree{}},
-	"1698257443_add_community_metadata_to_wallet_db.up.sql":                         &bintree{_1698257443_add_community_metadata_to_wallet_dbUpSql, map[string]*bintree{}},
-	"1699987075_add_timestamp_and_state_to_community_data_cache.up.sql":             &bintree{_1699987075_add_timestamp_and_state_to_community_data_cacheUpSql, map[string]*bintree{}},
-	"1700414564_add_wallet_connect_pairings_table.up.sql":                           &bintree{_1700414564_add_wallet_connect_pairings_tableUpSql, map[string]*bintree{}},
-	"1701101493_add_token_blocks_range.up.sql":                                      &bintree{_1701101493_add_token_blocks_rangeUpSql, map[string]*bintree{}},
-	"1702467441_wallet_connect_sessions_instead_of_pairings.up.sql":                 &bintree{_1702467441_wallet_connect_sessions_instead_of_pairingsUpSql, map[string]*bintree{}},
-	"1702577524_add_community_collections_and_collectibles_images_cache.up.sql":     &bintree{_1702577524_add_community_collections_and_collectibles_images_cacheUpSql, map[string]*bintree{}},
-	"1702867707_add_balance_to_collectibles_ownership_cache.up.sql":                 &bintree{_1702867707_add_balance_to_collectibles_ownership_cacheUpSql, map[string]*bintree{}},
-	"1703686612_add_color_to_saved_addresses.up.sql":                                &bintree{_1703686612_add_color_to_saved_addressesUpSql, map[string]*bintree{}},
-	"1704701942_remove_favourite_and_change_primary_key_for_saved_addresses.up.sql": &bintree{_1704701942_remove_favourite_and_change_primary_key_for_saved_addressesUpSql, map[string]*bintree{}},
-	"1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cache.up.sql":   &bintree{_1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cacheUpSql, map[string]*bintree{}},
-	"1705664490_add_balance_check_fields_blocks_ranges_sequential.up.sql":           &bintree{_1705664490_add_balance_check_fields_blocks_ranges_sequentialUpSql, map[string]*bintree{}},
-	"1706531789_remove_gasfee-only-eth-transfers.up.sql":                            &bintree{_1706531789_remove_gasfeeOnlyEthTransfersUpSql, map[string]*bintree{}},
-	"1707160323_add_contract_type_table.up.sql":                                     &bintree{_1707160323_add_contract_type_tableUpSql, map[string]*bintree{}},
-	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql":                            &bintree{_1708089811_add_nullable_fiesl_blocks_rangesUpSql, map[string]*bintree{}},
-	"1710189541_add_nonce_to_pending_transactions.up.sql":                           &bintree{_1710189541_add_nonce_to_pending_transactionsUpSql, map[string]*bintree{}},
-	"1712567001_add_soulbound_collectible_cache.up.sql":                             &bintree{_1712567001_add_soulbound_collectible_cacheUpSql, map[string]*bintree{}},
-	"1714670633_add_id_to_multi_transaction_table.up.sql":                           &bintree{_1714670633_add_id_to_multi_transaction_tableUpSql, map[string]*bintree{}},
-	"1715637927_add_collection_socials.up.sql":                                      &bintree{_1715637927_add_collection_socialsUpSql, map[string]*bintree{}},
-	"1715839555_rename_chain_prefixes.up.sql":                                       &bintree{_1715839555_rename_chain_prefixesUpSql, map[string]*bintree{}},
-	"1716313614_add_rpc_limits_table.up.sql":                                        &bintree{_1716313614_add_rpc_limits_tableUpSql, map[string]*bintree{}},
-	"1716912885_add_wallet_connect_dapps.up.sql":                                    &bintree{_1716912885_add_wallet_connect_dappsUpSql, map[string]*bintree{}},
-	"1721136888_recreate_indices_balance_history_remove_dups.up.sql":                &bintree{_1721136888_recreate_indices_balance_history_remove_dupsUpSql, map[string]*bintree{}},
-	"1721306883_add_connector_dapps.up.sql":                                         &bintree{_1721306883_add_connector_dappsUpSql, map[string]*bintree{}},
-	"doc.go":                                                                        &bintree{docGo, map[string]*bintree{}},
+	"1691753758_initial.up.sql":                                                     {_1691753758_initialUpSql, map[string]*bintree{}},
+	"1692701329_add_collectibles_and_collections_data_cache.up.sql":                 {_1692701329_add_collectibles_and_collections_data_cacheUpSql, map[string]*bintree{}},
+	"1692701339_add_scope_to_pending.up.sql":                                        {_1692701339_add_scope_to_pendingUpSql, map[string]*bintree{}},
+	"1694540071_add_collectibles_ownership_update_timestamp.up.sql":                 {_1694540071_add_collectibles_ownership_update_timestampUpSql, map[string]*bintree{}},
+	"1694692748_add_raw_balance_to_token_balances.up.sql":                           {_1694692748_add_raw_balance_to_token_balancesUpSql, map[string]*bintree{}},
+	"1695133989_add_community_id_to_collectibles_and_collections_data_cache.up.sql": {_1695133989_add_community_id_to_collectibles_and_collections_data_cacheUpSql, map[string]*bintree{}},
+	"1695932536_balance_history_v2.up.sql":                                          {_1695932536_balance_history_v2UpSql, map[string]*bintree{}},
+	"1696853635_input_data.up.sql":                                                  {_1696853635_input_dataUpSql, map[string]*bintree{}},
+	"1698117918_add_community_id_to_tokens.up.sql":                                  {_1698117918_add_community_id_to_tokensUpSql, map[string]*bintree{}},
+	"1698257443_add_community_metadata_to_wallet_db.up.sql":                         {_1698257443_add_community_metadata_to_wallet_dbUpSql, map[string]*bintree{}},
+	"1699987075_add_timestamp_and_state_to_community_data_cache.up.sql":             {_1699987075_add_timestamp_and_state_to_community_data_cacheUpSql, map[string]*bintree{}},
+	"1700414564_add_wallet_connect_pairings_table.up.sql":                           {_1700414564_add_wallet_connect_pairings_tableUpSql, map[string]*bintree{}},
+	"1701101493_add_token_blocks_range.up.sql":                                      {_1701101493_add_token_blocks_rangeUpSql, map[string]*bintree{}},
+	"1702467441_wallet_connect_sessions_instead_of_pairings.up.sql":                 {_1702467441_wallet_connect_sessions_instead_of_pairingsUpSql, map[string]*bintree{}},
+	"1702577524_add_community_collections_and_collectibles_images_cache.up.sql":     {_1702577524_add_community_collections_and_collectibles_images_cacheUpSql, map[string]*bintree{}},
+	"1702867707_add_balance_to_collectibles_ownership_cache.up.sql":                 {_1702867707_add_balance_to_collectibles_ownership_cacheUpSql, map[string]*bintree{}},
+	"1703686612_add_color_to_saved_addresses.up.sql":                                {_1703686612_add_color_to_saved_addressesUpSql, map[string]*bintree{}},
+	"1704701942_remove_favourite_and_change_primary_key_for_saved_addresses.up.sql": {_1704701942_remove_favourite_and_change_primary_key_for_saved_addressesUpSql, map[string]*bintree{}},
+	"1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cache.up.sql":   {_1704913491_add_type_and_tx_timestamp_to_collectibles_ownership_cacheUpSql, map[string]*bintree{}},
+	"1705664490_add_balance_check_fields_blocks_ranges_sequential.up.sql":           {_1705664490_add_balance_check_fields_blocks_ranges_sequentialUpSql, map[string]*bintree{}},
+	"1706531789_remove_gasfee-only-eth-transfers.up.sql":                            {_1706531789_remove_gasfeeOnlyEthTransfersUpSql, map[string]*bintree{}},
+	"1707160323_add_contract_type_table.up.sql":                                     {_1707160323_add_contract_type_tableUpSql, map[string]*bintree{}},
+	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql":                            {_1708089811_add_nullable_fiesl_blocks_rangesUpSql, map[string]*bintree{}},
+	"1710189541_add_nonce_to_pending_transactions.up.sql":                           {_1710189541_add_nonce_to_pending_transactionsUpSql, map[string]*bintree{}},
+	"1712567001_add_soulbound_collectible_cache.up.sql":                             {_1712567001_add_soulbound_collectible_cacheUpSql, map[string]*bintree{}},
+	"1714670633_add_id_to_multi_transaction_table.up.sql":                           {_1714670633_add_id_to_multi_transaction_tableUpSql, map[string]*bintree{}},
+	"1715637927_add_collection_socials.up.sql":                                      {_1715637927_add_collection_socialsUpSql, map[string]*bintree{}},
+	"1715839555_rename_chain_prefixes.up.sql":                                       {_1715839555_rename_chain_prefixesUpSql, map[string]*bintree{}},
+	"1716313614_add_rpc_limits_table.up.sql":                                        {_1716313614_add_rpc_limits_tableUpSql, map[string]*bintree{}},
+	"1716912885_add_wallet_connect_dapps.up.sql":                                    {_1716912885_add_wallet_connect_dappsUpSql, map[string]*bintree{}},
+	"1721136888_recreate_indices_balance_history_remove_dups.up.sql":                {_1721136888_recreate_indices_balance_history_remove_dupsUpSql, map[string]*bintree{}},
+	"1721306883_add_connector_dapps.up.sql":                                         {_1721306883_add_connector_dappsUpSql, map[string]*bintree{}},
+	"1729241520_add_token_lists.up.sql":                                             {_1729241520_add_token_listsUpSql, map[string]*bintree{}},
+	"1729258260_add_spam_contracts.up.sql":                                          {_1729258260_add_spam_contractsUpSql, map[string]*bintree{}},
+	"1729500000_add_collectibles_value.up.sql":                                      {_1729500000_add_collectibles_valueUpSql, map[string]*bintree{}},
+	"1729600000_add_smart_accounts.up.sql":                                          {_1729600000_add_smart_accountsUpSql, map[string]*bintree{}},
+	"1729700000_add_safes.up.sql":                                                   {_1729700000_add_safesUpSql, map[string]*bintree{}},
+	"doc.go":                                                                        {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -1009,7 +1096,7 @@ func RestoreAsset(dir, name string) error {
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	err = os.WriteFile(_filePath(dir, name), data, info.Mode())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,10 @@
+-- Token lists in the Uniswap token-list format subscribed to by URL.
+-- The content of the last valid version fetched is cached so that lists are available offline.
+CREATE TABLE IF NOT EXISTS token_lists (
+    url TEXT NOT NULL PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    added_at INTEGER NOT NULL,
+    fetched_at INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    content BLOB
+);
@@ -0,0 +1,29 @@
+-- spam_contracts keeps the spam classification of token and collectible contracts.
+-- The heuristic status is computed by the wallet, the user status overrides it and
+-- blocked contracts come from the block list imported by the user.
+CREATE TABLE IF NOT EXISTS spam_contracts (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    contract_address VARCHAR NOT NULL,
+    heuristic_status INTEGER NOT NULL DEFAULT 0,
+    reasons TEXT NOT NULL DEFAULT '',
+    user_status INTEGER NOT NULL DEFAULT 0,
+    blocked BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (chain_id, contract_address)
+) WITHOUT ROWID;
+
+-- Contracts hidden when filtering out spam, statuses match spam.Status
+CREATE VIEW IF NOT EXISTS spam_contracts_hidden AS
+SELECT
+    chain_id,
+    contract_address
+FROM
+    spam_contracts
+WHERE
+    user_status = 3
+    OR (
+        user_status = 0
+        AND (
+            blocked
+            OR heuristic_status = 3
+        )
+    );
@@ -0,0 +1,21 @@
+-- collection_price_data_cache keeps the latest floor and last sale prices of collections,
+-- in the native currency of the chain. Entries are refetched once older than the TTL.
+CREATE TABLE IF NOT EXISTS collection_price_data_cache (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    contract_address VARCHAR NOT NULL,
+    provider VARCHAR NOT NULL,
+    floor_price REAL NOT NULL DEFAULT 0,
+    last_sale_price REAL NOT NULL DEFAULT 0,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, contract_address)
+) WITHOUT ROWID;
+
+-- collectibles_value_history keeps snapshots of the value of the collectibles of an account,
+-- in the native currency of the chain
+CREATE TABLE IF NOT EXISTS collectibles_value_history (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    address VARCHAR NOT NULL,
+    timestamp INTEGER NOT NULL,
+    value REAL NOT NULL,
+    PRIMARY KEY (chain_id, address, timestamp)
+) WITHOUT ROWID;
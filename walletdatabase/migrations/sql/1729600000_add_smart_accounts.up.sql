@@ -0,0 +1,12 @@
+-- smart_accounts keeps the ERC-4337 smart accounts owned by keypair accounts.
+-- The address is counterfactual until the first UserOperation deploys the account.
+CREATE TABLE IF NOT EXISTS smart_accounts (
+    address VARCHAR NOT NULL PRIMARY KEY,
+    owner VARCHAR NOT NULL,
+    salt BLOB NOT NULL,
+    factory VARCHAR NOT NULL,
+    name VARCHAR NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_smart_accounts_owner ON smart_accounts (owner);
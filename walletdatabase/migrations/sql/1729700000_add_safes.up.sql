@@ -0,0 +1,49 @@
+-- safes keeps the Safe multisig accounts of the wallet, with their threshold and nonce as last read on chain
+CREATE TABLE IF NOT EXISTS safes (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    address VARCHAR NOT NULL,
+    name VARCHAR NOT NULL DEFAULT '',
+    version VARCHAR NOT NULL DEFAULT '',
+    threshold INTEGER NOT NULL,
+    nonce INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, address)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS safe_owners (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    safe_address VARCHAR NOT NULL,
+    owner VARCHAR NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, safe_address, owner)
+) WITHOUT ROWID;
+
+-- safe_proposals keeps the Safe transactions proposed to the owners, values are hex encoded
+CREATE TABLE IF NOT EXISTS safe_proposals (
+    safe_tx_hash VARCHAR NOT NULL PRIMARY KEY,
+    chain_id UNSIGNED BIGINT NOT NULL,
+    safe_address VARCHAR NOT NULL,
+    to_address VARCHAR NOT NULL,
+    value VARCHAR NOT NULL,
+    data BLOB,
+    operation INTEGER NOT NULL,
+    safe_tx_gas VARCHAR NOT NULL,
+    base_gas VARCHAR NOT NULL,
+    gas_price VARCHAR NOT NULL,
+    gas_token VARCHAR NOT NULL,
+    refund_receiver VARCHAR NOT NULL,
+    nonce INTEGER NOT NULL,
+    proposer VARCHAR NOT NULL,
+    created_at INTEGER NOT NULL,
+    executed_tx_hash VARCHAR
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_safe_proposals_safe ON safe_proposals (chain_id, safe_address, nonce);
+
+-- safe_signatures keeps the EIP-712 signatures of the owners confirming a proposal
+CREATE TABLE IF NOT EXISTS safe_signatures (
+    safe_tx_hash VARCHAR NOT NULL,
+    owner VARCHAR NOT NULL,
+    signature BLOB NOT NULL,
+    PRIMARY KEY (safe_tx_hash, owner)
+) WITHOUT ROWID;